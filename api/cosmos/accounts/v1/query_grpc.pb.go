@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_AccountQuery_FullMethodName  = "/cosmos.accounts.v1.Query/AccountQuery"
-	Query_Schema_FullMethodName        = "/cosmos.accounts.v1.Query/Schema"
-	Query_AccountType_FullMethodName   = "/cosmos.accounts.v1.Query/AccountType"
-	Query_AccountNumber_FullMethodName = "/cosmos.accounts.v1.Query/AccountNumber"
+	Query_AccountQuery_FullMethodName   = "/cosmos.accounts.v1.Query/AccountQuery"
+	Query_Schema_FullMethodName         = "/cosmos.accounts.v1.Query/Schema"
+	Query_AccountType_FullMethodName    = "/cosmos.accounts.v1.Query/AccountType"
+	Query_AccountNumber_FullMethodName  = "/cosmos.accounts.v1.Query/AccountNumber"
+	Query_SimulateBundle_FullMethodName = "/cosmos.accounts.v1.Query/SimulateBundle"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +38,11 @@ type QueryClient interface {
 	AccountType(ctx context.Context, in *AccountTypeRequest, opts ...grpc.CallOption) (*AccountTypeResponse, error)
 	// AccountNumber returns the account number given the account address.
 	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
+	// SimulateBundle simulates the authentication and the execution of the txs
+	// of a bundle, without persisting any state change. It is used by bundlers
+	// to check that bundled txs succeed and to estimate their gas, before
+	// sending a MsgExecuteBundle.
+	SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error)
 }
 
 type queryClient struct {
@@ -83,6 +89,15 @@ func (c *queryClient) AccountNumber(ctx context.Context, in *AccountNumberReques
 	return out, nil
 }
 
+func (c *queryClient) SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error) {
+	out := new(SimulateBundleResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -95,6 +110,11 @@ type QueryServer interface {
 	AccountType(context.Context, *AccountTypeRequest) (*AccountTypeResponse, error)
 	// AccountNumber returns the account number given the account address.
 	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
+	// SimulateBundle simulates the authentication and the execution of the txs
+	// of a bundle, without persisting any state change. It is used by bundlers
+	// to check that bundled txs succeed and to estimate their gas, before
+	// sending a MsgExecuteBundle.
+	SimulateBundle(context.Context, *SimulateBundleRequest) (*SimulateBundleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -114,6 +134,9 @@ func (UnimplementedQueryServer) AccountType(context.Context, *AccountTypeRequest
 func (UnimplementedQueryServer) AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountNumber not implemented")
 }
+func (UnimplementedQueryServer) SimulateBundle(context.Context, *SimulateBundleRequest) (*SimulateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBundle not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +222,24 @@ func _Query_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBundle(ctx, req.(*SimulateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +263,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountNumber",
 			Handler:    _Query_AccountNumber_Handler,
 		},
+		{
+			MethodName: "SimulateBundle",
+			Handler:    _Query_SimulateBundle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/accounts/v1/query.proto",
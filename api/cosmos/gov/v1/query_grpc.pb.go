@@ -32,6 +32,12 @@ const (
 	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
 	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
 	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_Delegate_FullMethodName            = "/cosmos.gov.v1.Query/Delegate"
+	Query_Delegates_FullMethodName           = "/cosmos.gov.v1.Query/Delegates"
+	Query_DelegateDelegators_FullMethodName  = "/cosmos.gov.v1.Query/DelegateDelegators"
+	Query_DelegatorDelegate_FullMethodName   = "/cosmos.gov.v1.Query/DelegatorDelegate"
+	Query_QueuedProposals_FullMethodName     = "/cosmos.gov.v1.Query/QueuedProposals"
+	Query_SimulateProposal_FullMethodName    = "/cosmos.gov.v1.Query/SimulateProposal"
 )
 
 // QueryClient is the client API for Query service.
@@ -62,6 +68,28 @@ type QueryClient interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// Delegate queries a governance delegate by its address.
+	// Since: cosmos-sdk x/gov v1.0.0
+	Delegate(ctx context.Context, in *QueryDelegateRequest, opts ...grpc.CallOption) (*QueryDelegateResponse, error)
+	// Delegates queries all governance delegates.
+	// Since: cosmos-sdk x/gov v1.0.0
+	Delegates(ctx context.Context, in *QueryDelegatesRequest, opts ...grpc.CallOption) (*QueryDelegatesResponse, error)
+	// DelegateDelegators queries the delegators which assigned their governance
+	// voting power to a delegate.
+	// Since: cosmos-sdk x/gov v1.0.0
+	DelegateDelegators(ctx context.Context, in *QueryDelegateDelegatorsRequest, opts ...grpc.CallOption) (*QueryDelegateDelegatorsResponse, error)
+	// DelegatorDelegate queries the governance delegate a delegator assigned its
+	// governance voting power to.
+	// Since: cosmos-sdk x/gov v1.0.0
+	DelegatorDelegate(ctx context.Context, in *QueryDelegatorDelegateRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegateResponse, error)
+	// QueuedProposals queries the proposals waiting for their execution time,
+	// ordered by execution time.
+	// Since: cosmos-sdk x/gov v1.0.0
+	QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error)
+	// SimulateProposal executes the messages of a proposal as the governance module
+	// account in a branched context whose state changes are always discarded.
+	// Since: cosmos-sdk x/gov v1.0.0
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
 }
 
 type queryClient struct {
@@ -171,6 +199,60 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) Delegate(ctx context.Context, in *QueryDelegateRequest, opts ...grpc.CallOption) (*QueryDelegateResponse, error) {
+	out := new(QueryDelegateResponse)
+	err := c.cc.Invoke(ctx, Query_Delegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Delegates(ctx context.Context, in *QueryDelegatesRequest, opts ...grpc.CallOption) (*QueryDelegatesResponse, error) {
+	out := new(QueryDelegatesResponse)
+	err := c.cc.Invoke(ctx, Query_Delegates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegateDelegators(ctx context.Context, in *QueryDelegateDelegatorsRequest, opts ...grpc.CallOption) (*QueryDelegateDelegatorsResponse, error) {
+	out := new(QueryDelegateDelegatorsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegateDelegators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorDelegate(ctx context.Context, in *QueryDelegatorDelegateRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegateResponse, error) {
+	out := new(QueryDelegatorDelegateResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedProposals(ctx context.Context, in *QueryQueuedProposalsRequest, opts ...grpc.CallOption) (*QueryQueuedProposalsResponse, error) {
+	out := new(QueryQueuedProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedProposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -199,6 +281,28 @@ type QueryServer interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// Delegate queries a governance delegate by its address.
+	// Since: cosmos-sdk x/gov v1.0.0
+	Delegate(context.Context, *QueryDelegateRequest) (*QueryDelegateResponse, error)
+	// Delegates queries all governance delegates.
+	// Since: cosmos-sdk x/gov v1.0.0
+	Delegates(context.Context, *QueryDelegatesRequest) (*QueryDelegatesResponse, error)
+	// DelegateDelegators queries the delegators which assigned their governance
+	// voting power to a delegate.
+	// Since: cosmos-sdk x/gov v1.0.0
+	DelegateDelegators(context.Context, *QueryDelegateDelegatorsRequest) (*QueryDelegateDelegatorsResponse, error)
+	// DelegatorDelegate queries the governance delegate a delegator assigned its
+	// governance voting power to.
+	// Since: cosmos-sdk x/gov v1.0.0
+	DelegatorDelegate(context.Context, *QueryDelegatorDelegateRequest) (*QueryDelegatorDelegateResponse, error)
+	// QueuedProposals queries the proposals waiting for their execution time,
+	// ordered by execution time.
+	// Since: cosmos-sdk x/gov v1.0.0
+	QueuedProposals(context.Context, *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error)
+	// SimulateProposal executes the messages of a proposal as the governance module
+	// account in a branched context whose state changes are always discarded.
+	// Since: cosmos-sdk x/gov v1.0.0
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -239,6 +343,24 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) Delegate(context.Context, *QueryDelegateRequest) (*QueryDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
+}
+func (UnimplementedQueryServer) Delegates(context.Context, *QueryDelegatesRequest) (*QueryDelegatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegates not implemented")
+}
+func (UnimplementedQueryServer) DelegateDelegators(context.Context, *QueryDelegateDelegatorsRequest) (*QueryDelegateDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateDelegators not implemented")
+}
+func (UnimplementedQueryServer) DelegatorDelegate(context.Context, *QueryDelegatorDelegateRequest) (*QueryDelegatorDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorDelegate not implemented")
+}
+func (UnimplementedQueryServer) QueuedProposals(context.Context, *QueryQueuedProposalsRequest) (*QueryQueuedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedProposals not implemented")
+}
+func (UnimplementedQueryServer) SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -450,6 +572,114 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Delegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Delegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Delegate(ctx, req.(*QueryDelegateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Delegates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Delegates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Delegates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Delegates(ctx, req.(*QueryDelegatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegateDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegateDelegators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateDelegators(ctx, req.(*QueryDelegateDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorDelegateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorDelegate(ctx, req.(*QueryDelegatorDelegateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedProposals(ctx, req.(*QueryQueuedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -501,6 +731,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "Delegate",
+			Handler:    _Query_Delegate_Handler,
+		},
+		{
+			MethodName: "Delegates",
+			Handler:    _Query_Delegates_Handler,
+		},
+		{
+			MethodName: "DelegateDelegators",
+			Handler:    _Query_DelegateDelegators_Handler,
+		},
+		{
+			MethodName: "DelegatorDelegate",
+			Handler:    _Query_DelegatorDelegate_Handler,
+		},
+		{
+			MethodName: "QueuedProposals",
+			Handler:    _Query_QueuedProposals_Handler,
+		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
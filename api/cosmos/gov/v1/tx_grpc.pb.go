@@ -31,6 +31,11 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.gov.v1.Msg/SudoExec"
+	Msg_RegisterDelegate_FullMethodName             = "/cosmos.gov.v1.Msg/RegisterDelegate"
+	Msg_UnregisterDelegate_FullMethodName           = "/cosmos.gov.v1.Msg/UnregisterDelegate"
+	Msg_AssignDelegate_FullMethodName               = "/cosmos.gov.v1.Msg/AssignDelegate"
+	Msg_UnassignDelegate_FullMethodName             = "/cosmos.gov.v1.Msg/UnassignDelegate"
+	Msg_CancelQueuedProposal_FullMethodName         = "/cosmos.gov.v1.Msg/CancelQueuedProposal"
 )
 
 // MsgClient is the client API for Msg service.
@@ -70,6 +75,30 @@ type MsgClient interface {
 	//
 	// Since: x/gov 1.0.0
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// RegisterDelegate defines a method to register an account as a governance delegate.
+	//
+	// Since: x/gov 1.0.0
+	RegisterDelegate(ctx context.Context, in *MsgRegisterDelegate, opts ...grpc.CallOption) (*MsgRegisterDelegateResponse, error)
+	// UnregisterDelegate defines a method to unregister a governance delegate. The
+	// governance voting power assigned to the delegate is unassigned.
+	//
+	// Since: x/gov 1.0.0
+	UnregisterDelegate(ctx context.Context, in *MsgUnregisterDelegate, opts ...grpc.CallOption) (*MsgUnregisterDelegateResponse, error)
+	// AssignDelegate defines a method to assign the governance voting power of a
+	// delegator to a governance delegate, replacing any previous assignment.
+	//
+	// Since: x/gov 1.0.0
+	AssignDelegate(ctx context.Context, in *MsgAssignDelegate, opts ...grpc.CallOption) (*MsgAssignDelegateResponse, error)
+	// UnassignDelegate defines a method to remove the assignment of the governance
+	// voting power of a delegator.
+	//
+	// Since: x/gov 1.0.0
+	UnassignDelegate(ctx context.Context, in *MsgUnassignDelegate, opts ...grpc.CallOption) (*MsgUnassignDelegateResponse, error)
+	// CancelQueuedProposal defines a method for a timelock guardian to cancel a
+	// queued proposal before its execution time.
+	//
+	// Since: x/gov 1.0.0
+	CancelQueuedProposal(ctx context.Context, in *MsgCancelQueuedProposal, opts ...grpc.CallOption) (*MsgCancelQueuedProposalResponse, error)
 }
 
 type msgClient struct {
@@ -170,6 +199,51 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) RegisterDelegate(ctx context.Context, in *MsgRegisterDelegate, opts ...grpc.CallOption) (*MsgRegisterDelegateResponse, error) {
+	out := new(MsgRegisterDelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterDelegate(ctx context.Context, in *MsgUnregisterDelegate, opts ...grpc.CallOption) (*MsgUnregisterDelegateResponse, error) {
+	out := new(MsgUnregisterDelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_UnregisterDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AssignDelegate(ctx context.Context, in *MsgAssignDelegate, opts ...grpc.CallOption) (*MsgAssignDelegateResponse, error) {
+	out := new(MsgAssignDelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_AssignDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnassignDelegate(ctx context.Context, in *MsgUnassignDelegate, opts ...grpc.CallOption) (*MsgUnassignDelegateResponse, error) {
+	out := new(MsgUnassignDelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_UnassignDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelQueuedProposal(ctx context.Context, in *MsgCancelQueuedProposal, opts ...grpc.CallOption) (*MsgCancelQueuedProposalResponse, error) {
+	out := new(MsgCancelQueuedProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelQueuedProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -207,6 +281,30 @@ type MsgServer interface {
 	//
 	// Since: x/gov 1.0.0
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// RegisterDelegate defines a method to register an account as a governance delegate.
+	//
+	// Since: x/gov 1.0.0
+	RegisterDelegate(context.Context, *MsgRegisterDelegate) (*MsgRegisterDelegateResponse, error)
+	// UnregisterDelegate defines a method to unregister a governance delegate. The
+	// governance voting power assigned to the delegate is unassigned.
+	//
+	// Since: x/gov 1.0.0
+	UnregisterDelegate(context.Context, *MsgUnregisterDelegate) (*MsgUnregisterDelegateResponse, error)
+	// AssignDelegate defines a method to assign the governance voting power of a
+	// delegator to a governance delegate, replacing any previous assignment.
+	//
+	// Since: x/gov 1.0.0
+	AssignDelegate(context.Context, *MsgAssignDelegate) (*MsgAssignDelegateResponse, error)
+	// UnassignDelegate defines a method to remove the assignment of the governance
+	// voting power of a delegator.
+	//
+	// Since: x/gov 1.0.0
+	UnassignDelegate(context.Context, *MsgUnassignDelegate) (*MsgUnassignDelegateResponse, error)
+	// CancelQueuedProposal defines a method for a timelock guardian to cancel a
+	// queued proposal before its execution time.
+	//
+	// Since: x/gov 1.0.0
+	CancelQueuedProposal(context.Context, *MsgCancelQueuedProposal) (*MsgCancelQueuedProposalResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -244,6 +342,21 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) RegisterDelegate(context.Context, *MsgRegisterDelegate) (*MsgRegisterDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDelegate not implemented")
+}
+func (UnimplementedMsgServer) UnregisterDelegate(context.Context, *MsgUnregisterDelegate) (*MsgUnregisterDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterDelegate not implemented")
+}
+func (UnimplementedMsgServer) AssignDelegate(context.Context, *MsgAssignDelegate) (*MsgAssignDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignDelegate not implemented")
+}
+func (UnimplementedMsgServer) UnassignDelegate(context.Context, *MsgUnassignDelegate) (*MsgUnassignDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignDelegate not implemented")
+}
+func (UnimplementedMsgServer) CancelQueuedProposal(context.Context, *MsgCancelQueuedProposal) (*MsgCancelQueuedProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedProposal not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -437,6 +550,96 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDelegate(ctx, req.(*MsgRegisterDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnregisterDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterDelegate(ctx, req.(*MsgUnregisterDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AssignDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAssignDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AssignDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AssignDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AssignDelegate(ctx, req.(*MsgAssignDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnassignDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnassignDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnassignDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnassignDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnassignDelegate(ctx, req.(*MsgUnassignDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelQueuedProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelQueuedProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedProposal(ctx, req.(*MsgCancelQueuedProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -484,6 +687,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "RegisterDelegate",
+			Handler:    _Msg_RegisterDelegate_Handler,
+		},
+		{
+			MethodName: "UnregisterDelegate",
+			Handler:    _Msg_UnregisterDelegate_Handler,
+		},
+		{
+			MethodName: "AssignDelegate",
+			Handler:    _Msg_AssignDelegate_Handler,
+		},
+		{
+			MethodName: "UnassignDelegate",
+			Handler:    _Msg_UnassignDelegate_Handler,
+		},
+		{
+			MethodName: "CancelQueuedProposal",
+			Handler:    _Msg_CancelQueuedProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
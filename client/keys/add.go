@@ -261,9 +261,13 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	hdPath, _ := cmd.Flags().GetString(flagHDPath)
 	useLedger, _ := cmd.Flags().GetBool(flags.FlagUseLedger)
 
-	if len(hdPath) == 0 {
+	switch {
+	case len(hdPath) == 0 && algo.Name() == hd.Ed25519Type:
+		// SLIP-10 only defines hardened derivation for ed25519
+		hdPath = fmt.Sprintf("m/44'/%d'/%d'/0'/%d'", coinType, account, index)
+	case len(hdPath) == 0:
 		hdPath = hd.CreateHDPath(coinType, account, index).String()
-	} else if useLedger {
+	case useLedger:
 		return errors.New("cannot set custom bip32 path with ledger")
 	}
 
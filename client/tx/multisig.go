@@ -0,0 +1,339 @@
+package tx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authsigning "cosmossdk.io/x/auth/signing"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// MultisigBundle is the JSON representation of a transaction of a
+// LegacyAminoPubKey multisig account pending the signatures of the members of
+// the multisig. The members pass the bundle around, possibly offline, adding
+// their signatures until the threshold of the multisig is met.
+type MultisigBundle struct {
+	ChainID       string `json:"chain_id"`
+	AccountNumber uint64 `json:"account_number,string"`
+	Sequence      uint64 `json:"sequence,string"`
+	// MultisigPubKey is the JSON encoding of the multisig public key.
+	MultisigPubKey json.RawMessage `json:"multisig_pub_key"`
+	// Tx is the JSON encoding of the unsigned transaction.
+	Tx json.RawMessage `json:"tx"`
+	// Signatures is the JSON encoding of the signatures of the members, in the
+	// format of the signatures output by 'tx sign --signature-only'.
+	Signatures json.RawMessage `json:"signatures"`
+}
+
+// MultisigTx is a transaction of a LegacyAminoPubKey multisig account together
+// with the signatures of the members of the multisig collected so far.
+type MultisigTx struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	PubKey        *kmultisig.LegacyAminoPubKey
+	Tx            authsigning.Tx
+	Signatures    []signing.SignatureV2
+}
+
+// NewMultisigTx returns a MultisigTx without any signature for the given
+// unsigned transaction, which must be signed by the multisig account.
+func NewMultisigTx(chainID string, accountNumber, sequence uint64, pubKey *kmultisig.LegacyAminoPubKey, tx authsigning.Tx) (*MultisigTx, error) {
+	if chainID == "" {
+		return nil, errors.New("chain id is required")
+	}
+
+	signers, err := tx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+
+	addr := pubKey.Address()
+	for _, signer := range signers {
+		if sdk.AccAddress(addr).Equals(sdk.AccAddress(signer)) {
+			return &MultisigTx{
+				ChainID:       chainID,
+				AccountNumber: accountNumber,
+				Sequence:      sequence,
+				PubKey:        pubKey,
+				Tx:            tx,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("multisig %s is not a signer of the transaction", sdk.AccAddress(addr))
+}
+
+// signerData returns the signer data of the multisig account.
+func (m *MultisigTx) signerData(pubKey cryptotypes.PubKey) authsigning.SignerData {
+	return authsigning.SignerData{
+		ChainID:       m.ChainID,
+		AccountNumber: m.AccountNumber,
+		Sequence:      m.Sequence,
+		PubKey:        pubKey,
+		Address:       sdk.AccAddress(m.PubKey.Address()).String(),
+	}
+}
+
+// isMember returns true if pubKey is one of the public keys of the multisig.
+func (m *MultisigTx) isMember(pubKey cryptotypes.PubKey) bool {
+	for _, pk := range m.PubKey.GetPubKeys() {
+		if pk.Equals(pubKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasSigned returns true if pubKey already signed the transaction.
+func (m *MultisigTx) hasSigned(pubKey cryptotypes.PubKey) bool {
+	for _, sig := range m.Signatures {
+		if sig.PubKey.Equals(pubKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddSignature adds the signature of a member of the multisig, after checking
+// it against the member set of the multisig and the transaction.
+func (m *MultisigTx) AddSignature(ctx context.Context, txConfig client.TxConfig, sig signing.SignatureV2) error {
+	if !m.isMember(sig.PubKey) {
+		return fmt.Errorf("%s is not a member of multisig %s", sdk.AccAddress(sig.PubKey.Address()), sdk.AccAddress(m.PubKey.Address()))
+	}
+
+	if m.hasSigned(sig.PubKey) {
+		return fmt.Errorf("%s already signed the transaction", sdk.AccAddress(sig.PubKey.Address()))
+	}
+
+	if sig.Sequence != m.Sequence {
+		return fmt.Errorf("signature sequence %d does not match the transaction sequence %d", sig.Sequence, m.Sequence)
+	}
+
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return fmt.Errorf("expected %T, got %T", &signing.SingleSignatureData{}, sig.Data)
+	}
+
+	if data.SignMode == signing.SignMode_SIGN_MODE_DIRECT {
+		return errors.New("the SIGN_MODE_DIRECT sign mode is not supported for multisigs")
+	}
+
+	adaptableTx, ok := m.Tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", m.Tx)
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return err
+	}
+
+	signerData := m.signerData(sig.PubKey)
+	txSignerData := txsigning.SignerData{
+		ChainID:       signerData.ChainID,
+		AccountNumber: signerData.AccountNumber,
+		Sequence:      signerData.Sequence,
+		Address:       signerData.Address,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+
+	err = authsigning.VerifySignature(ctx, sig.PubKey, txSignerData, sig.Data, txConfig.SignModeHandler(), adaptableTx.GetSigningTxData())
+	if err != nil {
+		return fmt.Errorf("couldn't verify signature for address %s: %w", sdk.AccAddress(sig.PubKey.Address()), err)
+	}
+
+	m.Signatures = append(m.Signatures, sig)
+	return nil
+}
+
+// Sign signs the transaction in the SIGN_MODE_LEGACY_AMINO_JSON sign mode with
+// the named key, which must be a member of the multisig, and adds the signature.
+func (m *MultisigTx) Sign(ctx context.Context, txConfig client.TxConfig, kb keyring.Keyring, name string) error {
+	k, err := kb.Key(name)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	if !m.isMember(pubKey) {
+		return fmt.Errorf("%s is not a member of multisig %s", name, sdk.AccAddress(m.PubKey.Address()))
+	}
+
+	signMode := signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	bytesToSign, err := authsigning.GetSignBytesAdapter(ctx, txConfig.SignModeHandler(), signMode, m.signerData(pubKey), m.Tx)
+	if err != nil {
+		return err
+	}
+
+	sigBytes, _, err := kb.Sign(name, bytesToSign, signMode)
+	if err != nil {
+		return err
+	}
+
+	return m.AddSignature(ctx, txConfig, signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: sigBytes,
+		},
+		Sequence: m.Sequence,
+	})
+}
+
+// Signers returns the public keys of the members of the multisig which signed
+// the transaction and of those which did not.
+func (m *MultisigTx) Signers() (signed, missing []cryptotypes.PubKey) {
+	for _, pk := range m.PubKey.GetPubKeys() {
+		if m.hasSigned(pk) {
+			signed = append(signed, pk)
+		} else {
+			missing = append(missing, pk)
+		}
+	}
+
+	return signed, missing
+}
+
+// HasThreshold returns true if enough members signed the transaction.
+func (m *MultisigTx) HasThreshold() bool {
+	return len(m.Signatures) >= int(m.PubKey.Threshold)
+}
+
+// BuildSignedTx assembles the signatures of the members into the multisig
+// signature of the transaction. It fails if the threshold is not met.
+func (m *MultisigTx) BuildSignedTx(txConfig client.TxConfig) (authsigning.Tx, error) {
+	if !m.HasThreshold() {
+		return nil, fmt.Errorf("%d signatures out of a threshold of %d", len(m.Signatures), m.PubKey.Threshold)
+	}
+
+	multisigSig := multisig.NewMultisig(len(m.PubKey.PubKeys))
+	for _, sig := range m.Signatures {
+		if err := multisig.AddSignatureV2(multisigSig, sig, m.PubKey.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	txBuilder, err := txConfig.WrapTxBuilder(m.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   m.PubKey,
+		Data:     multisigSig,
+		Sequence: m.Sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx(), nil
+}
+
+// MarshalMultisigTxJSON encodes the transaction to the JSON of a MultisigBundle.
+func MarshalMultisigTxJSON(clientCtx client.Context, m *MultisigTx) ([]byte, error) {
+	pubKey, err := clientCtx.Codec.MarshalInterfaceJSON(m.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := clientCtx.TxConfig.TxJSONEncoder()(m.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := clientCtx.TxConfig.MarshalSignatureJSON(m.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(MultisigBundle{
+		ChainID:        m.ChainID,
+		AccountNumber:  m.AccountNumber,
+		Sequence:       m.Sequence,
+		MultisigPubKey: pubKey,
+		Tx:             tx,
+		Signatures:     sigs,
+	})
+}
+
+// UnmarshalMultisigTxJSON decodes the transaction from the JSON of a MultisigBundle
+// and verifies its signatures.
+func UnmarshalMultisigTxJSON(clientCtx client.Context, bz []byte) (*MultisigTx, error) {
+	var bundle MultisigBundle
+	if err := json.Unmarshal(bz, &bundle); err != nil {
+		return nil, err
+	}
+
+	var pk cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(bundle.MultisigPubKey, &pk); err != nil {
+		return nil, err
+	}
+
+	pubKey, ok := pk.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &kmultisig.LegacyAminoPubKey{}, pk)
+	}
+
+	decoded, err := clientCtx.TxConfig.TxJSONDecoder()(bundle.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, ok := decoded.(authsigning.Tx)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", (authsigning.Tx)(nil), decoded)
+	}
+
+	m, err := NewMultisigTx(bundle.ChainID, bundle.AccountNumber, bundle.Sequence, pubKey, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bundle.Signatures) == 0 {
+		return m, nil
+	}
+
+	sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(bundle.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := clientCtx.CmdContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// the bundle may have been tampered with while passed around, check the
+	// signatures again.
+	for _, sig := range sigs {
+		if err := m.AddSignature(ctx, clientCtx.TxConfig, sig); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
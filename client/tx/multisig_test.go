@@ -0,0 +1,98 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	_ "cosmossdk.io/api/cosmos/counter/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	countertypes "github.com/cosmos/cosmos-sdk/x/counter/types"
+)
+
+func TestMultisigTx(t *testing.T) {
+	ctx := context.Background()
+	txConfig, cdc := newTestTxConfig()
+	countertypes.RegisterInterfaces(cdc.InterfaceRegistry())
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	path := hd.CreateHDPath(118, 0, 0).String()
+	names := []string{"member1", "member2", "member3", "outsider"}
+	pubKeys := make([]cryptotypes.PubKey, len(names))
+	for i, name := range names {
+		k, _, err := kb.NewMnemonic(name, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i], err = k.GetPubKey()
+		require.NoError(t, err)
+	}
+
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys[:3])
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	txf := mockTxFactory(txConfig)
+	txb, err := txf.BuildUnsignedTx(&countertypes.MsgIncreaseCounter{Signer: multisigAddr.String(), Count: 1})
+	require.NoError(t, err)
+
+	// require the multisig to be a signer of the transaction
+	_, err = NewMultisigTx(txf.ChainID(), txf.AccountNumber(), txf.Sequence(), kmultisig.NewLegacyAminoPubKey(1, pubKeys[1:]), txb.GetTx())
+	require.ErrorContains(t, err, "is not a signer of the transaction")
+	_, err = NewMultisigTx("", txf.AccountNumber(), txf.Sequence(), multisigPub, txb.GetTx())
+	require.Error(t, err)
+
+	multisigTx, err := NewMultisigTx(txf.ChainID(), txf.AccountNumber(), txf.Sequence(), multisigPub, txb.GetTx())
+	require.NoError(t, err)
+	require.False(t, multisigTx.HasThreshold())
+	_, err = multisigTx.BuildSignedTx(txConfig)
+	require.Error(t, err)
+
+	// require the signers to be members of the multisig
+	require.ErrorContains(t, multisigTx.Sign(ctx, txConfig, kb, "outsider"), "is not a member of multisig")
+
+	require.NoError(t, multisigTx.Sign(ctx, txConfig, kb, "member1"))
+	require.ErrorContains(t, multisigTx.Sign(ctx, txConfig, kb, "member1"), "already signed")
+	signed, missing := multisigTx.Signers()
+	require.Equal(t, []cryptotypes.PubKey{pubKeys[0]}, signed)
+	require.Equal(t, []cryptotypes.PubKey{pubKeys[1], pubKeys[2]}, missing)
+
+	// require an invalid signature to be rejected
+	invalidSig := signingtypes.SignatureV2{
+		PubKey: pubKeys[2],
+		Data: &signingtypes.SingleSignatureData{
+			SignMode:  signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			Signature: multisigTx.Signatures[0].Data.(*signingtypes.SingleSignatureData).Signature,
+		},
+		Sequence: txf.Sequence(),
+	}
+	require.ErrorContains(t, multisigTx.AddSignature(ctx, txConfig, invalidSig), "couldn't verify signature")
+
+	// require the bundle to survive a JSON round trip
+	clientCtx := client.Context{}.WithCodec(cdc).WithTxConfig(txConfig)
+	bz, err := MarshalMultisigTxJSON(clientCtx, multisigTx)
+	require.NoError(t, err)
+	decoded, err := UnmarshalMultisigTxJSON(clientCtx, bz)
+	require.NoError(t, err)
+	require.Len(t, decoded.Signatures, 1)
+	require.True(t, decoded.PubKey.Equals(multisigPub))
+
+	require.NoError(t, decoded.Sign(ctx, txConfig, kb, "member3"))
+	require.True(t, decoded.HasThreshold())
+
+	signedTx, err := decoded.BuildSignedTx(txConfig)
+	require.NoError(t, err)
+	sigs, err := signedTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, sigs[0].PubKey.Equals(multisigPub))
+	multisigData, ok := sigs[0].Data.(*signingtypes.MultiSignatureData)
+	require.True(t, ok)
+	require.Len(t, multisigData.Signatures, 2)
+}
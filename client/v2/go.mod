@@ -169,6 +169,7 @@ require (
 require (
 	buf.build/gen/go/cosmos/gogo-proto/protocolbuffers/go v1.32.0-20230509103710-5e5b9fdd0180.1 // indirect
 	buf.build/gen/go/tendermint/tendermint/protocolbuffers/go v1.32.0-20231117195010-33ed361a9051.1 // indirect
+	github.com/supranational/blst v0.3.14 // indirect
 )
 
 replace github.com/cosmos/cosmos-sdk => ./../../
@@ -1,9 +1,12 @@
 package hd
 
 import (
+	stded25519 "crypto/ed25519"
+
 	"github.com/cosmos/go-bip39"
 	"gitlab.com/yawning/secp256k1-voi/secec"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -21,10 +24,16 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Bls12381Type represents the BLS12-381 aggregatable signature system.
+	Bls12381Type = PubKeyType("bls12_381")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// Ed25519 uses ed25519 keys derived following SLIP-10.
+	Ed25519 = ed25519Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
@@ -74,3 +83,39 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: privKeyObj.Bytes()}
 	}
 }
+
+type ed25519Algo struct{}
+
+func (s ed25519Algo) Name() PubKeyType {
+	return Ed25519Type
+}
+
+// Derive derives and returns the ed25519 private key seed for the given seed and HD path
+// following SLIP-10. As SLIP-10 only supports hardened derivation for ed25519, an error is
+// returned if an element of the HD path isn't hardened, e.g. m/44'/118'/0'/0'/0' must be
+// used instead of m/44'/118'/0'/0/0.
+func (s ed25519Algo) Derive() DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		masterPriv, ch := ComputeEd25519MastersFromSeed(seed)
+		if len(hdPath) == 0 {
+			return masterPriv[:], nil
+		}
+
+		return DeriveEd25519PrivateKeyForPath(masterPriv, ch, hdPath)
+	}
+}
+
+// Generate generates an ed25519 private key from the given seed.
+func (s ed25519Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		seed := make([]byte, ed25519.SeedSize)
+		copy(seed, bz)
+
+		return &ed25519.PrivKey{Key: stded25519.NewKeyFromSeed(seed)}
+	}
+}
@@ -0,0 +1,41 @@
+//go:build cgo
+
+package hd
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Bls12381 uses BLS12-381 keys, whose signatures can be aggregated. It is only
+// available when built with cgo.
+var Bls12381 = bls12381Algo{}
+
+type bls12381Algo struct{}
+
+func (s bls12381Algo) Name() PubKeyType {
+	return Bls12381Type
+}
+
+// Derive derives the input keying material of the BLS12-381 private key for
+// the given seed and HD path. It uses the same BIP32 derivation as secp256k1.
+func (s bls12381Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates a BLS12-381 private key from the given input keying
+// material, padded with zeros to bls12_381.SeedSize bytes if it's shorter.
+func (s bls12381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		ikm := make([]byte, max(len(bz), bls12_381.SeedSize))
+		copy(ikm, bz)
+
+		privKey, err := bls12_381.GenPrivKeyFromSecret(ikm)
+		if err != nil {
+			// unreachable, the input keying material is at least SeedSize bytes long
+			panic(err)
+		}
+
+		return privKey
+	}
+}
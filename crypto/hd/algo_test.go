@@ -1,6 +1,7 @@
 package hd_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,4 +14,34 @@ func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("secp256k1"), hd.Secp256k1Type)
 	require.Equal(t, hd.PubKeyType("ed25519"), hd.Ed25519Type)
 	require.Equal(t, hd.PubKeyType("sr25519"), hd.Sr25519Type)
+	require.Equal(t, hd.PubKeyType("bls12_381"), hd.Bls12381Type)
+}
+
+func TestEd25519Generate(t *testing.T) {
+	// SLIP-10 test vector 1 for ed25519, chain m/0'/1'/2'/2'/1000000000'.
+	priv, err := hex.DecodeString("8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793")
+	require.NoError(t, err)
+
+	privKey := hd.Ed25519.Generate()(priv)
+	require.Equal(t, "3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a", hex.EncodeToString(privKey.PubKey().Bytes()))
+}
+
+func TestEd25519Derive(t *testing.T) {
+	mnemonic := "barrel original fuel morning among eternal filter ball stove pluck matrix mechanic"
+
+	// require non hardened elements of the path to be rejected
+	_, err := hd.Ed25519.Derive()(mnemonic, "", "m/44'/118'/0'/0/0")
+	require.ErrorContains(t, err, "is not hardened")
+	derived, err := hd.Ed25519.Derive()(mnemonic, "", "m/44'/118'/0'/0'/0'")
+	require.NoError(t, err)
+
+	// require another account to derive another key
+	other, err := hd.Ed25519.Derive()(mnemonic, "", "m/44'/118'/1'/0'/0'")
+	require.NoError(t, err)
+	require.NotEqual(t, derived, other)
+
+	// require the derivation to differ from secp256k1
+	secpDerived, err := hd.Secp256k1.Derive()(mnemonic, "", "m/44'/118'/0'/0'/0'")
+	require.NoError(t, err)
+	require.NotEqual(t, secpDerived, derived)
 }
@@ -8,6 +8,10 @@
 // In combination with the bip39 package in go-crypto this package provides the functionality for
 // deriving keys using a BIP 44 HD path, or, more general, by passing a BIP 32 path.
 //
+// ed25519 keys are derived following SLIP-10, which only defines hardened derivation:
+//
+//	https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+//
 // In particular, this package (together with bip39) provides all necessary functionality to derive
 // keys from mnemonics generated during the cosmos fundraiser.
 package hd
@@ -167,10 +167,37 @@ func ComputeMastersFromSeed(seed []byte) (secret, chainCode [32]byte) {
 // DerivePrivateKeyForPath derives the private key by following the BIP 32/44 path from privKeyBytes,
 // using the given chainCode.
 func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]byte, error) {
+	segments, err := parseDerivationPath(path)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	data := privKeyBytes
+	for _, segment := range segments {
+		data, chainCode = derivePrivateKey(data, chainCode, segment.index, segment.harden)
+	}
+
+	derivedKey := make([]byte, 32)
+	n := copy(derivedKey, data[:])
+
+	if n != 32 || len(data) != 32 {
+		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
+	}
+
+	return derivedKey, nil
+}
+
+// pathSegment is a parsed element of a BIP 32 path.
+type pathSegment struct {
+	index  uint32
+	harden bool
+}
+
+// parseDerivationPath parses the elements of the given BIP 32 path.
+func parseDerivationPath(path string) ([]pathSegment, error) {
 	// First step is to trim the right end path separator lest we panic.
 	// See issue https://github.com/cosmos/cosmos-sdk/issues/8557
 	path = strings.TrimRightFunc(path, func(r rune) bool { return r == filepath.Separator })
-	data := privKeyBytes
 	parts := strings.Split(path, "/")
 
 	switch {
@@ -180,6 +207,7 @@ func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]b
 		parts = parts[1:]
 	}
 
+	segments := make([]pathSegment, len(parts))
 	for i, part := range parts {
 		if part == "" {
 			return nil, fmt.Errorf("path %q with split element #%d is an empty string", part, i)
@@ -196,20 +224,13 @@ func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]b
 		// index values are in the range [0, 1<<31-1] aka [0, max(int32)]
 		idx, err := strconv.ParseUint(part, 10, 31)
 		if err != nil {
-			return []byte{}, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
+			return nil, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
 		}
 
-		data, chainCode = derivePrivateKey(data, chainCode, uint32(idx), harden)
+		segments[i] = pathSegment{index: uint32(idx), harden: harden}
 	}
 
-	derivedKey := make([]byte, 32)
-	n := copy(derivedKey, data[:])
-
-	if n != 32 || len(data) != 32 {
-		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
-	}
-
-	return derivedKey, nil
+	return segments, nil
 }
 
 // derivePrivateKey derives the private key with index and chainCode.
@@ -244,6 +265,49 @@ func derivePrivateKey(privKeyBytes, chainCode [32]byte, index uint32, harden boo
 	return x, chainCode2
 }
 
+// ComputeEd25519MastersFromSeed returns the SLIP-10 ed25519 master secret key's, and chain code.
+func ComputeEd25519MastersFromSeed(seed []byte) (secret, chainCode [32]byte) {
+	curveIdentifier := []byte("ed25519 seed")
+	secret, chainCode = i64(curveIdentifier, seed)
+
+	return
+}
+
+// DeriveEd25519PrivateKeyForPath derives the ed25519 private key seed by following the
+// SLIP-10 path from privKeyBytes, using the given chainCode. SLIP-10 only defines hardened
+// derivation for ed25519, every element of the path must therefore be hardened.
+// For more information see:
+//   - https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+func DeriveEd25519PrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]byte, error) {
+	segments, err := parseDerivationPath(path)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	data := privKeyBytes
+	for i, segment := range segments {
+		if !segment.harden {
+			return []byte{}, fmt.Errorf("invalid SLIP-10 ed25519 path %s: element #%d is not hardened", path, i)
+		}
+
+		data, chainCode = deriveEd25519PrivateKey(data, chainCode, segment.index)
+	}
+
+	derivedKey := make([]byte, 32)
+	copy(derivedKey, data[:])
+
+	return derivedKey, nil
+}
+
+// deriveEd25519PrivateKey derives the hardened child private key with index and chainCode.
+// It returns the new private key and new chain code.
+func deriveEd25519PrivateKey(privKeyBytes, chainCode [32]byte, index uint32) ([32]byte, [32]byte) {
+	data := append([]byte{byte(0)}, privKeyBytes[:]...)
+	data = append(data, uint32ToBytes(index|0x80000000)...)
+
+	return i64(chainCode[:], data)
+}
+
 // modular big endian addition
 func addScalars(a, b []byte) [32]byte {
 	aInt := new(big.Int).SetBytes(a)
@@ -0,0 +1,69 @@
+//go:build cgo
+
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestBls12381Keyring(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc, func(options *Options) {
+		options.SupportedAlgos = SigningAlgoList{hd.Secp256k1, hd.Bls12381}
+	})
+	require.NoError(t, err)
+
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+	_, err = kr.NewAccount("bls1", mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Bls12381)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("bls2", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Bls12381)
+	require.NoError(t, err)
+
+	// require the key to be derived deterministically from the mnemonic
+	derived, err := hd.Bls12381.Derive()(mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath)
+	require.NoError(t, err)
+	expected := hd.Bls12381.Generate()(derived)
+
+	k1, err := kr.Key("bls1")
+	require.NoError(t, err)
+	pk1, err := k1.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &bls12_381.PubKey{}, pk1)
+	require.True(t, expected.PubKey().Equals(pk1))
+
+	k2, err := kr.Key("bls2")
+	require.NoError(t, err)
+	pk2, err := k2.GetPubKey()
+	require.NoError(t, err)
+
+	// require the signatures of both keys to be aggregatable
+	msg := []byte("some message")
+	sig1, _, err := kr.Sign("bls1", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pk1.VerifySignature(msg, sig1))
+	sig2, _, err := kr.Sign("bls2", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	aggSig, err := bls12_381.AggregateSignatures([][]byte{sig1, sig2})
+	require.NoError(t, err)
+	require.True(t, bls12_381.FastAggregateVerify([]*bls12_381.PubKey{pk1.(*bls12_381.PubKey), pk2.(*bls12_381.PubKey)}, msg, aggSig))
+
+	// require the private key to survive an armored export and import
+	armor, err := kr.ExportPrivKeyArmor("bls1", "passphrase")
+	require.NoError(t, err)
+	require.NoError(t, kr.Delete("bls1"))
+	require.NoError(t, kr.ImportPrivKey("bls1", armor, "passphrase"))
+
+	k1, err = kr.Key("bls1")
+	require.NoError(t, err)
+	imported, err := k1.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pk1.Equals(imported))
+}
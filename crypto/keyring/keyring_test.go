@@ -1148,6 +1148,45 @@ func TestNewAccount(t *testing.T) {
 	}
 }
 
+func TestRecoverEd25519Key(t *testing.T) {
+	cdc := getCodec()
+	hdPath := "m/44'/118'/0'/0'/0'"
+
+	// require ed25519 to be opt-in
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("ed25519", English, hdPath, DefaultBIP39Passphrase, hd.Ed25519)
+	require.ErrorIs(t, err, ErrUnsupportedSigningAlgo)
+
+	kr, err = New(t.Name(), BackendTest, t.TempDir(), nil, cdc, func(options *Options) {
+		options.SupportedAlgos = SigningAlgoList{hd.Secp256k1, hd.Ed25519}
+	})
+	require.NoError(t, err)
+
+	// require the path to be hardened
+	_, _, err = kr.NewMnemonic("ed25519", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Ed25519)
+	require.ErrorContains(t, err, "is not hardened")
+
+	k, mnemonic, err := kr.NewMnemonic("ed25519", English, hdPath, DefaultBIP39Passphrase, hd.Ed25519)
+	require.NoError(t, err)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &ed25519.PubKey{}, pubKey)
+
+	msg := []byte("some message")
+	sig, _, err := kr.Sign("ed25519", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// require the key to be recovered from its mnemonic
+	require.NoError(t, kr.Delete("ed25519"))
+	k, err = kr.NewAccount("ed25519", mnemonic, DefaultBIP39Passphrase, hdPath, hd.Ed25519)
+	require.NoError(t, err)
+	recovered, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(recovered))
+}
+
 func TestInMemoryWithKeyring(t *testing.T) {
 	priv := types.PrivKey(secp256k1.GenPrivKey())
 	pub := priv.PubKey()
@@ -73,6 +73,22 @@ func TestDerive(t *testing.T) {
 			bip39Passphrase: "test",
 			derivedPriv:     "d5f925b9472b3793e839eae7722e5cef6766a91fad55cc42abea5e457ed5f648",
 		},
+		{
+			name:            "ed25519",
+			algo:            hd.Ed25519,
+			hdPath:          "m/44'/118'/0'/0'/0'",
+			mnemonic:        "circle music snake select deal march this romance until often welcome rich staff trigger drip exit there reopen denial insect hockey just wealth process",
+			bip39Passphrase: "",
+			derivedPriv:     "0ebe9203be0fd3f9e01724d61a10b165ff5f8164819c616f761580eadad5b169",
+		},
+		{
+			name:            "ed25519 with bip39Passphrase",
+			algo:            hd.Ed25519,
+			hdPath:          "m/44'/118'/0'/0'/0'",
+			mnemonic:        "circle music snake select deal march this romance until often welcome rich staff trigger drip exit there reopen denial insect hockey just wealth process",
+			bip39Passphrase: "test",
+			derivedPriv:     "3d2668e253f29ffeb9ac1a7658fc3b7a0a99fc405cda209dc5732a315a9fbbcf",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
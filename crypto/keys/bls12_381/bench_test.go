@@ -0,0 +1,41 @@
+//go:build cgo
+
+package bls12_381
+
+import (
+	"io"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/benchmarking"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func BenchmarkKeyGeneration(b *testing.B) {
+	b.ReportAllocs()
+	benchmarkKeygenWrapper := func(reader io.Reader) types.PrivKey {
+		priv, err := genPrivKey(reader)
+		if err != nil {
+			b.Fatal(err)
+		}
+		return priv
+	}
+	benchmarking.BenchmarkKeyGeneration(b, benchmarkKeygenWrapper)
+}
+
+func BenchmarkSigning(b *testing.B) {
+	b.ReportAllocs()
+	priv, err := GenPrivKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarking.BenchmarkSigning(b, priv)
+}
+
+func BenchmarkVerification(b *testing.B) {
+	b.ReportAllocs()
+	priv, err := GenPrivKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarking.BenchmarkVerification(b, priv)
+}
@@ -0,0 +1,227 @@
+//go:build cgo
+
+package bls12_381
+
+import (
+	"errors"
+	"io"
+
+	"github.com/cometbft/cometbft/crypto"
+	blst "github.com/supranational/blst/bindings/go"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Supported reports whether the BLS12-381 keys are supported, i.e. whether the
+// binary is built with cgo.
+const Supported = true
+
+// GenPrivKey generates a new BLS12-381 private key. It uses OS randomness.
+func GenPrivKey() (*PrivKey, error) {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) (*PrivKey, error) {
+	ikm := make([]byte, SeedSize)
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		return nil, err
+	}
+
+	return GenPrivKeyFromSecret(ikm)
+}
+
+// GenPrivKeyFromSecret derives the private key from the given input keying
+// material with the KeyGen procedure of the IETF BLS signature draft. The
+// secret must be at least SeedSize bytes long and must be the output of a KDF
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	sk := blst.KeyGen(secret)
+	if sk == nil {
+		return nil, errors.New("secret must be at least 32 bytes long")
+	}
+	defer sk.Zeroize()
+
+	return &PrivKey{Key: sk.Serialize()}, nil
+}
+
+// secretKey returns the blst secret key of privKey.
+func (privKey *PrivKey) secretKey() (*blst.SecretKey, error) {
+	sk := new(blst.SecretKey).Deserialize(privKey.Key)
+	if sk == nil || !sk.Valid() {
+		return nil, errors.New("invalid bls12_381 private key")
+	}
+
+	return sk, nil
+}
+
+// Sign produces a signature on the provided message.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	sk, err := privKey.secretKey()
+	if err != nil {
+		return nil, err
+	}
+	defer sk.Zeroize()
+
+	return new(blst.P2Affine).Sign(sk, msg, dstSignature).Compress(), nil
+}
+
+// ProvePossession returns a proof of possession of the private key, that is a
+// signature of the public key under a dedicated domain separation tag.
+func (privKey *PrivKey) ProvePossession() ([]byte, error) {
+	sk, err := privKey.secretKey()
+	if err != nil {
+		return nil, err
+	}
+	defer sk.Zeroize()
+
+	pk := new(blst.P1Affine).From(sk).Compress()
+	return new(blst.P2Affine).Sign(sk, pk, dstPossession).Compress(), nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+//
+// Panics if the private key is invalid.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	sk, err := privKey.secretKey()
+	if err != nil {
+		panic(err)
+	}
+	defer sk.Zeroize()
+
+	return &PubKey{Key: new(blst.P1Affine).From(sk).Compress()}
+}
+
+// publicKey returns the group checked blst public key of pubKey.
+func (pubKey *PubKey) publicKey() (*blst.P1Affine, bool) {
+	if len(pubKey.Key) != PubKeySize {
+		return nil, false
+	}
+
+	pk := new(blst.P1Affine).Uncompress(pubKey.Key)
+	if pk == nil || !pk.KeyValidate() {
+		return nil, false
+	}
+
+	return pk, true
+}
+
+// VerifySignature verifies the signature of msg by the public key.
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	return verify(pubKey, msg, sig, dstSignature)
+}
+
+// VerifyPossession verifies a proof of possession of the private key of the
+// public key, as returned by PrivKey.ProvePossession.
+func (pubKey *PubKey) VerifyPossession(proof []byte) bool {
+	return verify(pubKey, pubKey.Key, proof, dstPossession)
+}
+
+func verify(pubKey *PubKey, msg, sig, dst []byte) bool {
+	pk, ok := pubKey.publicKey()
+	if !ok {
+		return false
+	}
+
+	s, ok := signature(sig)
+	if !ok {
+		return false
+	}
+
+	return s.Verify(false, pk, false, msg, dst)
+}
+
+// signature returns the group checked blst signature of sig.
+func signature(sig []byte) (*blst.P2Affine, bool) {
+	if len(sig) != SignatureSize {
+		return nil, false
+	}
+
+	s := new(blst.P2Affine).Uncompress(sig)
+	if s == nil || !s.SigValidate(false) {
+		return nil, false
+	}
+
+	return s, true
+}
+
+// AggregateSignatures aggregates the given signatures into a single signature
+// of SignatureSize bytes.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	for _, sig := range sigs {
+		if len(sig) != SignatureSize {
+			return nil, errors.New("invalid signature size")
+		}
+	}
+
+	agg := new(blst.P2Aggregate)
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return agg.ToAffine().Compress(), nil
+}
+
+// AggregateVerify verifies that sig is the aggregated signature of each msgs[i]
+// by pubKeys[i]. The possession of the private keys of pubKeys must have been
+// verified beforehand.
+func AggregateVerify(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	pks, ok := publicKeys(pubKeys)
+	if !ok {
+		return false
+	}
+
+	s, ok := signature(sig)
+	if !ok {
+		return false
+	}
+
+	bMsgs := make([]blst.Message, len(msgs))
+	for i, msg := range msgs {
+		bMsgs[i] = msg
+	}
+
+	return s.AggregateVerify(false, pks, false, bMsgs, dstSignature)
+}
+
+// FastAggregateVerify verifies that sig is the aggregated signature of msg by
+// all pubKeys. The possession of the private keys of pubKeys must have been
+// verified beforehand.
+func FastAggregateVerify(pubKeys []*PubKey, msg, sig []byte) bool {
+	if len(pubKeys) == 0 {
+		return false
+	}
+
+	pks, ok := publicKeys(pubKeys)
+	if !ok {
+		return false
+	}
+
+	s, ok := signature(sig)
+	if !ok {
+		return false
+	}
+
+	return s.FastAggregateVerify(false, pks, msg, dstSignature)
+}
+
+func publicKeys(pubKeys []*PubKey) ([]*blst.P1Affine, bool) {
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pk, ok := pubKey.publicKey()
+		if !ok {
+			return nil, false
+		}
+		pks[i] = pk
+	}
+
+	return pks, true
+}
@@ -0,0 +1,70 @@
+//go:build !cgo
+
+package bls12_381
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Supported reports whether the BLS12-381 keys are supported, i.e. whether the
+// binary is built with cgo.
+const Supported = false
+
+// GenPrivKey generates a new BLS12-381 private key. It always fails without cgo.
+func GenPrivKey() (*PrivKey, error) {
+	return nil, ErrNotSupported
+}
+
+// GenPrivKeyFromSecret derives the private key from the given input keying
+// material. It always fails without cgo.
+func GenPrivKeyFromSecret(_ []byte) (*PrivKey, error) {
+	return nil, ErrNotSupported
+}
+
+// Sign produces a signature on the provided message. It always fails without cgo.
+func (privKey *PrivKey) Sign(_ []byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// ProvePossession returns a proof of possession of the private key. It always
+// fails without cgo.
+func (privKey *PrivKey) ProvePossession() ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// PubKey gets the corresponding public key from the private key. It always
+// returns nil without cgo, as no private key can be generated or decoded from
+// the registered codecs.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	return nil
+}
+
+// VerifySignature verifies the signature of msg by the public key. It always
+// returns false without cgo.
+func (pubKey *PubKey) VerifySignature(_, _ []byte) bool {
+	return false
+}
+
+// VerifyPossession verifies a proof of possession of the private key of the
+// public key. It always returns false without cgo.
+func (pubKey *PubKey) VerifyPossession(_ []byte) bool {
+	return false
+}
+
+// AggregateSignatures aggregates the given signatures into a single signature.
+// It always fails without cgo.
+func AggregateSignatures(_ [][]byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// AggregateVerify verifies that sig is the aggregated signature of each msgs[i]
+// by pubKeys[i]. It always returns false without cgo.
+func AggregateVerify(_ []*PubKey, _ [][]byte, _ []byte) bool {
+	return false
+}
+
+// FastAggregateVerify verifies that sig is the aggregated signature of msg by
+// all pubKeys. It always returns false without cgo.
+func FastAggregateVerify(_ []*PubKey, _, _ []byte) bool {
+	return false
+}
@@ -0,0 +1,37 @@
+//go:build !cgo
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+)
+
+// Note: run with CGO_ENABLED=0.
+func TestNotSupported(t *testing.T) {
+	_, err := bls12_381.GenPrivKey()
+	require.ErrorIs(t, err, bls12_381.ErrNotSupported)
+
+	_, err = bls12_381.AggregateSignatures([][]byte{make([]byte, bls12_381.SignatureSize)})
+	require.ErrorIs(t, err, bls12_381.ErrNotSupported)
+
+	require.False(t, bls12_381.Supported)
+	require.Nil(t, (&bls12_381.PrivKey{Key: make([]byte, bls12_381.PrivKeySize)}).PubKey())
+
+	// the private keys can't be decoded
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	_, err = registry.Resolve("/cosmos.crypto.bls12_381.PrivKey")
+	require.Error(t, err)
+	_, err = registry.Resolve("/cosmos.crypto.bls12_381.PubKey")
+	require.NoError(t, err)
+
+	pubKey := &bls12_381.PubKey{Key: make([]byte, bls12_381.PubKeySize)}
+	require.False(t, pubKey.VerifySignature([]byte("msg"), make([]byte, bls12_381.SignatureSize)))
+	require.False(t, bls12_381.FastAggregateVerify([]*bls12_381.PubKey{pubKey}, []byte("msg"), make([]byte, bls12_381.SignatureSize)))
+}
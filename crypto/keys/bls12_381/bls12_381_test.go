@@ -0,0 +1,271 @@
+//go:build cgo
+
+package bls12_381_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func genKeys(t *testing.T, n int) ([]*bls12_381.PrivKey, []*bls12_381.PubKey) {
+	t.Helper()
+
+	privKeys := make([]*bls12_381.PrivKey, n)
+	pubKeys := make([]*bls12_381.PubKey, n)
+	for i := range privKeys {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		pubKeys[i] = privKey.PubKey().(*bls12_381.PubKey)
+	}
+
+	return privKeys, pubKeys
+}
+
+func TestSignAndValidateBls12381(t *testing.T) {
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey()
+	require.Len(t, privKey.Bytes(), bls12_381.PrivKeySize)
+	require.Len(t, pubKey.Bytes(), bls12_381.PubKeySize)
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, bls12_381.SignatureSize)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// require another message or key to be rejected
+	require.False(t, pubKey.VerifySignature(crypto.CRandBytes(1000), sig))
+	otherKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	require.False(t, otherKey.PubKey().VerifySignature(msg, sig))
+
+	// require a mutated or truncated signature to be rejected
+	sig[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature(msg, sig[:bls12_381.SignatureSize-1]))
+
+	// require an invalid public key to be rejected
+	invalid := &bls12_381.PubKey{Key: make([]byte, bls12_381.PubKeySize)}
+	require.False(t, invalid.VerifySignature(msg, sig))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secret := crypto.CRandBytes(bls12_381.SeedSize)
+	privKey, err := bls12_381.GenPrivKeyFromSecret(secret)
+	require.NoError(t, err)
+
+	// require the derivation to be deterministic
+	privKey2, err := bls12_381.GenPrivKeyFromSecret(secret)
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(privKey2))
+	require.True(t, privKey.PubKey().Equals(privKey2.PubKey()))
+
+	_, err = bls12_381.GenPrivKeyFromSecret(secret[:bls12_381.SeedSize-1])
+	require.Error(t, err)
+
+	_, err = (&bls12_381.PrivKey{Key: make([]byte, bls12_381.PrivKeySize)}).Sign(secret)
+	require.Error(t, err)
+}
+
+func TestPossession(t *testing.T) {
+	privKeys, pubKeys := genKeys(t, 2)
+
+	proof, err := privKeys[0].ProvePossession()
+	require.NoError(t, err)
+	require.True(t, pubKeys[0].VerifyPossession(proof))
+	require.False(t, pubKeys[1].VerifyPossession(proof))
+
+	// require a signature of the public key not to be a proof of possession
+	sig, err := privKeys[0].Sign(pubKeys[0].Key)
+	require.NoError(t, err)
+	require.False(t, pubKeys[0].VerifyPossession(sig))
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	privKeys, pubKeys := genKeys(t, 5)
+	msg := []byte("vote extension")
+
+	sigs := make([][]byte, len(privKeys))
+	for i, privKey := range privKeys {
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+
+	aggSig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, aggSig, bls12_381.SignatureSize)
+
+	require.True(t, bls12_381.FastAggregateVerify(pubKeys, msg, aggSig))
+	require.False(t, bls12_381.FastAggregateVerify(pubKeys[1:], msg, aggSig))
+	require.False(t, bls12_381.FastAggregateVerify(pubKeys, []byte("other"), aggSig))
+	require.False(t, bls12_381.FastAggregateVerify(nil, msg, aggSig))
+
+	// a single signature is its own aggregate
+	aggSig, err = bls12_381.AggregateSignatures(sigs[:1])
+	require.NoError(t, err)
+	require.Equal(t, sigs[0], aggSig)
+	require.True(t, bls12_381.FastAggregateVerify(pubKeys[:1], msg, aggSig))
+}
+
+func TestAggregateVerify(t *testing.T) {
+	privKeys, pubKeys := genKeys(t, 3)
+
+	msgs := make([][]byte, len(privKeys))
+	sigs := make([][]byte, len(privKeys))
+	for i, privKey := range privKeys {
+		msgs[i] = crypto.CRandBytes(32)
+		sig, err := privKey.Sign(msgs[i])
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+
+	aggSig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, bls12_381.AggregateVerify(pubKeys, msgs, aggSig))
+
+	// require the messages to match the public keys
+	require.False(t, bls12_381.AggregateVerify(pubKeys, [][]byte{msgs[1], msgs[0], msgs[2]}, aggSig))
+	require.False(t, bls12_381.AggregateVerify(pubKeys, msgs[:2], aggSig))
+	require.False(t, bls12_381.AggregateVerify(pubKeys[:2], msgs[:2], aggSig))
+	require.False(t, bls12_381.AggregateVerify(pubKeys, msgs, sigs[0]))
+
+	_, err = bls12_381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12_381.AggregateSignatures([][]byte{sigs[0], sigs[1][1:]})
+	require.Error(t, err)
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	_, pubKeys := genKeys(t, 2)
+
+	testCases := []struct {
+		msg      string
+		pubKey   cryptotypes.PubKey
+		other    cryptotypes.PubKey
+		expectEq bool
+	}{
+		{
+			"different bytes",
+			pubKeys[0],
+			pubKeys[1],
+			false,
+		},
+		{
+			"equals",
+			pubKeys[0],
+			&bls12_381.PubKey{Key: pubKeys[0].Key},
+			true,
+		},
+		{
+			"different types",
+			pubKeys[0],
+			secp256k1.GenPrivKey().PubKey(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			require.Equal(t, tc.expectEq, tc.pubKey.Equals(tc.other))
+		})
+	}
+}
+
+func TestAddressBls12381(t *testing.T) {
+	_, pubKeys := genKeys(t, 2)
+	require.Len(t, pubKeys[0].Address(), 32)
+	require.NotEqual(t, pubKeys[0].Address(), pubKeys[1].Address())
+
+	require.Panics(t, func() {
+		(&bls12_381.PubKey{Key: pubKeys[0].Key[1:]}).Address()
+	})
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKeys, pubKeys := genKeys(t, 1)
+
+	testCases := []struct {
+		desc      string
+		msg       codec.AminoMarshaler
+		typ       interface{}
+		expBinary []byte
+		expJSON   string
+	}{
+		{
+			"bls12_381 private key",
+			privKeys[0],
+			&bls12_381.PrivKey{},
+			append([]byte{32}, privKeys[0].Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKeys[0].Bytes()) + "\"",
+		},
+		{
+			"bls12_381 public key",
+			pubKeys[0],
+			&bls12_381.PubKey{},
+			append([]byte{48}, pubKeys[0].Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(pubKeys[0].Bytes()) + "\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Do a round trip of encoding/decoding binary.
+			bz, err := aminoCdc.Marshal(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBinary, bz)
+
+			err = aminoCdc.Unmarshal(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+
+			// Do a round trip of encoding/decoding JSON.
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expJSON, string(bz))
+
+			err = aminoCdc.UnmarshalJSON(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	privKeys, pubKeys := genKeys(t, 1)
+
+	bz, err := cdc.MarshalInterfaceJSON(pubKeys[0])
+	require.NoError(t, err)
+
+	var pk cryptotypes.PubKey
+	err = cdc.UnmarshalInterfaceJSON(bz, &pk)
+	require.NoError(t, err)
+	require.True(t, pk.Equals(pubKeys[0]))
+
+	bz, err = cdc.MarshalInterfaceJSON(privKeys[0])
+	require.NoError(t, err)
+
+	var sk cryptotypes.PrivKey
+	err = cdc.UnmarshalInterfaceJSON(bz, &sk)
+	require.NoError(t, err)
+	require.True(t, sk.Equals(privKeys[0]))
+}
@@ -0,0 +1,18 @@
+// Package bls12_381 implements Cosmos-SDK compatible BLS12-381 public and
+// private keys. The keys can be protobuf serialized and packed in Any.
+//
+// Public keys are points on G1 (48 bytes compressed) and signatures points on
+// G2 (96 bytes compressed), following the minimal-pubkey-size variant of the
+// proof of possession scheme of the IETF BLS signature draft. Signatures over
+// the same or distinct messages can be aggregated into a single signature with
+// AggregateSignatures and checked with FastAggregateVerify and AggregateVerify.
+//
+// Aggregate verification is only safe against rogue key attacks when every
+// public key involved has proven possession of its private key, see
+// PrivKey.ProvePossession and PubKey.VerifyPossession.
+//
+// The implementation relies on github.com/supranational/blst and therefore
+// requires cgo. Without cgo, public keys can still be (de)serialized but every
+// cryptographic operation fails, and private keys are not registered in the
+// codecs, see Supported.
+package bls12_381
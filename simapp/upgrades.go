@@ -2,9 +2,13 @@ package simapp
 
 import (
 	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
 
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/accounts"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
 	protocolpooltypes "cosmossdk.io/x/protocolpool/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -20,11 +24,36 @@ import (
 // v0.50.x to v0.51.x.
 const UpgradeName = "v050-to-v051"
 
+// DenomMetadataBackfillFile is the file, in the config directory of the node home,
+// holding the JSON list of the metadata set by the upgrade for the denoms which have
+// none, e.g. the IBC assets received by the chain. The metadata of the other denoms
+// is left unchanged, and no metadata is set if the file does not exist.
+const DenomMetadataBackfillFile = "denom_metadata.json"
+
 func (app SimApp) RegisterUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(
 		UpgradeName,
 		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			toVM, err := app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			if err != nil {
+				return nil, err
+			}
+
+			upgradeInfoPath, err := app.UpgradeKeeper.GetUpgradeInfoPath()
+			if err != nil {
+				return nil, err
+			}
+			// the upgrade info is in the data directory of the node home
+			backfillPath := filepath.Join(filepath.Dir(filepath.Dir(upgradeInfoPath)), "config", DenomMetadataBackfillFile)
+			metadatas, err := bankkeeper.LoadDenomMetadata(app.appCodec, backfillPath)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return nil, err
+			}
+			if err := bankkeeper.NewMigrator(app.BankKeeper).BackfillDenomMetadata(ctx, metadatas); err != nil {
+				return nil, err
+			}
+
+			return toVM, nil
 		},
 	)
 
@@ -6,10 +6,15 @@ import (
 	"context"
 	"testing"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/simapp"
+	"cosmossdk.io/x/accounts"
+	baseaccountv1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	banktypes "cosmossdk.io/x/bank/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 )
@@ -71,6 +76,66 @@ func TestAccountAbstraction(t *testing.T) {
 }
 */
 
+func TestSimulateBundle(t *testing.T) {
+	app := setupApp(t)
+	ak := app.AccountsKeeper
+	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger()).
+		WithHeaderInfo(header.Info{ChainID: app.ChainID()})
+
+	pubKey, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
+	_, aaAddr, err := ak.Init(ctx, "base", accCreator, &baseaccountv1.MsgInit{PubKey: pubKey}, nil)
+	require.NoError(t, err)
+	fundAccount(t, app, ctx, aaAddr, "1000000stake")
+	bundler := bechify(t, app, bundlerAddr)
+
+	bundledTx := func(privKey *secp256k1.PrivKey, amount string) *tx.TxRaw {
+		msg := &banktypes.MsgSend{
+			FromAddress: bechify(t, app, aaAddr),
+			ToAddress:   bechify(t, app, aliceAddr),
+			Amount:      coins(t, amount),
+		}
+		bz, err := app.TxEncode(sign(t, ctx, app, aaAddr, privKey, msg))
+		require.NoError(t, err)
+		txRaw := new(tx.TxRaw)
+		require.NoError(t, txRaw.Unmarshal(bz))
+		return txRaw
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		resps, err := ak.SimulateBundle(ctx, bundler, []*tx.TxRaw{bundledTx(privKey, "100stake")})
+		require.NoError(t, err)
+		require.Len(t, resps, 1)
+		require.Empty(t, resps[0].Error)
+		require.Len(t, resps[0].ExecResponses, 1)
+		require.NotZero(t, resps[0].AuthenticationGasUsed)
+		require.NotZero(t, resps[0].ExecutionGasUsed)
+
+		// the simulation does not persist any state change
+		balanceIs(t, ctx, app, aaAddr, "1000000stake")
+		balanceIs(t, ctx, app, aliceAddr.Bytes(), "")
+		seq, err := ak.Query(ctx, aaAddr, &baseaccountv1.QuerySequence{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), seq.(*baseaccountv1.QuerySequenceResponse).Sequence)
+	})
+
+	t.Run("authentication and execution failures", func(t *testing.T) {
+		resps, err := ak.SimulateBundle(ctx, bundler, []*tx.TxRaw{
+			bundledTx(secp256k1.GenPrivKey(), "100stake"),
+			bundledTx(privKey, "2000000stake"),
+		})
+		require.NoError(t, err)
+		require.Len(t, resps, 2)
+
+		require.Contains(t, resps[0].Error, accounts.ErrAuthentication.Error())
+		require.Zero(t, resps[0].ExecutionGasUsed)
+
+		require.Contains(t, resps[1].Error, accounts.ErrExecution.Error())
+		require.NotZero(t, resps[1].AuthenticationGasUsed)
+		require.NotZero(t, resps[1].ExecutionGasUsed)
+	})
+}
+
 func intoAny(t *testing.T, msgs ...gogoproto.Message) (anys []*codectypes.Any) {
 	t.Helper()
 	for _, msg := range msgs {
@@ -10,6 +10,7 @@ import (
 	baseaccountv1 "cosmossdk.io/x/accounts/defaults/base/v1"
 	"cosmossdk.io/x/bank/testutil"
 	banktypes "cosmossdk.io/x/bank/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,8 +22,10 @@ func TestBaseAccount(t *testing.T) {
 	ak := app.AccountsKeeper
 	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger())
 
+	pubKey, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
 	_, baseAccountAddr, err := ak.Init(ctx, "base", accCreator, &baseaccountv1.MsgInit{
-		PubKey: privKey.PubKey().Bytes(),
+		PubKey: pubKey,
 	}, nil)
 	require.NoError(t, err)
 
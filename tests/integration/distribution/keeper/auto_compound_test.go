@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	distrtypes "cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	delAddr, valAddr := setupDelegation(t, f)
+	queryClient := distrtypes.NewQueryClient(f.app.QueryHelper())
+
+	testCases := []struct {
+		name          string
+		msg           *distrtypes.MsgSetAutoCompound
+		expErr        bool
+		expErrMsg     string
+		expValidators []string
+	}{
+		{
+			name:      "invalid delegator address",
+			msg:       distrtypes.NewMsgSetAutoCompound("invalid", valAddr.String(), true),
+			expErr:    true,
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "invalid validator address",
+			msg:       distrtypes.NewMsgSetAutoCompound(delAddr.String(), "invalid", true),
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name:      "delegation does not exist",
+			msg:       distrtypes.NewMsgSetAutoCompound(sdk.AccAddress(PKS[2].Address()).String(), valAddr.String(), true),
+			expErr:    true,
+			expErrMsg: "delegation does not exist",
+		},
+		{
+			name:          "enable auto-compounding",
+			msg:           distrtypes.NewMsgSetAutoCompound(delAddr.String(), valAddr.String(), true),
+			expValidators: []string{valAddr.String()},
+		},
+		{
+			name: "disable auto-compounding",
+			msg:  distrtypes.NewMsgSetAutoCompound(delAddr.String(), valAddr.String(), false),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := f.app.RunMsg(
+				tc.msg,
+				integration.WithAutomaticFinalizeBlock(),
+				integration.WithAutomaticCommit(),
+			)
+			if tc.expErr {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Assert(t, res != nil)
+
+			queryRes, err := queryClient.DelegatorAutoCompoundValidators(f.sdkCtx, &distrtypes.QueryDelegatorAutoCompoundValidatorsRequest{DelegatorAddress: delAddr.String()})
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tc.expValidators, queryRes.Validators)
+		})
+	}
+
+	// the rewards of delegators with another withdraw address cannot be auto-compounded
+	assert.NilError(t, f.distrKeeper.SetWithdrawAddr(f.sdkCtx, delAddr, sdk.AccAddress(PKS[2].Address())))
+	_, err := f.app.RunMsg(
+		distrtypes.NewMsgSetAutoCompound(delAddr.String(), valAddr.String(), true),
+		integration.WithAutomaticFinalizeBlock(),
+		integration.WithAutomaticCommit(),
+	)
+	assert.ErrorIs(t, err, distrtypes.ErrAutoCompoundWithdrawAddr)
+}
+
+func TestAutoCompoundDelegationRewards(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	delAddr, valAddr := setupDelegation(t, f)
+	selfDelAddr := sdk.AccAddress(valAddr)
+
+	params, err := f.distrKeeper.Params.Get(f.sdkCtx)
+	assert.NilError(t, err)
+	params.CommunityTax = math.LegacyZeroDec()
+	params.AutoCompoundInterval = 10
+	params.AutoCompoundBatchSize = 1
+	assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
+
+	assert.NilError(t, f.distrKeeper.SetAutoCompound(f.sdkCtx, delAddr, valAddr, true))
+	assert.NilError(t, f.distrKeeper.SetAutoCompound(f.sdkCtx, selfDelAddr, valAddr, true))
+
+	allocateRewards := func() {
+		rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+		assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+		validator, err := f.stakingKeeper.GetValidator(f.sdkCtx, valAddr)
+		assert.NilError(t, err)
+		assert.NilError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+	}
+	shares := func(addr sdk.AccAddress) math.LegacyDec {
+		delegation, err := f.stakingKeeper.Delegations.Get(f.sdkCtx, collections.Join(addr, valAddr))
+		assert.NilError(t, err)
+		return delegation.Shares
+	}
+	initShares := map[string]math.LegacyDec{
+		delAddr.String():     shares(delAddr),
+		selfDelAddr.String(): shares(selfDelAddr),
+	}
+	compounded := func() int {
+		var n int
+		for _, addr := range []sdk.AccAddress{delAddr, selfDelAddr} {
+			if shares(addr).GT(initShares[addr.String()]) {
+				n++
+			}
+		}
+		return n
+	}
+
+	allocateRewards()
+
+	// no pass starts outside of the interval
+	f.sdkCtx = f.sdkCtx.WithHeaderInfo(header.Info{Height: 9})
+	assert.NilError(t, f.distrKeeper.AutoCompoundDelegationRewards(f.sdkCtx))
+	assert.Equal(t, 0, compounded())
+
+	// a pass starts at the interval and processes a single delegation
+	f.sdkCtx = f.sdkCtx.WithHeaderInfo(header.Info{Height: 10})
+	assert.NilError(t, f.distrKeeper.AutoCompoundDelegationRewards(f.sdkCtx))
+	assert.Equal(t, 1, compounded())
+	hasCursor, err := f.distrKeeper.AutoCompoundCursor.Has(f.sdkCtx)
+	assert.NilError(t, err)
+	assert.Assert(t, hasCursor)
+
+	// the pass continues in the next block and completes
+	f.sdkCtx = f.sdkCtx.WithHeaderInfo(header.Info{Height: 11})
+	assert.NilError(t, f.distrKeeper.AutoCompoundDelegationRewards(f.sdkCtx))
+	assert.Equal(t, 2, compounded())
+	hasCursor, err = f.distrKeeper.AutoCompoundCursor.Has(f.sdkCtx)
+	assert.NilError(t, err)
+	assert.Assert(t, !hasCursor)
+
+	// delegations with another withdraw address are not auto-compounded
+	withdrawAddr := sdk.AccAddress(PKS[2].Address())
+	assert.NilError(t, f.distrKeeper.SetWithdrawAddr(f.sdkCtx, delAddr, withdrawAddr))
+	delShares := shares(delAddr)
+	allocateRewards()
+	params.AutoCompoundBatchSize = 2
+	assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
+	f.sdkCtx = f.sdkCtx.WithHeaderInfo(header.Info{Height: 20})
+	assert.NilError(t, f.distrKeeper.AutoCompoundDelegationRewards(f.sdkCtx))
+	assert.Assert(t, delShares.Equal(shares(delAddr)))
+	assert.Assert(t, f.bankKeeper.GetAllBalances(f.sdkCtx, withdrawAddr).IsZero())
+
+	// removing the delegation opts it out of auto-compounding
+	_, _, err = f.stakingKeeper.Undelegate(f.sdkCtx, delAddr, valAddr, delShares)
+	assert.NilError(t, err)
+	has, err := f.distrKeeper.AutoCompoundDelegations.Has(f.sdkCtx, collections.Join(delAddr, valAddr))
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+}
@@ -1,7 +1,18 @@
 package keeper_test
 
 import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	stakingtestutil "cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -9,3 +20,31 @@ var (
 
 	valConsPk0 = PKS[0]
 )
+
+// setupDelegation creates a validator and a delegation to it, with the
+// distribution hooks set so that rewards are tracked. The delegations are
+// created at height 1, as delegations created in the current block (the
+// integration app runs messages at height 0) have no rewards yet.
+func setupDelegation(t *testing.T, f *fixture) (sdk.AccAddress, sdk.ValAddress) {
+	t.Helper()
+
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, distrtypes.DefaultParams()))
+	require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+
+	valAddr := sdk.ValAddress(PKS[0].Address())
+	delAddr := sdk.AccAddress(PKS[1].Address())
+
+	tokens := f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, int64(1000))
+	for _, addr := range []sdk.AccAddress{sdk.AccAddress(valAddr), delAddr} {
+		f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, addr))
+		require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+		require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+	}
+
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx.WithHeaderInfo(header.Info{Height: 1}), f.stakingKeeper)
+	tstaking.CreateValidator(valAddr, valConsPk0, math.NewInt(100), true)
+	tstaking.Delegate(delAddr, valAddr, math.NewInt(100))
+
+	return delAddr, valAddr
+}
@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,6 +12,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -36,6 +39,7 @@ import (
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
@@ -78,11 +82,12 @@ func initFixture(t *testing.T) *fixture {
 	authority := authtypes.NewModuleAddress("gov")
 
 	maccPerms := map[string][]string{
-		pooltypes.ModuleName:           {},
-		pooltypes.StreamAccount:        {},
-		distrtypes.ModuleName:          {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		pooltypes.ModuleName:               {},
+		pooltypes.StreamAccount:            {},
+		distrtypes.ModuleName:              {authtypes.Minter},
+		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		stakingtypes.LiquidStakingPoolName: {authtypes.Minter, authtypes.Burner},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -766,10 +771,12 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:          communityTax,
+					BaseProposerReward:    math.LegacyZeroDec(),
+					BonusProposerReward:   math.LegacyZeroDec(),
+					WithdrawAddrEnabled:   withdrawAddrEnabled,
+					AutoCompoundInterval:  distrtypes.DefaultParams().AutoCompoundInterval,
+					AutoCompoundBatchSize: distrtypes.DefaultParams().AutoCompoundBatchSize,
 				},
 			},
 			expErr: false,
@@ -994,3 +1001,255 @@ func TestMsgDepositValidatorRewardsPool(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, distrtypes.DefaultParams()))
+
+	owner := sdk.AccAddress("owner")
+	addr1 := sdk.AccAddress(PKS[0].Address())
+	valAddr1 := sdk.ValAddress(addr1)
+
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, addr1))
+	tokens := f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, int64(1000))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, addr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx, f.stakingKeeper)
+	tstaking.CreateValidator(valAddr1, valConsPk0, math.NewInt(100), true)
+
+	// a record without delegation only holds the rewards withdrawn on changes
+	// of its tokenized delegation
+	record := stakingtypes.NewTokenizeShareRecord(1, owner.String(), valAddr1.String())
+	require.NoError(t, f.stakingKeeper.TokenizeShareRecords.Set(f.sdkCtx, record.Id, record))
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("foo", 500))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, record.GetModuleAddress(), rewards))
+
+	testCases := []struct {
+		name      string
+		msg       *distrtypes.MsgWithdrawTokenizeShareRecordReward
+		expErr    bool
+		expErrMsg string
+		expAmount sdk.Coins
+	}{
+		{
+			name:      "invalid owner address",
+			msg:       distrtypes.NewMsgWithdrawTokenizeShareRecordReward("invalid"),
+			expErr:    true,
+			expErrMsg: "invalid owner address",
+		},
+		{
+			name:      "no tokenize share records",
+			msg:       distrtypes.NewMsgWithdrawTokenizeShareRecordReward(addr1.String()),
+			expErr:    true,
+			expErrMsg: "no tokenize share records owned by address",
+		},
+		{
+			name:      "withdraw rewards",
+			msg:       distrtypes.NewMsgWithdrawTokenizeShareRecordReward(owner.String()),
+			expAmount: rewards,
+		},
+		{
+			name:      "no rewards left",
+			msg:       distrtypes.NewMsgWithdrawTokenizeShareRecordReward(owner.String()),
+			expAmount: sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := f.app.RunMsg(
+				tc.msg,
+				integration.WithAutomaticFinalizeBlock(),
+				integration.WithAutomaticCommit(),
+			)
+			if tc.expErr {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Assert(t, res != nil)
+
+			result := distrtypes.MsgWithdrawTokenizeShareRecordRewardResponse{}
+			assert.NilError(t, f.cdc.Unmarshal(res.Value, &result))
+			assert.Assert(t, tc.expAmount.Equal(result.Amount))
+
+			assert.Assert(t, f.bankKeeper.GetAllBalances(f.sdkCtx, record.GetModuleAddress()).IsZero())
+			assert.Assert(t, rewards.Equal(f.bankKeeper.GetAllBalances(f.sdkCtx, owner)))
+		})
+	}
+}
+
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	delAddr, valAddr1 := setupDelegation(t, f)
+
+	// a second validator with commission, also delegated to by delAddr
+	operator := sdk.AccAddress(PKS[2].Address())
+	valAddr2 := sdk.ValAddress(operator)
+	tokens := f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, int64(1000))
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, operator))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, operator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+	// created at height 1, like in setupDelegation, for the delegations to have rewards
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx.WithHeaderInfo(header.Info{Height: 1}), f.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(5, 1), math.LegacyOneDec(), math.LegacyZeroDec())
+	tstaking.CreateValidator(valAddr2, PKS[2], math.NewInt(100), true)
+	tstaking.Delegate(delAddr, valAddr2, math.NewInt(100))
+
+	for _, valAddr := range []sdk.ValAddress{valAddr1, valAddr2} {
+		rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+		require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+		validator, err := f.stakingKeeper.GetValidator(f.sdkCtx, valAddr)
+		require.NoError(t, err)
+		require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+	}
+
+	testCases := []struct {
+		name          string
+		msg           *distrtypes.MsgWithdrawAllDelegatorRewards
+		expErr        bool
+		expErrMsg     string
+		expValidators []string
+		expCommission bool
+	}{
+		{
+			name:      "invalid delegator address",
+			msg:       distrtypes.NewMsgWithdrawAllDelegatorRewards("invalid", false),
+			expErr:    true,
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "commission of a delegator not operating a validator",
+			msg:       distrtypes.NewMsgWithdrawAllDelegatorRewards(delAddr.String(), true),
+			expErr:    true,
+			expErrMsg: "validator does not exist",
+		},
+		{
+			name: "invalid start validator address",
+			msg: &distrtypes.MsgWithdrawAllDelegatorRewards{
+				DelegatorAddress:      delAddr.String(),
+				StartValidatorAddress: "invalid",
+			},
+			expErr:    true,
+			expErrMsg: "invalid start validator address",
+		},
+		{
+			name:          "withdraw the rewards of all the delegations",
+			msg:           distrtypes.NewMsgWithdrawAllDelegatorRewards(delAddr.String(), false),
+			expValidators: []string{valAddr1.String(), valAddr2.String()},
+		},
+		{
+			name:          "withdraw the rewards and the commission of an operator",
+			msg:           distrtypes.NewMsgWithdrawAllDelegatorRewards(operator.String(), true),
+			expValidators: []string{valAddr2.String()},
+			expCommission: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := f.app.RunMsg(
+				tc.msg,
+				integration.WithAutomaticFinalizeBlock(),
+				integration.WithAutomaticCommit(),
+			)
+			if tc.expErr {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Assert(t, res != nil)
+
+			result := distrtypes.MsgWithdrawAllDelegatorRewardsResponse{}
+			assert.NilError(t, f.cdc.Unmarshal(res.Value, &result))
+			assert.Equal(t, len(tc.expValidators), len(result.Withdrawn))
+
+			total := sdk.NewCoins()
+			for i, withdrawn := range result.Withdrawn {
+				assert.Assert(t, slices.Contains(tc.expValidators, withdrawn.ValidatorAddress))
+				if i > 0 {
+					assert.Assert(t, withdrawn.ValidatorAddress != result.Withdrawn[i-1].ValidatorAddress)
+				}
+				assert.Assert(t, withdrawn.Rewards.IsAllPositive())
+				assert.Equal(t, tc.expCommission, withdrawn.Commission.IsAllPositive())
+				total = total.Add(withdrawn.Rewards...).Add(withdrawn.Commission...)
+			}
+			assert.Assert(t, total.Equal(result.Amount))
+			assert.Equal(t, "", result.NextValidatorAddress)
+		})
+	}
+}
+
+func TestMsgWithdrawAllDelegatorRewardsBatches(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	delAddr, valAddr := setupDelegation(t, f)
+	valAddrs := []sdk.ValAddress{valAddr}
+
+	// more delegations than a single message withdraws
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx.WithHeaderInfo(header.Info{Height: 1}), f.stakingKeeper)
+	tokens := f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, int64(1000))
+	for _, pk := range simtestutil.CreateTestPubKeys(distrtypes.MaxWithdrawAllDelegations + 2)[2:] {
+		operator := sdk.AccAddress(pk.Address())
+		f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, operator))
+		require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+		require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, operator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+		tstaking.CreateValidator(sdk.ValAddress(operator), pk, math.NewInt(100), true)
+		tstaking.Delegate(delAddr, sdk.ValAddress(operator), math.NewInt(100))
+		valAddrs = append(valAddrs, sdk.ValAddress(operator))
+	}
+	assert.Equal(t, distrtypes.MaxWithdrawAllDelegations+1, len(valAddrs))
+
+	for _, valAddr := range valAddrs {
+		rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+		require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+		validator, err := f.stakingKeeper.GetValidator(f.sdkCtx, valAddr)
+		require.NoError(t, err)
+		require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+	}
+
+	// the delegations are withdrawn in the order of their validator address
+	slices.SortFunc(valAddrs, func(a, b sdk.ValAddress) int { return bytes.Compare(a, b) })
+
+	withdraw := func(start string) distrtypes.MsgWithdrawAllDelegatorRewardsResponse {
+		res, err := f.app.RunMsg(
+			&distrtypes.MsgWithdrawAllDelegatorRewards{
+				DelegatorAddress:      delAddr.String(),
+				StartValidatorAddress: start,
+			},
+			integration.WithAutomaticFinalizeBlock(),
+			integration.WithAutomaticCommit(),
+		)
+		assert.NilError(t, err)
+
+		result := distrtypes.MsgWithdrawAllDelegatorRewardsResponse{}
+		assert.NilError(t, f.cdc.Unmarshal(res.Value, &result))
+		for _, withdrawn := range result.Withdrawn {
+			assert.Assert(t, withdrawn.Rewards.IsAllPositive())
+		}
+		return result
+	}
+
+	// the first batch stops at the last delegation
+	result := withdraw("")
+	assert.Equal(t, distrtypes.MaxWithdrawAllDelegations, len(result.Withdrawn))
+	assert.Equal(t, valAddrs[0].String(), result.Withdrawn[0].ValidatorAddress)
+	assert.Equal(t, valAddrs[len(valAddrs)-1].String(), result.NextValidatorAddress)
+
+	// the next batch resumes from it
+	result = withdraw(result.NextValidatorAddress)
+	assert.Equal(t, 1, len(result.Withdrawn))
+	assert.Equal(t, valAddrs[len(valAddrs)-1].String(), result.Withdrawn[0].ValidatorAddress)
+	assert.Equal(t, "", result.NextValidatorAddress)
+}
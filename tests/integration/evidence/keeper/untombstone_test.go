@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/evidence/keeper"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	minttypes "cosmossdk.io/x/mint/types"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+	stakingtestutil "cosmossdk.io/x/staking/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUntombstone(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, valpubkey := valAddresses[0], pubkeys[0]
+	consAddr := sdk.ConsAddress(valpubkey.Address())
+	consAddrStr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	assert.NilError(t, err)
+
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, sdk.AccAddress(operatorAddr)))
+	tstaking.CreateValidatorWithValPower(operatorAddr, valpubkey, power, true)
+	_, err = f.stakingKeeper.EndBlocker(f.sdkCtx)
+	assert.NilError(t, err)
+
+	assert.NilError(t, f.slashingKeeper.AddrPubkeyRelation.Set(f.sdkCtx, valpubkey.Address(), valpubkey))
+	info := slashingtypes.NewValidatorSigningInfo(consAddrStr, f.sdkCtx.BlockHeight(), time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(f.sdkCtx, consAddr, info))
+
+	// fund the community pool to refund the slashed stake from
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+	poolFunds := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000_000_000)))
+	assert.NilError(t, f.bankKeeper.MintCoins(ctx, minttypes.ModuleName, poolFunds))
+	assert.NilError(t, f.bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, pooltypes.ModuleName, poolFunds))
+
+	msgServer := keeper.NewMsgServerImpl(*f.evidenceKeeper)
+	authority := f.evidenceKeeper.GetAuthority()
+
+	// a validator that is not tombstoned cannot be untombstoned
+	_, err = msgServer.Untombstone(ctx, evidencetypes.NewMsgUntombstone(authority, consAddrStr, nil, ""))
+	assert.ErrorIs(t, err, slashingtypes.ErrValidatorNotTombstoned)
+
+	// a delegator is slashed with the validator
+	delegator := sdk.AccAddress(valAddresses[1])
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, delegator))
+	tstaking.Delegate(delegator, operatorAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 50))
+
+	// double sign to get the validator tombstoned
+	val, err := f.stakingKeeper.GetValidator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	oldTokens := val.GetTokens()
+
+	nci := comet.Info{
+		Evidence: []comet.Evidence{{
+			Validator: comet.Validator{Address: valpubkey.Address(), Power: power},
+			Type:      comet.DuplicateVote,
+			Time:      time.Now().UTC(),
+			Height:    1,
+		}},
+	}
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx.WithCometInfo(nci)))
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, consAddr))
+
+	val, err = f.stakingKeeper.GetValidator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	slashed := oldTokens.Sub(val.GetTokens())
+	assert.Assert(t, slashed.IsPositive())
+
+	// the tokens slashed from each delegation are recorded
+	operatorSlashed, err := f.evidenceKeeper.SlashedDelegations.Get(ctx, collections.Join(operatorAddr.Bytes(), sdk.AccAddress(operatorAddr).Bytes()))
+	assert.NilError(t, err)
+	delegatorSlashed, err := f.evidenceKeeper.SlashedDelegations.Get(ctx, collections.Join(operatorAddr.Bytes(), delegator.Bytes()))
+	assert.NilError(t, err)
+	assert.Assert(t, operatorSlashed.IsPositive() && delegatorSlashed.IsPositive())
+	assert.Assert(t, operatorSlashed.Add(delegatorSlashed).LTE(slashed))
+
+	// the slashed delegator unbonds and a new delegator delegates before the
+	// validator is untombstoned
+	tstaking.Undelegate(delegator, operatorAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 40), true)
+	newDelegator := sdk.AccAddress(valAddresses[2])
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, newDelegator))
+	tstaking.Delegate(newDelegator, operatorAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 50))
+
+	// a refund in another denom is rejected
+	otherRefund := sdk.NewCoin("other", slashed)
+	_, err = msgServer.Untombstone(ctx, evidencetypes.NewMsgUntombstone(authority, consAddrStr, &otherRefund, ""))
+	assert.ErrorIs(t, err, evidencetypes.ErrInvalidRefund)
+
+	// a refund exceeding the slashed tokens is rejected
+	excessRefund := sdk.NewCoin(bondDenom, slashed.AddRaw(1))
+	_, err = msgServer.Untombstone(ctx, evidencetypes.NewMsgUntombstone(authority, consAddrStr, &excessRefund, ""))
+	assert.ErrorContains(t, err, "exceeds the")
+
+	// untombstone the validator and refund the slashed stake
+	operatorBalance := f.bankKeeper.GetBalance(ctx, sdk.AccAddress(operatorAddr), bondDenom)
+	delegatorBalance := f.bankKeeper.GetBalance(ctx, delegator, bondDenom)
+	newDelegatorBalance := f.bankKeeper.GetBalance(ctx, newDelegator, bondDenom)
+	val, err = f.stakingKeeper.GetValidator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	valTokens := val.GetTokens()
+
+	refund := sdk.NewCoin(bondDenom, slashed)
+	res, err := msgServer.Untombstone(ctx, evidencetypes.NewMsgUntombstone(authority, consAddrStr, &refund, "false positive"))
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(0), res.Id)
+
+	assert.Assert(t, !f.slashingKeeper.IsTombstoned(ctx, consAddr))
+	val, err = f.stakingKeeper.GetValidator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, val.GetTokens().Equal(valTokens))
+	assert.Assert(t, val.IsJailed())
+
+	// the slashed delegators are refunded, even if they unbonded, and the new
+	// delegator is not
+	assert.DeepEqual(t, operatorBalance.AddAmount(operatorSlashed), f.bankKeeper.GetBalance(ctx, sdk.AccAddress(operatorAddr), bondDenom))
+	assert.DeepEqual(t, delegatorBalance.AddAmount(delegatorSlashed), f.bankKeeper.GetBalance(ctx, delegator, bondDenom))
+	assert.DeepEqual(t, newDelegatorBalance, f.bankKeeper.GetBalance(ctx, newDelegator, bondDenom))
+
+	communityPool, err := f.poolKeeper.GetCommunityPool(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, poolFunds.Sub(sdk.NewCoin(bondDenom, operatorSlashed.Add(delegatorSlashed))), communityPool)
+
+	// the slashed tokens can only be refunded once
+	has, err := f.evidenceKeeper.SlashedTokens.Has(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+	has, err = f.evidenceKeeper.SlashedDelegations.Has(ctx, collections.Join(operatorAddr.Bytes(), delegator.Bytes()))
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+
+	// the validator can unjail again
+	assert.NilError(t, f.slashingKeeper.Unjail(ctx, operatorAddr))
+
+	// the decision is recorded in the untombstone history
+	historyRes, err := keeper.NewQuerier(f.evidenceKeeper).UntombstoneHistory(ctx, &evidencetypes.QueryUntombstoneHistoryRequest{ConsensusAddress: consAddrStr})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(historyRes.Records))
+	record := historyRes.Records[0]
+	assert.Equal(t, consAddrStr, record.ConsensusAddress)
+	assert.Equal(t, ctx.HeaderInfo().Height, record.Height)
+	assert.DeepEqual(t, &refund, record.Refund)
+	assert.Equal(t, authority, record.Authority)
+	assert.Equal(t, "false positive", record.Justification)
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/evidence/keeper"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtestutil "cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHandleVoteExtensionEquivocation(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	const chainID = "test-chain"
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithHeaderInfo(header.Info{Height: 1, Time: time.Now(), ChainID: chainID})
+	cp := ctx.ConsensusParams()
+	cp.Abci = &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1}
+	ctx = ctx.WithConsensusParams(cp)
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr := valAddresses[0]
+	oldPrivKey, newPrivKey := ed25519.GenPrivKey(), ed25519.GenPrivKey()
+	oldConsAddr := sdk.ConsAddress(oldPrivKey.PubKey().Address())
+	newConsAddr := sdk.ConsAddress(newPrivKey.PubKey().Address())
+
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	f.accountKeeper.SetAccount(ctx, f.accountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(operatorAddr)))
+	tstaking.CreateValidatorWithValPower(operatorAddr, oldPrivKey.PubKey(), power, true)
+	_, err := f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	// the validator signed the commit of height 1 with less power than it has now
+	infractionPower := int64(80)
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx.
+		WithHeaderInfo(header.Info{Height: 2, Time: time.Now(), ChainID: chainID}).
+		WithCometInfo(comet.Info{LastCommit: comet.CommitInfo{Votes: []comet.VoteInfo{
+			{Validator: comet.Validator{Address: oldConsAddr, Power: infractionPower}, BlockIDFlag: comet.BlockIDFlagCommit},
+		}}}),
+	))
+
+	// rotate the consensus key of the validator at height 5
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 5, Time: time.Now(), ChainID: chainID})
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(operatorAddr.String(), newPrivKey.PubKey())
+	assert.NilError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(f.stakingKeeper).RotateConsPubKey(ctx, msg)
+	assert.NilError(t, err)
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, f.slashingKeeper.HasValidatorSigningInfo(ctx, newConsAddr))
+
+	// the new consensus key signed the commit of height 6, the old one left the
+	// validator set
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx.
+		WithHeaderInfo(header.Info{Height: 7, Time: time.Now(), ChainID: chainID}).
+		WithCometInfo(comet.Info{LastCommit: comet.CommitInfo{Votes: []comet.VoteInfo{
+			{Validator: comet.Validator{Address: newConsAddr, Power: power}, BlockIDFlag: comet.BlockIDFlagCommit},
+		}}}),
+	))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Now(), ChainID: chainID})
+
+	newEvidence := func(privKey cryptotypes.PrivKey, height int64) *evidencetypes.VoteExtensionEquivocation {
+		sign := func(extension []byte) []byte {
+			signBytes, err := evidencetypes.VoteExtensionSignBytes(chainID, height, 0, extension)
+			assert.NilError(t, err)
+			sig, err := privKey.Sign(signBytes)
+			assert.NilError(t, err)
+			return sig
+		}
+
+		pkAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+		assert.NilError(t, err)
+
+		return &evidencetypes.VoteExtensionEquivocation{
+			Height:              height,
+			Round:               0,
+			ConsensusAddress:    sdk.ConsAddress(privKey.PubKey().Address()).String(),
+			ConsensusPubkey:     pkAny,
+			VoteExtensionA:      []byte("price:1"),
+			ExtensionSignatureA: sign([]byte("price:1")),
+			VoteExtensionB:      []byte("price:2"),
+			ExtensionSignatureB: sign([]byte("price:2")),
+		}
+	}
+
+	msgServer := keeper.NewMsgServerImpl(*f.evidenceKeeper)
+	submitter := sdk.AccAddress(valAddresses[1])
+
+	testCases := []struct {
+		name      string
+		evidence  func() *evidencetypes.VoteExtensionEquivocation
+		expErrMsg string
+	}{
+		{
+			name:      "vote extensions from the current height",
+			evidence:  func() *evidencetypes.VoteExtensionEquivocation { return newEvidence(newPrivKey, 10) },
+			expErrMsg: "must be from a past height",
+		},
+		{
+			name:      "old consensus key after the rotation",
+			evidence:  func() *evidencetypes.VoteExtensionEquivocation { return newEvidence(oldPrivKey, 7) },
+			expErrMsg: "not in the validator set at height 7",
+		},
+		{
+			name:      "new consensus key before the rotation",
+			evidence:  func() *evidencetypes.VoteExtensionEquivocation { return newEvidence(newPrivKey, 3) },
+			expErrMsg: "not in the validator set at height 3",
+		},
+		{
+			name: "consensus public key of another address",
+			evidence: func() *evidencetypes.VoteExtensionEquivocation {
+				e := newEvidence(oldPrivKey, 3)
+				var err error
+				e.ConsensusPubkey, err = codectypes.NewAnyWithValue(newPrivKey.PubKey())
+				assert.NilError(t, err)
+				return e
+			},
+			expErrMsg: "consensus public key does not match the consensus address",
+		},
+		{
+			name: "invalid signature",
+			evidence: func() *evidencetypes.VoteExtensionEquivocation {
+				e := newEvidence(oldPrivKey, 3)
+				e.ExtensionSignatureB = e.ExtensionSignatureA
+				return e
+			},
+			expErrMsg: "invalid vote extension signature",
+		},
+		{
+			name: "signature from another chain",
+			evidence: func() *evidencetypes.VoteExtensionEquivocation {
+				e := newEvidence(oldPrivKey, 3)
+				signBytes, err := evidencetypes.VoteExtensionSignBytes("other-chain", 3, 0, e.VoteExtensionB)
+				assert.NilError(t, err)
+				e.ExtensionSignatureB, err = oldPrivKey.Sign(signBytes)
+				assert.NilError(t, err)
+				return e
+			},
+			expErrMsg: "invalid vote extension signature",
+		},
+		{
+			name:     "valid evidence signed with the consensus key before the rotation",
+			evidence: func() *evidencetypes.VoteExtensionEquivocation { return newEvidence(oldPrivKey, 3) },
+		},
+		{
+			name:      "validator already tombstoned",
+			evidence:  func() *evidencetypes.VoteExtensionEquivocation { return newEvidence(newPrivKey, 8) },
+			expErrMsg: "already tombstoned",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := f.stakingKeeper.GetValidator(ctx, operatorAddr)
+			assert.NilError(t, err)
+			oldTokens := val.GetTokens()
+
+			evidence := tc.evidence()
+			msg, err := evidencetypes.NewMsgSubmitEvidence(submitter, evidence)
+			assert.NilError(t, err)
+
+			_, err = msgServer.SubmitEvidence(ctx, msg)
+			if tc.expErrMsg != "" {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			assert.NilError(t, err)
+
+			// the validator is slashed with its power at the evidence height,
+			// jailed and tombstoned under its current key
+			slashFraction, err := f.slashingKeeper.SlashFractionDoubleSign(ctx)
+			assert.NilError(t, err)
+			slashAmount := slashFraction.MulInt(f.stakingKeeper.TokensFromConsensusPower(ctx, infractionPower)).TruncateInt()
+			val, err = f.stakingKeeper.GetValidator(ctx, operatorAddr)
+			assert.NilError(t, err)
+			assert.Assert(t, val.IsJailed())
+			assert.Assert(t, val.GetTokens().Equal(oldTokens.Sub(slashAmount)), "%s != %s - %s", val.GetTokens(), oldTokens, slashAmount)
+			assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, newConsAddr))
+			assert.Assert(t, !f.slashingKeeper.IsTombstoned(ctx, oldConsAddr))
+
+			has, err := f.evidenceKeeper.Evidences.Has(ctx, evidence.Hash())
+			assert.NilError(t, err)
+			assert.Assert(t, has)
+		})
+	}
+}
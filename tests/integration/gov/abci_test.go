@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/gov/keeper"
 	"cosmossdk.io/x/gov/types"
@@ -17,6 +18,7 @@ import (
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -462,6 +464,60 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestEndBlockerProposalUndecodableMessage(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.app
+	ctx := app.BaseApp.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+
+	acc := suite.AccountKeeper.NewAccountWithAddress(ctx, addrs[0])
+	suite.AccountKeeper.SetAccount(ctx, acc)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	_, err := suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	ac := addresscodec.NewBech32Codec("cosmos")
+	govAcct, err := ac.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+	toAddr, err := ac.BytesToString(addrs[0])
+	require.NoError(t, err)
+
+	msg := banktypes.NewMsgSend(govAcct, toAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1))))
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins))
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	// the message of the stored proposal cannot be unpacked
+	proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	proposal.Messages = []*codectypes.Any{{}}
+	require.NoError(t, suite.GovKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+
+	params, err := suite.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	newHeader := ctx.HeaderInfo()
+	newHeader.Time = ctx.HeaderInfo().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+	ctx = ctx.WithHeaderInfo(newHeader)
+
+	// the passed proposal fails instead of halting the chain
+	err = suite.GovKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusFailed, proposal.Status)
+	require.Nil(t, proposal.ExecutionTime)
+}
+
 func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	testcases := []struct {
 		name string
@@ -633,6 +689,167 @@ func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	}
 }
 
+func TestQueuedProposal(t *testing.T) {
+	testcases := []struct {
+		name      string
+		malleate  func(t *testing.T, ctx sdk.Context, s suite, proposalID uint64, guardian string)
+		expStatus v1.ProposalStatus
+	}{
+		{
+			name:      "executed at execution time",
+			expStatus: v1.StatusPassed,
+		},
+		{
+			name: "canceled by a timelock guardian",
+			malleate: func(t *testing.T, ctx sdk.Context, s suite, proposalID uint64, guardian string) {
+				t.Helper()
+				govMsgSvr := keeper.NewMsgServerImpl(s.GovKeeper)
+
+				_, err := govMsgSvr.CancelQueuedProposal(ctx, v1.NewMsgCancelQueuedProposal(proposalID, guardian, "security issue"))
+				require.NoError(t, err)
+
+				queued, err := s.GovKeeper.QueuedProposalsQueue.Has(ctx, collections.Join(ctx.HeaderInfo().Time.Add(time.Hour), proposalID))
+				require.NoError(t, err)
+				require.False(t, queued)
+			},
+			expStatus: v1.StatusRejected,
+		},
+		{
+			name: "vetoed during timelock",
+			malleate: func(t *testing.T, ctx sdk.Context, s suite, proposalID uint64, guardian string) {
+				t.Helper()
+				params, err := s.GovKeeper.Params.Get(ctx)
+				require.NoError(t, err)
+				params.TimelockVetoThreshold = "0.5"
+				require.NoError(t, s.GovKeeper.Params.Set(ctx, params))
+
+				voter, err := s.AccountKeeper.AddressCodec().StringToBytes(guardian)
+				require.NoError(t, err)
+
+				err = s.GovKeeper.AddVote(ctx, proposalID, voter, v1.NewNonSplitVoteOption(v1.OptionYes), "")
+				require.ErrorIs(t, err, types.ErrInvalidVote)
+
+				err = s.GovKeeper.AddVote(ctx, proposalID, voter, v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), "")
+				require.NoError(t, err)
+			},
+			expStatus: v1.StatusRejected,
+		},
+		{
+			name: "not vetoed without timelock veto threshold",
+			malleate: func(t *testing.T, ctx sdk.Context, s suite, proposalID uint64, guardian string) {
+				t.Helper()
+				voter, err := s.AccountKeeper.AddressCodec().StringToBytes(guardian)
+				require.NoError(t, err)
+
+				err = s.GovKeeper.AddVote(ctx, proposalID, voter, v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), "")
+				require.ErrorIs(t, err, types.ErrInactiveProposal)
+			},
+			expStatus: v1.StatusPassed,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.app
+			ctx := app.BaseApp.NewContext(false)
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+
+			acc := suite.AccountKeeper.NewAccountWithAddress(ctx, addrs[0])
+			suite.AccountKeeper.SetAccount(ctx, acc)
+
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			_, err := suite.StakingKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			guardian, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[0])
+			require.NoError(t, err)
+
+			params, err := suite.GovKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			params.TimelockGuardians = []string{guardian}
+			require.NoError(t, suite.GovKeeper.Params.Set(ctx, params))
+
+			govAcct, err := suite.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+			require.NoError(t, err)
+			recipient := sdk.AccAddress("recipient")
+			recipientStr, err := suite.AccountKeeper.AddressCodec().BytesToString(recipient)
+			require.NoError(t, err)
+
+			amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+			require.NoError(t, banktestutil.FundModuleAccount(ctx, suite.BankKeeper, types.ModuleName, amount))
+
+			msg := banktypes.NewMsgSend(govAcct, recipientStr, amount)
+			delay := time.Hour
+			err = suite.GovKeeper.MessageBasedParams.Set(ctx, sdk.MsgTypeURL(msg), v1.MessageBasedParams{
+				VotingPeriod:   params.VotingPeriod,
+				Quorum:         params.Quorum,
+				YesQuorum:      params.YesQuorum,
+				Threshold:      params.Threshold,
+				VetoThreshold:  params.VetoThreshold,
+				ExecutionDelay: &delay,
+			})
+			require.NoError(t, err)
+
+			proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins))
+			require.NoError(t, err)
+
+			err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+			require.NoError(t, err)
+
+			newHeader := ctx.HeaderInfo()
+			newHeader.Time = ctx.HeaderInfo().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+			ctx = ctx.WithHeaderInfo(newHeader)
+
+			err = suite.GovKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, v1.StatusQueued, proposal.Status)
+			require.Equal(t, newHeader.Time.Add(delay), *proposal.ExecutionTime)
+
+			queued, err := suite.GovKeeper.QueuedProposalsQueue.Has(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id))
+			require.NoError(t, err)
+			require.True(t, queued)
+
+			if tc.malleate != nil {
+				tc.malleate(t, ctx, suite, proposal.Id, guardian)
+			}
+
+			newHeader.Time = *proposal.ExecutionTime
+			ctx = ctx.WithHeaderInfo(newHeader)
+
+			err = suite.GovKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, proposal.Status, proposal.FailedReason)
+
+			expBalance := sdk.NewCoins()
+			if tc.expStatus == v1.StatusPassed {
+				expBalance = amount
+			}
+			require.Equal(t, expBalance, suite.BankKeeper.GetAllBalances(ctx, recipient))
+
+			queued, err = suite.GovKeeper.QueuedProposalsQueue.Has(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id))
+			require.NoError(t, err)
+			require.False(t, queued)
+
+			voted, err := suite.GovKeeper.Votes.Has(ctx, collections.Join(proposal.Id, addrs[0]))
+			require.NoError(t, err)
+			require.False(t, voted)
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	t.Helper()
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gotest.tools/v3/assert"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
@@ -100,6 +101,11 @@ func TestImportExportQueues(t *testing.T) {
 	assert.Assert(t, proposal1.Status == v1.StatusDepositPeriod)
 	assert.Assert(t, proposal2.Status == v1.StatusVotingPeriod)
 
+	// Register a governance delegate and assign voting power to it
+	delegator := sdk.AccAddress("delegator___________")
+	assert.NilError(t, s1.GovKeeper.RegisterDelegate(ctx, addrs[0], "metadata"))
+	assert.NilError(t, s1.GovKeeper.AssignDelegate(ctx, delegator, addrs[0]))
+
 	authGenState, err := s1.AccountKeeper.ExportGenesis(ctx)
 	require.NoError(t, err)
 	bankGenState := s1.BankKeeper.ExportGenesis(ctx)
@@ -169,6 +175,17 @@ func TestImportExportQueues(t *testing.T) {
 	assert.Assert(t, proposal1.Status == v1.StatusDepositPeriod)
 	assert.Assert(t, proposal2.Status == v1.StatusVotingPeriod)
 
+	// Make sure that the governance delegate and its delegator were imported
+	delegate, err := s2.GovKeeper.Delegates.Get(ctx2, addrs[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "metadata", delegate.Metadata)
+	delegateAddr, err := s2.GovKeeper.DelegatorDelegates.Get(ctx2, delegator)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, addrs[0], delegateAddr)
+	has, err := s2.GovKeeper.DelegateDelegators.Has(ctx2, collections.Join(addrs[0], delegator))
+	assert.NilError(t, err)
+	assert.Assert(t, has)
+
 	macc := s2.GovKeeper.GetGovernanceAccount(ctx2)
 	assert.DeepEqual(t, sdk.Coins(params.MinDeposit), s2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
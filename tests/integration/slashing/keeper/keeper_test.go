@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/comet"
 	coreheader "cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth"
 	authkeeper "cosmossdk.io/x/auth/keeper"
@@ -68,9 +69,10 @@ func initFixture(tb testing.TB) *fixture {
 	authority := authtypes.NewModuleAddress("gov")
 
 	maccPerms := map[string][]string{
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		minttypes.ModuleName:               {authtypes.Minter},
+		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		stakingtypes.LiquidStakingPoolName: {authtypes.Minter, authtypes.Burner},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -370,6 +372,103 @@ func TestHandleAlreadyJailed(t *testing.T) {
 	assert.DeepEqual(t, resultingTokens, validator.GetTokens())
 }
 
+// Test a validator being jailed for downtime repeatedly
+// Ensure that the penalties escalate within the escalation window
+func TestHandleRepeatDowntime(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	params := testutil.TestParams()
+	params.SignedBlocksWindow = 10
+	params.DowntimeJailDuration = time.Minute
+	params.SlashFractionDowntime = math.LegacyNewDecWithPrec(1, 2)
+	params.DowntimeEscalationWindow = time.Hour
+	params.DowntimeEscalationMultiplier = math.LegacyNewDec(2)
+	params.MaxDowntimeEscalations = 1
+	assert.NilError(t, f.slashingKeeper.Params.Set(f.ctx, params))
+
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := f.valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+
+	consStr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	assert.NilError(t, err)
+
+	info := slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(f.ctx, consAddr, info))
+
+	acc := f.accountKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(addr))
+	f.accountKeeper.SetAccount(f.ctx, acc)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	_, err = f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	blockTime := time.Unix(1700000000, 0).UTC()
+	height := int64(0)
+
+	// first signed blocks window OK
+	for ; height < params.SignedBlocksWindow; height++ {
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height, Time: blockTime})
+		assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagCommit))
+	}
+
+	// missBlocks misses blocks until the validator is jailed for downtime
+	missBlocks := func() {
+		validator, err := f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+		assert.NilError(t, err)
+		for ; !validator.IsJailed(); height++ {
+			f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height, Time: blockTime})
+			assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagAbsent))
+			validator, err = f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+			assert.NilError(t, err)
+		}
+	}
+
+	// first downtime jailing is penalized with the base penalties
+	missBlocks()
+	info, err = f.slashingKeeper.ValidatorSigningInfo.Get(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(1), info.DowntimeJailCount)
+	assert.Equal(t, blockTime.Add(time.Minute), info.JailedUntil)
+	assert.Equal(t, blockTime.Add(time.Minute+time.Hour), info.DowntimeEscalationResetTime)
+	validator, err := f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+	assert.NilError(t, err)
+	expTokens := amt.Sub(f.stakingKeeper.TokensFromConsensusPower(f.ctx, 1))
+	assert.DeepEqual(t, expTokens, validator.GetTokens())
+
+	// repeat downtime jailing within the escalation window doubles the penalties
+	blockTime = blockTime.Add(30 * time.Minute)
+	assert.NilError(t, f.stakingKeeper.Unjail(f.ctx, consAddr))
+	missBlocks()
+	info, err = f.slashingKeeper.ValidatorSigningInfo.Get(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(2), info.DowntimeJailCount)
+	assert.Equal(t, blockTime.Add(2*time.Minute), info.JailedUntil)
+	validator, err = f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+	assert.NilError(t, err)
+	expTokens = expTokens.Sub(f.stakingKeeper.TokensFromConsensusPower(f.ctx, 2))
+	assert.DeepEqual(t, expTokens, validator.GetTokens())
+
+	// escalation is capped at the max downtime escalations
+	res, err := f.slashingKeeper.DowntimePenalty(f.ctx, &slashingtypes.QueryDowntimePenaltyRequest{ConsAddress: consStr})
+	assert.NilError(t, err)
+	assert.Equal(t, int64(3), res.DowntimeJailCount)
+	assert.Assert(t, math.LegacyNewDecWithPrec(2, 2).Equal(res.SlashFraction))
+	assert.Equal(t, 2*time.Minute, res.JailDuration)
+
+	// escalation resets once the escalation window has elapsed
+	f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height, Time: info.DowntimeEscalationResetTime})
+	res, err = f.slashingKeeper.DowntimePenalty(f.ctx, &slashingtypes.QueryDowntimePenaltyRequest{ConsAddress: consStr})
+	assert.NilError(t, err)
+	assert.Equal(t, int64(1), res.DowntimeJailCount)
+	assert.Assert(t, params.SlashFractionDowntime.Equal(res.SlashFraction))
+	assert.Equal(t, params.DowntimeJailDuration, res.JailDuration)
+}
+
 // Test a validator dipping in and out of the validator set
 // Ensure that missed blocks are tracked correctly and that
 // the start height of the signing info is reset correctly
@@ -115,11 +115,12 @@ func initFixture(tb testing.TB) *fixture {
 	authority := authtypes.NewModuleAddress("gov")
 
 	maccPerms := map[string][]string{
-		pooltypes.ModuleName:    {},
-		minttypes.ModuleName:    {authtypes.Minter},
-		types.ModuleName:        {authtypes.Minter},
-		types.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		types.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		pooltypes.ModuleName:        {},
+		minttypes.ModuleName:        {authtypes.Minter},
+		types.ModuleName:            {authtypes.Minter},
+		types.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
+		types.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		types.LiquidStakingPoolName: {authtypes.Minter, authtypes.Burner},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
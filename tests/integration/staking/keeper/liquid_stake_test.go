@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+	delAddr, owner, holder := addrs[2], addrs[3], addrs[4]
+
+	validator, err := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	delTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err = f.stakingKeeper.Delegate(ctx, delAddr, delTokens, types.Unbonded, validator, true)
+	assert.NilError(t, err)
+
+	// tokenize 4 of the 10 delegated tokens
+	tokenizeTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 4)
+	record, shareToken, err := f.stakingKeeper.TokenizeShares(ctx, delAddr, valAddrs[0], tokenizeTokens, owner)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), record.Id)
+	assert.Equal(t, owner.String(), record.Owner)
+	assert.Equal(t, valAddrs[0].String(), record.Validator)
+	assert.DeepEqual(t, sdk.NewCoin(record.GetShareTokenDenom(), tokenizeTokens), shareToken)
+	assert.DeepEqual(t, shareToken, f.bankKeeper.GetBalance(ctx, delAddr, shareToken.Denom))
+
+	// share tokens are minted by the liquid staking pool, the not bonded pool cannot mint
+	liquidStakingPool := f.accountKeeper.GetModuleAccount(ctx, types.LiquidStakingPoolName)
+	assert.Assert(t, liquidStakingPool.HasPermission(authtypes.Minter))
+	assert.Assert(t, !f.accountKeeper.GetModuleAccount(ctx, types.NotBondedPoolName).HasPermission(authtypes.Minter))
+	assert.Assert(t, f.bankKeeper.GetBalance(ctx, liquidStakingPool.GetAddress(), shareToken.Denom).IsZero())
+
+	delegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, valAddrs[0]))
+	assert.NilError(t, err)
+	assert.Assert(math.LegacyDecEq(t, math.LegacyNewDecFromInt(delTokens.Sub(tokenizeTokens)), delegation.Shares))
+
+	recordDelegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), valAddrs[0]))
+	assert.NilError(t, err)
+	assert.Assert(math.LegacyDecEq(t, math.LegacyNewDecFromInt(tokenizeTokens), recordDelegation.Shares))
+
+	liquidShares, err := f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.Assert(math.LegacyDecEq(t, math.LegacyNewDecFromInt(tokenizeTokens), liquidShares))
+
+	totalLiquidStaked, err := f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, tokenizeTokens, totalLiquidStaked))
+
+	records, err := f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []types.TokenizeShareRecord{record}, records)
+
+	// share tokens are transferable, their holder can redeem them
+	halfShareToken := sdk.NewCoin(shareToken.Denom, shareToken.Amount.QuoRaw(2))
+	assert.NilError(t, f.bankKeeper.SendCoins(ctx, delAddr, holder, sdk.NewCoins(halfShareToken)))
+
+	returned, err := f.stakingKeeper.RedeemTokensForShares(ctx, holder, halfShareToken)
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, halfShareToken.Amount, returned.Amount))
+	assert.Assert(t, f.bankKeeper.GetBalance(ctx, holder, shareToken.Denom).IsZero())
+
+	holderDelegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(holder, valAddrs[0]))
+	assert.NilError(t, err)
+	assert.Assert(math.LegacyDecEq(t, math.LegacyNewDecFromInt(halfShareToken.Amount), holderDelegation.Shares))
+
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	assert.NilError(t, err)
+
+	// redeeming more share tokens than held fails
+	_, err = f.stakingKeeper.RedeemTokensForShares(ctx, holder, halfShareToken)
+	assert.ErrorContains(t, err, "insufficient funds")
+
+	// redeeming the remaining share tokens removes the record
+	_, err = f.stakingKeeper.RedeemTokensForShares(ctx, delAddr, f.bankKeeper.GetBalance(ctx, delAddr, shareToken.Denom))
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	assert.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+
+	liquidShares, err = f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.Assert(t, liquidShares.IsZero())
+
+	delegation, err = f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, valAddrs[0]))
+	assert.NilError(t, err)
+	assert.Assert(math.LegacyDecEq(t, math.LegacyNewDecFromInt(delTokens.Sub(halfShareToken.Amount)), delegation.Shares))
+
+	supply := f.bankKeeper.GetSupply(ctx, shareToken.Denom)
+	assert.Assert(t, supply.IsZero())
+}
+
+func TestTokenizeSharesCaps(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	validator, err := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	_, err = f.stakingKeeper.Delegate(ctx, addrs[2], f.stakingKeeper.TokensFromConsensusPower(ctx, 10), types.Unbonded, validator, true)
+	assert.NilError(t, err)
+
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 4)
+
+	// the validator cap limits the tokenized shares of a single validator
+	params, err := f.stakingKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	assert.NilError(t, f.stakingKeeper.Params.Set(ctx, params))
+
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, addrs[2], valAddrs[0], tokens, addrs[2])
+	assert.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// the global cap limits the tokenized shares of all the validators
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	assert.NilError(t, f.stakingKeeper.Params.Set(ctx, params))
+
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, addrs[2], valAddrs[0], tokens, addrs[2])
+	assert.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// the global cap is a ratio of the bonded tokens, the validators which are
+	// not bonded are not counted against it
+	unbonded := testutil.NewValidator(t, valAddrs[2], PKs[2])
+	assert.NilError(t, f.stakingKeeper.SetValidator(ctx, unbonded))
+	assert.NilError(t, f.stakingKeeper.SetValidatorByConsAddr(ctx, unbonded))
+	assert.NilError(t, f.stakingKeeper.SetNewValidatorByPowerIndex(ctx, unbonded))
+	_, err = f.stakingKeeper.Delegate(ctx, addrs[3], f.stakingKeeper.TokensFromConsensusPower(ctx, 10), types.Unbonded, unbonded, true)
+	assert.NilError(t, err)
+
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, addrs[3], valAddrs[2], f.stakingKeeper.TokensFromConsensusPower(ctx, 9), addrs[3])
+	assert.NilError(t, err)
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[1], f.stakingKeeper.TokensFromConsensusPower(ctx, 1), addrs[0])
+	assert.NilError(t, err)
+
+	// the validator bond factor limits the tokenized shares to a multiple of the
+	// validator bond shares
+	params.GlobalLiquidStakingCap = math.LegacyOneDec()
+	params.ValidatorBondFactor = math.LegacyNewDec(1)
+	assert.NilError(t, f.stakingKeeper.Params.Set(ctx, params))
+
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, addrs[2], valAddrs[0], tokens, addrs[2])
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	_, err = msgServer.ValidatorBond(ctx, types.NewMsgValidatorBond(addrs[0].String(), valAddrs[0].String()))
+	assert.NilError(t, err)
+	_, err = msgServer.ValidatorBond(ctx, types.NewMsgValidatorBond(addrs[0].String(), valAddrs[0].String()))
+	assert.ErrorIs(t, err, types.ErrValidatorBondAlreadySet)
+
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, addrs[2], valAddrs[0], tokens, addrs[2])
+	assert.NilError(t, err)
+
+	// validator bond delegations cannot be tokenized
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], tokens, addrs[0])
+	assert.ErrorIs(t, err, types.ErrValidatorBondNotAllowedForTokenizeShare)
+
+	// the validator bond cannot be reduced below the tokenized shares
+	bondTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(addrs[0].String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, bondTokens.Sub(tokens).AddRaw(1))))
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(addrs[0].String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, bondTokens.Sub(tokens))))
+	assert.NilError(t, err)
+}
+
+func TestTransferTokenizeShareRecord(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+
+	record, _, err := f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], f.stakingKeeper.TokensFromConsensusPower(ctx, 1), addrs[0])
+	assert.NilError(t, err)
+
+	err = f.stakingKeeper.TransferTokenizeShareRecord(ctx, record.Id, addrs[1], addrs[2].String())
+	assert.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	assert.NilError(t, f.stakingKeeper.TransferTokenizeShareRecord(ctx, record.Id, addrs[0], addrs[1].String()))
+
+	records, err := f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0])
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	records, err = f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1])
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, addrs[1].String(), records[0].Owner)
+}
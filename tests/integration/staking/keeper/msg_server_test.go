@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/x/bank/testutil"
 	pooltypes "cosmossdk.io/x/protocolpool/types"
 	"cosmossdk.io/x/staking/keeper"
+	stakingtestutil "cosmossdk.io/x/staking/testutil"
 	"cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -392,3 +393,77 @@ func TestRotateConsPubKey(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorPowerCap(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	params, err := f.stakingKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.MaxValidatorPowerRatio = math.LegacyNewDecWithPrec(3, 1)
+	assert.NilError(t, f.stakingKeeper.Params.Set(ctx, params))
+
+	addrs := simtestutil.AddTestAddrsIncremental(f.bankKeeper, f.stakingKeeper, ctx, 6, f.stakingKeeper.TokensFromConsensusPower(ctx, 100))
+	for _, addr := range addrs {
+		f.accountKeeper.SetAccount(ctx, f.accountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+
+	// the cap cannot be reached with less than 4 bonded validators, it is not
+	// enforced until then
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	for i := 0; i < 4; i++ {
+		tstaking.CreateValidatorWithValPower(valAddrs[i], PKs[i], 10, true)
+	}
+	tstaking.TurnBlock(ctx.HeaderInfo().Time)
+
+	power := func(power int64) sdk.Coin {
+		return sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+
+	// 15 of 45 bonded tokens exceeds the cap
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(addrs[5].String(), valAddrs[0].String(), power(5)))
+	assert.ErrorIs(t, err, types.ErrValidatorPowerCapExceeded)
+
+	// 12 of 42 bonded tokens is below the cap
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(addrs[5].String(), valAddrs[0].String(), power(2)))
+	assert.NilError(t, err)
+
+	// redelegations are capped like delegations, failing messages run in a
+	// cache context as their state changes are discarded by the tx
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.BeginRedelegate(cacheCtx, types.NewMsgBeginRedelegate(addrs[1].String(), valAddrs[1].String(), valAddrs[0].String(), power(2)))
+	assert.ErrorIs(t, err, types.ErrValidatorPowerCapExceeded)
+
+	// the self-delegation of a new validator is capped too
+	msg := tstaking.CreateValidatorMsg(valAddrs[4], PKs[4], power(20).Amount)
+	msg.Description = types.NewDescription("Validator", "", "", "", "")
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.CreateValidator(cacheCtx, msg)
+	assert.ErrorIs(t, err, types.ErrValidatorPowerCapExceeded)
+
+	msg.Value = power(10)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	assert.NilError(t, err)
+	tstaking.TurnBlock(ctx.HeaderInfo().Time)
+
+	// tokens redelegated from a bonded validator are already bonded: 16 of 52
+	// bonded tokens exceeds the cap, 15 of 52 is below it
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.BeginRedelegate(cacheCtx, types.NewMsgBeginRedelegate(addrs[1].String(), valAddrs[1].String(), valAddrs[0].String(), power(4)))
+	assert.ErrorIs(t, err, types.ErrValidatorPowerCapExceeded)
+
+	_, err = msgServer.BeginRedelegate(ctx, types.NewMsgBeginRedelegate(addrs[1].String(), valAddrs[1].String(), valAddrs[0].String(), power(3)))
+	assert.NilError(t, err)
+
+	// delegations made by other modules through the keeper are capped too
+	validator, err := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	_, err = f.stakingKeeper.Delegate(ctx, addrs[5], power(1).Amount, types.Unbonded, validator, true)
+	assert.ErrorIs(t, err, types.ErrValidatorPowerCapExceeded)
+}
@@ -238,7 +238,10 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 		// a pulsar encoded Math.dec (the string representation of a Decimal) into a gogo Math.dec (expecting an int64).
 		protoUnmarshalFails bool
 	}{
-		"auth/params": {gogo: &authtypes.Params{TxSigLimit: 10}, pulsar: &authapi.Params{TxSigLimit: 10}},
+		"auth/params": {
+			gogo:   &authtypes.Params{TxSigLimit: 10, PubKeyRotationCooldown: time.Hour},
+			pulsar: &authapi.Params{TxSigLimit: 10, PubKeyRotationCooldown: &durationpb.Duration{Seconds: 3600}},
+		},
 		"auth/module_account": {
 			gogo: &authtypes.ModuleAccount{
 				BaseAccount: authtypes.NewBaseAccountWithAddress(addr1), Permissions: []string{},
@@ -261,8 +264,8 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 			},
 		},
 		"authz/msg_update_params": {
-			gogo:   &authtypes.MsgUpdateParams{Params: authtypes.Params{TxSigLimit: 10}},
-			pulsar: &authapi.MsgUpdateParams{Params: &authapi.Params{TxSigLimit: 10}},
+			gogo:   &authtypes.MsgUpdateParams{Params: authtypes.Params{TxSigLimit: 10, PubKeyRotationCooldown: time.Hour}},
+			pulsar: &authapi.MsgUpdateParams{Params: &authapi.Params{TxSigLimit: 10, PubKeyRotationCooldown: &durationpb.Duration{Seconds: 3600}}},
 		},
 		"authz/msg_exec/empty_msgs": {
 			gogo:   &authztypes.MsgExec{Msgs: []*codectypes.Any{}},
@@ -344,20 +347,22 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 			pulsar: &gov_v1_api.MsgSubmitProposal{},
 		},
 		"slashing/params/empty_dec": {
-			gogo:   &slashingtypes.Params{DowntimeJailDuration: 1e9 + 7},
-			pulsar: &slashingapi.Params{DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7}},
+			gogo:   &slashingtypes.Params{DowntimeJailDuration: 1e9 + 7, DowntimeEscalationWindow: 1e9},
+			pulsar: &slashingapi.Params{DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7}, DowntimeEscalationWindow: &durationpb.Duration{Seconds: 1}},
 		},
 		// This test cases demonstrates the expected contract and proper way to set a cosmos.Dec field represented
 		// as bytes in protobuf message, namely:
 		// dec10bz, _ := types.NewDec(10).Marshal()
 		"slashing/params/dec": {
 			gogo: &slashingtypes.Params{
-				DowntimeJailDuration: 1e9 + 7,
-				MinSignedPerWindow:   math.LegacyNewDec(10),
+				DowntimeJailDuration:     1e9 + 7,
+				MinSignedPerWindow:       math.LegacyNewDec(10),
+				DowntimeEscalationWindow: 1e9,
 			},
 			pulsar: &slashingapi.Params{
-				DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7},
-				MinSignedPerWindow:   dec10bz,
+				DowntimeJailDuration:     &durationpb.Duration{Seconds: 1, Nanos: 7},
+				MinSignedPerWindow:       dec10bz,
+				DowntimeEscalationWindow: &durationpb.Duration{Seconds: 1},
 			},
 		},
 		"staking/msg_update_params": {
@@ -115,3 +115,58 @@ func (t TestAccount) RegisterQueryHandlers(builder *implementation.QueryBuilder)
 		return &types.UInt64Value{Value: v}, nil
 	})
 }
+
+var _ implementation.MigratableAccount = (*TestAccountV2)(nil)
+
+func NewTestAccountV2(d accountstd.Dependencies) (*TestAccountV2, error) {
+	return &TestAccountV2{
+		Counter: collections.NewSequence(d.SchemaBuilder, collections.NewPrefix(0), "counter"),
+		Label:   collections.NewItem(d.SchemaBuilder, collections.NewPrefix(1), "label", collections.StringValue),
+	}, nil
+}
+
+// TestAccountV2 is a new version of TestAccount, accounts of type "test" can be migrated to it.
+type TestAccountV2 struct {
+	Counter collections.Sequence
+	Label   collections.Item[string]
+}
+
+func (t TestAccountV2) RegisterInitHandler(builder *implementation.InitBuilder) {
+	implementation.RegisterInitHandler(builder, func(_ context.Context, _ *types.Empty) (*types.Empty, error) {
+		return &types.Empty{}, nil
+	})
+}
+
+func (t TestAccountV2) RegisterExecuteHandlers(*implementation.ExecuteBuilder) {}
+
+func (t TestAccountV2) RegisterQueryHandlers(builder *implementation.QueryBuilder) {
+	implementation.RegisterQueryHandler(builder, func(ctx context.Context, _ *types.Empty) (*types.StringValue, error) {
+		label, err := t.Label.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &types.StringValue{Value: label}, nil
+	})
+
+	implementation.RegisterQueryHandler(builder, func(ctx context.Context, _ *types.DoubleValue) (*types.UInt64Value, error) {
+		v, err := t.Counter.Peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &types.UInt64Value{Value: v}, nil
+	})
+}
+
+func (t TestAccountV2) RegisterMigrateHandlers(builder *implementation.MigrateBuilder) {
+	// the counter of the old account is read and rewritten, and the label is added.
+	implementation.RegisterMigrateHandler(builder, "test", func(ctx context.Context, req *types.StringValue) (*types.UInt64Value, error) {
+		counter, err := t.Counter.Peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.Counter.Set(ctx, counter*2); err != nil {
+			return nil, err
+		}
+		return &types.UInt64Value{Value: counter}, t.Label.Set(ctx, req.Value)
+	})
+}
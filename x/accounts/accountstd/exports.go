@@ -26,6 +26,12 @@ type QueryBuilder = implementation.QueryBuilder
 // InitBuilder is the exported type of InitBuilder.
 type InitBuilder = implementation.InitBuilder
 
+// MigratableInterface is the exported interface of a MigratableAccount.
+type MigratableInterface = implementation.MigratableAccount
+
+// MigrateBuilder is the exported type of MigrateBuilder.
+type MigrateBuilder = implementation.MigrateBuilder
+
 // AccountCreatorFunc is the exported type of AccountCreatorFunc.
 type AccountCreatorFunc = implementation.AccountCreatorFunc
 
@@ -55,6 +61,15 @@ func RegisterInitHandler[
 	implementation.RegisterInitHandler(router, handler)
 }
 
+// RegisterMigrateHandler registers the handler migrating the accounts of the given account type
+// to a smart account that uses protobuf.
+func RegisterMigrateHandler[
+	Req any, ProtoReq implementation.ProtoMsgG[Req], Resp any, ProtoResp implementation.ProtoMsgG[Resp],
+](router *MigrateBuilder, fromAccountType string, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterMigrateHandler(router, fromAccountType, handler)
+}
+
 // AddAccount is a helper function to add a smart account to the list of smart accounts.
 func AddAccount[A Interface](name string, constructor func(deps Dependencies) (A, error)) AccountCreatorFunc {
 	return func(deps implementation.Dependencies) (string, implementation.Account, error) {
@@ -17,22 +17,28 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
+// TxCmd returns the transactions commands of the accounts module. Besides the
+// generic init, execute and migrate commands, `<account-type> <method>` commands are
+// generated at runtime from the schema of the account type.
 func TxCmd(name string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                name,
 		Short:              "Transactions command for the " + name + " module",
-		RunE:               client.ValidateCmd,
+		RunE:               runSchemaTxCmd,
 		DisableFlagParsing: true,
 	}
-	cmd.AddCommand(GetTxInitCmd(), GetExecuteCmd())
+	cmd.AddCommand(GetTxInitCmd(), GetExecuteCmd(), GetMigrateCmd())
 	return cmd
 }
 
+// QueryCmd returns the query commands of the accounts module. Besides the generic
+// query command, `<account-address> <method>` commands are generated at runtime from
+// the schema of the account type of the account.
 func QueryCmd(name string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                name,
 		Short:              "Query command for the " + name + " module",
-		RunE:               client.ValidateCmd,
+		RunE:               runSchemaQueryCmd,
 		DisableFlagParsing: true,
 	}
 	cmd.AddCommand(GetQueryAccountCmd())
@@ -113,6 +119,63 @@ func GetExecuteCmd() *cobra.Command {
 	return cmd
 }
 
+func GetMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [account-address] [account-type] [json-message]",
+		Short: "Migrate an account to a new account type, the sender must be the account itself",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			// the migration message is defined by the migration handler of
+			// the new account type for the current account type.
+			queryClient := v1.NewQueryClient(clientCtx)
+			accType, err := queryClient.AccountType(cmd.Context(), &v1.AccountTypeRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			schema, err := queryClient.Schema(cmd.Context(), &v1.SchemaRequest{
+				AccountType: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			var msgSchema *v1.SchemaResponse_Handler
+			for _, handler := range schema.MigrateHandlers {
+				if handler.FromAccountType == accType.AccountType {
+					msgSchema = handler.Handler
+					break
+				}
+			}
+			if msgSchema == nil {
+				return fmt.Errorf("account type %s does not accept migrations from account type %s", args[1], accType.AccountType)
+			}
+
+			msgBytes, err := encodeJSONToProto(msgSchema.Request, args[2])
+			if err != nil {
+				return err
+			}
+			msg := v1.MsgMigrate{
+				Sender:         sender.String(),
+				AccountAddress: args[0],
+				AccountType:    args[1],
+				Message:        msgBytes,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetQueryAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "query [account-address] [query-request-type-url] [json-message]",
@@ -0,0 +1,392 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/client/v2/autocli/flag"
+	v1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagFunds defines the flag used to send funds alongside an account init or execute message.
+	FlagFunds = "funds"
+
+	initMethodName = "init"
+)
+
+// runSchemaTxCmd handles `accounts tx <account-type> <method> [flags]`. The
+// available methods are generated at runtime from the schema of the account type.
+func runSchemaTxCmd(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return client.ValidateCmd(cmd, args)
+	}
+
+	clientCtx, err := bootstrapClientContext(cmd, args, flags.AddTxFlagsToCmd)
+	if err != nil {
+		return err
+	}
+	accountType := args[0]
+	schema, err := v1.NewQueryClient(clientCtx).Schema(cmd.Context(), &v1.SchemaRequest{
+		AccountType: accountType,
+	})
+	if err != nil {
+		return err
+	}
+
+	schemaCmd, err := NewSchemaTxCmd(clientCtx, accountType, schema)
+	if err != nil {
+		return err
+	}
+	return executeSchemaCmd(cmd, schemaCmd, args[1:])
+}
+
+// runSchemaQueryCmd handles `accounts query <account-address> <method> [flags]`. The
+// available methods are generated at runtime from the schema of the account type of
+// the given account.
+func runSchemaQueryCmd(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return client.ValidateCmd(cmd, args)
+	}
+
+	clientCtx, err := bootstrapClientContext(cmd, args, flags.AddQueryFlagsToCmd)
+	if err != nil {
+		return err
+	}
+	clientCtx = clientCtx.WithCmdContext(cmd.Context())
+	accountAddr := args[0]
+	schema, err := getSchemaForAccount(clientCtx, accountAddr)
+	if err != nil {
+		return err
+	}
+
+	schemaCmd, err := NewSchemaQueryCmd(clientCtx, accountAddr, schema)
+	if err != nil {
+		return err
+	}
+	return executeSchemaCmd(cmd, schemaCmd, args[1:])
+}
+
+// NewSchemaTxCmd builds the transaction commands of an account type from its schema.
+// The init message is exposed as the "init" command, every execute message is exposed
+// as a command named after the message, which takes the address of the account as
+// first positional argument.
+func NewSchemaTxCmd(clientCtx client.Context, accountType string, schema *v1.SchemaResponse) (*cobra.Command, error) {
+	builder, err := newFlagBuilder(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &cobra.Command{
+		Use:   accountType,
+		Short: fmt.Sprintf("Transactions commands for accounts of type %s", accountType),
+		RunE:  client.ValidateCmd,
+	}
+
+	if schema.InitSchema != nil {
+		initCmd, err := newSchemaMsgCmd(builder, initMethodName, schema.InitSchema.Request, false, func(sender, _ string, msg *codectypes.Any, funds sdk.Coins) sdk.Msg {
+			return &v1.MsgInit{Sender: sender, AccountType: accountType, Message: msg, Funds: funds}
+		})
+		if err != nil {
+			return nil, err
+		}
+		cmd.AddCommand(initCmd)
+	}
+
+	for _, handler := range schema.ExecuteHandlers {
+		executeCmd, err := newSchemaMsgCmd(builder, methodName(cmd, handler.Request), handler.Request, true, func(sender, target string, msg *codectypes.Any, funds sdk.Coins) sdk.Msg {
+			return &v1.MsgExecute{Sender: sender, Target: target, Message: msg, Funds: funds}
+		})
+		if err != nil {
+			return nil, err
+		}
+		cmd.AddCommand(executeCmd)
+	}
+
+	return cmd, nil
+}
+
+// NewSchemaQueryCmd builds the query commands of an account from the schema of its
+// account type. Every query request is exposed as a command named after the request.
+func NewSchemaQueryCmd(clientCtx client.Context, accountAddr string, schema *v1.SchemaResponse) (*cobra.Command, error) {
+	builder, err := newFlagBuilder(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &cobra.Command{
+		Use:   accountAddr,
+		Short: fmt.Sprintf("Query commands for account %s", accountAddr),
+		RunE:  client.ValidateCmd,
+	}
+
+	for _, handler := range schema.QueryHandlers {
+		queryCmd, err := newSchemaQueryCmd(builder, methodName(cmd, handler.Request), handler.Request, accountAddr)
+		if err != nil {
+			return nil, err
+		}
+		cmd.AddCommand(queryCmd)
+	}
+
+	return cmd, nil
+}
+
+// newSchemaMsgCmd creates a command which builds the given account message from
+// flags, wraps it using makeMsg and broadcasts it. If withTarget is true, the first
+// positional argument is the address of the account being executed.
+func newSchemaMsgCmd(
+	builder *flag.Builder,
+	use, msgName string,
+	withTarget bool,
+	makeMsg func(sender, target string, msg *codectypes.Any, funds sdk.Coins) sdk.Msg,
+) (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:          use,
+		Short:        fmt.Sprintf("Execute %s", msgName),
+		SilenceUsage: true,
+	}
+	if withTarget {
+		cmd.Use = use + " [account-address]"
+	}
+
+	binder, err := addMessageFlags(builder, cmd.Flags(), msgName)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Args = targetArgs(binder.CobraArgs, withTarget)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		var target string
+		if withTarget {
+			target, args = args[0], args[1:]
+		}
+		msg, err := buildAnyMessage(binder, args)
+		if err != nil {
+			return err
+		}
+		funds, err := parseFunds(cmd)
+		if err != nil {
+			return err
+		}
+
+		sender := clientCtx.GetFromAddress().String()
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), makeMsg(sender, target, msg, funds))
+	}
+
+	cmd.Flags().String(FlagFunds, "", "Coins to send to the account alongside the message")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd, nil
+}
+
+// newSchemaQueryCmd creates a command which builds the given query request from
+// flags and sends it to the account.
+func newSchemaQueryCmd(builder *flag.Builder, use, reqName, accountAddr string) (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:          use,
+		Short:        fmt.Sprintf("Query %s", reqName),
+		SilenceUsage: true,
+	}
+
+	binder, err := addMessageFlags(builder, cmd.Flags(), reqName)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Args = binder.CobraArgs
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		req, err := buildAnyMessage(binder, args)
+		if err != nil {
+			return err
+		}
+		res, err := v1.NewQueryClient(clientCtx).AccountQuery(cmd.Context(), &v1.AccountQueryRequest{
+			Target:  accountAddr,
+			Request: req,
+		})
+		if err != nil {
+			return err
+		}
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd, nil
+}
+
+// newFlagBuilder creates the autocli flag builder used to turn account messages into flags.
+func newFlagBuilder(clientCtx client.Context) (*flag.Builder, error) {
+	builder := &flag.Builder{
+		TypeResolver:          protoregistry.GlobalTypes,
+		FileResolver:          clientCtx.InterfaceRegistry,
+		AddressCodec:          clientCtx.AddressCodec,
+		ValidatorAddressCodec: clientCtx.ValidatorAddressCodec,
+		ConsensusAddressCodec: clientCtx.ConsensusAddressCodec,
+	}
+	if err := builder.ValidateAndComplete(); err != nil {
+		return nil, err
+	}
+	return builder, nil
+}
+
+// addMessageFlags adds the flags of the given message to the flag set. Account messages
+// are mostly gogoproto types, so if the message is not known to the global protobuf
+// registry, a dynamic type is built from its descriptor.
+func addMessageFlags(builder *flag.Builder, flagSet *pflag.FlagSet, msgName string) (*flag.MessageBinder, error) {
+	desc, err := builder.FileResolver.FindDescriptorByName(protoreflect.FullName(msgName))
+	if err != nil {
+		return nil, fmt.Errorf("message type %s not found: %w", msgName, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", msgName)
+	}
+
+	msgType, err := builder.TypeResolver.FindMessageByName(msgDesc.FullName())
+	if err != nil {
+		msgType = dynamicpb.NewMessageType(msgDesc)
+	}
+
+	return builder.AddMessageFlags(context.Background(), flagSet, msgType, &autocliv1.RpcCommandOptions{})
+}
+
+// buildAnyMessage builds the message from flags and arguments and packs it into an Any.
+func buildAnyMessage(binder *flag.MessageBinder, args []string) (*codectypes.Any, error) {
+	msg, err := binder.BuildMessage(args)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := proto.Marshal(msg.Interface())
+	if err != nil {
+		return nil, err
+	}
+	return &codectypes.Any{
+		TypeUrl: "/" + string(msg.Descriptor().FullName()),
+		Value:   bz,
+	}, nil
+}
+
+func parseFunds(cmd *cobra.Command) (sdk.Coins, error) {
+	fundsStr, err := cmd.Flags().GetString(FlagFunds)
+	if err != nil || fundsStr == "" {
+		return nil, err
+	}
+	return sdk.ParseCoinsNormalized(fundsStr)
+}
+
+// targetArgs prepends the account address to the positional arguments of a message.
+func targetArgs(msgArgs cobra.PositionalArgs, withTarget bool) cobra.PositionalArgs {
+	if !withTarget {
+		return msgArgs
+	}
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("requires the account address as first argument")
+		}
+		if msgArgs == nil {
+			return nil
+		}
+		return msgArgs(cmd, args[1:])
+	}
+}
+
+// bootstrapClientContext returns the client context used to fetch the account schema,
+// the flags which are not known yet, such as the message flags, are ignored.
+func bootstrapClientContext(cmd *cobra.Command, args []string, addFlags func(*cobra.Command)) (client.Context, error) {
+	bootstrapCmd := &cobra.Command{
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+	}
+	addFlags(bootstrapCmd)
+	addRootPersistentFlags(cmd, bootstrapCmd)
+	bootstrapCmd.SetContext(cmd.Context())
+	if err := bootstrapCmd.ParseFlags(args); err != nil {
+		return client.Context{}, err
+	}
+	return client.GetClientQueryContext(bootstrapCmd)
+}
+
+// executeSchemaCmd runs the generated command with the remaining arguments.
+func executeSchemaCmd(parent, schemaCmd *cobra.Command, args []string) error {
+	addRootPersistentFlags(parent, schemaCmd)
+	schemaCmd.SetArgs(args)
+	schemaCmd.SetOut(parent.OutOrStdout())
+	schemaCmd.SetErr(parent.ErrOrStderr())
+	return schemaCmd.ExecuteContext(parent.Context())
+}
+
+// addRootPersistentFlags makes the persistent flags of the root command, such as --home,
+// available to the generated commands, which are not attached to the command tree.
+func addRootPersistentFlags(parent, cmd *cobra.Command) {
+	parent.Root().PersistentFlags().VisitAll(func(f *pflag.Flag) {
+		if cmd.Flags().Lookup(f.Name) == nil && cmd.PersistentFlags().Lookup(f.Name) == nil {
+			cmd.PersistentFlags().AddFlag(f)
+		}
+	})
+}
+
+// methodName returns the command name of the given message, which is the kebab case
+// name of the message without its Msg or Query prefix and Request suffix, e.g.
+// cosmos.accounts.defaults.base.v1.MsgSwapPubKey becomes swap-pub-key. If the name is
+// already in use by another command of the parent, the full message name is used.
+func methodName(parent *cobra.Command, msgName string) string {
+	name := msgName
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	for _, prefix := range []string{"Msg", "Query"} {
+		if len(name) > len(prefix) && strings.HasPrefix(name, prefix) && unicode.IsUpper(rune(name[len(prefix)])) {
+			name = strings.TrimPrefix(name, prefix)
+			break
+		}
+	}
+	if trimmed := strings.TrimSuffix(name, "Request"); trimmed != "" {
+		name = trimmed
+	}
+	name = toKebab(name)
+
+	for _, c := range parent.Commands() {
+		if c.Name() == name {
+			return msgName
+		}
+	}
+	return name
+}
+
+// toKebab converts a CamelCase name to kebab-case, e.g. PubKey becomes pub-key.
+func toKebab(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	counterv1 "cosmossdk.io/x/accounts/testing/counter/v1"
+	v1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func counterSchema() *v1.SchemaResponse {
+	return &v1.SchemaResponse{
+		InitSchema: &v1.SchemaResponse_Handler{
+			Request:  "cosmos.accounts.testing.counter.v1.MsgInit",
+			Response: "cosmos.accounts.testing.counter.v1.MsgInitResponse",
+		},
+		ExecuteHandlers: []*v1.SchemaResponse_Handler{
+			{
+				Request:  "cosmos.accounts.testing.counter.v1.MsgIncreaseCounter",
+				Response: "cosmos.accounts.testing.counter.v1.MsgIncreaseCounterResponse",
+			},
+			{
+				Request:  "cosmos.accounts.testing.counter.v1.MsgTestDependencies",
+				Response: "cosmos.accounts.testing.counter.v1.MsgTestDependenciesResponse",
+			},
+		},
+		QueryHandlers: []*v1.SchemaResponse_Handler{
+			{
+				Request:  "cosmos.accounts.testing.counter.v1.QueryCounterRequest",
+				Response: "cosmos.accounts.testing.counter.v1.QueryCounterResponse",
+			},
+		},
+	}
+}
+
+func testClientCtx() client.Context {
+	return client.Context{}.
+		WithInterfaceRegistry(codectypes.NewInterfaceRegistry()).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+}
+
+func subCommands(cmd *cobra.Command) []string {
+	var names []string
+	for _, c := range cmd.Commands() {
+		names = append(names, c.Name())
+	}
+	return names
+}
+
+func TestNewSchemaTxCmd(t *testing.T) {
+	cmd, err := NewSchemaTxCmd(testClientCtx(), "counter", counterSchema())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"init", "increase-counter", "test-dependencies"}, subCommands(cmd))
+
+	initCmd, _, err := cmd.Find([]string{"init"})
+	require.NoError(t, err)
+	require.NotNil(t, initCmd.Flags().Lookup("initial-value"))
+	require.NotNil(t, initCmd.Flags().Lookup(FlagFunds))
+	require.Error(t, initCmd.Args(initCmd, []string{"extra"}))
+
+	increaseCmd, _, err := cmd.Find([]string{"increase-counter"})
+	require.NoError(t, err)
+	require.NotNil(t, increaseCmd.Flags().Lookup("amount"))
+	require.Error(t, increaseCmd.Args(increaseCmd, nil))
+	require.NoError(t, increaseCmd.Args(increaseCmd, []string{"account-address"}))
+}
+
+func TestNewSchemaQueryCmd(t *testing.T) {
+	cmd, err := NewSchemaQueryCmd(testClientCtx(), "account-address", counterSchema())
+	require.NoError(t, err)
+	require.Equal(t, []string{"counter"}, subCommands(cmd))
+}
+
+func TestBuildAnyMessage(t *testing.T) {
+	builder, err := newFlagBuilder(testClientCtx())
+	require.NoError(t, err)
+
+	cmd := &cobra.Command{}
+	binder, err := addMessageFlags(builder, cmd.Flags(), "cosmos.accounts.testing.counter.v1.MsgIncreaseCounter")
+	require.NoError(t, err)
+	require.NoError(t, cmd.Flags().Parse([]string{"--amount", "10"}))
+
+	msg, err := buildAnyMessage(binder, nil)
+	require.NoError(t, err)
+	require.Equal(t, "/cosmos.accounts.testing.counter.v1.MsgIncreaseCounter", msg.TypeUrl)
+
+	decoded := new(counterv1.MsgIncreaseCounter)
+	require.NoError(t, decoded.Unmarshal(msg.Value))
+	require.Equal(t, uint64(10), decoded.Amount)
+
+	_, err = addMessageFlags(builder, cmd.Flags(), "cosmos.accounts.testing.counter.v1.Unknown")
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestMethodName(t *testing.T) {
+	parent := &cobra.Command{}
+	require.Equal(t, "swap-pub-key", methodName(parent, "cosmos.accounts.defaults.base.v1.MsgSwapPubKey"))
+	require.Equal(t, "pub-key", methodName(parent, "cosmos.accounts.defaults.base.v1.QueryPubKey"))
+	require.Equal(t, "counter", methodName(parent, "cosmos.accounts.testing.counter.v1.QueryCounterRequest"))
+	require.Equal(t, "string-value", methodName(parent, "google.protobuf.StringValue"))
+	require.Equal(t, "messages", methodName(parent, "test.v1.Messages"))
+
+	// names already in use fall back to the full message name
+	parent.AddCommand(&cobra.Command{Use: "init"})
+	require.Equal(t, "test.v1.MsgInit", methodName(parent, "test.v1.MsgInit"))
+}
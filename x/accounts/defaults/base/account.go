@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -22,35 +23,109 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var (
-	PubKeyPrefix   = collections.NewPrefix(0)
-	SequencePrefix = collections.NewPrefix(1)
+	// LegacyPubKeyPrefix is the prefix of the secp256k1 pubkey of the accounts
+	// created before the pubkey was stored as an Any.
+	LegacyPubKeyPrefix = collections.NewPrefix(0)
+	SequencePrefix     = collections.NewPrefix(1)
+	PubKeyPrefix       = collections.NewPrefix(2)
 )
 
-func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+// PubKeyDecoder decodes and validates the protobuf encoding of a pubkey.
+type PubKeyDecoder = func(bz []byte) (cryptotypes.PubKey, error)
+
+// Option configures the base account.
+type Option func(a *Account)
+
+// WithPubKeyType adds a pubkey type to the key types supported by the account,
+// identified by the type URL of its protobuf message.
+func WithPubKeyType(typeURL string, decoder PubKeyDecoder) Option {
+	return func(a *Account) {
+		a.supportedPubKeys[typeURL] = decoder
+	}
+}
+
+// DefaultPubKeyTypes returns the secp256k1, secp256r1 and ed25519 pubkey
+// types supported by default by the account.
+func DefaultPubKeyTypes() map[string]PubKeyDecoder {
+	return map[string]PubKeyDecoder{
+		typeURL(&secp256k1.PubKey{}): func(bz []byte) (cryptotypes.PubKey, error) {
+			pk := &secp256k1.PubKey{}
+			if err := gogoproto.Unmarshal(bz, pk); err != nil {
+				return nil, err
+			}
+			if _, err := dcrd_secp256k1.ParsePubKey(pk.Key); err != nil {
+				return nil, err
+			}
+			return pk, nil
+		},
+		typeURL(&secp256r1.PubKey{}): func(bz []byte) (cryptotypes.PubKey, error) {
+			pk := &secp256r1.PubKey{}
+			if err := gogoproto.Unmarshal(bz, pk); err != nil {
+				return nil, err
+			}
+			// the unmarshalling of the key rejects the points which are not
+			// on the curve, a missing key must be rejected here
+			if pk.Key == nil {
+				return nil, errors.New("empty secp256r1 pubkey")
+			}
+			return pk, nil
+		},
+		typeURL(&ed25519.PubKey{}): func(bz []byte) (cryptotypes.PubKey, error) {
+			pk := &ed25519.PubKey{}
+			if err := gogoproto.Unmarshal(bz, pk); err != nil {
+				return nil, err
+			}
+			if len(pk.Key) != ed25519.PubKeySize {
+				return nil, fmt.Errorf("invalid ed25519 pubkey size, wanted: %d, got: %d", ed25519.PubKeySize, len(pk.Key))
+			}
+			return pk, nil
+		},
+	}
+}
+
+func typeURL(msg gogoproto.Message) string {
+	return "/" + gogoproto.MessageName(msg)
+}
+
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
 	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
-		return name, Account{
-			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
-			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
-			addrCodec:       deps.AddressCodec,
-			signingHandlers: handlerMap,
-		}, nil
+		acc := Account{
+			PubKey:           collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[codectypes.Any](deps.LegacyStateCodec)),
+			LegacyPubKey:     collections.NewItem(deps.SchemaBuilder, LegacyPubKeyPrefix, "legacy_pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
+			Sequence:         collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			addrCodec:        deps.AddressCodec,
+			hs:               deps.Environment.HeaderService,
+			signingHandlers:  handlerMap,
+			supportedPubKeys: DefaultPubKeyTypes(),
+		}
+		for _, option := range options {
+			option(&acc)
+		}
+		return name, acc, nil
 	}
 }
 
 // Account implements a base account.
 type Account struct {
-	PubKey   collections.Item[secp256k1.PubKey]
+	PubKey   collections.Item[codectypes.Any]
 	Sequence collections.Sequence
+	// LegacyPubKey is the secp256k1 pubkey of the accounts created before the
+	// pubkey was stored as an Any. It's moved to PubKey by migratePubKey.
+	LegacyPubKey collections.Item[secp256k1.PubKey]
 
 	addrCodec address.Codec
 	hs        header.Service
 
-	signingHandlers *signing.HandlerMap
+	signingHandlers  *signing.HandlerMap
+	supportedPubKeys map[string]PubKeyDecoder
 }
 
 func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
@@ -62,15 +137,85 @@ func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.Msg
 		return nil, errors.New("unauthorized")
 	}
 
+	if msg.NewPubKey == nil {
+		return nil, errors.New("empty pubkey")
+	}
+
+	if err := a.migratePubKey(ctx); err != nil {
+		return nil, err
+	}
+
+	current, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the key type of the account is chosen at init and cannot change
+	if msg.NewPubKey.TypeUrl != current.TypeUrl {
+		return nil, fmt.Errorf("invalid pubkey type, wanted: %s, got: %s", current.TypeUrl, msg.NewPubKey.TypeUrl)
+	}
+
 	return &v1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
 }
 
-func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
-	_, err := dcrd_secp256k1.ParsePubKey(key)
+func (a Account) verifyAndSetPubKey(ctx context.Context, key *codectypes.Any) error {
+	if key == nil {
+		return errors.New("empty pubkey")
+	}
+	if _, err := a.decodePubKey(key); err != nil {
+		return err
+	}
+	return a.PubKey.Set(ctx, codectypes.Any{TypeUrl: key.TypeUrl, Value: key.Value})
+}
+
+// getPubKey returns the pubkey of the account, packing the legacy secp256k1
+// pubkey if it was not migrated yet.
+func (a Account) getPubKey(ctx context.Context) (codectypes.Any, error) {
+	pk, err := a.PubKey.Get(ctx)
+	if !errors.Is(err, collections.ErrNotFound) {
+		return pk, err
+	}
+
+	legacy, err := a.LegacyPubKey.Get(ctx)
+	if err != nil {
+		return codectypes.Any{}, err
+	}
+	pkAny, err := codectypes.NewAnyWithValue(&legacy)
 	if err != nil {
+		return codectypes.Any{}, err
+	}
+	return codectypes.Any{TypeUrl: pkAny.TypeUrl, Value: pkAny.Value}, nil
+}
+
+// migratePubKey moves the legacy secp256k1 pubkey of the account, if any, to
+// PubKey. The accounts are migrated the first time they authenticate or swap
+// their pubkey.
+func (a Account) migratePubKey(ctx context.Context) error {
+	has, err := a.LegacyPubKey.Has(ctx)
+	if err != nil || !has {
 		return err
 	}
-	return a.PubKey.Set(ctx, secp256k1.PubKey{Key: key})
+
+	pk, err := a.getPubKey(ctx)
+	if err != nil {
+		return err
+	}
+	if err := a.PubKey.Set(ctx, pk); err != nil {
+		return err
+	}
+	return a.LegacyPubKey.Remove(ctx)
+}
+
+func (a Account) decodePubKey(key *codectypes.Any) (cryptotypes.PubKey, error) {
+	decoder, ok := a.supportedPubKeys[key.TypeUrl]
+	if !ok {
+		return nil, fmt.Errorf("unsupported pubkey type: %s", key.TypeUrl)
+	}
+	pk, err := decoder(key.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pubkey of type %s: %w", key.TypeUrl, err)
+	}
+	return pk, nil
 }
 
 // Authenticate implements the authentication flow of an abstracted base account.
@@ -79,6 +224,10 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
 	}
 
+	if err := a.migratePubKey(ctx); err != nil {
+		return nil, err
+	}
+
 	pubKey, signerData, err := a.computeSignerData(ctx)
 	if err != nil {
 		return nil, err
@@ -122,31 +271,31 @@ func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 }
 
 // computeSignerData will populate signer data and also increase the sequence.
-func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signing.SignerData, error) {
+func (a Account) computeSignerData(ctx context.Context) (cryptotypes.PubKey, signing.SignerData, error) {
 	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 	chainID := a.hs.GetHeaderInfo(ctx).ChainID
 
 	wantSequence, err := a.Sequence.Next(ctx)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
-	pk, err := a.PubKey.Get(ctx)
+	pkAny, err := a.PubKey.Get(ctx)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
-	pkAny, err := codectypes.NewAnyWithValue(&pk)
+	pk, err := a.decodePubKey(&pkAny)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
 	accNum, err := a.getNumber(ctx, addrStr)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
 	return pk, signing.SignerData{
@@ -194,6 +343,14 @@ func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData
 	}, nil
 }
 
+func (a Account) QueryPubKey(ctx context.Context, _ *v1.QueryPubKey) (*v1.QueryPubKeyResponse, error) {
+	pk, err := a.getPubKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPubKeyResponse{PubKey: &pk}, nil
+}
+
 func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
 	seq, err := a.Sequence.Peek(ctx)
 	if err != nil {
@@ -212,5 +369,6 @@ func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 }
 
 func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryPubKey)
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
 }
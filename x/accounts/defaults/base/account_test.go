@@ -0,0 +1,232 @@
+package base
+
+import (
+	"context"
+	"crypto/elliptic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/direct"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	chainID    = "test-chain"
+	accountNum = 3
+)
+
+var accountAddr = []byte("base")
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{}
+
+func (headerService) GetHeaderInfo(context.Context) header.Info {
+	return header.Info{ChainID: chainID, Time: time.Unix(1000, 0)}
+}
+
+type fixture struct {
+	t    *testing.T
+	ctx  context.Context
+	ss   store.KVStoreService
+	impl implementation.Implementation
+	acc  Account
+}
+
+func newFixture(t *testing.T, options ...Option) *fixture {
+	t.Helper()
+	ss, ctx := colltest.MockStore()
+	env := runtime.NewEnvironment(ss, log.NewNopLogger())
+	env.HeaderService = headerService{}
+
+	f := &fixture{t: t, ctx: ctx, ss: ss}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	accs, err := implementation.MakeAccountsMap(cdc, addressCodec{}, env, []implementation.AccountCreatorFunc{
+		func(deps implementation.Dependencies) (string, implementation.Account, error) {
+			name, acc, err := NewAccount("base", signing.NewHandlerMap(direct.SignModeHandler{}), options...)(deps)
+			if err == nil {
+				f.acc = acc.(Account)
+			}
+			return name, acc, err
+		},
+	})
+	require.NoError(t, err)
+
+	f.impl = accs["base"]
+	return f
+}
+
+func (f *fixture) accountCtx(sender []byte) context.Context {
+	return implementation.MakeAccountContext(
+		f.ctx,
+		f.ss,
+		accountNum,
+		accountAddr,
+		sender,
+		nil,
+		func(context.Context, []byte, implementation.ProtoMsg, implementation.ProtoMsg) error {
+			return nil
+		},
+		func(context.Context, []byte, implementation.ProtoMsg) (implementation.ProtoMsg, error) {
+			return nil, nil
+		},
+		func(_ context.Context, _, resp implementation.ProtoMsg) error {
+			resp.(*accountsv1.AccountNumberResponse).Number = accountNum
+			return nil
+		},
+	)
+}
+
+func (f *fixture) init(pubKey cryptotypes.PubKey) error {
+	_, err := f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: newAny(f.t, pubKey)})
+	return err
+}
+
+func (f *fixture) authenticate(privKey cryptotypes.PrivKey, sequence uint64) error {
+	f.t.Helper()
+	body := &tx.TxBody{Memo: "base"}
+	authInfo := &tx.AuthInfo{
+		SignerInfos: []*tx.SignerInfo{{
+			ModeInfo: &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_DIRECT}}},
+			Sequence: sequence,
+		}},
+		Fee: &tx.Fee{},
+	}
+	bodyBytes, err := body.Marshal()
+	require.NoError(f.t, err)
+	authInfoBytes, err := authInfo.Marshal()
+	require.NoError(f.t, err)
+	signBytes, err := (&tx.SignDoc{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, ChainId: chainID, AccountNumber: accountNum}).Marshal()
+	require.NoError(f.t, err)
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(f.t, err)
+
+	_, err = f.impl.Execute(f.accountCtx(address.Module("accounts")), &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{sig}},
+		Tx:    &tx.Tx{Body: body, AuthInfo: authInfo, Signatures: [][]byte{sig}},
+	})
+	return err
+}
+
+func newAny(t *testing.T, pubKey cryptotypes.PubKey) *codectypes.Any {
+	t.Helper()
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	return pkAny
+}
+
+func TestAuthenticateKeyTypes(t *testing.T) {
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	privKeys := map[string]cryptotypes.PrivKey{
+		"secp256k1": secp256k1.GenPrivKey(),
+		"secp256r1": secp256r1Key,
+		"ed25519":   ed25519.GenPrivKey(),
+	}
+	for name, privKey := range privKeys {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t)
+			require.NoError(t, f.init(privKey.PubKey()))
+
+			resp, err := f.impl.Query(f.accountCtx(nil), &v1.QueryPubKey{})
+			require.NoError(t, err)
+			require.Equal(t, newAny(t, privKey.PubKey()).Value, resp.(*v1.QueryPubKeyResponse).PubKey.Value)
+
+			require.NoError(t, f.authenticate(privKey, 0))
+			require.ErrorContains(t, f.authenticate(ed25519.GenPrivKey(), 1), "signature verification failed")
+		})
+	}
+}
+
+func TestPubKeyTypes(t *testing.T) {
+	f := newFixture(t)
+
+	// unsupported and invalid keys are rejected
+	_, err := f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{})
+	require.ErrorContains(t, err, "empty pubkey")
+	_, err = f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: &codectypes.Any{TypeUrl: "/unknown.PubKey"}})
+	require.ErrorContains(t, err, "unsupported pubkey type")
+	_, err = f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: newAny(t, &secp256k1.PubKey{Key: []byte("invalid")})})
+	require.ErrorContains(t, err, "invalid pubkey")
+	_, err = f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: newAny(t, &ed25519.PubKey{Key: []byte("invalid")})})
+	require.ErrorContains(t, err, "invalid pubkey")
+	// a secp256r1 key with the x coordinate of a point which is not on the curve
+	offCurve := append([]byte{0x02}, make([]byte, 32)...)
+	for x, _ := elliptic.UnmarshalCompressed(elliptic.P256(), offCurve); x != nil; x, _ = elliptic.UnmarshalCompressed(elliptic.P256(), offCurve) {
+		offCurve[32]++
+	}
+	_, err = f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: &codectypes.Any{TypeUrl: "/cosmos.crypto.secp256r1.PubKey", Value: append([]byte{0x0a, 0x21}, offCurve...)}})
+	require.ErrorContains(t, err, "invalid pubkey")
+	_, err = f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: &codectypes.Any{TypeUrl: "/cosmos.crypto.secp256r1.PubKey"}})
+	require.ErrorContains(t, err, "empty secp256r1 pubkey")
+
+	require.NoError(t, f.init(ed25519.GenPrivKey().PubKey()))
+
+	// the key type is preserved across swaps
+	_, err = f.impl.Execute(f.accountCtx([]byte("other")), &v1.MsgSwapPubKey{NewPubKey: newAny(t, ed25519.GenPrivKey().PubKey())})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = f.impl.Execute(f.accountCtx(accountAddr), &v1.MsgSwapPubKey{NewPubKey: newAny(t, secp256k1.GenPrivKey().PubKey())})
+	require.ErrorContains(t, err, "invalid pubkey type")
+
+	newKey := ed25519.GenPrivKey()
+	_, err = f.impl.Execute(f.accountCtx(accountAddr), &v1.MsgSwapPubKey{NewPubKey: newAny(t, newKey.PubKey())})
+	require.NoError(t, err)
+	require.NoError(t, f.authenticate(newKey, 0))
+}
+
+func TestWithPubKeyType(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	f := newFixture(t, WithPubKeyType("/custom.PubKey", func([]byte) (cryptotypes.PubKey, error) {
+		return privKey.PubKey(), nil
+	}))
+
+	_, err := f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: &codectypes.Any{TypeUrl: "/custom.PubKey"}})
+	require.NoError(t, err)
+	require.NoError(t, f.authenticate(privKey, 0))
+}
+
+func TestMigrateLegacyPubKey(t *testing.T) {
+	f := newFixture(t)
+	privKey := secp256k1.GenPrivKey()
+	require.NoError(t, f.acc.LegacyPubKey.Set(f.accountCtx(nil), secp256k1.PubKey{Key: privKey.PubKey().Bytes()}))
+
+	// the legacy pubkey is returned before the migration
+	resp, err := f.impl.Query(f.accountCtx(nil), &v1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, newAny(t, privKey.PubKey()).Value, resp.(*v1.QueryPubKeyResponse).PubKey.Value)
+
+	// the pubkey is migrated when the account authenticates
+	require.NoError(t, f.authenticate(privKey, 0))
+	has, err := f.acc.LegacyPubKey.Has(f.accountCtx(nil))
+	require.NoError(t, err)
+	require.False(t, has)
+	pk, err := f.acc.PubKey.Get(f.accountCtx(nil))
+	require.NoError(t, err)
+	require.Equal(t, newAny(t, privKey.PubKey()).Value, pk.Value)
+	require.NoError(t, f.authenticate(privKey, 1))
+}
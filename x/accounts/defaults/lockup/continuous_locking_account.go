@@ -0,0 +1,130 @@
+package lockup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContinuousLockingAccountType is the type name of the continuous lockup account.
+const ContinuousLockingAccountType = "continuous-locking-account"
+
+// NewContinuousLockingAccount creates a new ContinuousLockingAccount object.
+func NewContinuousLockingAccount(d accountstd.Dependencies) (*ContinuousLockingAccount, error) {
+	return &ContinuousLockingAccount{
+		BaseLockup: newBaseLockup(d),
+		StartTime:  collections.NewItem(d.SchemaBuilder, StartTimePrefix, "start_time", collections.Int64Value),
+	}, nil
+}
+
+// ContinuousLockingAccount is a lockup account whose funds unlock linearly
+// between its start and end time.
+type ContinuousLockingAccount struct {
+	*BaseLockup
+	// StartTime is the unix time, in seconds, at which the funds start unlocking.
+	StartTime collections.Item[int64]
+}
+
+func (cva ContinuousLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (*lockuptypes.MsgInitLockupAccountResponse, error) {
+	if msg.EndTime.IsZero() {
+		return nil, errors.New("invalid end time")
+	}
+
+	if !msg.EndTime.After(msg.StartTime) {
+		return nil, errors.New("invalid start and end time (must be start before end)")
+	}
+
+	if err := cva.BaseLockup.init(ctx, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	if err := cva.StartTime.Set(ctx, msg.StartTime.Unix()); err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitLockupAccountResponse{}, cva.EndTime.Set(ctx, msg.EndTime.Unix())
+}
+
+// GetLockedCoins returns the funds still locked at the given time, the
+// original locking minus the share unlocked linearly since the start time.
+func (cva ContinuousLockingAccount) GetLockedCoins(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
+	startTime, err := cva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := cva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedCoins := sdk.NewCoins()
+	now := blockTime.Unix()
+	for _, denom := range denoms {
+		originalLocking, err := cva.getAmount(ctx, cva.OriginalLocking, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case now <= startTime:
+			lockedCoins = lockedCoins.Add(sdk.NewCoin(denom, originalLocking))
+		case now < endTime:
+			unlocked := originalLocking.Mul(math.NewInt(now - startTime)).Quo(math.NewInt(endTime - startTime))
+			lockedCoins = lockedCoins.Add(sdk.NewCoin(denom, originalLocking.Sub(unlocked)))
+		}
+	}
+
+	return lockedCoins, nil
+}
+
+func (cva ContinuousLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return cva.BaseLockup.Delegate(ctx, msg, cva.GetLockedCoins)
+}
+
+func (cva ContinuousLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return cva.BaseLockup.SendCoins(ctx, msg, cva.GetLockedCoins)
+}
+
+func (cva ContinuousLockingAccount) QueryLockupAccountInfo(ctx context.Context, _ *lockuptypes.QueryLockupAccountInfoRequest) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	resp, err := cva.BaseLockup.QueryLockupAccountBaseInfo(ctx, cva.GetLockedCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, err := cva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := cva.getEndTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startT := time.Unix(startTime, 0)
+	resp.StartTime = &startT
+	resp.EndTime = &endTime
+	return resp, nil
+}
+
+func (cva ContinuousLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, cva.Init)
+}
+
+func (cva ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, cva.Delegate)
+	accountstd.RegisterExecuteHandler(builder, cva.SendCoins)
+	cva.BaseLockup.RegisterExecuteHandlers(builder)
+}
+
+func (cva ContinuousLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, cva.QueryLockupAccountInfo)
+}
@@ -0,0 +1,101 @@
+package lockup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelayedLockingAccountType is the type name of the delayed lockup account.
+const DelayedLockingAccountType = "delayed-locking-account"
+
+// NewDelayedLockingAccount creates a new DelayedLockingAccount object.
+func NewDelayedLockingAccount(d accountstd.Dependencies) (*DelayedLockingAccount, error) {
+	return &DelayedLockingAccount{
+		BaseLockup: newBaseLockup(d),
+	}, nil
+}
+
+// DelayedLockingAccount is a lockup account whose funds are all unlocked at
+// its end time.
+type DelayedLockingAccount struct {
+	*BaseLockup
+}
+
+func (dva DelayedLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (*lockuptypes.MsgInitLockupAccountResponse, error) {
+	if msg.EndTime.IsZero() {
+		return nil, errors.New("invalid end time")
+	}
+
+	if err := dva.BaseLockup.init(ctx, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitLockupAccountResponse{}, dva.EndTime.Set(ctx, msg.EndTime.Unix())
+}
+
+// GetLockedCoins returns the funds still locked at the given time, the
+// original locking until the end time and none after.
+func (dva DelayedLockingAccount) GetLockedCoins(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
+	endTime, err := dva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedCoins := sdk.NewCoins()
+	if blockTime.Unix() >= endTime {
+		return lockedCoins, nil
+	}
+
+	for _, denom := range denoms {
+		originalLocking, err := dva.getAmount(ctx, dva.OriginalLocking, denom)
+		if err != nil {
+			return nil, err
+		}
+		lockedCoins = lockedCoins.Add(sdk.NewCoin(denom, originalLocking))
+	}
+
+	return lockedCoins, nil
+}
+
+func (dva DelayedLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return dva.BaseLockup.Delegate(ctx, msg, dva.GetLockedCoins)
+}
+
+func (dva DelayedLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return dva.BaseLockup.SendCoins(ctx, msg, dva.GetLockedCoins)
+}
+
+func (dva DelayedLockingAccount) QueryLockupAccountInfo(ctx context.Context, _ *lockuptypes.QueryLockupAccountInfoRequest) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	resp, err := dva.BaseLockup.QueryLockupAccountBaseInfo(ctx, dva.GetLockedCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := dva.getEndTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.EndTime = &endTime
+	return resp, nil
+}
+
+func (dva DelayedLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, dva.Init)
+}
+
+func (dva DelayedLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, dva.Delegate)
+	accountstd.RegisterExecuteHandler(builder, dva.SendCoins)
+	dva.BaseLockup.RegisterExecuteHandlers(builder)
+}
+
+func (dva DelayedLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, dva.QueryLockupAccountInfo)
+}
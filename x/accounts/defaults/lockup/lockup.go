@@ -0,0 +1,396 @@
+package lockup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	OwnerPrefix            = collections.NewPrefix(0)
+	OriginalLockingPrefix  = collections.NewPrefix(1)
+	DelegatedFreePrefix    = collections.NewPrefix(2)
+	DelegatedLockingPrefix = collections.NewPrefix(3)
+	StartTimePrefix        = collections.NewPrefix(4)
+	EndTimePrefix          = collections.NewPrefix(5)
+	LockingPeriodsPrefix   = collections.NewPrefix(6)
+)
+
+var (
+	// ErrUnauthorized is returned when the sender is not the owner of the account.
+	ErrUnauthorized = errors.New("unauthorized: sender is not the owner of the account")
+	// ErrInsufficientFunds is returned when the unlocked funds of the account
+	// do not cover the requested amount.
+	ErrInsufficientFunds = errors.New("insufficient unlocked funds")
+)
+
+// getLockedCoinsFunc returns the amount of the given denoms still locked at
+// the given time.
+type getLockedCoinsFunc = func(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error)
+
+func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
+	return &BaseLockup{
+		Owner:            collections.NewItem(d.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+		OriginalLocking:  collections.NewMap(d.SchemaBuilder, OriginalLockingPrefix, "original_locking", collections.StringKey, sdk.IntValue),
+		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
+		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
+		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collections.Int64Value),
+		addressCodec:     d.AddressCodec,
+		headerService:    d.Environment.HeaderService,
+	}
+}
+
+// BaseLockup holds the state and the logic shared by the lockup accounts. The
+// funds sent at the creation of the account are locked and unlocked
+// following the schedule of the lockup account type. The owner can delegate
+// all the funds of the account, locked funds included, but can only send the
+// unlocked ones.
+type BaseLockup struct {
+	// Owner is the address of the owner of the account.
+	Owner collections.Item[[]byte]
+	// OriginalLocking maps the denoms to the amount locked at the creation of the account.
+	OriginalLocking collections.Map[string, math.Int]
+	// DelegatedFree maps the denoms to the amount of unlocked funds delegated.
+	DelegatedFree collections.Map[string, math.Int]
+	// DelegatedLocking maps the denoms to the amount of locked funds delegated.
+	DelegatedLocking collections.Map[string, math.Int]
+	// EndTime is the unix time, in seconds, at which the funds are fully unlocked.
+	EndTime collections.Item[int64]
+
+	addressCodec  address.Codec
+	headerService header.Service
+}
+
+// init sets the owner of the account and locks the funds sent alongside the
+// init request.
+func (bva *BaseLockup) init(ctx context.Context, ownerAddress string) error {
+	owner, err := bva.addressCodec.StringToBytes(ownerAddress)
+	if err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+
+	funds := accountstd.Funds(ctx)
+	if funds.IsZero() {
+		return errors.New("the funds to lock must be sent alongside the init request")
+	}
+
+	if err := bva.Owner.Set(ctx, owner); err != nil {
+		return err
+	}
+
+	for _, coin := range funds {
+		if err := bva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bva *BaseLockup) checkSender(ctx context.Context) error {
+	owner, err := bva.Owner.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(owner, accountstd.Sender(ctx)) {
+		return ErrUnauthorized
+	}
+
+	return nil
+}
+
+func (bva *BaseLockup) Delegate(
+	ctx context.Context, msg *lockuptypes.MsgDelegate, getLockedCoinsFunc getLockedCoinsFunc,
+) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	if err := bva.checkSender(ctx); err != nil {
+		return nil, err
+	}
+
+	whoami, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := bva.getBalance(ctx, whoami, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if balance.Amount.LT(msg.Amount.Amount) {
+		return nil, fmt.Errorf("delegation amount %s exceeds the balance %s of the account", msg.Amount, balance)
+	}
+
+	lockedCoins, err := getLockedCoinsFunc(ctx, bva.headerService.GetHeaderInfo(ctx).Time, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bva.trackDelegation(ctx, lockedCoins.AmountOf(msg.Amount.Denom), msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return bva.execute(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: whoami,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           msg.Amount,
+	})
+}
+
+// trackDelegation splits a delegation between the locked funds which are not
+// delegated yet and the unlocked funds.
+func (bva *BaseLockup) trackDelegation(ctx context.Context, locked math.Int, amount sdk.Coin) error {
+	delLocking, err := bva.getAmount(ctx, bva.DelegatedLocking, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	delFree, err := bva.getAmount(ctx, bva.DelegatedFree, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	// the amount of locked funds which are not delegated yet
+	x := math.MinInt(math.MaxInt(locked.Sub(delLocking), math.ZeroInt()), amount.Amount)
+	y := amount.Amount.Sub(x)
+
+	if err := bva.DelegatedLocking.Set(ctx, amount.Denom, delLocking.Add(x)); err != nil {
+		return err
+	}
+
+	return bva.DelegatedFree.Set(ctx, amount.Denom, delFree.Add(y))
+}
+
+func (bva *BaseLockup) Undelegate(ctx context.Context, msg *lockuptypes.MsgUndelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	if err := bva.checkSender(ctx); err != nil {
+		return nil, err
+	}
+
+	whoami, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bva.trackUndelegation(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return bva.execute(ctx, &stakingtypes.MsgUndelegate{
+		DelegatorAddress: whoami,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           msg.Amount,
+	})
+}
+
+// trackUndelegation releases the unlocked funds delegated first, so that
+// slashed delegations are accounted against the unlocked funds.
+func (bva *BaseLockup) trackUndelegation(ctx context.Context, amount sdk.Coin) error {
+	delLocking, err := bva.getAmount(ctx, bva.DelegatedLocking, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	delFree, err := bva.getAmount(ctx, bva.DelegatedFree, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	x := math.MinInt(delFree, amount.Amount)
+	y := math.MinInt(delLocking, amount.Amount.Sub(x))
+
+	if err := bva.DelegatedFree.Set(ctx, amount.Denom, delFree.Sub(x)); err != nil {
+		return err
+	}
+
+	return bva.DelegatedLocking.Set(ctx, amount.Denom, delLocking.Sub(y))
+}
+
+func (bva *BaseLockup) WithdrawReward(ctx context.Context, msg *lockuptypes.MsgWithdrawReward) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	if err := bva.checkSender(ctx); err != nil {
+		return nil, err
+	}
+
+	whoami, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return bva.execute(ctx, &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: whoami,
+		ValidatorAddress: msg.ValidatorAddress,
+	})
+}
+
+func (bva *BaseLockup) SendCoins(
+	ctx context.Context, msg *lockuptypes.MsgSend, getLockedCoinsFunc getLockedCoinsFunc,
+) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	if err := bva.checkSender(ctx); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, fmt.Errorf("invalid amount %s", msg.Amount)
+	}
+
+	whoami, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	lockedCoins, err := getLockedCoinsFunc(ctx, bva.headerService.GetHeaderInfo(ctx).Time, msg.Amount.Denoms()...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, coin := range msg.Amount {
+		spendable, err := bva.getSpendableAmount(ctx, whoami, lockedCoins.AmountOf(coin.Denom), coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		if spendable.LT(coin.Amount) {
+			return nil, fmt.Errorf("%w: %s%s is spendable, %s requested", ErrInsufficientFunds, spendable, coin.Denom, coin)
+		}
+	}
+
+	return bva.execute(ctx, &banktypes.MsgSend{
+		FromAddress: whoami,
+		ToAddress:   msg.ToAddress,
+		Amount:      msg.Amount,
+	})
+}
+
+// getSpendableAmount returns the balance of the account not covered by the
+// locked funds which are not delegated.
+func (bva *BaseLockup) getSpendableAmount(ctx context.Context, whoami string, locked math.Int, denom string) (math.Int, error) {
+	balance, err := bva.getBalance(ctx, whoami, denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	delLocking, err := bva.getAmount(ctx, bva.DelegatedLocking, denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	stillLocked := math.MaxInt(locked.Sub(delLocking), math.ZeroInt())
+	return math.MaxInt(balance.Amount.Sub(stillLocked), math.ZeroInt()), nil
+}
+
+func (bva *BaseLockup) getBalance(ctx context.Context, addr, denom string) (*sdk.Coin, error) {
+	resp, err := accountstd.QueryModule[banktypes.QueryBalanceResponse](ctx, &banktypes.QueryBalanceRequest{Address: addr, Denom: denom})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Balance == nil {
+		coin := sdk.NewCoin(denom, math.ZeroInt())
+		return &coin, nil
+	}
+
+	return resp.Balance, nil
+}
+
+func (bva *BaseLockup) getAmount(ctx context.Context, m collections.Map[string, math.Int], denom string) (math.Int, error) {
+	amount, err := m.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return amount, err
+}
+
+func (bva *BaseLockup) getCoins(ctx context.Context, m collections.Map[string, math.Int]) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	err := m.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		coins = coins.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	return coins, err
+}
+
+func (bva *BaseLockup) execute(ctx context.Context, msg sdk.Msg) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	resp, err := accountstd.ExecModuleUntyped(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	respAny, err := accountstd.PackAny(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: []*codectypes.Any{respAny}}, nil
+}
+
+// QueryLockupAccountBaseInfo returns the lockup schedule and balances shared
+// by the lockup account types.
+func (bva *BaseLockup) QueryLockupAccountBaseInfo(
+	ctx context.Context, getLockedCoinsFunc getLockedCoinsFunc,
+) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	owner, err := bva.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddress, err := bva.addressCodec.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	originalLocking, err := bva.getCoins(ctx, bva.OriginalLocking)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatedFree, err := bva.getCoins(ctx, bva.DelegatedFree)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatedLocking, err := bva.getCoins(ctx, bva.DelegatedLocking)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedCoins, err := getLockedCoinsFunc(ctx, bva.headerService.GetHeaderInfo(ctx).Time, originalLocking.Denoms()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.QueryLockupAccountInfoResponse{
+		Owner:            ownerAddress,
+		OriginalLocking:  originalLocking,
+		DelegatedFree:    delegatedFree,
+		DelegatedLocking: delegatedLocking,
+		LockedCoins:      lockedCoins,
+		UnlockedCoins:    originalLocking.Sub(lockedCoins...),
+	}, nil
+}
+
+func (bva *BaseLockup) getEndTime(ctx context.Context) (time.Time, error) {
+	endTime, err := bva.EndTime.Get(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(endTime, 0), nil
+}
+
+func (bva *BaseLockup) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, bva.Undelegate)
+	accountstd.RegisterExecuteHandler(builder, bva.WithdrawReward)
+}
@@ -0,0 +1,244 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{ time *time.Time }
+
+func (h headerService) GetHeaderInfo(context.Context) header.Info {
+	return header.Info{Time: *h.time}
+}
+
+var (
+	accountAddr = []byte("lockup")
+	owner       = []byte("owner")
+)
+
+type fixture struct {
+	t        *testing.T
+	ctx      context.Context
+	ss       store.KVStoreService
+	impl     implementation.Implementation
+	now      *time.Time
+	balance  sdk.Coins
+	executed []implementation.ProtoMsg
+}
+
+func newFixture(t *testing.T, creator implementation.AccountCreatorFunc) *fixture {
+	t.Helper()
+	ss, ctx := colltest.MockStore()
+	env := runtime.NewEnvironment(ss, log.NewNopLogger())
+	now := time.Unix(1000, 0)
+	env.HeaderService = headerService{time: &now}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	accs, err := implementation.MakeAccountsMap(cdc, addressCodec{}, env, []implementation.AccountCreatorFunc{creator})
+	require.NoError(t, err)
+	require.Len(t, accs, 1)
+
+	f := &fixture{t: t, ctx: ctx, ss: ss, now: &now}
+	for _, impl := range accs {
+		f.impl = impl
+	}
+	return f
+}
+
+func (f *fixture) accountCtx(sender []byte, funds sdk.Coins) context.Context {
+	return implementation.MakeAccountContext(
+		f.ctx,
+		f.ss,
+		1,
+		accountAddr,
+		sender,
+		funds,
+		func(context.Context, []byte, implementation.ProtoMsg, implementation.ProtoMsg) error {
+			return nil
+		},
+		func(_ context.Context, _ []byte, msg implementation.ProtoMsg) (implementation.ProtoMsg, error) {
+			f.executed = append(f.executed, msg)
+			switch msg := msg.(type) {
+			case *banktypes.MsgSend:
+				f.balance = f.balance.Sub(msg.Amount...)
+				return &banktypes.MsgSendResponse{}, nil
+			case *stakingtypes.MsgDelegate:
+				f.balance = f.balance.Sub(msg.Amount)
+				return &stakingtypes.MsgDelegateResponse{}, nil
+			case *stakingtypes.MsgUndelegate:
+				return &stakingtypes.MsgUndelegateResponse{}, nil
+			}
+			return &banktypes.MsgSendResponse{}, nil
+		},
+		func(_ context.Context, req, resp implementation.ProtoMsg) error {
+			coin := sdk.NewCoin(req.(*banktypes.QueryBalanceRequest).Denom, f.balance.AmountOf(req.(*banktypes.QueryBalanceRequest).Denom))
+			resp.(*banktypes.QueryBalanceResponse).Balance = &coin
+			return nil
+		},
+	)
+}
+
+func (f *fixture) init(msg implementation.ProtoMsg, funds sdk.Coins) error {
+	_, err := f.impl.Init(f.accountCtx([]byte("creator"), funds), msg)
+	if err == nil {
+		f.balance = funds
+	}
+	return err
+}
+
+func (f *fixture) execute(sender []byte, msg implementation.ProtoMsg) error {
+	_, err := f.impl.Execute(f.accountCtx(sender, nil), msg)
+	return err
+}
+
+func (f *fixture) info() *lockuptypes.QueryLockupAccountInfoResponse {
+	resp, err := f.impl.Query(f.accountCtx(nil, nil), &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(f.t, err)
+	return resp.(*lockuptypes.QueryLockupAccountInfoResponse)
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+func send(amount int64) *lockuptypes.MsgSend {
+	return &lockuptypes.MsgSend{ToAddress: "receiver", Amount: coins(amount)}
+}
+
+func TestContinuousLockingAccount(t *testing.T) {
+	f := newFixture(t, accountstd.AddAccount(ContinuousLockingAccountType, NewContinuousLockingAccount))
+	start := time.Unix(1000, 0)
+
+	require.Error(t, f.init(&lockuptypes.MsgInitLockupAccount{Owner: "owner", StartTime: start, EndTime: start}, coins(100)))
+	require.Error(t, f.init(&lockuptypes.MsgInitLockupAccount{Owner: "owner", StartTime: start, EndTime: start.Add(100 * time.Second)}, nil))
+	require.NoError(t, f.init(&lockuptypes.MsgInitLockupAccount{Owner: "owner", StartTime: start, EndTime: start.Add(100 * time.Second)}, coins(100)))
+
+	info := f.info()
+	require.Equal(t, "owner", info.Owner)
+	require.Equal(t, coins(100), info.LockedCoins)
+	require.Equal(t, start, *info.StartTime)
+	require.Equal(t, start.Add(100*time.Second), *info.EndTime)
+
+	// funds unlock linearly
+	*f.now = start.Add(40 * time.Second)
+	info = f.info()
+	require.Equal(t, coins(60), info.LockedCoins)
+	require.Equal(t, coins(40), info.UnlockedCoins)
+
+	require.ErrorIs(t, f.execute([]byte("other"), send(10)), ErrUnauthorized)
+	require.ErrorIs(t, f.execute(owner, send(41)), ErrInsufficientFunds)
+	require.NoError(t, f.execute(owner, send(40)))
+	require.Equal(t, coins(60), f.balance)
+
+	*f.now = start.Add(100 * time.Second)
+	require.Empty(t, f.info().LockedCoins)
+	require.NoError(t, f.execute(owner, send(60)))
+}
+
+func TestDelayedLockingAccount(t *testing.T) {
+	f := newFixture(t, accountstd.AddAccount(DelayedLockingAccountType, NewDelayedLockingAccount))
+	end := time.Unix(2000, 0)
+
+	require.Error(t, f.init(&lockuptypes.MsgInitLockupAccount{Owner: "owner"}, coins(100)))
+	require.NoError(t, f.init(&lockuptypes.MsgInitLockupAccount{Owner: "owner", EndTime: end}, coins(100)))
+
+	// funds received after the init are not locked
+	f.balance = f.balance.Add(coins(5)...)
+	require.ErrorIs(t, f.execute(owner, send(6)), ErrInsufficientFunds)
+	require.NoError(t, f.execute(owner, send(5)))
+
+	*f.now = end.Add(-time.Second)
+	require.ErrorIs(t, f.execute(owner, send(1)), ErrInsufficientFunds)
+
+	*f.now = end
+	require.Empty(t, f.info().LockedCoins)
+	require.NoError(t, f.execute(owner, send(100)))
+}
+
+func TestPeriodicLockingAccount(t *testing.T) {
+	f := newFixture(t, accountstd.AddAccount(PeriodicLockingAccountType, NewPeriodicLockingAccount))
+	start := time.Unix(1000, 0)
+	periods := []lockuptypes.Period{
+		{Length: 10 * time.Second, Amount: coins(30)},
+		{Length: 20 * time.Second, Amount: coins(70)},
+	}
+
+	require.ErrorContains(t, f.init(&lockuptypes.MsgInitPeriodicLockingAccount{Owner: "owner", StartTime: start, LockingPeriods: periods}, coins(99)), "must equal")
+	require.Error(t, f.init(&lockuptypes.MsgInitPeriodicLockingAccount{Owner: "owner", StartTime: start}, coins(100)))
+	require.NoError(t, f.init(&lockuptypes.MsgInitPeriodicLockingAccount{Owner: "owner", StartTime: start, LockingPeriods: periods}, coins(100)))
+
+	resp, err := f.impl.Query(f.accountCtx(nil, nil), &lockuptypes.QueryLockingPeriodsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.(*lockuptypes.QueryLockingPeriodsResponse).LockingPeriods, 2)
+
+	info := f.info()
+	require.Equal(t, start.Add(30*time.Second), *info.EndTime)
+	require.Equal(t, coins(100), info.LockedCoins)
+
+	*f.now = start.Add(29 * time.Second)
+	require.Equal(t, coins(70), f.info().LockedCoins)
+	require.ErrorIs(t, f.execute(owner, send(31)), ErrInsufficientFunds)
+	require.NoError(t, f.execute(owner, send(30)))
+
+	*f.now = start.Add(30 * time.Second)
+	require.Empty(t, f.info().LockedCoins)
+}
+
+func TestPermanentLockingAccountDelegations(t *testing.T) {
+	f := newFixture(t, accountstd.AddAccount(PermanentLockingAccountType, NewPermanentLockingAccount))
+	require.NoError(t, f.init(&lockuptypes.MsgInitLockupAccount{Owner: "owner"}, coins(100)))
+	f.balance = f.balance.Add(coins(20)...)
+
+	delegate := &lockuptypes.MsgDelegate{ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 110)}
+	require.ErrorIs(t, f.execute([]byte("other"), delegate), ErrUnauthorized)
+	require.NoError(t, f.execute(owner, delegate))
+	require.IsType(t, &stakingtypes.MsgDelegate{}, f.executed[len(f.executed)-1])
+
+	// the locked funds are delegated first
+	info := f.info()
+	require.Equal(t, coins(100), info.DelegatedLocking)
+	require.Equal(t, coins(10), info.DelegatedFree)
+	require.Equal(t, coins(100), info.LockedCoins)
+
+	// the remaining balance is unlocked since all the locked funds are delegated
+	require.NoError(t, f.execute(owner, send(10)))
+
+	// the unlocked delegations are undelegated first
+	undelegate := &lockuptypes.MsgUndelegate{ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 30)}
+	require.NoError(t, f.execute(owner, undelegate))
+	f.balance = f.balance.Add(undelegate.Amount)
+	info = f.info()
+	require.Empty(t, info.DelegatedFree)
+	require.Equal(t, coins(80), info.DelegatedLocking)
+	require.Equal(t, math.NewInt(30), f.balance.AmountOf("stake"))
+
+	// the undelegated locked funds cannot be sent
+	require.ErrorIs(t, f.execute(owner, send(11)), ErrInsufficientFunds)
+	require.NoError(t, f.execute(owner, send(10)))
+
+	require.NoError(t, f.execute(owner, &lockuptypes.MsgWithdrawReward{ValidatorAddress: "val"}))
+}
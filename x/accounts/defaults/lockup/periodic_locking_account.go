@@ -0,0 +1,171 @@
+package lockup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PeriodicLockingAccountType is the type name of the periodic lockup account.
+const PeriodicLockingAccountType = "periodic-locking-account"
+
+// NewPeriodicLockingAccount creates a new PeriodicLockingAccount object.
+func NewPeriodicLockingAccount(d accountstd.Dependencies) (*PeriodicLockingAccount, error) {
+	return &PeriodicLockingAccount{
+		BaseLockup:     newBaseLockup(d),
+		StartTime:      collections.NewItem(d.SchemaBuilder, StartTimePrefix, "start_time", collections.Int64Value),
+		LockingPeriods: collections.NewMap(d.SchemaBuilder, LockingPeriodsPrefix, "locking_periods", collections.Uint64Key, codec.CollValue[lockuptypes.Period](d.LegacyStateCodec)),
+	}, nil
+}
+
+// PeriodicLockingAccount is a lockup account whose funds are unlocked at the
+// end of each of its locking periods, the periods starting one after the
+// other from its start time.
+type PeriodicLockingAccount struct {
+	*BaseLockup
+	// StartTime is the unix time, in seconds, at which the first period starts.
+	StartTime      collections.Item[int64]
+	LockingPeriods collections.Map[uint64, lockuptypes.Period]
+}
+
+func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitPeriodicLockingAccount) (*lockuptypes.MsgInitPeriodicLockingAccountResponse, error) {
+	if len(msg.LockingPeriods) == 0 {
+		return nil, errors.New("locking periods cannot be empty")
+	}
+
+	endTime := msg.StartTime
+	totalCoins := sdk.NewCoins()
+	for i, period := range msg.LockingPeriods {
+		if period.Length < time.Second {
+			return nil, fmt.Errorf("invalid period length of %s in period %d, length must be at least one second", period.Length, i)
+		}
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return nil, fmt.Errorf("invalid amount %s in period %d", period.Amount, i)
+		}
+		endTime = endTime.Add(period.Length)
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	if funds := accountstd.Funds(ctx); !funds.Equal(totalCoins) {
+		return nil, fmt.Errorf("the funds sent %s must equal the sum of the locking periods amounts %s", funds, totalCoins)
+	}
+
+	if err := pva.BaseLockup.init(ctx, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	for i, period := range msg.LockingPeriods {
+		if err := pva.LockingPeriods.Set(ctx, uint64(i), period); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := pva.StartTime.Set(ctx, msg.StartTime.Unix()); err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitPeriodicLockingAccountResponse{}, pva.EndTime.Set(ctx, endTime.Unix())
+}
+
+// GetLockedCoins returns the funds still locked at the given time, the
+// original locking minus the amounts of the periods which ended.
+func (pva PeriodicLockingAccount) GetLockedCoins(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
+	startTime, err := pva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockedCoins := sdk.NewCoins()
+	periodEnd := time.Unix(startTime, 0)
+	err = pva.LockingPeriods.Walk(ctx, nil, func(_ uint64, period lockuptypes.Period) (bool, error) {
+		periodEnd = periodEnd.Add(period.Length)
+		if blockTime.Before(periodEnd) {
+			return true, nil
+		}
+		unlockedCoins = unlockedCoins.Add(period.Amount...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lockedCoins := sdk.NewCoins()
+	for _, denom := range denoms {
+		originalLocking, err := pva.getAmount(ctx, pva.OriginalLocking, denom)
+		if err != nil {
+			return nil, err
+		}
+		locked := originalLocking.Sub(unlockedCoins.AmountOf(denom))
+		if locked.IsPositive() {
+			lockedCoins = lockedCoins.Add(sdk.NewCoin(denom, locked))
+		}
+	}
+
+	return lockedCoins, nil
+}
+
+func (pva PeriodicLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return pva.BaseLockup.Delegate(ctx, msg, pva.GetLockedCoins)
+}
+
+func (pva PeriodicLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return pva.BaseLockup.SendCoins(ctx, msg, pva.GetLockedCoins)
+}
+
+func (pva PeriodicLockingAccount) QueryLockupAccountInfo(ctx context.Context, _ *lockuptypes.QueryLockupAccountInfoRequest) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	resp, err := pva.BaseLockup.QueryLockupAccountBaseInfo(ctx, pva.GetLockedCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, err := pva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := pva.getEndTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startT := time.Unix(startTime, 0)
+	resp.StartTime = &startT
+	resp.EndTime = &endTime
+	return resp, nil
+}
+
+func (pva PeriodicLockingAccount) QueryLockingPeriods(ctx context.Context, _ *lockuptypes.QueryLockingPeriodsRequest) (*lockuptypes.QueryLockingPeriodsResponse, error) {
+	var lockingPeriods []*lockuptypes.Period
+	err := pva.LockingPeriods.Walk(ctx, nil, func(_ uint64, period lockuptypes.Period) (bool, error) {
+		lockingPeriods = append(lockingPeriods, &period)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.QueryLockingPeriodsResponse{LockingPeriods: lockingPeriods}, nil
+}
+
+func (pva PeriodicLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, pva.Init)
+}
+
+func (pva PeriodicLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, pva.Delegate)
+	accountstd.RegisterExecuteHandler(builder, pva.SendCoins)
+	pva.BaseLockup.RegisterExecuteHandlers(builder)
+}
+
+func (pva PeriodicLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, pva.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, pva.QueryLockingPeriods)
+}
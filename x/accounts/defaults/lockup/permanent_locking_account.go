@@ -0,0 +1,71 @@
+package lockup
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PermanentLockingAccountType is the type name of the permanent lockup account.
+const PermanentLockingAccountType = "permanent-locking-account"
+
+// NewPermanentLockingAccount creates a new PermanentLockingAccount object.
+func NewPermanentLockingAccount(d accountstd.Dependencies) (*PermanentLockingAccount, error) {
+	return &PermanentLockingAccount{
+		BaseLockup: newBaseLockup(d),
+	}, nil
+}
+
+// PermanentLockingAccount is a lockup account whose funds are never
+// unlocked, they can only be delegated.
+type PermanentLockingAccount struct {
+	*BaseLockup
+}
+
+func (pva PermanentLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (*lockuptypes.MsgInitLockupAccountResponse, error) {
+	return &lockuptypes.MsgInitLockupAccountResponse{}, pva.BaseLockup.init(ctx, msg.Owner)
+}
+
+// GetLockedCoins returns the original locking, which is locked forever.
+func (pva PermanentLockingAccount) GetLockedCoins(ctx context.Context, _ time.Time, denoms ...string) (sdk.Coins, error) {
+	lockedCoins := sdk.NewCoins()
+	for _, denom := range denoms {
+		originalLocking, err := pva.getAmount(ctx, pva.OriginalLocking, denom)
+		if err != nil {
+			return nil, err
+		}
+		lockedCoins = lockedCoins.Add(sdk.NewCoin(denom, originalLocking))
+	}
+
+	return lockedCoins, nil
+}
+
+func (pva PermanentLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return pva.BaseLockup.Delegate(ctx, msg, pva.GetLockedCoins)
+}
+
+func (pva PermanentLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return pva.BaseLockup.SendCoins(ctx, msg, pva.GetLockedCoins)
+}
+
+func (pva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context, _ *lockuptypes.QueryLockupAccountInfoRequest) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	return pva.BaseLockup.QueryLockupAccountBaseInfo(ctx, pva.GetLockedCoins)
+}
+
+func (pva PermanentLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, pva.Init)
+}
+
+func (pva PermanentLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, pva.Delegate)
+	accountstd.RegisterExecuteHandler(builder, pva.SendCoins)
+	pva.BaseLockup.RegisterExecuteHandlers(builder)
+}
+
+func (pva PermanentLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, pva.QueryLockupAccountInfo)
+}
@@ -0,0 +1,572 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"google.golang.org/protobuf/proto"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	MembersPrefix       = collections.NewPrefix(0)
+	MemberPubKeysPrefix = collections.NewPrefix(1)
+	ConfigPrefix        = collections.NewPrefix(2)
+	ProposalsPrefix     = collections.NewPrefix(3)
+	ProposalSeqPrefix   = collections.NewPrefix(4)
+	VotesPrefix         = collections.NewPrefix(5)
+	SequencePrefix      = collections.NewPrefix(6)
+)
+
+// AuthenticationMethod is the authentication method reported by the multisig
+// account to the account abstraction flow.
+const AuthenticationMethod = "multisig"
+
+var _ accountstd.Interface = (*Account)(nil)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Members:       collections.NewMap(deps.SchemaBuilder, MembersPrefix, "members", collections.BytesKey, collections.Uint64Value),
+			MemberPubKeys: collections.NewMap(deps.SchemaBuilder, MemberPubKeysPrefix, "member_pub_keys", collections.BytesKey, codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
+			Config:        collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Proposals:     collections.NewMap(deps.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](deps.LegacyStateCodec)),
+			ProposalSeq:   collections.NewSequence(deps.SchemaBuilder, ProposalSeqPrefix, "proposal_seq"),
+			Votes:         collections.NewMap(deps.SchemaBuilder, VotesPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Int32Value),
+			Sequence:      collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+
+			addrCodec:       deps.AddressCodec,
+			hs:              deps.Environment.HeaderService,
+			signingHandlers: handlerMap,
+		}, nil
+	}
+}
+
+// Account implements a multisig account. Its members, each with a voting
+// weight, create and vote on proposals holding the messages the multisig
+// executes once the threshold is met. Members holding a public key can also
+// sign transactions directly as the multisig through the account abstraction
+// flow.
+type Account struct {
+	// Members maps the address of the members to their voting weight.
+	Members collections.Map[[]byte, uint64]
+	// MemberPubKeys maps the address of the members to their public key, if any.
+	MemberPubKeys collections.Map[[]byte, secp256k1.PubKey]
+	// Config is the configuration of the multisig.
+	Config collections.Item[v1.Config]
+	// Proposals maps the proposal ids to the proposals.
+	Proposals collections.Map[uint64, v1.Proposal]
+	// ProposalSeq is the sequence of the proposal ids.
+	ProposalSeq collections.Sequence
+	// Votes maps the proposal id and the address of a member to its vote option.
+	Votes collections.Map[collections.Pair[uint64, []byte], int32]
+	// Sequence is the sequence of the transactions signed as the multisig.
+	Sequence collections.Sequence
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.Config == nil {
+		return nil, errors.New("config must be specified")
+	}
+
+	if err := a.updateMembers(ctx, msg.Members); err != nil {
+		return nil, err
+	}
+
+	if err := a.Config.Set(ctx, *msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, a.validateConfig(ctx)
+}
+
+// updateMembers adds, updates or removes, when their weight is zero, the given members.
+func (a Account) updateMembers(ctx context.Context, members []*v1.Member) error {
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		addr, err := a.addrCodec.StringToBytes(member.Address)
+		if err != nil {
+			return fmt.Errorf("invalid member address %s: %w", member.Address, err)
+		}
+
+		if seen[string(addr)] {
+			return fmt.Errorf("duplicate member %s", member.Address)
+		}
+		seen[string(addr)] = true
+
+		if member.Weight == 0 {
+			if err := a.Members.Remove(ctx, addr); err != nil {
+				return err
+			}
+			if err := a.MemberPubKeys.Remove(ctx, addr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := a.Members.Set(ctx, addr, member.Weight); err != nil {
+			return err
+		}
+
+		if len(member.PubKey) == 0 {
+			if err := a.MemberPubKeys.Remove(ctx, addr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := dcrd_secp256k1.ParsePubKey(member.PubKey); err != nil {
+			return fmt.Errorf("invalid pubkey of member %s: %w", member.Address, err)
+		}
+
+		pubKey := secp256k1.PubKey{Key: member.PubKey}
+		if !bytes.Equal(pubKey.Address(), addr) {
+			return fmt.Errorf("pubkey does not match the address of member %s", member.Address)
+		}
+
+		if err := a.MemberPubKeys.Set(ctx, addr, pubKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateConfig checks the configuration against the members of the multisig.
+func (a Account) validateConfig(ctx context.Context) error {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if config.Threshold == 0 {
+		return errors.New("threshold must be positive")
+	}
+
+	if config.VotingPeriod <= 0 {
+		return errors.New("voting period must be positive")
+	}
+
+	totalWeight, err := a.totalWeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	if totalWeight < config.Threshold {
+		return fmt.Errorf("threshold %d is greater than the total weight %d of the members", config.Threshold, totalWeight)
+	}
+
+	return nil
+}
+
+func (a Account) totalWeight(ctx context.Context) (uint64, error) {
+	var total uint64
+	err := a.Members.Walk(ctx, nil, func(_ []byte, weight uint64) (bool, error) {
+		total += weight
+		return false, nil
+	})
+	return total, err
+}
+
+// senderMember returns the address of the sender, which must be a member.
+func (a Account) senderMember(ctx context.Context) ([]byte, error) {
+	sender := accountstd.Sender(ctx)
+	isMember, err := a.Members.Has(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isMember {
+		return nil, errors.New("unauthorized: sender is not a member of the multisig")
+	}
+
+	return sender, nil
+}
+
+func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal) (*v1.MsgCreateProposalResponse, error) {
+	sender, err := a.senderMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Proposal == nil || len(msg.Proposal.Messages) == 0 {
+		return nil, errors.New("proposal must have at least one message")
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposer, err := a.addrCodec.BytesToString(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalID, err := a.ProposalSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := *msg.Proposal
+	proposal.Proposer = proposer
+	proposal.VotingPeriodEnd = a.hs.GetHeaderInfo(ctx).Time.Unix() + config.VotingPeriod
+	proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD
+
+	if err := a.Proposals.Set(ctx, proposalID, proposal); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateProposalResponse{ProposalId: proposalID}, nil
+}
+
+func (a Account) Vote(ctx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResponse, error) {
+	sender, err := a.senderMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := v1.VoteOption_name[int32(msg.Vote)]; !ok || msg.Vote == v1.VoteOption_VOTE_OPTION_UNSPECIFIED {
+		return nil, fmt.Errorf("invalid vote option %s", msg.Vote)
+	}
+
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, fmt.Errorf("proposal %d: %w", msg.ProposalId, err)
+	}
+
+	if proposal.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD || a.hs.GetHeaderInfo(ctx).Time.Unix() >= proposal.VotingPeriodEnd {
+		return nil, fmt.Errorf("voting period of proposal %d has ended", msg.ProposalId)
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(msg.ProposalId, sender)
+	if !config.Revote {
+		voted, err := a.Votes.Has(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		if voted {
+			return nil, fmt.Errorf("sender already voted on proposal %d and revote is disabled", msg.ProposalId)
+		}
+	}
+
+	return &v1.MsgVoteResponse{}, a.Votes.Set(ctx, key, int32(msg.Vote))
+}
+
+// yesWeight returns the sum of the current weights of the members who voted yes on a proposal.
+func (a Account) yesWeight(ctx context.Context, proposalID uint64) (uint64, error) {
+	var yes uint64
+	rng := collections.NewPrefixedPairRange[uint64, []byte](proposalID)
+	err := a.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], vote int32) (bool, error) {
+		if v1.VoteOption(vote) != v1.VoteOption_VOTE_OPTION_YES {
+			return false, nil
+		}
+
+		weight, err := a.Members.Get(ctx, key.K2())
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			// the member was removed since it voted.
+			return false, nil
+		case err != nil:
+			return true, err
+		}
+
+		yes += weight
+		return false, nil
+	})
+	return yes, err
+}
+
+func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal) (*v1.MsgExecuteProposalResponse, error) {
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, fmt.Errorf("proposal %d: %w", msg.ProposalId, err)
+	}
+
+	if proposal.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD {
+		return nil, fmt.Errorf("proposal %d was already finalized", msg.ProposalId)
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	votingEnded := a.hs.GetHeaderInfo(ctx).Time.Unix() >= proposal.VotingPeriodEnd
+	if !votingEnded && !config.EarlyExecution {
+		return nil, fmt.Errorf("voting period of proposal %d has not ended and early execution is disabled", msg.ProposalId)
+	}
+
+	yes, err := a.yesWeight(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if yes < config.Threshold {
+		if !votingEnded {
+			return nil, fmt.Errorf("proposal %d has %d yes votes out of a threshold of %d", msg.ProposalId, yes, config.Threshold)
+		}
+
+		proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+		return &v1.MsgExecuteProposalResponse{}, a.Proposals.Set(ctx, msg.ProposalId, proposal)
+	}
+
+	// the proposal is marked as passed before executing its messages, so that
+	// they cannot execute it again.
+	proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+	if err := a.Proposals.Set(ctx, msg.ProposalId, proposal); err != nil {
+		return nil, err
+	}
+
+	responses, err := accountstd.ExecModuleAnys(ctx, proposal.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteProposalResponse{Responses: responses}, nil
+}
+
+func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized: only the multisig can update its config")
+	}
+
+	if err := a.updateMembers(ctx, msg.UpdateMembers); err != nil {
+		return nil, err
+	}
+
+	if msg.Config != nil {
+		if err := a.Config.Set(ctx, *msg.Config); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.MsgUpdateConfigResponse{}, a.validateConfig(ctx)
+}
+
+// Authenticate implements the authentication flow of an abstracted multisig
+// account. The signature of the multisig is a MultiSignature holding the
+// signatures of members over the SIGN_MODE_DIRECT sign bytes of the
+// transaction, whose weights must meet the threshold.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerData, err := a.computeSignerData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+	if signerInfo.Sequence != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, signerInfo.Sequence)
+	}
+
+	single, ok := signerInfo.ModeInfo.Sum.(*tx.ModeInfo_Single_)
+	if !ok || signingv1beta1.SignMode(single.Single.Mode) != signingv1beta1.SignMode_SIGN_MODE_DIRECT {
+		return nil, fmt.Errorf("only sign mode single direct accepted got: %v", signerInfo.ModeInfo.Sum)
+	}
+
+	var multiSig v1.MultiSignature
+	if err := multiSig.Unmarshal(msg.Tx.Signatures[msg.SignerIndex]); err != nil {
+		return nil, fmt.Errorf("invalid multisig signature: %w", err)
+	}
+
+	txData, err := getTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signingv1beta1.SignMode_SIGN_MODE_DIRECT, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var weight uint64
+	signed := make(map[string]bool, len(multiSig.Signatures))
+	for _, sig := range multiSig.Signatures {
+		addr, err := a.addrCodec.StringToBytes(sig.Member)
+		if err != nil {
+			return nil, err
+		}
+
+		if signed[string(addr)] {
+			return nil, fmt.Errorf("duplicate signature of member %s", sig.Member)
+		}
+		signed[string(addr)] = true
+
+		memberWeight, err := a.Members.Get(ctx, addr)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a member of the multisig: %w", sig.Member, err)
+		}
+
+		pubKey, err := a.MemberPubKeys.Get(ctx, addr)
+		if err != nil {
+			return nil, fmt.Errorf("member %s has no pubkey: %w", sig.Member, err)
+		}
+
+		if !pubKey.VerifySignature(signBytes, sig.Signature) {
+			return nil, fmt.Errorf("signature verification failed for member %s", sig.Member)
+		}
+
+		weight += memberWeight
+	}
+
+	if weight < config.Threshold {
+		return nil, fmt.Errorf("signatures weight %d is below the threshold %d", weight, config.Threshold)
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.Sequence.Set(ctx, signerData.Sequence+1)
+}
+
+// computeSignerData will populate signer data.
+func (a Account) computeSignerData(ctx context.Context) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	wantSequence, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return signing.SignerData{
+		Address:       addrStr,
+		ChainID:       a.hs.GetHeaderInfo(ctx).ChainID,
+		AccountNumber: accNum.Number,
+		Sequence:      wantSequence,
+	}, nil
+}
+
+func getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:          txBody,
+		AuthInfo:      authInfo,
+		BodyBytes:     msg.RawTx.BodyBytes,
+		AuthInfoBytes: msg.RawTx.AuthInfoBytes,
+	}, nil
+}
+
+func (a Account) QueryAuthenticationMethods(_ context.Context, _ *aa_interface_v1.QueryAuthenticationMethods) (*aa_interface_v1.QueryAuthenticationMethodsResponse, error) {
+	return &aa_interface_v1.QueryAuthenticationMethodsResponse{AuthenticationMethods: []string{AuthenticationMethod}}, nil
+}
+
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*v1.Member
+	err = a.Members.Walk(ctx, nil, func(addr []byte, weight uint64) (bool, error) {
+		addrStr, err := a.addrCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+
+		member := &v1.Member{Address: addrStr, Weight: weight}
+		pubKey, err := a.MemberPubKeys.Get(ctx, addr)
+		switch {
+		case err == nil:
+			member.PubKey = pubKey.Key
+		case !errors.Is(err, collections.ErrNotFound):
+			return true, err
+		}
+
+		members = append(members, member)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryConfigResponse{Members: members, Config: &config}, nil
+}
+
+func (a Account) QueryProposal(ctx context.Context, msg *v1.QueryProposal) (*v1.QueryProposalResponse, error) {
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, fmt.Errorf("proposal %d: %w", msg.ProposalId, err)
+	}
+
+	return &v1.QueryProposalResponse{Proposal: &proposal}, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
+	accountstd.RegisterExecuteHandler(builder, a.Vote)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryAuthenticationMethods)
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+}
@@ -0,0 +1,294 @@
+package multisig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/direct"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	chainID    = "test-chain"
+	accountNum = 7
+)
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{ time *time.Time }
+
+func (h headerService) GetHeaderInfo(context.Context) header.Info {
+	return header.Info{ChainID: chainID, Time: *h.time}
+}
+
+type fixture struct {
+	t        *testing.T
+	ctx      context.Context
+	ss       store.KVStoreService
+	impl     implementation.Implementation
+	now      *time.Time
+	executed []implementation.ProtoMsg
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	ss, ctx := colltest.MockStore()
+	env := runtime.NewEnvironment(ss, log.NewNopLogger())
+	now := time.Unix(1000, 0)
+	env.HeaderService = headerService{time: &now}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	accs, err := implementation.MakeAccountsMap(cdc, addressCodec{}, env, []implementation.AccountCreatorFunc{
+		NewAccount("multisig", signing.NewHandlerMap(direct.SignModeHandler{})),
+	})
+	require.NoError(t, err)
+
+	return &fixture{t: t, ctx: ctx, ss: ss, impl: accs["multisig"], now: &now}
+}
+
+var accountAddr = []byte("multisig")
+
+func (f *fixture) accountCtx(sender []byte) context.Context {
+	return implementation.MakeAccountContext(
+		f.ctx,
+		f.ss,
+		accountNum,
+		accountAddr,
+		sender,
+		nil,
+		func(context.Context, []byte, implementation.ProtoMsg, implementation.ProtoMsg) error {
+			return nil
+		},
+		func(_ context.Context, _ []byte, msg implementation.ProtoMsg) (implementation.ProtoMsg, error) {
+			f.executed = append(f.executed, msg)
+			return &v1.MsgVoteResponse{}, nil
+		},
+		func(_ context.Context, _, resp implementation.ProtoMsg) error {
+			resp.(*accountsv1.AccountNumberResponse).Number = accountNum
+			return nil
+		},
+	)
+}
+
+func (f *fixture) execute(sender []byte, msg implementation.ProtoMsg) (implementation.ProtoMsg, error) {
+	return f.impl.Execute(f.accountCtx(sender), msg)
+}
+
+func (f *fixture) query(msg implementation.ProtoMsg) implementation.ProtoMsg {
+	resp, err := f.impl.Query(f.accountCtx(nil), msg)
+	require.NoError(f.t, err)
+	return resp
+}
+
+func TestMultisigProposals(t *testing.T) {
+	f := newFixture(t)
+	_, err := f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{
+		Members: []*v1.Member{{Address: "alice", Weight: 2}, {Address: "bob", Weight: 1}, {Address: "carol", Weight: 1}},
+		Config:  &v1.Config{Threshold: 3, VotingPeriod: 100},
+	})
+	require.NoError(t, err)
+
+	msg, err := codectypes.NewAnyWithValue(&v1.MsgVote{ProposalId: 42})
+	require.NoError(t, err)
+	proposal := &v1.Proposal{Title: "title", Messages: []*codectypes.Any{msg}}
+
+	_, err = f.execute([]byte("eve"), &v1.MsgCreateProposal{Proposal: proposal})
+	require.ErrorContains(t, err, "not a member")
+	_, err = f.execute([]byte("alice"), &v1.MsgCreateProposal{Proposal: &v1.Proposal{Title: "empty"}})
+	require.Error(t, err)
+
+	resp, err := f.execute([]byte("alice"), &v1.MsgCreateProposal{Proposal: proposal})
+	require.NoError(t, err)
+	proposalID := resp.(*v1.MsgCreateProposalResponse).ProposalId
+
+	_, err = f.execute([]byte("alice"), &v1.MsgVote{ProposalId: proposalID, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+	_, err = f.execute([]byte("alice"), &v1.MsgVote{ProposalId: proposalID, Vote: v1.VoteOption_VOTE_OPTION_NO})
+	require.ErrorContains(t, err, "revote is disabled")
+	_, err = f.execute([]byte("bob"), &v1.MsgVote{ProposalId: proposalID, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+
+	// early execution is disabled
+	_, err = f.execute([]byte("carol"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+	require.ErrorContains(t, err, "early execution is disabled")
+
+	*f.now = f.now.Add(100 * time.Second)
+	_, err = f.execute([]byte("carol"), &v1.MsgVote{ProposalId: proposalID, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.ErrorContains(t, err, "has ended")
+
+	execResp, err := f.execute([]byte("carol"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+	require.NoError(t, err)
+	require.Len(t, execResp.(*v1.MsgExecuteProposalResponse).Responses, 1)
+	require.Len(t, f.executed, 1)
+	require.Equal(t, uint64(42), f.executed[0].(*v1.MsgVote).ProposalId)
+
+	got := f.query(&v1.QueryProposal{ProposalId: proposalID}).(*v1.QueryProposalResponse)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_PASSED, got.Proposal.Status)
+	require.Equal(t, "alice", got.Proposal.Proposer)
+
+	_, err = f.execute([]byte("carol"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+	require.ErrorContains(t, err, "already finalized")
+
+	// a proposal below the threshold is rejected at the end of its voting period
+	resp, err = f.execute([]byte("bob"), &v1.MsgCreateProposal{Proposal: proposal})
+	require.NoError(t, err)
+	proposalID = resp.(*v1.MsgCreateProposalResponse).ProposalId
+	_, err = f.execute([]byte("alice"), &v1.MsgVote{ProposalId: proposalID, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+
+	*f.now = f.now.Add(100 * time.Second)
+	_, err = f.execute([]byte("bob"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+	require.NoError(t, err)
+	require.Len(t, f.executed, 1)
+	got = f.query(&v1.QueryProposal{ProposalId: proposalID}).(*v1.QueryProposalResponse)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_REJECTED, got.Proposal.Status)
+}
+
+func TestMultisigEarlyExecutionAndRevote(t *testing.T) {
+	f := newFixture(t)
+	_, err := f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{
+		Members: []*v1.Member{{Address: "alice", Weight: 1}, {Address: "bob", Weight: 1}},
+		Config:  &v1.Config{Threshold: 2, VotingPeriod: 100, Revote: true, EarlyExecution: true},
+	})
+	require.NoError(t, err)
+
+	msg, err := codectypes.NewAnyWithValue(&v1.MsgVote{ProposalId: 42})
+	require.NoError(t, err)
+	resp, err := f.execute([]byte("alice"), &v1.MsgCreateProposal{Proposal: &v1.Proposal{Messages: []*codectypes.Any{msg}}})
+	require.NoError(t, err)
+	proposalID := resp.(*v1.MsgCreateProposalResponse).ProposalId
+
+	_, err = f.execute([]byte("alice"), &v1.MsgVote{ProposalId: proposalID, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+	_, err = f.execute([]byte("bob"), &v1.MsgVote{ProposalId: proposalID, Vote: v1.VoteOption_VOTE_OPTION_NO})
+	require.NoError(t, err)
+
+	_, err = f.execute([]byte("alice"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+	require.ErrorContains(t, err, "out of a threshold")
+
+	_, err = f.execute([]byte("bob"), &v1.MsgVote{ProposalId: proposalID, Vote: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+	_, err = f.execute([]byte("alice"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+	require.NoError(t, err)
+	require.Len(t, f.executed, 1)
+}
+
+func TestMultisigUpdateConfig(t *testing.T) {
+	f := newFixture(t)
+	_, err := f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{
+		Members: []*v1.Member{{Address: "alice", Weight: 1}, {Address: "alice", Weight: 1}},
+		Config:  &v1.Config{Threshold: 1, VotingPeriod: 100},
+	})
+	require.ErrorContains(t, err, "duplicate member")
+
+	_, err = f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{
+		Members: []*v1.Member{{Address: "alice", Weight: 1}, {Address: "bob", Weight: 1}},
+		Config:  &v1.Config{Threshold: 2, VotingPeriod: 100},
+	})
+	require.NoError(t, err)
+
+	update := &v1.MsgUpdateConfig{
+		UpdateMembers: []*v1.Member{{Address: "bob", Weight: 0}, {Address: "carol", Weight: 3}},
+		Config:        &v1.Config{Threshold: 3, VotingPeriod: 50},
+	}
+	_, err = f.execute([]byte("alice"), update)
+	require.ErrorContains(t, err, "unauthorized")
+
+	_, err = f.execute(accountAddr, &v1.MsgUpdateConfig{Config: &v1.Config{Threshold: 3, VotingPeriod: 50}})
+	require.ErrorContains(t, err, "greater than the total weight")
+
+	_, err = f.execute(accountAddr, update)
+	require.NoError(t, err)
+
+	got := f.query(&v1.QueryConfig{}).(*v1.QueryConfigResponse)
+	require.Equal(t, &v1.Config{Threshold: 3, VotingPeriod: 50}, got.Config)
+	require.Equal(t, []*v1.Member{{Address: "alice", Weight: 1}, {Address: "carol", Weight: 3}}, got.Members)
+}
+
+func TestMultisigAuthenticate(t *testing.T) {
+	f := newFixture(t)
+	privKeys := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	members := make([]*v1.Member, len(privKeys))
+	for i, privKey := range privKeys {
+		members[i] = &v1.Member{Address: string(privKey.PubKey().Address()), Weight: 1, PubKey: privKey.PubKey().Bytes()}
+	}
+
+	_, err := f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{
+		Members: []*v1.Member{{Address: "alice", Weight: 1, PubKey: privKeys[0].PubKey().Bytes()}},
+		Config:  &v1.Config{Threshold: 1, VotingPeriod: 100},
+	})
+	require.ErrorContains(t, err, "does not match the address")
+
+	_, err = f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{Members: members, Config: &v1.Config{Threshold: 2, VotingPeriod: 100}})
+	require.NoError(t, err)
+
+	methods := f.query(&aa_interface_v1.QueryAuthenticationMethods{}).(*aa_interface_v1.QueryAuthenticationMethodsResponse)
+	require.Equal(t, []string{AuthenticationMethod}, methods.AuthenticationMethods)
+
+	authenticate := func(sequence uint64, signers ...int) error {
+		t.Helper()
+		body := &tx.TxBody{Memo: "multisig"}
+		authInfo := &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{{
+				ModeInfo: &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_DIRECT}}},
+				Sequence: sequence,
+			}},
+			Fee: &tx.Fee{},
+		}
+		bodyBytes, err := body.Marshal()
+		require.NoError(t, err)
+		authInfoBytes, err := authInfo.Marshal()
+		require.NoError(t, err)
+		signBytes, err := (&tx.SignDoc{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, ChainId: chainID, AccountNumber: accountNum}).Marshal()
+		require.NoError(t, err)
+
+		var multiSig v1.MultiSignature
+		for _, i := range signers {
+			sig, err := privKeys[i].Sign(signBytes)
+			require.NoError(t, err)
+			multiSig.Signatures = append(multiSig.Signatures, &v1.MemberSignature{Member: members[i].Address, Signature: sig})
+		}
+		sigBytes, err := multiSig.Marshal()
+		require.NoError(t, err)
+
+		_, err = f.execute(address.Module("accounts"), &aa_interface_v1.MsgAuthenticate{
+			RawTx: &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{sigBytes}},
+			Tx:    &tx.Tx{Body: body, AuthInfo: authInfo, Signatures: [][]byte{sigBytes}},
+		})
+		return err
+	}
+
+	require.ErrorContains(t, authenticate(0, 0), "below the threshold")
+	require.ErrorContains(t, authenticate(0, 0, 0), "duplicate signature")
+	require.NoError(t, authenticate(0, 0, 2))
+	require.ErrorContains(t, authenticate(0, 1, 2), "unexpected sequence number")
+	require.NoError(t, authenticate(1, 1, 2))
+
+	seq := f.query(&v1.QuerySequence{}).(*v1.QuerySequenceResponse)
+	require.Equal(t, uint64(2), seq.Sequence)
+
+	_, err = f.execute([]byte("alice"), &aa_interface_v1.MsgAuthenticate{})
+	require.ErrorContains(t, err, "only accounts module")
+}
@@ -0,0 +1,401 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"google.golang.org/protobuf/proto"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/session/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	PubKeyPrefix      = collections.NewPrefix(0)
+	SequencePrefix    = collections.NewPrefix(1)
+	SessionKeysPrefix = collections.NewPrefix(2)
+)
+
+var (
+	// ErrSessionKeyExpired is returned when a transaction is signed by an expired session key.
+	ErrSessionKeyExpired = errors.New("session key expired")
+	// ErrMessageNotAllowed is returned when a session key signs a message it is not allowed to.
+	ErrMessageNotAllowed = errors.New("message not allowed")
+	// ErrSpendLimitExceeded is returned when a transaction signed by a session key spends more
+	// than its spend limit.
+	ErrSpendLimitExceeded = errors.New("spend limit exceeded")
+)
+
+// pricedMessages are the type URLs of the messages whose spent coins are
+// computed by computeMsgSpent. A session key can only be allowed to sign them.
+// accountsv1.MsgExecute is left out: the coins spent by its inner message cannot be
+// computed, and executing on the account itself would let a session key manage the
+// session keys.
+var pricedMessages = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	sdk.MsgTypeURL(&accountsv1.MsgInit{}),
+}
+
+var _ accountstd.Interface = (*Account)(nil)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			PubKey:      collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
+			Sequence:    collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			SessionKeys: collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+
+			addrCodec:       deps.AddressCodec,
+			hs:              deps.Environment.HeaderService,
+			cdc:             deps.LegacyStateCodec,
+			signingHandlers: handlerMap,
+		}, nil
+	}
+}
+
+// Account implements a session key account. Its owner signs transactions
+// without restrictions, and delegates a limited signing power to session
+// keys. Each session key expires, can only sign the messages allowed to it,
+// and the coins spent by the transactions it signs are debited from its spend
+// limit.
+type Account struct {
+	// PubKey is the pubkey of the owner of the account.
+	PubKey collections.Item[secp256k1.PubKey]
+	// Sequence is the sequence of the transactions signed by the account.
+	Sequence collections.Sequence
+	// SessionKeys maps the pubkey of the session keys to the session keys.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
+
+	addrCodec address.Codec
+	hs        header.Service
+	cdc       interface {
+		Unmarshal([]byte, gogoproto.Message) error
+	}
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if _, err := dcrd_secp256k1.ParsePubKey(msg.PubKey); err != nil {
+		return nil, fmt.Errorf("invalid owner pubkey: %w", err)
+	}
+	return &v1.MsgInitResponse{}, a.PubKey.Set(ctx, secp256k1.PubKey{Key: msg.PubKey})
+}
+
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	sessionKey := msg.SessionKey
+	if sessionKey == nil {
+		return nil, errors.New("empty session key")
+	}
+
+	if _, err := dcrd_secp256k1.ParsePubKey(sessionKey.PubKey); err != nil {
+		return nil, fmt.Errorf("invalid session key pubkey: %w", err)
+	}
+
+	owner, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(owner.Key, sessionKey.PubKey) {
+		return nil, errors.New("the owner pubkey cannot be a session key")
+	}
+
+	if sessionKey.Expiry <= a.hs.GetHeaderInfo(ctx).Time.Unix() {
+		return nil, fmt.Errorf("%w: expiry must be in the future", ErrSessionKeyExpired)
+	}
+
+	if len(sessionKey.AllowedMessages) == 0 {
+		return nil, errors.New("the allowed messages of the session key cannot be empty")
+	}
+	for _, typeURL := range sessionKey.AllowedMessages {
+		if !slices.Contains(pricedMessages, typeURL) {
+			return nil, fmt.Errorf("%w: the coins spent by %s cannot be computed", ErrMessageNotAllowed, typeURL)
+		}
+	}
+
+	if !sessionKey.SpendLimit.IsValid() {
+		return nil, fmt.Errorf("invalid spend limit %s", sessionKey.SpendLimit)
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, sessionKey.PubKey, *sessionKey)
+}
+
+func (a Account) RemoveSessionKey(ctx context.Context, msg *v1.MsgRemoveSessionKey) (*v1.MsgRemoveSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("session key not found: %w", collections.ErrNotFound)
+	}
+
+	return &v1.MsgRemoveSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey)
+}
+
+// Authenticate implements the authentication flow of an abstracted session key account.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerData, err := a.computeSignerData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+	if signerInfo.Sequence != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, signerInfo.Sequence)
+	}
+
+	signMode, err := parseSignMode(signerInfo.ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	txData, err := getTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := msg.Tx.Signatures[msg.SignerIndex]
+
+	owner, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the owner signs without restrictions
+	if !owner.VerifySignature(signBytes, signature) {
+		if err := a.authenticateSessionKey(ctx, msg, signerData.Address, signBytes, signature); err != nil {
+			return nil, err
+		}
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.Sequence.Set(ctx, signerData.Sequence+1)
+}
+
+// authenticateSessionKey finds the session key which signed the transaction, checks the
+// transaction against its constraints and debits the coins spent from its spend limit.
+func (a Account) authenticateSessionKey(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate, whoami string, signBytes, signature []byte) error {
+	var (
+		sessionKey v1.SessionKey
+		found      bool
+	)
+	err := a.SessionKeys.Walk(ctx, nil, func(pubKey []byte, key v1.SessionKey) (bool, error) {
+		pk := secp256k1.PubKey{Key: pubKey}
+		if pk.VerifySignature(signBytes, signature) {
+			sessionKey, found = key, true
+		}
+		return found, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.New("signature verification failed")
+	}
+
+	if a.hs.GetHeaderInfo(ctx).Time.Unix() >= sessionKey.Expiry {
+		return ErrSessionKeyExpired
+	}
+
+	for _, anyMsg := range msg.Tx.Body.Messages {
+		if !slices.Contains(sessionKey.AllowedMessages, anyMsg.TypeUrl) {
+			return fmt.Errorf("%w: %s", ErrMessageNotAllowed, anyMsg.TypeUrl)
+		}
+	}
+
+	spent, err := a.computeSpent(msg, whoami)
+	if err != nil {
+		return err
+	}
+
+	if !spent.IsAllLTE(sessionKey.SpendLimit) {
+		return fmt.Errorf("%w: spending %s, limit is %s", ErrSpendLimitExceeded, spent, sessionKey.SpendLimit)
+	}
+
+	sessionKey.SpendLimit = sessionKey.SpendLimit.Sub(spent...)
+	return a.SessionKeys.Set(ctx, sessionKey.PubKey, sessionKey)
+}
+
+// computeSpent returns the coins spent by the account in the transaction: the fees, if paid
+// by the account, and the coins sent by the bank and the accounts messages.
+func (a Account) computeSpent(msg *aa_interface_v1.MsgAuthenticate, whoami string) (sdk.Coins, error) {
+	spent := sdk.NewCoins()
+
+	// the fee is paid by the first signer, unless a fee payer or granter is set.
+	fee := msg.Tx.AuthInfo.Fee
+	if fee != nil && fee.Granter == "" && (fee.Payer == whoami || (fee.Payer == "" && msg.SignerIndex == 0)) {
+		spent = spent.Add(fee.Amount...)
+	}
+
+	for _, anyMsg := range msg.Tx.Body.Messages {
+		coins, err := a.computeMsgSpent(anyMsg, whoami)
+		if err != nil {
+			return nil, err
+		}
+		spent = spent.Add(coins...)
+	}
+
+	return spent, nil
+}
+
+func (a Account) computeMsgSpent(anyMsg *codectypes.Any, whoami string) (sdk.Coins, error) {
+	switch anyMsg.TypeUrl {
+	case sdk.MsgTypeURL(&banktypes.MsgSend{}):
+		msg := new(banktypes.MsgSend)
+		if err := a.cdc.Unmarshal(anyMsg.Value, msg); err != nil {
+			return nil, err
+		}
+		if msg.FromAddress == whoami {
+			return msg.Amount, nil
+		}
+	case sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):
+		msg := new(banktypes.MsgMultiSend)
+		if err := a.cdc.Unmarshal(anyMsg.Value, msg); err != nil {
+			return nil, err
+		}
+		spent := sdk.NewCoins()
+		for _, input := range msg.Inputs {
+			if input.Address == whoami {
+				spent = spent.Add(input.Coins...)
+			}
+		}
+		return spent, nil
+	case sdk.MsgTypeURL(&accountsv1.MsgInit{}):
+		msg := new(accountsv1.MsgInit)
+		if err := a.cdc.Unmarshal(anyMsg.Value, msg); err != nil {
+			return nil, err
+		}
+		if msg.Sender == whoami {
+			return msg.Funds, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w: the coins spent by %s cannot be computed", ErrMessageNotAllowed, anyMsg.TypeUrl)
+	}
+	return nil, nil
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data.
+func (a Account) computeSignerData(ctx context.Context) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	wantSequence, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return signing.SignerData{
+		Address:       addrStr,
+		ChainID:       a.hs.GetHeaderInfo(ctx).ChainID,
+		AccountNumber: accNum.Number,
+		Sequence:      wantSequence,
+	}, nil
+}
+
+func getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:          txBody,
+		AuthInfo:      authInfo,
+		BodyBytes:     msg.RawTx.BodyBytes,
+		AuthInfoBytes: msg.RawTx.AuthInfoBytes,
+	}, nil
+}
+
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	var sessionKeys []*v1.SessionKey
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, key v1.SessionKey) (bool, error) {
+		sessionKeys = append(sessionKeys, &key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+}
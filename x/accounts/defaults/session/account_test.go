@@ -0,0 +1,277 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	v1 "cosmossdk.io/x/accounts/defaults/session/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/direct"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	chainID    = "test-chain"
+	accountNum = 5
+)
+
+var accountAddr = []byte("session")
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{ time *time.Time }
+
+func (h headerService) GetHeaderInfo(context.Context) header.Info {
+	return header.Info{ChainID: chainID, Time: *h.time}
+}
+
+type fixture struct {
+	t    *testing.T
+	ctx  context.Context
+	ss   store.KVStoreService
+	impl implementation.Implementation
+	now  *time.Time
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	ss, ctx := colltest.MockStore()
+	env := runtime.NewEnvironment(ss, log.NewNopLogger())
+	now := time.Unix(1000, 0)
+	env.HeaderService = headerService{time: &now}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	accs, err := implementation.MakeAccountsMap(cdc, addressCodec{}, env, []implementation.AccountCreatorFunc{
+		NewAccount("session", signing.NewHandlerMap(direct.SignModeHandler{})),
+	})
+	require.NoError(t, err)
+
+	return &fixture{t: t, ctx: ctx, ss: ss, impl: accs["session"], now: &now}
+}
+
+func (f *fixture) accountCtx(sender []byte) context.Context {
+	return implementation.MakeAccountContext(
+		f.ctx,
+		f.ss,
+		accountNum,
+		accountAddr,
+		sender,
+		nil,
+		func(context.Context, []byte, implementation.ProtoMsg, implementation.ProtoMsg) error {
+			return nil
+		},
+		func(context.Context, []byte, implementation.ProtoMsg) (implementation.ProtoMsg, error) {
+			return nil, nil
+		},
+		func(_ context.Context, _, resp implementation.ProtoMsg) error {
+			resp.(*accountsv1.AccountNumberResponse).Number = accountNum
+			return nil
+		},
+	)
+}
+
+func (f *fixture) execute(sender []byte, msg implementation.ProtoMsg) error {
+	_, err := f.impl.Execute(f.accountCtx(sender), msg)
+	return err
+}
+
+func (f *fixture) sequence() uint64 {
+	resp, err := f.impl.Query(f.accountCtx(nil), &v1.QuerySequence{})
+	require.NoError(f.t, err)
+	return resp.(*v1.QuerySequenceResponse).Sequence
+}
+
+func (f *fixture) sessionKeys() []*v1.SessionKey {
+	resp, err := f.impl.Query(f.accountCtx(nil), &v1.QuerySessionKeys{})
+	require.NoError(f.t, err)
+	return resp.(*v1.QuerySessionKeysResponse).SessionKeys
+}
+
+// authenticate signs a transaction holding the given messages and paying the given fee with
+// the private key, and authenticates it.
+func (f *fixture) authenticate(privKey *secp256k1.PrivKey, fee sdk.Coins, msgs ...sdk.Msg) error {
+	f.t.Helper()
+	anyMsgs := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(f.t, err)
+		anyMsgs[i] = anyMsg
+	}
+
+	body := &tx.TxBody{Messages: anyMsgs}
+	authInfo := &tx.AuthInfo{
+		SignerInfos: []*tx.SignerInfo{{
+			ModeInfo: &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_DIRECT}}},
+			Sequence: f.sequence(),
+		}},
+		Fee: &tx.Fee{Amount: fee},
+	}
+	bodyBytes, err := body.Marshal()
+	require.NoError(f.t, err)
+	authInfoBytes, err := authInfo.Marshal()
+	require.NoError(f.t, err)
+	signBytes, err := (&tx.SignDoc{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, ChainId: chainID, AccountNumber: accountNum}).Marshal()
+	require.NoError(f.t, err)
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(f.t, err)
+
+	return f.execute(address.Module("accounts"), &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{sig}},
+		Tx:    &tx.Tx{Body: body, AuthInfo: authInfo, Signatures: [][]byte{sig}},
+	})
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+func send(amount int64) *banktypes.MsgSend {
+	return &banktypes.MsgSend{FromAddress: string(accountAddr), ToAddress: "receiver", Amount: coins(amount)}
+}
+
+func TestSessionKeys(t *testing.T) {
+	f := newFixture(t)
+	owner := secp256k1.GenPrivKey()
+	sessionPriv := secp256k1.GenPrivKey()
+
+	_, err := f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: []byte("invalid")})
+	require.Error(t, err)
+	_, err = f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: owner.PubKey().Bytes()})
+	require.NoError(t, err)
+
+	sessionKey := &v1.SessionKey{
+		PubKey:          sessionPriv.PubKey().Bytes(),
+		Expiry:          f.now.Add(time.Hour).Unix(),
+		AllowedMessages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		SpendLimit:      coins(100),
+	}
+
+	// only the account can manage its session keys
+	require.ErrorContains(t, f.execute([]byte("other"), &v1.MsgAddSessionKey{SessionKey: sessionKey}), "unauthorized")
+	require.ErrorIs(t, f.execute(accountAddr, &v1.MsgAddSessionKey{SessionKey: &v1.SessionKey{
+		PubKey:          sessionKey.PubKey,
+		Expiry:          f.now.Unix(),
+		AllowedMessages: sessionKey.AllowedMessages,
+	}}), ErrSessionKeyExpired)
+	require.ErrorContains(t, f.execute(accountAddr, &v1.MsgAddSessionKey{SessionKey: &v1.SessionKey{
+		PubKey:          owner.PubKey().Bytes(),
+		Expiry:          sessionKey.Expiry,
+		AllowedMessages: sessionKey.AllowedMessages,
+	}}), "owner pubkey")
+	require.ErrorIs(t, f.execute(accountAddr, &v1.MsgAddSessionKey{SessionKey: &v1.SessionKey{
+		PubKey:          sessionKey.PubKey,
+		Expiry:          sessionKey.Expiry,
+		AllowedMessages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})},
+	}}), ErrMessageNotAllowed)
+	require.NoError(t, f.execute(accountAddr, &v1.MsgAddSessionKey{SessionKey: sessionKey}))
+	require.Len(t, f.sessionKeys(), 1)
+
+	// the owner signs without restrictions
+	require.NoError(t, f.authenticate(owner, coins(10), send(1000), &stakingtypes.MsgDelegate{}))
+	require.Equal(t, uint64(1), f.sequence())
+
+	// the session key spends are debited, including the fee
+	require.NoError(t, f.authenticate(sessionPriv, coins(10), send(30)))
+	require.Equal(t, uint64(2), f.sequence())
+	require.Equal(t, coins(60), f.sessionKeys()[0].SpendLimit)
+
+	// transfers from other addresses are not debited
+	require.NoError(t, f.authenticate(sessionPriv, nil, &banktypes.MsgSend{FromAddress: "other", ToAddress: "receiver", Amount: coins(1000)}))
+	require.Equal(t, coins(60), f.sessionKeys()[0].SpendLimit)
+
+	require.ErrorIs(t, f.authenticate(sessionPriv, nil, send(50), send(11)), ErrSpendLimitExceeded)
+	require.ErrorIs(t, f.authenticate(sessionPriv, nil, &banktypes.MsgSend{
+		FromAddress: string(accountAddr), ToAddress: "receiver", Amount: sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+	}), ErrSpendLimitExceeded)
+	require.ErrorIs(t, f.authenticate(sessionPriv, nil, &stakingtypes.MsgDelegate{}), ErrMessageNotAllowed)
+	require.ErrorContains(t, f.authenticate(secp256k1.GenPrivKey(), nil, send(1)), "signature verification failed")
+
+	require.NoError(t, f.authenticate(sessionPriv, nil, send(60)))
+	require.Empty(t, f.sessionKeys()[0].SpendLimit)
+
+	// the session key expires
+	*f.now = f.now.Add(time.Hour)
+	require.ErrorIs(t, f.authenticate(sessionPriv, nil, &banktypes.MsgSend{FromAddress: "other"}), ErrSessionKeyExpired)
+
+	require.ErrorContains(t, f.execute([]byte("other"), &v1.MsgRemoveSessionKey{PubKey: sessionKey.PubKey}), "unauthorized")
+	require.NoError(t, f.execute(accountAddr, &v1.MsgRemoveSessionKey{PubKey: sessionKey.PubKey}))
+	require.Empty(t, f.sessionKeys())
+	require.Error(t, f.execute(accountAddr, &v1.MsgRemoveSessionKey{PubKey: sessionKey.PubKey}))
+
+	require.ErrorContains(t, f.execute([]byte("alice"), &aa_interface_v1.MsgAuthenticate{}), "only accounts module")
+}
+
+func TestComputeMsgSpentUnpriced(t *testing.T) {
+	anyMsg, err := codectypes.NewAnyWithValue(&stakingtypes.MsgDelegate{})
+	require.NoError(t, err)
+
+	_, err = Account{}.computeMsgSpent(anyMsg, string(accountAddr))
+	require.ErrorIs(t, err, ErrMessageNotAllowed)
+}
+
+func TestSessionKeyCannotExecuteOnAccount(t *testing.T) {
+	f := newFixture(t)
+	owner := secp256k1.GenPrivKey()
+	sessionPriv := secp256k1.GenPrivKey()
+
+	_, err := f.impl.Init(f.accountCtx([]byte("creator")), &v1.MsgInit{PubKey: owner.PubKey().Bytes()})
+	require.NoError(t, err)
+
+	// a session key cannot be allowed to execute messages on accounts
+	require.ErrorIs(t, f.execute(accountAddr, &v1.MsgAddSessionKey{SessionKey: &v1.SessionKey{
+		PubKey:          sessionPriv.PubKey().Bytes(),
+		Expiry:          f.now.Add(time.Hour).Unix(),
+		AllowedMessages: []string{sdk.MsgTypeURL(&accountsv1.MsgExecute{})},
+	}}), ErrMessageNotAllowed)
+	require.NoError(t, f.execute(accountAddr, &v1.MsgAddSessionKey{SessionKey: &v1.SessionKey{
+		PubKey:          sessionPriv.PubKey().Bytes(),
+		Expiry:          f.now.Add(time.Hour).Unix(),
+		AllowedMessages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		SpendLimit:      coins(100),
+	}}))
+
+	// so it cannot give itself an unlimited session key through the account
+	escalation, err := codectypes.NewAnyWithValue(&v1.MsgAddSessionKey{SessionKey: &v1.SessionKey{
+		PubKey:          secp256k1.GenPrivKey().PubKey().Bytes(),
+		Expiry:          f.now.Add(1000 * time.Hour).Unix(),
+		AllowedMessages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+	}})
+	require.NoError(t, err)
+	require.ErrorIs(t, f.authenticate(sessionPriv, nil, &accountsv1.MsgExecute{
+		Sender:  string(accountAddr),
+		Target:  string(accountAddr),
+		Message: escalation,
+	}), ErrMessageNotAllowed)
+
+	// a session key registered with it before is rejected too
+	anyMsg, err := codectypes.NewAnyWithValue(&accountsv1.MsgExecute{Sender: string(accountAddr), Target: string(accountAddr), Message: escalation})
+	require.NoError(t, err)
+	_, err = Account{}.computeMsgSpent(anyMsg, string(accountAddr))
+	require.ErrorIs(t, err, ErrMessageNotAllowed)
+
+	require.Len(t, f.sessionKeys(), 1)
+	require.Equal(t, uint64(0), f.sequence())
+}
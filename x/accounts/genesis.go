@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/internal/implementation"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
 
@@ -76,9 +77,32 @@ func (k Keeper) ImportState(ctx context.Context, genState *v1.GenesisState) erro
 			return fmt.Errorf("%w: %s", err, acc.Address)
 		}
 	}
+
+	// init new accounts
+	for i, msgInit := range genState.InitAccountMsgs {
+		err = k.initAccountAtGenesis(ctx, msgInit)
+		if err != nil {
+			return fmt.Errorf("invalid genesis account msg init at index %d: %w", i, err)
+		}
+	}
 	return nil
 }
 
+func (k Keeper) initAccountAtGenesis(ctx context.Context, msgInit *v1.MsgInit) error {
+	sender, err := k.addressCodec.StringToBytes(msgInit.Sender)
+	if err != nil {
+		return err
+	}
+
+	msg, err := implementation.UnpackAnyRaw(msgInit.Message)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = k.Init(ctx, msgInit.AccountType, sender, msg, msgInit.Funds)
+	return err
+}
+
 func (k Keeper) importAccount(ctx context.Context, acc *v1.GenesisAccount) error {
 	// TODO: maybe check if impl exists?
 	addrBytes, err := k.addressCodec.StringToBytes(acc.Address)
@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/collections/colltest"
 	"cosmossdk.io/x/accounts/internal/implementation"
+	v1 "cosmossdk.io/x/accounts/v1"
 )
 
 func TestGenesis(t *testing.T) {
@@ -35,11 +36,22 @@ func TestGenesis(t *testing.T) {
 	state, err := k.ExportState(ctx)
 	require.NoError(t, err)
 
+	// we init a third account at genesis
+	initMsg, err := implementation.PackAny(&types.Empty{})
+	require.NoError(t, err)
+	state.InitAccountMsgs = []*v1.MsgInit{{Sender: "sender", AccountType: "test", Message: initMsg}}
+
 	// reset state
 	_, ctx = colltest.MockStore()
 	err = k.ImportState(ctx, state)
 	require.NoError(t, err)
 
+	addr3, err := k.makeAddress(2)
+	require.NoError(t, err)
+	accType, err := k.AccountsByType.Get(ctx, addr3)
+	require.NoError(t, err)
+	require.Equal(t, "test", accType)
+
 	// if genesis import went fine, we should be able to query the accounts
 	// and get the expected values.
 	resp, err := k.Query(ctx, addr1, &types.DoubleValue{})
@@ -50,3 +62,17 @@ func TestGenesis(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.UInt64Value{Value: 20}, resp)
 }
+
+func TestImportStateInvalidInitAccountMsg(t *testing.T) {
+	k, ctx := newKeeper(t, func(deps implementation.Dependencies) (string, implementation.Account, error) {
+		acc, err := NewTestAccount(deps)
+		return "test", acc, err
+	})
+
+	initMsg, err := implementation.PackAny(&types.Empty{})
+	require.NoError(t, err)
+	err = k.ImportState(ctx, &v1.GenesisState{
+		InitAccountMsgs: []*v1.MsgInit{{Sender: "sender", AccountType: "unknown", Message: initMsg}},
+	})
+	require.ErrorIs(t, err, errAccountTypeNotFound)
+}
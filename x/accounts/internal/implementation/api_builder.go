@@ -9,6 +9,7 @@ import (
 var (
 	errNoInitHandler    = errors.New("no init handler")
 	errNoExecuteHandler = errors.New("account does not accept messages")
+	errNoMigrateHandler = errors.New("no migrate handler")
 	errInvalidMessage   = errors.New("invalid message")
 )
 
@@ -100,6 +101,43 @@ func (r *QueryBuilder) makeHandler() (func(ctx context.Context, queryRequest Pro
 	return r.er.makeHandler()
 }
 
+// NewMigrateBuilder creates a new MigrateBuilder instance.
+func NewMigrateBuilder() *MigrateBuilder {
+	return &MigrateBuilder{
+		handlers:       make(map[string]func(ctx context.Context, migrateRequest ProtoMsg) (migrateResponse ProtoMsg, err error)),
+		handlersSchema: make(map[string]HandlerSchema),
+	}
+}
+
+// MigrateBuilder defines a smart account's migration router, it will be used to map the
+// account type an account is migrated from to the handler migrating its state.
+type MigrateBuilder struct {
+	// handlers is a map of the account types the smart account accepts migrations from
+	// to the handler functions that will be called when an account is migrated.
+	handlers map[string]func(ctx context.Context, migrateRequest ProtoMsg) (migrateResponse ProtoMsg, err error)
+
+	// handlersSchema is a map of the account types to the schemas of the messages that will be
+	// passed to the handler functions and the messages that will be returned by them.
+	handlersSchema map[string]HandlerSchema
+
+	// err is the error that occurred before building the handler function.
+	err error
+}
+
+func (m *MigrateBuilder) makeHandler() (func(ctx context.Context, fromAccountType string, migrateRequest ProtoMsg) (migrateResponse ProtoMsg, err error), error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return func(ctx context.Context, fromAccountType string, migrateRequest ProtoMsg) (migrateResponse ProtoMsg, err error) {
+		handler, ok := m.handlers[fromAccountType]
+		if !ok {
+			return nil, fmt.Errorf("%w: from account type %s", errNoMigrateHandler, fromAccountType)
+		}
+		return handler(ctx, migrateRequest)
+	}, nil
+}
+
 // IsRoutingError returns true if the error is a routing error,
 // which typically occurs when a message cannot be matched to a handler.
 func IsRoutingError(err error) bool {
@@ -37,3 +37,30 @@ func TestEmptyQueryExecuteHandler(t *testing.T) {
 	_, err = eh(ctx, &types.StringValue{})
 	require.ErrorIs(t, err, errNoExecuteHandler)
 }
+
+func TestMigrateBuilder(t *testing.T) {
+	mb := NewMigrateBuilder()
+	RegisterMigrateHandler(mb, "v1", func(_ context.Context, req *types.StringValue) (*types.StringValue, error) {
+		return &types.StringValue{Value: req.Value + "-migrated"}, nil
+	})
+
+	mh, err := mb.makeHandler()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	resp, err := mh(ctx, "v1", &types.StringValue{Value: "test"})
+	require.NoError(t, err)
+	require.Equal(t, "test-migrated", resp.(*types.StringValue).Value)
+
+	_, err = mh(ctx, "v0", &types.StringValue{Value: "test"})
+	require.ErrorIs(t, err, errNoMigrateHandler)
+	_, err = mh(ctx, "v1", &types.BytesValue{})
+	require.ErrorIs(t, err, errInvalidMessage)
+
+	// only one handler can be registered by account type
+	RegisterMigrateHandler(mb, "v1", func(_ context.Context, req *types.BytesValue) (*types.BytesValue, error) {
+		return nil, nil
+	})
+	_, err = mb.makeHandler()
+	require.ErrorContains(t, err, "already registered")
+}
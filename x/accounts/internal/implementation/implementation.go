@@ -87,6 +87,16 @@ func newImplementation(schemaBuilder *collections.SchemaBuilder, account Account
 		return Implementation{}, err
 	}
 
+	// make migrate handler, only migratable accounts register migrate handlers
+	mr := NewMigrateBuilder()
+	if migratable, ok := account.(MigratableAccount); ok {
+		migratable.RegisterMigrateHandlers(mr)
+	}
+	migrateHandler, err := mr.makeHandler()
+	if err != nil {
+		return Implementation{}, err
+	}
+
 	// build schema
 	schema, err := schemaBuilder.Build()
 	if err != nil {
@@ -96,10 +106,12 @@ func newImplementation(schemaBuilder *collections.SchemaBuilder, account Account
 		Init:                  initHandler,
 		Execute:               executeHandler,
 		Query:                 queryHandler,
+		Migrate:               migrateHandler,
 		CollectionsSchema:     schema,
 		InitHandlerSchema:     ir.schema,
 		QueryHandlersSchema:   qr.er.handlersSchema,
 		ExecuteHandlersSchema: er.handlersSchema,
+		MigrateHandlersSchema: mr.handlersSchema,
 	}, nil
 }
 
@@ -112,6 +124,9 @@ type Implementation struct {
 	Execute func(ctx context.Context, msg ProtoMsg) (resp ProtoMsg, err error)
 	// Query defines the query handler for the smart account.
 	Query func(ctx context.Context, msg ProtoMsg) (resp ProtoMsg, err error)
+	// Migrate defines the migration handler for the smart account, it migrates the state of an
+	// account of the given type to the smart account.
+	Migrate func(ctx context.Context, fromAccountType string, msg ProtoMsg) (resp ProtoMsg, err error)
 	// CollectionsSchema represents the state schema.
 	CollectionsSchema collections.Schema
 	// InitHandlerSchema represents the init handler schema.
@@ -120,6 +135,9 @@ type Implementation struct {
 	QueryHandlersSchema map[string]HandlerSchema
 	// ExecuteHandlersSchema is the schema of the execute handlers.
 	ExecuteHandlersSchema map[string]HandlerSchema
+	// MigrateHandlersSchema is the schema of the migrate handlers, by the account type
+	// they migrate accounts from.
+	MigrateHandlersSchema map[string]HandlerSchema
 }
 
 // HasExec returns true if the account can execute the given msg.
@@ -139,6 +157,12 @@ func (i Implementation) HasInit(m ProtoMsg) bool {
 	return i.InitHandlerSchema.RequestSchema.Name == MessageName(m)
 }
 
+// HasMigrate returns true if the accounts of the given type can be migrated to the account.
+func (i Implementation) HasMigrate(fromAccountType string) bool {
+	_, ok := i.MigrateHandlersSchema[fromAccountType]
+	return ok
+}
+
 // MessageSchema defines the schema of a message.
 // A message can also define a state schema.
 type MessageSchema struct {
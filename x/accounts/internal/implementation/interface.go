@@ -15,3 +15,14 @@ type Account interface {
 	// might also decide to not register any query handler.
 	RegisterQueryHandlers(builder *QueryBuilder)
 }
+
+// MigratableAccount defines a smart account which accounts of other types can
+// be migrated to.
+type MigratableAccount interface {
+	Account
+
+	// RegisterMigrateHandlers allows the smart account to register the handlers migrating
+	// the state of accounts of other types to its own. The handlers will be called when an
+	// account is migrated to the smart account type.
+	RegisterMigrateHandlers(builder *MigrateBuilder)
+}
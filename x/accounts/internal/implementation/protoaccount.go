@@ -62,6 +62,33 @@ func RegisterQueryHandler[
 	RegisterExecuteHandler(router.er, handler)
 }
 
+// RegisterMigrateHandler registers the handler migrating the state of the accounts of the
+// given account type to a smart account that uses protobuf.
+func RegisterMigrateHandler[
+	Req any, ProtoReq ProtoMsgG[Req], Resp any, ProtoResp ProtoMsgG[Resp],
+](router *MigrateBuilder, fromAccountType string, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	reqName := MessageName(ProtoReq(new(Req)))
+	// check if not registered already
+	if _, ok := router.handlers[fromAccountType]; ok {
+		router.err = fmt.Errorf("migrate handler already registered for account type %s", fromAccountType)
+		return
+	}
+
+	router.handlers[fromAccountType] = func(ctx context.Context, migrateRequest ProtoMsg) (migrateResponse ProtoMsg, err error) {
+		concrete, ok := migrateRequest.(ProtoReq)
+		if !ok {
+			return nil, fmt.Errorf("%w: wanted %s, got %T", errInvalidMessage, reqName, migrateRequest)
+		}
+		return handler(ctx, concrete)
+	}
+
+	router.handlersSchema[fromAccountType] = HandlerSchema{
+		RequestSchema:  *NewProtoMessageSchema[Req, ProtoReq](),
+		ResponseSchema: *NewProtoMessageSchema[Resp, ProtoResp](),
+	}
+}
+
 func NewProtoMessageSchema[T any, PT ProtoMsgG[T]]() *MessageSchema {
 	msg := PT(new(T))
 	if _, ok := (interface{}(msg)).(proto.Message); ok {
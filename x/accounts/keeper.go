@@ -205,6 +205,49 @@ func (k Keeper) MigrateLegacyAccount(
 	return k.init(ctx, accType, addr, accNum, addr, msg, nil)
 }
 
+// Migrate migrates the given account to a new account type, the migration must be requested
+// by the account itself. The migration handler of the new account type rewrites the state of
+// the account, which keeps its address and account number.
+func (k Keeper) Migrate(
+	ctx context.Context,
+	accountAddr []byte,
+	sender []byte,
+	accountType string,
+	migrateRequest implementation.ProtoMsg,
+) (implementation.ProtoMsg, error) {
+	if !bytes.Equal(accountAddr, sender) {
+		return nil, fmt.Errorf("%w: only the account can migrate itself", ErrUnauthorized)
+	}
+
+	fromAccountType, err := k.AccountsByType.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+	if fromAccountType == accountType {
+		return nil, fmt.Errorf("account is already of type %s", accountType)
+	}
+
+	impl, ok := k.accounts[accountType]
+	if !ok {
+		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, accountType)
+	}
+
+	accountNum, err := k.AccountByNumber.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the context and migrate the account state
+	ctx = k.makeAccountContext(ctx, accountNum, accountAddr, sender, nil, false)
+	resp, err := impl.Migrate(ctx, fromAccountType, migrateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// map account address to the new account type
+	return resp, k.AccountsByType.Set(ctx, accountAddr, accountType)
+}
+
 // Execute executes a state transition on the given account.
 func (k Keeper) Execute(
 	ctx context.Context,
@@ -319,7 +362,6 @@ func (k Keeper) makeAccountContext(ctx context.Context, accountNumber uint64, ac
 
 // sendAnyMessages it a helper function that executes untyped codectypes.Any messages
 // The messages must all belong to a module.
-// nolint: unused // TODO: remove nolint when we bring back bundler payments
 func (k Keeper) sendAnyMessages(ctx context.Context, sender []byte, anyMessages []*implementation.Any) ([]*implementation.Any, error) {
 	anyResponses := make([]*implementation.Any, len(anyMessages))
 	for i := range anyMessages {
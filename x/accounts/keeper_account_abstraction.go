@@ -1,23 +1,40 @@
 package accounts
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
 	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	v1 "cosmossdk.io/x/accounts/v1"
 
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+// AuthenticationGasLimit is the maximum amount of gas the authentication of a bundled
+// tx can consume. The bundler pays for the authentication even when it fails, so the
+// cap prevents abstracted accounts from griefing bundlers with expensive authentications.
+const AuthenticationGasLimit uint64 = 1_000_000
+
+// DefaultExecutionGasLimit is the maximum amount of gas the execution of a bundled tx
+// can consume when its fee doesn't set a gas limit. It also bounds the execution of the
+// bundled txs simulated by SimulateBundle.
+const DefaultExecutionGasLimit uint64 = 10_000_000
+
 var (
 	// ErrAuthentication is returned when the authentication fails.
 	ErrAuthentication = errors.New("authentication failed")
-	// ErrBundlerPayment is returned when the bundler payment fails.
-	ErrBundlerPayment = errors.New("bundler payment failed")
 	// ErrExecution is returned when the execution fails.
 	ErrExecution = errors.New("execution failed")
+	// ErrInvalidBundledTx is returned when a bundled tx cannot be executed on behalf of an abstracted account.
+	ErrInvalidBundledTx = errors.New("invalid bundled tx")
+
+	// errSimulation is used to discard the state changes of a bundle simulation.
+	errSimulation = errors.New("bundle simulation")
 )
 
 // IsAbstractedAccount returns if the provided address is an abstracted account or not.
@@ -44,3 +61,123 @@ func (k Keeper) AuthenticateAccount(ctx context.Context, addr []byte, msg *aa_in
 	}
 	return nil
 }
+
+// ExecuteBundledTx authenticates and executes a tx sent by a bundler on behalf of an
+// abstracted account. The authentication and the execution run in separate branches,
+// so the state changes of a successful authentication, such as a sequence increase,
+// are kept even if the execution fails. Failures are reported in the response.
+func (k Keeper) ExecuteBundledTx(ctx context.Context, bundler string, bundledTx *tx.TxRaw) *v1.BundledTxResponse {
+	return k.executeBundledTx(ctx, bundler, bundledTx, false)
+}
+
+// SimulateBundle authenticates and executes the given bundled txs like MsgExecuteBundle
+// would, in a branched context whose state changes are always discarded. It reports
+// for each bundled tx whether it succeeded and the gas consumed by its authentication
+// and its execution. The gas limit of the bundled txs is ignored, to allow estimating
+// it, the execution is bounded by DefaultExecutionGasLimit and the authentication gas
+// limit is still enforced.
+func (k Keeper) SimulateBundle(ctx context.Context, bundler string, bundledTxs []*tx.TxRaw) ([]*v1.BundledTxResponse, error) {
+	responses := make([]*v1.BundledTxResponse, len(bundledTxs))
+	err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+		for i, bundledTx := range bundledTxs {
+			responses[i] = k.executeBundledTx(ctx, bundler, bundledTx, true)
+		}
+		// discard the state changes of the bundle.
+		return errSimulation
+	})
+	if !errors.Is(err, errSimulation) {
+		return nil, err
+	}
+	return responses, nil
+}
+
+func (k Keeper) executeBundledTx(ctx context.Context, bundler string, bundledTx *tx.TxRaw, simulate bool) *v1.BundledTxResponse {
+	resp := &v1.BundledTxResponse{}
+	decodedTx, signer, err := k.decodeBundledTx(ctx, bundledTx)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+
+	resp.AuthenticationGasUsed, err = k.environment.BranchService.ExecuteWithGasLimit(ctx, AuthenticationGasLimit, func(ctx context.Context) error {
+		return k.AuthenticateAccount(ctx, signer, &aa_interface_v1.MsgAuthenticate{
+			Bundler:     bundler,
+			RawTx:       bundledTx,
+			Tx:          decodedTx,
+			SignerIndex: 0,
+		})
+	})
+	if err != nil {
+		if !errors.Is(err, ErrAuthentication) {
+			err = fmt.Errorf("%w: %w", ErrAuthentication, err)
+		}
+		resp.Error = err.Error()
+		return resp
+	}
+
+	gasLimit := DefaultExecutionGasLimit
+	if fee := decodedTx.AuthInfo.Fee; !simulate && fee != nil && fee.GasLimit != 0 {
+		gasLimit = fee.GasLimit
+	}
+	var execResponses []*implementation.Any
+	resp.ExecutionGasUsed, err = k.environment.BranchService.ExecuteWithGasLimit(ctx, gasLimit, func(ctx context.Context) error {
+		var execErr error
+		execResponses, execErr = k.sendAnyMessages(ctx, signer, decodedTx.Body.Messages)
+		return execErr
+	})
+	if err != nil {
+		resp.Error = fmt.Errorf("%w: %w", ErrExecution, err).Error()
+		return resp
+	}
+	resp.ExecResponses = execResponses
+	return resp
+}
+
+// decodeBundledTx decodes the bundled tx and returns it alongside its signer. A bundled
+// tx must be signed by a single abstracted account, which is the signer of all its messages.
+func (k Keeper) decodeBundledTx(ctx context.Context, bundledTx *tx.TxRaw) (*tx.Tx, []byte, error) {
+	if bundledTx == nil {
+		return nil, nil, fmt.Errorf("%w: empty tx", ErrInvalidBundledTx)
+	}
+	body := new(tx.TxBody)
+	if err := body.Unmarshal(bundledTx.BodyBytes); err != nil {
+		return nil, nil, fmt.Errorf("%w: cannot decode body: %w", ErrInvalidBundledTx, err)
+	}
+	authInfo := new(tx.AuthInfo)
+	if err := authInfo.Unmarshal(bundledTx.AuthInfoBytes); err != nil {
+		return nil, nil, fmt.Errorf("%w: cannot decode auth info: %w", ErrInvalidBundledTx, err)
+	}
+	if len(body.Messages) == 0 {
+		return nil, nil, fmt.Errorf("%w: no messages", ErrInvalidBundledTx)
+	}
+
+	var signer []byte
+	for i, anyMsg := range body.Messages {
+		msg, err := implementation.UnpackAnyRaw(anyMsg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: cannot decode message %d: %w", ErrInvalidBundledTx, i, err)
+		}
+		signers, _, err := k.signerProvider.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: cannot get signers of message %d: %w", ErrInvalidBundledTx, i, err)
+		}
+		if len(signers) != 1 || (signer != nil && !bytes.Equal(signer, signers[0])) {
+			return nil, nil, fmt.Errorf("%w: messages must have a single and same signer", ErrInvalidBundledTx)
+		}
+		signer = signers[0]
+	}
+
+	isAbstracted, err := k.IsAbstractedAccount(ctx, signer)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isAbstracted {
+		return nil, nil, fmt.Errorf("%w: signer is not an abstracted account", ErrInvalidBundledTx)
+	}
+
+	return &tx.Tx{
+		Body:       body,
+		AuthInfo:   authInfo,
+		Signatures: bundledTx.Signatures,
+	}, signer, nil
+}
@@ -0,0 +1,181 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/accounts/testing/account_abstraction"
+	rotationv1 "cosmossdk.io/x/accounts/testing/rotation/v1"
+	v1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func makeBundledTx(t *testing.T, msgs ...*banktypes.MsgSend) *tx.TxRaw {
+	t.Helper()
+	body := &tx.TxBody{}
+	for _, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		body.Messages = append(body.Messages, anyMsg)
+	}
+	bodyBytes, err := body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := (&tx.AuthInfo{Fee: &tx.Fee{GasLimit: 100_000}}).Marshal()
+	require.NoError(t, err)
+	return &tx.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{[]byte("signature")},
+	}
+}
+
+func send(from, to []byte, amount int64) *banktypes.MsgSend {
+	return &banktypes.MsgSend{
+		FromAddress: string(from),
+		ToAddress:   string(to),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("atom", amount)),
+	}
+}
+
+func TestKeeper_SimulateBundle(t *testing.T) {
+	m, ctx := newKeeper(t,
+		accountstd.AddAccount("aa", account_abstraction.NewMinimalAbstractedAccount),
+		accountstd.AddAccount("test", NewTestAccount),
+	)
+	m.queryRouter = mockQuery(func(ctx context.Context, req, resp implementation.ProtoMsg) error { return nil })
+	m.signerProvider = mockSigner(func(msg implementation.ProtoMsg) ([]byte, error) {
+		return []byte(msg.(*banktypes.MsgSend).FromAddress), nil
+	})
+	m.msgRouter = mockExec(func(ctx context.Context, msg, msgResp implementation.ProtoMsg) error {
+		if msg.(*banktypes.MsgSend).Amount.AmountOf("atom").Int64() > 100 {
+			return errors.New("insufficient funds")
+		}
+		return nil
+	})
+
+	_, aaAddr, err := m.Init(ctx, "aa", []byte("creator"), &rotationv1.MsgInit{}, nil)
+	require.NoError(t, err)
+	_, otherAAAddr, err := m.Init(ctx, "aa", []byte("creator"), &rotationv1.MsgInit{}, nil)
+	require.NoError(t, err)
+	_, testAddr, err := m.Init(ctx, "test", []byte("creator"), &types.Empty{}, nil)
+	require.NoError(t, err)
+	recipient := []byte("recipient")
+
+	t.Run("ok", func(t *testing.T) {
+		resps, err := m.SimulateBundle(ctx, "bundler", []*tx.TxRaw{
+			makeBundledTx(t, send(aaAddr, recipient, 10), send(aaAddr, recipient, 20)),
+		})
+		require.NoError(t, err)
+		require.Len(t, resps, 1)
+		require.Empty(t, resps[0].Error)
+		require.Len(t, resps[0].ExecResponses, 2)
+		require.Equal(t, "/cosmos.bank.v1beta1.MsgSendResponse", resps[0].ExecResponses[0].TypeUrl)
+	})
+
+	t.Run("each tx has its own result", func(t *testing.T) {
+		resps, err := m.SimulateBundle(ctx, "bundler", []*tx.TxRaw{
+			makeBundledTx(t, send(aaAddr, recipient, 1000)),
+			makeBundledTx(t, send(testAddr, recipient, 10)),
+			makeBundledTx(t, send(aaAddr, recipient, 10), send(otherAAAddr, recipient, 10)),
+			{BodyBytes: []byte("invalid")},
+			makeBundledTx(t),
+			makeBundledTx(t, send(otherAAAddr, recipient, 10)),
+		})
+		require.NoError(t, err)
+		require.Len(t, resps, 6)
+
+		// execution failure, the authentication succeeded
+		require.Contains(t, resps[0].Error, ErrExecution.Error())
+		require.Contains(t, resps[0].Error, "insufficient funds")
+		require.Empty(t, resps[0].ExecResponses)
+		// signer is not an abstracted account
+		require.Contains(t, resps[1].Error, ErrInvalidBundledTx.Error())
+		require.Contains(t, resps[1].Error, "not an abstracted account")
+		// multiple signers
+		require.Contains(t, resps[2].Error, "single and same signer")
+		// cannot decode
+		require.Contains(t, resps[3].Error, "cannot decode body")
+		// no messages
+		require.Contains(t, resps[4].Error, "no messages")
+		// the failures do not affect the other txs
+		require.Empty(t, resps[5].Error)
+		require.Len(t, resps[5].ExecResponses, 1)
+	})
+}
+
+func TestMsgServer_ExecuteBundle(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("aa", account_abstraction.NewMinimalAbstractedAccount))
+	m.queryRouter = mockQuery(func(ctx context.Context, req, resp implementation.ProtoMsg) error { return nil })
+	m.signerProvider = mockSigner(func(msg implementation.ProtoMsg) ([]byte, error) {
+		return []byte(msg.(*banktypes.MsgSend).FromAddress), nil
+	})
+	var executed int
+	m.msgRouter = mockExec(func(ctx context.Context, msg, msgResp implementation.ProtoMsg) error {
+		executed++
+		return nil
+	})
+
+	_, aaAddr, err := m.Init(ctx, "aa", []byte("creator"), &rotationv1.MsgInit{}, nil)
+	require.NoError(t, err)
+
+	resp, err := NewMsgServer(m).ExecuteBundle(ctx, &v1.MsgExecuteBundle{
+		Bundler: "bundler",
+		Txs: []*tx.TxRaw{
+			makeBundledTx(t, send(aaAddr, []byte("recipient"), 10)),
+			makeBundledTx(t, send([]byte("unknown"), []byte("recipient"), 10)),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 2)
+	require.Empty(t, resp.Responses[0].Error)
+	require.Len(t, resp.Responses[0].ExecResponses, 1)
+	require.Contains(t, resp.Responses[1].Error, "not an abstracted account")
+	require.Equal(t, 1, executed)
+}
+
+// gasLimitBranchService records the gas limits of the executions.
+type gasLimitBranchService struct {
+	branchService
+
+	gasLimits *[]uint64
+}
+
+func (b gasLimitBranchService) ExecuteWithGasLimit(ctx context.Context, gasLimit uint64, f func(ctx context.Context) error) (uint64, error) {
+	*b.gasLimits = append(*b.gasLimits, gasLimit)
+	return b.branchService.ExecuteWithGasLimit(ctx, gasLimit, f)
+}
+
+func TestKeeper_ExecuteBundledTxGasLimit(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("aa", account_abstraction.NewMinimalAbstractedAccount))
+	m.queryRouter = mockQuery(func(ctx context.Context, req, resp implementation.ProtoMsg) error { return nil })
+	m.signerProvider = mockSigner(func(msg implementation.ProtoMsg) ([]byte, error) {
+		return []byte(msg.(*banktypes.MsgSend).FromAddress), nil
+	})
+	m.msgRouter = mockExec(func(ctx context.Context, msg, msgResp implementation.ProtoMsg) error { return nil })
+
+	_, aaAddr, err := m.Init(ctx, "aa", []byte("creator"), &rotationv1.MsgInit{}, nil)
+	require.NoError(t, err)
+
+	var gasLimits []uint64
+	m.environment.BranchService = gasLimitBranchService{gasLimits: &gasLimits}
+
+	bundledTx := makeBundledTx(t, send(aaAddr, []byte("recipient"), 10))
+	noGasLimitTx := makeBundledTx(t, send(aaAddr, []byte("recipient"), 10))
+	noGasLimitTx.AuthInfoBytes, err = (&tx.AuthInfo{Fee: &tx.Fee{}}).Marshal()
+	require.NoError(t, err)
+
+	// the execution is bounded by the gas limit of the fee, or the default one
+	require.Empty(t, m.ExecuteBundledTx(ctx, "bundler", bundledTx).Error)
+	require.Empty(t, m.ExecuteBundledTx(ctx, "bundler", noGasLimitTx).Error)
+	require.Equal(t, []uint64{AuthenticationGasLimit, 100_000, AuthenticationGasLimit, DefaultExecutionGasLimit}, gasLimits)
+}
@@ -134,3 +134,47 @@ func TestKeeper_Query(t *testing.T) {
 		require.True(t, implementation.Equal(&types.Int64Value{Value: 1000}, resp))
 	})
 }
+
+func TestKeeper_Migrate(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("test-v2", NewTestAccountV2))
+	m.queryRouter = mockQuery(func(ctx context.Context, req, resp implementation.ProtoMsg) error { return nil })
+
+	// create account and set its state
+	_, accAddr, err := m.Init(ctx, "test", []byte("sender"), &types.Empty{}, nil)
+	require.NoError(t, err)
+	_, err = m.Execute(ctx, accAddr, []byte("sender"), &types.UInt64Value{Value: 21}, nil)
+	require.NoError(t, err)
+
+	t.Run("unauthorized", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, []byte("sender"), "test-v2", &types.StringValue{Value: "v2"})
+		require.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, accAddr, "unknown", &types.StringValue{Value: "v2"})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := m.Migrate(ctx, accAddr, accAddr, "test-v2", &types.StringValue{Value: "v2"})
+		require.NoError(t, err)
+		require.Equal(t, &types.UInt64Value{Value: 21}, resp)
+
+		accType, err := m.AccountsByType.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, "test-v2", accType)
+
+		// the address and account number are kept, the state is migrated
+		label, err := m.Query(ctx, accAddr, &types.Empty{})
+		require.NoError(t, err)
+		require.Equal(t, &types.StringValue{Value: "v2"}, label)
+		counter, err := m.Query(ctx, accAddr, &types.DoubleValue{})
+		require.NoError(t, err)
+		require.Equal(t, &types.UInt64Value{Value: 42}, counter)
+	})
+
+	t.Run("no migrate handler", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, accAddr, "test", &types.StringValue{Value: "v1"})
+		require.ErrorContains(t, err, "no migrate handler")
+	})
+}
@@ -95,6 +95,59 @@ func (m msgServer) Execute(ctx context.Context, execute *v1.MsgExecute) (*v1.Msg
 	}, nil
 }
 
+func (m msgServer) Migrate(ctx context.Context, request *v1.MsgMigrate) (*v1.MsgMigrateResponse, error) {
+	// decode sender address
+	senderAddr, err := m.k.addressCodec.StringToBytes(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+	// decode the account address
+	accountAddr, err := m.k.addressCodec.StringToBytes(request.AccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode message bytes into the concrete boxed message type
+	req, err := implementation.UnpackAnyRaw(request.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	// run account migration logic
+	resp, err := m.k.Migrate(ctx, accountAddr, senderAddr, request.AccountType, req)
+	if err != nil {
+		return nil, err
+	}
+
+	eventManager := m.k.environment.EventService.EventManager(ctx)
+	err = eventManager.EmitKV(
+		"account_migration",
+		event.NewAttribute("address", request.AccountAddress),
+		event.NewAttribute("account_type", request.AccountType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// encode the response
+	respAny, err := implementation.PackAny(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgMigrateResponse{
+		Response: respAny,
+	}, nil
+}
+
 func (m msgServer) ExecuteBundle(ctx context.Context, req *v1.MsgExecuteBundle) (*v1.MsgExecuteBundleResponse, error) {
-	panic("impl")
+	_, err := m.k.addressCodec.StringToBytes(req.Bundler)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*v1.BundledTxResponse, len(req.Txs))
+	for i, bundledTx := range req.Txs {
+		responses[i] = m.k.ExecuteBundledTx(ctx, req.Bundler, bundledTx)
+	}
+	return &v1.MsgExecuteBundleResponse{Responses: responses}, nil
 }
@@ -16,7 +16,7 @@ import (
 )
 
 func TestMsgServer(t *testing.T) {
-	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("test-v2", NewTestAccountV2))
 	k.queryRouter = mockQuery(func(ctx context.Context, req, resp implementation.ProtoMsg) error {
 		_, ok := req.(*bankv1beta1.QueryBalanceRequest)
 		require.True(t, ok)
@@ -52,4 +52,25 @@ func TestMsgServer(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.NotNil(t, execResp)
+
+	// migrate
+	migrateMsgAny, err := implementation.PackAny(&wrapperspb.StringValue{Value: "v2"})
+	require.NoError(t, err)
+
+	_, err = s.Migrate(ctx, &v1.MsgMigrate{
+		Sender:         "sender",
+		AccountAddress: initResp.AccountAddress,
+		AccountType:    "test-v2",
+		Message:        migrateMsgAny,
+	})
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	migrateResp, err := s.Migrate(ctx, &v1.MsgMigrate{
+		Sender:         initResp.AccountAddress,
+		AccountAddress: initResp.AccountAddress,
+		AccountType:    "test-v2",
+		Message:        migrateMsgAny,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, migrateResp)
 }
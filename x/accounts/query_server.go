@@ -84,9 +84,20 @@ func (q queryServer) AccountNumber(ctx context.Context, request *v1.AccountNumbe
 	return &v1.AccountNumberResponse{Number: number}, nil
 }
 
+func (q queryServer) SimulateBundle(ctx context.Context, request *v1.SimulateBundleRequest) (*v1.SimulateBundleResponse, error) {
+	_, err := q.k.addressCodec.StringToBytes(request.Bundler)
+	if err != nil {
+		return nil, err
+	}
+	responses, err := q.k.SimulateBundle(ctx, request.Bundler, request.Txs)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SimulateBundleResponse{Responses: responses}, nil
+}
+
 const (
 	// TODO(tip): evaluate if the following numbers should be parametrised over state, or over the node.
-	SimulateAuthenticateGasLimit   = 1_000_000
-	SimulateBundlerPaymentGasLimit = SimulateAuthenticateGasLimit
-	ExecuteGasLimit                = SimulateAuthenticateGasLimit
+	SimulateAuthenticateGasLimit = AuthenticationGasLimit
+	ExecuteGasLimit              = DefaultExecutionGasLimit
 )
@@ -39,6 +39,18 @@ func (e eventService) EmitNonConsensus(event protoiface.MessageV1) error {
 
 func (e eventService) EventManager(ctx context.Context) event.Manager { return e }
 
+// branchService runs the executions in the parent context, state changes are not
+// rolled back on failure and no gas is reported.
+type branchService struct{}
+
+func (b branchService) Execute(ctx context.Context, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
+
+func (b branchService) ExecuteWithGasLimit(ctx context.Context, _ uint64, f func(ctx context.Context) error) (uint64, error) {
+	return 0, f(ctx)
+}
+
 var _ InterfaceRegistry = (*interfaceRegistry)(nil)
 
 type interfaceRegistry struct{}
@@ -52,6 +64,7 @@ func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Kee
 	ss, ctx := colltest.MockStore()
 	env := runtime.NewEnvironment(ss, log.NewNopLogger())
 	env.EventService = eventService{}
+	env.BranchService = branchService{}
 	m, err := NewKeeper(nil, env, addressCodec{}, nil, nil, nil, interfaceRegistry{}, accounts...)
 	require.NoError(t, err)
 	return m, ctx
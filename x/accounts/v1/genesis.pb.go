@@ -28,6 +28,11 @@ type GenesisState struct {
 	AccountNumber uint64 `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
 	// accounts are the genesis accounts.
 	Accounts []*GenesisAccount `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// init_account_msgs are the messages initializing new accounts at genesis,
+	// after the genesis accounts were imported.
+	//
+	// Since: cosmos-sdk 0.51
+	InitAccountMsgs []*MsgInit `protobuf:"bytes,3,rep,name=init_account_msgs,json=initAccountMsgs,proto3" json:"init_account_msgs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +82,13 @@ func (m *GenesisState) GetAccounts() []*GenesisAccount {
 	return nil
 }
 
+func (m *GenesisState) GetInitAccountMsgs() []*MsgInit {
+	if m != nil {
+		return m.InitAccountMsgs
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 type GenesisAccount struct {
 	// address is the address of the account.
@@ -214,25 +226,28 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/genesis.proto", fileDescriptor_409859d32eae9438) }
 
 var fileDescriptor_409859d32eae9438 = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x54, 0x2a, 0xe5, 0xe2, 0x71, 0x87, 0x28, 0x0a, 0x2e, 0x49,
-	0x2c, 0x49, 0x15, 0x52, 0xe5, 0xe2, 0x83, 0x4a, 0xc7, 0xe7, 0x95, 0xe6, 0x26, 0xa5, 0x16, 0x49,
-	0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x04, 0xf1, 0x42, 0x45, 0xfd, 0xc0, 0x82, 0x42, 0x76, 0x5c, 0x1c,
-	0x30, 0x53, 0x24, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x94, 0xf4, 0x30, 0x4d, 0xd7, 0x83, 0x1a,
-	0xed, 0x08, 0x11, 0x0a, 0x82, 0xeb, 0x51, 0x5a, 0xc6, 0xc8, 0xc5, 0x87, 0x2a, 0x29, 0x24, 0xc1,
-	0xc5, 0x9e, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x0c, 0xb6, 0x92, 0x33, 0x08, 0xc6, 0x15, 0x52,
-	0xe4, 0xe2, 0x81, 0xb9, 0xa9, 0xa4, 0xb2, 0x20, 0x55, 0x82, 0x09, 0x2c, 0xcd, 0x0d, 0x15, 0x0b,
-	0xa9, 0x2c, 0xc0, 0xe6, 0x6c, 0x66, 0x6c, 0xce, 0x36, 0xe0, 0x62, 0x2d, 0x06, 0x79, 0x53, 0x82,
-	0x05, 0xec, 0x66, 0x29, 0x6c, 0x6e, 0xf6, 0x0e, 0x0b, 0x48, 0xcc, 0x2c, 0x0a, 0x82, 0x28, 0x54,
-	0x32, 0xe0, 0x62, 0x83, 0x08, 0x08, 0x09, 0x70, 0x31, 0x67, 0xa7, 0x56, 0x82, 0xdd, 0xc6, 0x13,
-	0x04, 0x62, 0x0a, 0x89, 0x70, 0xb1, 0x96, 0x25, 0xe6, 0x94, 0x42, 0x1c, 0xc4, 0x13, 0x04, 0xe1,
-	0x38, 0x99, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x14, 0xc4, 0xb6,
-	0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0xe4, 0x98, 0x4a, 0x62, 0x03, 0x47, 0x91, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0x52, 0xae, 0xf2, 0xc5, 0xc6, 0x01, 0x00, 0x00,
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xcd, 0x4e, 0xc2, 0x40,
+	0x10, 0x66, 0x29, 0xa0, 0x0e, 0x15, 0x75, 0xe3, 0xa1, 0x81, 0xa4, 0xc1, 0x26, 0x26, 0x9c, 0x5a,
+	0x50, 0xcf, 0x26, 0x7a, 0x21, 0xc6, 0x60, 0xcc, 0x6a, 0x3c, 0x78, 0x21, 0x05, 0x36, 0xcd, 0x06,
+	0xe9, 0x92, 0xce, 0x42, 0xe0, 0x2d, 0x7c, 0x0a, 0x1f, 0xc3, 0xb3, 0x47, 0x8e, 0x1e, 0x0d, 0xbc,
+	0x88, 0x61, 0xb7, 0x35, 0x1a, 0x7b, 0xeb, 0x7c, 0xfd, 0xfe, 0x66, 0x07, 0x9a, 0x43, 0x89, 0x13,
+	0x89, 0x41, 0x38, 0x1c, 0xca, 0x59, 0xac, 0x30, 0x98, 0x77, 0x82, 0x88, 0xc7, 0x1c, 0x05, 0xfa,
+	0xd3, 0x44, 0x2a, 0x49, 0xa9, 0x61, 0xf8, 0x19, 0xc3, 0x9f, 0x77, 0xea, 0x8d, 0x1c, 0x95, 0x5a,
+	0x18, 0x81, 0xf7, 0x4e, 0xc0, 0xee, 0x1a, 0x8b, 0x07, 0x15, 0x2a, 0x4e, 0x4f, 0xa1, 0x96, 0x12,
+	0xfb, 0xf1, 0x6c, 0x32, 0xe0, 0x89, 0x43, 0x9a, 0xa4, 0x55, 0x62, 0xfb, 0x29, 0x7a, 0xa7, 0x41,
+	0x7a, 0x09, 0xbb, 0x99, 0x9f, 0x53, 0x6c, 0x5a, 0xad, 0xea, 0x99, 0xe7, 0xff, 0xcf, 0xf6, 0x53,
+	0xeb, 0x2b, 0x03, 0xb1, 0x1f, 0x0d, 0xed, 0xc2, 0x91, 0x88, 0x85, 0xea, 0x67, 0x59, 0x13, 0x8c,
+	0xd0, 0xb1, 0xb4, 0x51, 0x23, 0xcf, 0xa8, 0x87, 0xd1, 0x4d, 0x2c, 0x14, 0x3b, 0xd8, 0xaa, 0x52,
+	0xbb, 0x1e, 0x46, 0xe8, 0xbd, 0x11, 0xa8, 0xfd, 0x4d, 0xa1, 0x0e, 0xec, 0x84, 0xa3, 0x51, 0xc2,
+	0x11, 0x75, 0xf7, 0x3d, 0x96, 0x8d, 0xf4, 0x04, 0xec, 0x2c, 0x50, 0x2d, 0xa7, 0xdc, 0x29, 0xea,
+	0xdf, 0xd5, 0x14, 0x7b, 0x5c, 0x4e, 0xf3, 0xf6, 0xb7, 0xf2, 0xf6, 0x6f, 0x43, 0x19, 0xb7, 0xef,
+	0xe5, 0x94, 0x74, 0xe7, 0x7a, 0x5e, 0xe7, 0xdb, 0xa7, 0xfb, 0x50, 0x24, 0xcc, 0x10, 0xbd, 0x36,
+	0x54, 0x0c, 0x40, 0x0f, 0xc1, 0x1a, 0xf3, 0xa5, 0xee, 0x66, 0xb3, 0xed, 0x27, 0x3d, 0x86, 0xf2,
+	0x3c, 0x7c, 0x99, 0x99, 0x42, 0x36, 0x33, 0xc3, 0xf5, 0xc5, 0xc7, 0xda, 0x25, 0xab, 0xb5, 0x4b,
+	0xbe, 0xd6, 0x2e, 0x79, 0xdd, 0xb8, 0x85, 0xd5, 0xc6, 0x2d, 0x7c, 0x6e, 0xdc, 0xc2, 0x73, 0xdd,
+	0xa4, 0xe1, 0x68, 0xec, 0x0b, 0x19, 0x2c, 0x7e, 0x9f, 0x76, 0x50, 0xd1, 0x87, 0x3d, 0xff, 0x1e,
+	0x00, 0xe5, 0x36, 0x4e, 0xfe, 0x2d, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -255,6 +270,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InitAccountMsgs) > 0 {
+		for iNdEx := len(m.InitAccountMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitAccountMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -396,6 +425,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InitAccountMsgs) > 0 {
+		for _, e := range m.InitAccountMsgs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -530,6 +565,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitAccountMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitAccountMsgs = append(m.InitAccountMsgs, &MsgInit{})
+			if err := m.InitAccountMsgs[len(m.InitAccountMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	tx "github.com/cosmos/cosmos-sdk/types/tx"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -183,6 +184,10 @@ type SchemaResponse struct {
 	ExecuteHandlers []*SchemaResponse_Handler `protobuf:"bytes,2,rep,name=execute_handlers,json=executeHandlers,proto3" json:"execute_handlers,omitempty"`
 	// query_handlers defines the schema descriptor for the Query account method.
 	QueryHandlers []*SchemaResponse_Handler `protobuf:"bytes,3,rep,name=query_handlers,json=queryHandlers,proto3" json:"query_handlers,omitempty"`
+	// migrate_handlers defines the schema descriptor for the Migrate account method.
+	//
+	// Since: cosmos-sdk 0.51
+	MigrateHandlers []*SchemaResponse_MigrateHandler `protobuf:"bytes,4,rep,name=migrate_handlers,json=migrateHandlers,proto3" json:"migrate_handlers,omitempty"`
 }
 
 func (m *SchemaResponse) Reset()         { *m = SchemaResponse{} }
@@ -239,6 +244,13 @@ func (m *SchemaResponse) GetQueryHandlers() []*SchemaResponse_Handler {
 	return nil
 }
 
+func (m *SchemaResponse) GetMigrateHandlers() []*SchemaResponse_MigrateHandler {
+	if m != nil {
+		return m.MigrateHandlers
+	}
+	return nil
+}
+
 // Handler defines a schema descriptor for a handler.
 // Where request and response are names that can be used to lookup the
 // reflection descriptor.
@@ -296,6 +308,61 @@ func (m *SchemaResponse_Handler) GetResponse() string {
 	return ""
 }
 
+// MigrateHandler defines a schema descriptor for a migration handler.
+type SchemaResponse_MigrateHandler struct {
+	// from_account_type is the account type the handler migrates accounts from.
+	FromAccountType string `protobuf:"bytes,1,opt,name=from_account_type,json=fromAccountType,proto3" json:"from_account_type,omitempty"`
+	// handler is the schema descriptor of the handler.
+	Handler *SchemaResponse_Handler `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler,omitempty"`
+}
+
+func (m *SchemaResponse_MigrateHandler) Reset()         { *m = SchemaResponse_MigrateHandler{} }
+func (m *SchemaResponse_MigrateHandler) String() string { return proto.CompactTextString(m) }
+func (*SchemaResponse_MigrateHandler) ProtoMessage()    {}
+func (*SchemaResponse_MigrateHandler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16ad14c22e3080d2, []int{3, 1}
+}
+func (m *SchemaResponse_MigrateHandler) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaResponse_MigrateHandler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaResponse_MigrateHandler.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchemaResponse_MigrateHandler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaResponse_MigrateHandler.Merge(m, src)
+}
+func (m *SchemaResponse_MigrateHandler) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaResponse_MigrateHandler) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaResponse_MigrateHandler.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaResponse_MigrateHandler proto.InternalMessageInfo
+
+func (m *SchemaResponse_MigrateHandler) GetFromAccountType() string {
+	if m != nil {
+		return m.FromAccountType
+	}
+	return ""
+}
+
+func (m *SchemaResponse_MigrateHandler) GetHandler() *SchemaResponse_Handler {
+	if m != nil {
+		return m.Handler
+	}
+	return nil
+}
+
 // AccountTypeRequest is the request type for the Query/AccountType RPC method.
 type AccountTypeRequest struct {
 	// address defines the address to query the account type for.
@@ -481,54 +548,169 @@ func (m *AccountNumberResponse) GetNumber() uint64 {
 	return 0
 }
 
+// SimulateBundleRequest is the request type for the Query/SimulateBundle RPC method.
+type SimulateBundleRequest struct {
+	// bundler defines the address of the bundler which would send the bundle.
+	Bundler string `protobuf:"bytes,1,opt,name=bundler,proto3" json:"bundler,omitempty"`
+	// txs defines the txs to execute on behalf of other users.
+	Txs []*tx.TxRaw `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *SimulateBundleRequest) Reset()         { *m = SimulateBundleRequest{} }
+func (m *SimulateBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleRequest) ProtoMessage()    {}
+func (*SimulateBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16ad14c22e3080d2, []int{8}
+}
+func (m *SimulateBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleRequest.Merge(m, src)
+}
+func (m *SimulateBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBundleRequest proto.InternalMessageInfo
+
+func (m *SimulateBundleRequest) GetBundler() string {
+	if m != nil {
+		return m.Bundler
+	}
+	return ""
+}
+
+func (m *SimulateBundleRequest) GetTxs() []*tx.TxRaw {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+// SimulateBundleResponse is the response type for the Query/SimulateBundle RPC method.
+type SimulateBundleResponse struct {
+	// responses are the simulated responses of the bundled txs, in the order of
+	// the request.
+	Responses []*BundledTxResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (m *SimulateBundleResponse) Reset()         { *m = SimulateBundleResponse{} }
+func (m *SimulateBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleResponse) ProtoMessage()    {}
+func (*SimulateBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16ad14c22e3080d2, []int{9}
+}
+func (m *SimulateBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleResponse.Merge(m, src)
+}
+func (m *SimulateBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBundleResponse proto.InternalMessageInfo
+
+func (m *SimulateBundleResponse) GetResponses() []*BundledTxResponse {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AccountQueryRequest)(nil), "cosmos.accounts.v1.AccountQueryRequest")
 	proto.RegisterType((*AccountQueryResponse)(nil), "cosmos.accounts.v1.AccountQueryResponse")
 	proto.RegisterType((*SchemaRequest)(nil), "cosmos.accounts.v1.SchemaRequest")
 	proto.RegisterType((*SchemaResponse)(nil), "cosmos.accounts.v1.SchemaResponse")
 	proto.RegisterType((*SchemaResponse_Handler)(nil), "cosmos.accounts.v1.SchemaResponse.Handler")
+	proto.RegisterType((*SchemaResponse_MigrateHandler)(nil), "cosmos.accounts.v1.SchemaResponse.MigrateHandler")
 	proto.RegisterType((*AccountTypeRequest)(nil), "cosmos.accounts.v1.AccountTypeRequest")
 	proto.RegisterType((*AccountTypeResponse)(nil), "cosmos.accounts.v1.AccountTypeResponse")
 	proto.RegisterType((*AccountNumberRequest)(nil), "cosmos.accounts.v1.AccountNumberRequest")
 	proto.RegisterType((*AccountNumberResponse)(nil), "cosmos.accounts.v1.AccountNumberResponse")
+	proto.RegisterType((*SimulateBundleRequest)(nil), "cosmos.accounts.v1.SimulateBundleRequest")
+	proto.RegisterType((*SimulateBundleResponse)(nil), "cosmos.accounts.v1.SimulateBundleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/accounts/v1/query.proto", fileDescriptor_16ad14c22e3080d2) }
 
 var fileDescriptor_16ad14c22e3080d2 = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x13, 0x48, 0x60, 0xd2, 0x14, 0x34, 0x94, 0xca, 0xf8, 0x60, 0xa5, 0x3e, 0xd0, 0xc0,
-	0x61, 0xdd, 0x06, 0x0e, 0xdc, 0x50, 0x38, 0x55, 0x42, 0x42, 0x8a, 0x81, 0x0b, 0x12, 0x0a, 0x8e,
-	0xb3, 0x4d, 0x23, 0x1a, 0x6f, 0xea, 0xb5, 0xab, 0xe6, 0x13, 0xb8, 0xf1, 0x59, 0x3d, 0xf6, 0xc8,
-	0x11, 0x25, 0x3f, 0x82, 0xba, 0x3b, 0x9b, 0x38, 0x50, 0xe2, 0xf6, 0xe6, 0xd9, 0x79, 0xf3, 0xde,
-	0xfa, 0xbd, 0xb1, 0xc1, 0x8b, 0x85, 0x9c, 0x08, 0x19, 0x44, 0x71, 0x2c, 0xf2, 0x24, 0x93, 0xc1,
-	0xf9, 0x61, 0x70, 0x96, 0xf3, 0x74, 0xc6, 0xa6, 0xa9, 0xc8, 0x04, 0xa2, 0xee, 0x33, 0xd3, 0x67,
-	0xe7, 0x87, 0xee, 0xb3, 0x91, 0x10, 0xa3, 0x53, 0x1e, 0x28, 0xc4, 0x20, 0x3f, 0x0e, 0xa2, 0x84,
-	0xe0, 0xfe, 0x57, 0x78, 0xd2, 0xd5, 0xc8, 0xde, 0x35, 0x49, 0xc8, 0xcf, 0x72, 0x2e, 0x33, 0xdc,
-	0x85, 0x5a, 0x16, 0xa5, 0x23, 0x9e, 0x39, 0x76, 0xcb, 0x6e, 0x3f, 0x0c, 0xa9, 0x42, 0x06, 0xf5,
-	0x54, 0x43, 0x9c, 0x4a, 0xcb, 0x6e, 0x37, 0x3a, 0x3b, 0x4c, 0x73, 0x33, 0xc3, 0xcd, 0xba, 0xc9,
-	0x2c, 0x34, 0x20, 0xff, 0x08, 0x76, 0xd6, 0xe9, 0xe5, 0x54, 0x24, 0x92, 0xe3, 0x01, 0x3c, 0x48,
-	0xe9, 0x59, 0x29, 0xfc, 0x8f, 0x68, 0x89, 0xf2, 0x3b, 0xd0, 0xfc, 0x18, 0x9f, 0xf0, 0x49, 0x64,
-	0xae, 0xb8, 0x07, 0x5b, 0xf4, 0x8e, 0xfd, 0x6c, 0x36, 0xe5, 0x74, 0xd1, 0x06, 0x9d, 0x7d, 0x9a,
-	0x4d, 0xb9, 0x7f, 0x59, 0x81, 0x6d, 0x33, 0x44, 0xc2, 0xef, 0xa1, 0x31, 0x4e, 0xc6, 0x59, 0x5f,
-	0xaa, 0x63, 0xd2, 0x7e, 0xc9, 0xfe, 0x35, 0x8d, 0xad, 0x0f, 0xb2, 0xa3, 0x28, 0x19, 0x9e, 0xf2,
-	0x34, 0x84, 0xeb, 0x71, 0xdd, 0xc3, 0xcf, 0xf0, 0x98, 0x5f, 0xf0, 0x38, 0xcf, 0x78, 0xff, 0x44,
-	0xb7, 0xa5, 0x53, 0x69, 0x55, 0xef, 0xc8, 0xf8, 0x88, 0x38, 0xa8, 0x96, 0xd8, 0x83, 0x6d, 0x95,
-	0xe8, 0x8a, 0xb4, 0x7a, 0x67, 0xd2, 0xa6, 0x62, 0x30, 0x94, 0xee, 0x5b, 0xa8, 0xd3, 0x33, 0x3a,
-	0xab, 0x08, 0xb5, 0x65, 0xa6, 0x44, 0xb7, 0x10, 0x4a, 0x45, 0xb5, 0x56, 0xf6, 0x33, 0xc0, 0xee,
-	0xca, 0x59, 0x93, 0x81, 0x03, 0xf5, 0x68, 0x38, 0x4c, 0xb9, 0x94, 0x86, 0x8b, 0x4a, 0xff, 0xcd,
-	0x72, 0xaf, 0x34, 0x9e, 0xec, 0xbf, 0x45, 0x68, 0x07, 0xcb, 0x95, 0xf9, 0x90, 0x4f, 0x06, 0x3c,
-	0x2d, 0xd7, 0x0a, 0xe0, 0xe9, 0x5f, 0x13, 0xa4, 0xb6, 0x0b, 0xb5, 0x44, 0x9d, 0xa8, 0x89, 0x7b,
-	0x21, 0x55, 0x9d, 0x1f, 0x55, 0xb8, 0xaf, 0xf6, 0x11, 0x63, 0xd8, 0x2a, 0xee, 0x27, 0xee, 0xdf,
-	0x64, 0xf1, 0x0d, 0x1f, 0x88, 0xdb, 0x2e, 0x07, 0x92, 0x73, 0x16, 0xf6, 0xa0, 0x46, 0x0b, 0xb3,
-	0xb7, 0x29, 0x41, 0x4d, 0xec, 0x97, 0x87, 0xec, 0x5b, 0xf8, 0x0d, 0x1a, 0x05, 0x7b, 0xf1, 0xf9,
-	0x86, 0xdb, 0x14, 0xf2, 0x72, 0xf7, 0x4b, 0x71, 0x4b, 0x85, 0x63, 0x68, 0xae, 0x99, 0x8a, 0x9b,
-	0xde, 0x78, 0x2d, 0x29, 0xf7, 0xc5, 0x2d, 0x90, 0x46, 0xe7, 0xdd, 0xeb, 0xcb, 0xb9, 0x67, 0x5f,
-	0xcd, 0x3d, 0xfb, 0xf7, 0xdc, 0xb3, 0x7f, 0x2e, 0x3c, 0xeb, 0x6a, 0xe1, 0x59, 0xbf, 0x16, 0x9e,
-	0xf5, 0xc5, 0xd5, 0x2c, 0x72, 0xf8, 0x9d, 0x8d, 0x45, 0x70, 0x51, 0xfc, 0xe3, 0x0d, 0x6a, 0xea,
-	0x2f, 0xf1, 0xea, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0x75, 0x93, 0xfa, 0x0e, 0x05, 0x00,
-	0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0x92, 0xd0, 0x49, 0x9b, 0x96, 0xed, 0x8f, 0x8c, 0x91, 0xac, 0xd6, 0x12, 0xb4,
+	0xe4, 0xb0, 0x6e, 0x0a, 0x07, 0x6e, 0xa8, 0x85, 0x43, 0x25, 0x04, 0x52, 0xdd, 0x72, 0x41, 0x54,
+	0xc1, 0x49, 0xb6, 0xa9, 0x45, 0x6d, 0xa7, 0xde, 0x75, 0x71, 0xae, 0x3c, 0x01, 0xef, 0xc1, 0x8b,
+	0x70, 0xec, 0x91, 0x23, 0x6a, 0x5f, 0x81, 0x07, 0x40, 0xb6, 0x67, 0x1d, 0xbb, 0x58, 0x49, 0x7a,
+	0xf3, 0xec, 0xcc, 0xf7, 0x7d, 0xb3, 0x3b, 0x9f, 0x77, 0x41, 0xef, 0xf9, 0xdc, 0xf5, 0xb9, 0x69,
+	0xf7, 0x7a, 0x7e, 0xe8, 0x09, 0x6e, 0x5e, 0xb5, 0xcd, 0xcb, 0x90, 0x05, 0x23, 0x3a, 0x0c, 0x7c,
+	0xe1, 0x13, 0x92, 0xe6, 0xa9, 0xcc, 0xd3, 0xab, 0xb6, 0xf6, 0x78, 0xe0, 0xfb, 0x83, 0x0b, 0x66,
+	0x26, 0x15, 0xdd, 0xf0, 0xcc, 0xb4, 0x3d, 0x2c, 0xd7, 0x34, 0xa4, 0x13, 0x91, 0x79, 0xd5, 0xee,
+	0x32, 0x61, 0xb7, 0x4d, 0x11, 0x61, 0xee, 0x49, 0x89, 0x94, 0x4c, 0x1a, 0xa7, 0xb0, 0xba, 0x9f,
+	0xae, 0x1f, 0xc5, 0xea, 0x16, 0xbb, 0x0c, 0x19, 0x17, 0x64, 0x03, 0x6a, 0xc2, 0x0e, 0x06, 0x4c,
+	0xa8, 0xca, 0xa6, 0xb2, 0xb3, 0x60, 0x61, 0x44, 0x28, 0xd4, 0x83, 0xb4, 0x44, 0x9d, 0xdb, 0x54,
+	0x76, 0x1a, 0x7b, 0x6b, 0x34, 0x6d, 0x8a, 0xca, 0xa6, 0xe8, 0xbe, 0x37, 0xb2, 0x64, 0x91, 0x71,
+	0x08, 0x6b, 0x45, 0x7a, 0x3e, 0xf4, 0x3d, 0xce, 0xc8, 0x2e, 0x3c, 0x0c, 0xf0, 0x5b, 0x55, 0x26,
+	0x10, 0x65, 0x55, 0xc6, 0x1e, 0x2c, 0x1d, 0xf7, 0xce, 0x99, 0x6b, 0xcb, 0x16, 0xb7, 0x60, 0x11,
+	0x77, 0xd4, 0x11, 0xa3, 0x21, 0xc3, 0x46, 0x1b, 0xb8, 0x76, 0x32, 0x1a, 0x32, 0xe3, 0xe7, 0x3c,
+	0x34, 0x25, 0x08, 0x85, 0xdf, 0x41, 0xc3, 0xf1, 0x1c, 0xd1, 0xe1, 0xc9, 0x32, 0x6a, 0xb7, 0xe8,
+	0xff, 0xa7, 0x4d, 0x8b, 0x40, 0x7a, 0x68, 0x7b, 0xfd, 0x0b, 0x16, 0x58, 0x10, 0xc3, 0xd3, 0x1c,
+	0xf9, 0x08, 0x2b, 0x2c, 0x62, 0xbd, 0x50, 0xb0, 0xce, 0x79, 0x9a, 0xe6, 0xea, 0xdc, 0x66, 0xf5,
+	0x9e, 0x8c, 0xcb, 0xc8, 0x81, 0x31, 0x27, 0x47, 0xd0, 0x4c, 0xac, 0x30, 0x26, 0xad, 0xde, 0x9b,
+	0x74, 0x29, 0x61, 0xc8, 0x28, 0x3f, 0xc3, 0x8a, 0xeb, 0x0c, 0x02, 0x3b, 0xdf, 0xe9, 0x7c, 0x42,
+	0xda, 0x9e, 0x81, 0xf4, 0x7d, 0x0a, 0xcd, 0x1a, 0x76, 0x0b, 0x31, 0xd7, 0x5e, 0x43, 0x1d, 0xbf,
+	0x89, 0x3a, 0x36, 0x48, 0x3a, 0x10, 0x19, 0x12, 0x2d, 0x37, 0xf2, 0xb9, 0x24, 0x95, 0xc5, 0xda,
+	0x77, 0x05, 0x9a, 0x45, 0x11, 0xd2, 0x82, 0x47, 0x67, 0x81, 0xef, 0x76, 0x4a, 0x66, 0xbc, 0x1c,
+	0x27, 0xf6, 0xc7, 0x73, 0x26, 0x6f, 0xa1, 0x8e, 0xbb, 0x42, 0x57, 0xde, 0xe7, 0xa4, 0x24, 0xd4,
+	0xa0, 0x40, 0x72, 0xa4, 0xd2, 0x66, 0x2a, 0xd4, 0xed, 0x7e, 0x3f, 0x60, 0x9c, 0xcb, 0x0d, 0x61,
+	0x68, 0xbc, 0x82, 0xd5, 0x42, 0x3d, 0x3a, 0x6c, 0x06, 0x5f, 0xee, 0x66, 0x7f, 0xc5, 0x87, 0xd0,
+	0xed, 0xb2, 0x60, 0xba, 0x96, 0x09, 0xeb, 0x77, 0x10, 0xa8, 0xb6, 0x01, 0x35, 0x2f, 0x59, 0x49,
+	0x10, 0xf3, 0x16, 0x46, 0xc6, 0x29, 0xac, 0x1f, 0x3b, 0x6e, 0x78, 0x61, 0x0b, 0x76, 0x10, 0xc6,
+	0xfb, 0xcb, 0x69, 0x74, 0xc3, 0xf4, 0xac, 0x50, 0x03, 0x43, 0xd2, 0x82, 0xaa, 0x88, 0xa4, 0x81,
+	0x55, 0x79, 0x82, 0x22, 0xa2, 0x78, 0xa3, 0xd0, 0x93, 0xc8, 0xb2, 0xbf, 0x59, 0x71, 0x91, 0x71,
+	0x0a, 0x1b, 0x77, 0xe9, 0xb1, 0xa1, 0x37, 0xb0, 0x20, 0xc7, 0x1a, 0xef, 0x22, 0xe6, 0x7a, 0x5a,
+	0x36, 0x8d, 0x14, 0xd6, 0x3f, 0x89, 0x24, 0xd2, 0x1a, 0xe3, 0xf6, 0xfe, 0x56, 0xe1, 0x41, 0x72,
+	0x61, 0x90, 0x1e, 0x2c, 0xe6, 0x2f, 0x10, 0xb2, 0x5d, 0xc6, 0x55, 0x72, 0x83, 0x69, 0x3b, 0xd3,
+	0x0b, 0xf1, 0x66, 0xa9, 0x90, 0x23, 0xa8, 0xe1, 0x1f, 0xbd, 0x35, 0xc9, 0x38, 0x29, 0xb1, 0x31,
+	0xdd, 0x5b, 0x46, 0x85, 0x7c, 0x81, 0x46, 0xde, 0xa1, 0xcf, 0x26, 0x74, 0x93, 0x73, 0x9b, 0xb6,
+	0x3d, 0xb5, 0x2e, 0x53, 0x38, 0x83, 0xa5, 0x82, 0x25, 0xc8, 0xa4, 0x1d, 0x17, 0x7c, 0xa6, 0x3d,
+	0x9f, 0xa1, 0x32, 0xd3, 0x71, 0xa0, 0x59, 0x1c, 0x35, 0x29, 0x85, 0x97, 0xba, 0x4d, 0x6b, 0xcd,
+	0x52, 0x2a, 0xa5, 0x0e, 0x5e, 0xfe, 0xba, 0xd1, 0x95, 0xeb, 0x1b, 0x5d, 0xf9, 0x73, 0xa3, 0x2b,
+	0x3f, 0x6e, 0xf5, 0xca, 0xf5, 0xad, 0x5e, 0xf9, 0x7d, 0xab, 0x57, 0x3e, 0xe1, 0xfb, 0xc6, 0xfb,
+	0x5f, 0xa9, 0xe3, 0x9b, 0x51, 0xfe, 0x2d, 0xeb, 0xd6, 0x92, 0x17, 0xe3, 0xc5, 0xbf, 0x01, 0x00,
+	0x27, 0x0b, 0x80, 0x13, 0x53, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -551,6 +733,11 @@ type QueryClient interface {
 	AccountType(ctx context.Context, in *AccountTypeRequest, opts ...grpc.CallOption) (*AccountTypeResponse, error)
 	// AccountNumber returns the account number given the account address.
 	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
+	// SimulateBundle simulates the authentication and the execution of the txs
+	// of a bundle, without persisting any state change. It is used by bundlers
+	// to check that bundled txs succeed and to estimate their gas, before
+	// sending a MsgExecuteBundle.
+	SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error)
 }
 
 type queryClient struct {
@@ -597,6 +784,15 @@ func (c *queryClient) AccountNumber(ctx context.Context, in *AccountNumberReques
 	return out, nil
 }
 
+func (c *queryClient) SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error) {
+	out := new(SimulateBundleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Query/SimulateBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// AccountQuery runs an account query.
@@ -607,6 +803,11 @@ type QueryServer interface {
 	AccountType(context.Context, *AccountTypeRequest) (*AccountTypeResponse, error)
 	// AccountNumber returns the account number given the account address.
 	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
+	// SimulateBundle simulates the authentication and the execution of the txs
+	// of a bundle, without persisting any state change. It is used by bundlers
+	// to check that bundled txs succeed and to estimate their gas, before
+	// sending a MsgExecuteBundle.
+	SimulateBundle(context.Context, *SimulateBundleRequest) (*SimulateBundleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -625,6 +826,9 @@ func (*UnimplementedQueryServer) AccountType(ctx context.Context, req *AccountTy
 func (*UnimplementedQueryServer) AccountNumber(ctx context.Context, req *AccountNumberRequest) (*AccountNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountNumber not implemented")
 }
+func (*UnimplementedQueryServer) SimulateBundle(ctx context.Context, req *SimulateBundleRequest) (*SimulateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBundle not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -702,6 +906,24 @@ func _Query_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accounts.v1.Query/SimulateBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBundle(ctx, req.(*SimulateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.accounts.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -722,6 +944,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountNumber",
 			Handler:    _Query_AccountNumber_Handler,
 		},
+		{
+			MethodName: "SimulateBundle",
+			Handler:    _Query_SimulateBundle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/accounts/v1/query.proto",
@@ -854,6 +1080,20 @@ func (m *SchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MigrateHandlers) > 0 {
+		for iNdEx := len(m.MigrateHandlers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigrateHandlers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.QueryHandlers) > 0 {
 		for iNdEx := len(m.QueryHandlers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -934,6 +1174,48 @@ func (m *SchemaResponse_Handler) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *SchemaResponse_MigrateHandler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaResponse_MigrateHandler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaResponse_MigrateHandler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Handler != nil {
+		{
+			size, err := m.Handler.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAccountType) > 0 {
+		i -= len(m.FromAccountType)
+		copy(dAtA[i:], m.FromAccountType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromAccountType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccountTypeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1052,6 +1334,87 @@ func (m *AccountNumberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SimulateBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bundler) > 0 {
+		i -= len(m.Bundler)
+		copy(dAtA[i:], m.Bundler)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bundler)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1128,6 +1491,12 @@ func (m *SchemaResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.MigrateHandlers) > 0 {
+		for _, e := range m.MigrateHandlers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1148,6 +1517,23 @@ func (m *SchemaResponse_Handler) Size() (n int) {
 	return n
 }
 
+func (m *SchemaResponse_MigrateHandler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAccountType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Handler != nil {
+		l = m.Handler.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *AccountTypeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1199,11 +1585,45 @@ func (m *AccountNumberResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SimulateBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bundler)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SimulateBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *AccountQueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1624,6 +2044,40 @@ func (m *SchemaResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateHandlers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrateHandlers = append(m.MigrateHandlers, &SchemaResponse_MigrateHandler{})
+			if err := m.MigrateHandlers[len(m.MigrateHandlers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1759,6 +2213,124 @@ func (m *SchemaResponse_Handler) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SchemaResponse_MigrateHandler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrateHandler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrateHandler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Handler == nil {
+				m.Handler = &SchemaResponse_Handler{}
+			}
+			if err := m.Handler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AccountTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2074,6 +2646,206 @@ func (m *AccountNumberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SimulateBundleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &tx.TxRaw{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &BundledTxResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
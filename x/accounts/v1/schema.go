@@ -25,9 +25,27 @@ func makeAccountSchema(impl implementation.Implementation) *SchemaResponse {
 		},
 		ExecuteHandlers: makeHandlersSchema(impl.ExecuteHandlersSchema),
 		QueryHandlers:   makeHandlersSchema(impl.QueryHandlersSchema),
+		MigrateHandlers: makeMigrateHandlersSchema(impl.MigrateHandlersSchema),
 	}
 }
 
+func makeMigrateHandlersSchema(handlers map[string]implementation.HandlerSchema) []*SchemaResponse_MigrateHandler {
+	schemas := make([]*SchemaResponse_MigrateHandler, 0, len(handlers))
+	for fromAccountType, handler := range handlers {
+		schemas = append(schemas, &SchemaResponse_MigrateHandler{
+			FromAccountType: fromAccountType,
+			Handler: &SchemaResponse_Handler{
+				Request:  handler.RequestSchema.Name,
+				Response: handler.ResponseSchema.Name,
+			},
+		})
+	}
+	slices.SortFunc(schemas, func(a, b *SchemaResponse_MigrateHandler) int {
+		return strings.Compare(a.FromAccountType, b.FromAccountType)
+	})
+	return schemas
+}
+
 func makeHandlersSchema(handlers map[string]implementation.HandlerSchema) []*SchemaResponse_Handler {
 	schemas := make([]*SchemaResponse_Handler, 0, len(handlers))
 	for name, handler := range handlers {
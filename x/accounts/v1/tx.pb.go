@@ -282,6 +282,128 @@ func (m *MsgExecuteResponse) GetResponse() *types.Any {
 	return nil
 }
 
+// MsgMigrate defines the Migrate request type for the Msg/Migrate RPC method.
+type MsgMigrate struct {
+	// sender is the address of the sender of this message, it must be the
+	// account being migrated.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account_address is the address of the account to migrate.
+	AccountAddress string `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	// account_type is the account type to migrate the account to.
+	AccountType string `protobuf:"bytes,3,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	// message is the migration message sent to the migration handler of the
+	// new account type.
+	Message *types.Any `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *MsgMigrate) Reset()         { *m = MsgMigrate{} }
+func (m *MsgMigrate) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrate) ProtoMessage()    {}
+func (*MsgMigrate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{4}
+}
+func (m *MsgMigrate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrate.Merge(m, src)
+}
+func (m *MsgMigrate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrate proto.InternalMessageInfo
+
+func (m *MsgMigrate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrate) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *MsgMigrate) GetAccountType() string {
+	if m != nil {
+		return m.AccountType
+	}
+	return ""
+}
+
+func (m *MsgMigrate) GetMessage() *types.Any {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+// MsgMigrateResponse defines the Migrate response type for the Msg/Migrate RPC method.
+type MsgMigrateResponse struct {
+	// response is the response returned by the migration handler of the new
+	// account type.
+	Response *types.Any `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (m *MsgMigrateResponse) Reset()         { *m = MsgMigrateResponse{} }
+func (m *MsgMigrateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateResponse) ProtoMessage()    {}
+func (*MsgMigrateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{5}
+}
+func (m *MsgMigrateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateResponse.Merge(m, src)
+}
+func (m *MsgMigrateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateResponse) GetResponse() *types.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
 // MsgExecuteBundle defines the ExecuteBundle request type for the Msg/ExecuteBundle RPC method.
 type MsgExecuteBundle struct {
 	// bundler defines the entity going through the standard TX flow
@@ -295,7 +417,7 @@ func (m *MsgExecuteBundle) Reset()         { *m = MsgExecuteBundle{} }
 func (m *MsgExecuteBundle) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteBundle) ProtoMessage()    {}
 func (*MsgExecuteBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{4}
+	return fileDescriptor_29c2b6d8a13d4189, []int{6}
 }
 func (m *MsgExecuteBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,15 +462,24 @@ func (m *MsgExecuteBundle) GetTxs() []*tx.TxRaw {
 
 // BundledTxResponse defines the response of a bundled tx.
 type BundledTxResponse struct {
-	ExecResponses *types.Any `protobuf:"bytes,1,opt,name=exec_responses,json=execResponses,proto3" json:"exec_responses,omitempty"`
-	Error         string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// exec_responses are the responses of the messages of the bundled tx.
+	ExecResponses []*types.Any `protobuf:"bytes,1,rep,name=exec_responses,json=execResponses,proto3" json:"exec_responses,omitempty"`
+	// error is the error returned by the authentication or the execution of
+	// the bundled tx, it is empty if the bundled tx succeeded.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// authentication_gas_used is the gas consumed by the authentication of the
+	// bundled tx.
+	AuthenticationGasUsed uint64 `protobuf:"varint,3,opt,name=authentication_gas_used,json=authenticationGasUsed,proto3" json:"authentication_gas_used,omitempty"`
+	// execution_gas_used is the gas consumed by the execution of the messages
+	// of the bundled tx.
+	ExecutionGasUsed uint64 `protobuf:"varint,4,opt,name=execution_gas_used,json=executionGasUsed,proto3" json:"execution_gas_used,omitempty"`
 }
 
 func (m *BundledTxResponse) Reset()         { *m = BundledTxResponse{} }
 func (m *BundledTxResponse) String() string { return proto.CompactTextString(m) }
 func (*BundledTxResponse) ProtoMessage()    {}
 func (*BundledTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{5}
+	return fileDescriptor_29c2b6d8a13d4189, []int{7}
 }
 func (m *BundledTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,7 +508,7 @@ func (m *BundledTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BundledTxResponse proto.InternalMessageInfo
 
-func (m *BundledTxResponse) GetExecResponses() *types.Any {
+func (m *BundledTxResponse) GetExecResponses() []*types.Any {
 	if m != nil {
 		return m.ExecResponses
 	}
@@ -391,6 +522,20 @@ func (m *BundledTxResponse) GetError() string {
 	return ""
 }
 
+func (m *BundledTxResponse) GetAuthenticationGasUsed() uint64 {
+	if m != nil {
+		return m.AuthenticationGasUsed
+	}
+	return 0
+}
+
+func (m *BundledTxResponse) GetExecutionGasUsed() uint64 {
+	if m != nil {
+		return m.ExecutionGasUsed
+	}
+	return 0
+}
+
 // MsgExecuteBundleResponse defines the ExecuteBundle response type for the Msg/ExecuteBundle RPC method.
 type MsgExecuteBundleResponse struct {
 	// responses is the list of responses returned by the account implementations.
@@ -401,7 +546,7 @@ func (m *MsgExecuteBundleResponse) Reset()         { *m = MsgExecuteBundleRespon
 func (m *MsgExecuteBundleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteBundleResponse) ProtoMessage()    {}
 func (*MsgExecuteBundleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{6}
+	return fileDescriptor_29c2b6d8a13d4189, []int{8}
 }
 func (m *MsgExecuteBundleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,6 +587,8 @@ func init() {
 	proto.RegisterType((*MsgInitResponse)(nil), "cosmos.accounts.v1.MsgInitResponse")
 	proto.RegisterType((*MsgExecute)(nil), "cosmos.accounts.v1.MsgExecute")
 	proto.RegisterType((*MsgExecuteResponse)(nil), "cosmos.accounts.v1.MsgExecuteResponse")
+	proto.RegisterType((*MsgMigrate)(nil), "cosmos.accounts.v1.MsgMigrate")
+	proto.RegisterType((*MsgMigrateResponse)(nil), "cosmos.accounts.v1.MsgMigrateResponse")
 	proto.RegisterType((*MsgExecuteBundle)(nil), "cosmos.accounts.v1.MsgExecuteBundle")
 	proto.RegisterType((*BundledTxResponse)(nil), "cosmos.accounts.v1.BundledTxResponse")
 	proto.RegisterType((*MsgExecuteBundleResponse)(nil), "cosmos.accounts.v1.MsgExecuteBundleResponse")
@@ -450,46 +597,51 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/tx.proto", fileDescriptor_29c2b6d8a13d4189) }
 
 var fileDescriptor_29c2b6d8a13d4189 = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0xb6, 0xf9, 0x7a, 0xd3, 0x9f, 0x8f, 0x51, 0x55, 0x5c, 0x57, 0x72, 0x4b, 0xf8,
-	0x8b, 0x2a, 0x18, 0x37, 0x85, 0x55, 0x59, 0xb5, 0x15, 0x08, 0x16, 0x5d, 0x60, 0x75, 0xc5, 0xa6,
-	0xf2, 0xcf, 0x64, 0x88, 0xda, 0x78, 0x22, 0xdf, 0x71, 0x71, 0x76, 0x88, 0x07, 0x40, 0x3c, 0x07,
-	0xab, 0x3e, 0x46, 0x97, 0x5d, 0xb2, 0x40, 0x80, 0x1a, 0xa4, 0xbe, 0x06, 0xb2, 0x3d, 0xe3, 0x04,
-	0x4a, 0xa2, 0x2e, 0x59, 0x65, 0xe6, 0x9e, 0x73, 0xef, 0x9c, 0x73, 0xc6, 0x19, 0x58, 0x0b, 0x04,
-	0x76, 0x05, 0x3a, 0x5e, 0x10, 0x88, 0x24, 0x92, 0xe8, 0x9c, 0xb6, 0x1c, 0x99, 0xd2, 0x5e, 0x2c,
-	0xa4, 0x20, 0xa4, 0x00, 0xa9, 0x06, 0xe9, 0x69, 0xcb, 0x5a, 0xe5, 0x42, 0xf0, 0x13, 0xe6, 0xe4,
-	0x0c, 0x3f, 0x69, 0x3b, 0x5e, 0xd4, 0x2f, 0xe8, 0xd6, 0x6d, 0x35, 0xab, 0x8b, 0x3c, 0x1b, 0xd3,
-	0x45, 0xae, 0x00, 0x5b, 0x01, 0xbe, 0x87, 0xcc, 0x39, 0x6d, 0xf9, 0x4c, 0x7a, 0x2d, 0x27, 0x10,
-	0x9d, 0x48, 0xe1, 0x96, 0xc2, 0x65, 0x5a, 0xa2, 0x5a, 0x83, 0xb5, 0xcc, 0x05, 0x17, 0xf9, 0xd2,
-	0xc9, 0x56, 0x45, 0xb5, 0xf1, 0xd3, 0x80, 0xda, 0x01, 0xf2, 0x57, 0x51, 0x47, 0x92, 0x15, 0x98,
-	0x45, 0x16, 0x85, 0x2c, 0x36, 0x8d, 0x0d, 0xa3, 0x39, 0xe7, 0xaa, 0x1d, 0xb9, 0x03, 0xf3, 0x4a,
-	0xf8, 0x91, 0xec, 0xf7, 0x98, 0x39, 0x95, 0xa3, 0x75, 0x55, 0x3b, 0xec, 0xf7, 0x18, 0xa1, 0x50,
-	0xeb, 0x32, 0x44, 0x8f, 0x33, 0xb3, 0xba, 0x61, 0x34, 0xeb, 0xdb, 0xcb, 0xb4, 0xb0, 0x47, 0xb5,
-	0x3d, 0xba, 0x1b, 0xf5, 0x5d, 0x4d, 0x22, 0x1e, 0xcc, 0xb4, 0x93, 0x28, 0x44, 0x73, 0x7a, 0xa3,
-	0xda, 0xac, 0x6f, 0xaf, 0x52, 0x15, 0x50, 0x66, 0x8c, 0x2a, 0xe9, 0x74, 0x5f, 0x74, 0xa2, 0xbd,
-	0xad, 0xf3, 0x6f, 0xeb, 0x95, 0xcf, 0xdf, 0xd7, 0x9b, 0xbc, 0x23, 0xdf, 0x26, 0x3e, 0x0d, 0x44,
-	0xd7, 0x51, 0x2e, 0x8b, 0x9f, 0xc7, 0x18, 0x1e, 0x3b, 0x99, 0x2e, 0xcc, 0x1b, 0xd0, 0x2d, 0x26,
-	0xef, 0xd4, 0x3f, 0x5c, 0x9d, 0x6d, 0x2a, 0x0b, 0x8d, 0x13, 0x58, 0x52, 0x2e, 0x5d, 0x86, 0x3d,
-	0x11, 0x21, 0x23, 0x0f, 0x61, 0x49, 0xbb, 0xf2, 0xc2, 0x30, 0x66, 0x88, 0xca, 0xf6, 0xa2, 0x2a,
-	0xef, 0x16, 0x55, 0xb2, 0x05, 0xff, 0xc5, 0xaa, 0x29, 0xb7, 0x3e, 0xce, 0x5c, 0xc9, 0x6a, 0x7c,
-	0x35, 0x00, 0x0e, 0x90, 0x3f, 0x4f, 0x59, 0x90, 0x48, 0x36, 0x36, 0xd7, 0x15, 0x98, 0x95, 0x5e,
-	0xcc, 0x99, 0x54, 0x89, 0xaa, 0xdd, 0x3f, 0x1f, 0xe6, 0x0b, 0x20, 0x43, 0x77, 0x65, 0x9e, 0xa3,
-	0x31, 0x19, 0x37, 0x8a, 0xa9, 0x0d, 0xff, 0x0f, 0xe7, 0xec, 0x25, 0x51, 0x78, 0xc2, 0x88, 0x09,
-	0x35, 0x3f, 0x5f, 0xe9, 0xb0, 0xf4, 0x96, 0x6c, 0x42, 0x55, 0xa6, 0x68, 0x4e, 0xe5, 0x1e, 0x4d,
-	0xed, 0x51, 0xa6, 0xa5, 0xc3, 0xc3, 0xd4, 0xf5, 0xde, 0xb9, 0x19, 0x69, 0x67, 0x3e, 0x93, 0xab,
-	0x3b, 0x1b, 0x6d, 0xb8, 0x55, 0x4c, 0x0f, 0x0f, 0xd3, 0x52, 0xee, 0x33, 0x58, 0x64, 0x29, 0x0b,
-	0x8e, 0xb4, 0x1a, 0x9c, 0x28, 0x7a, 0x21, 0xe3, 0xea, 0x5e, 0x24, 0xcb, 0x30, 0xc3, 0xe2, 0x58,
-	0xc4, 0xea, 0xe2, 0x8a, 0x4d, 0xe3, 0x08, 0xcc, 0x3f, 0xfd, 0x94, 0xc7, 0xed, 0xc3, 0xdc, 0xe8,
-	0x49, 0x99, 0x87, 0xfb, 0xf4, 0xfa, 0xab, 0x40, 0xaf, 0x09, 0x75, 0x87, 0x7d, 0xdb, 0x1f, 0xa7,
-	0xa0, 0x7a, 0x80, 0x9c, 0xbc, 0x84, 0xe9, 0xfc, 0x0f, 0xbb, 0xf6, 0xb7, 0x09, 0xea, 0x3b, 0xb7,
-	0xee, 0x4e, 0x00, 0x4b, 0x59, 0xaf, 0xa1, 0xa6, 0xbf, 0x52, 0x7b, 0x0c, 0x5f, 0xe1, 0xd6, 0x83,
-	0xc9, 0x78, 0x39, 0x32, 0x80, 0x85, 0xdf, 0xaf, 0xf4, 0xde, 0xe4, 0xc6, 0x82, 0x65, 0x3d, 0xba,
-	0x09, 0x4b, 0x1f, 0x62, 0xcd, 0xbc, 0xbf, 0x3a, 0xdb, 0x34, 0xf6, 0x9e, 0x9e, 0x5f, 0xda, 0xc6,
-	0xc5, 0xa5, 0x6d, 0xfc, 0xb8, 0xb4, 0x8d, 0x4f, 0x03, 0xbb, 0x72, 0x31, 0xb0, 0x2b, 0x5f, 0x06,
-	0x76, 0xe5, 0x8d, 0x7a, 0x09, 0x31, 0x3c, 0xa6, 0x1d, 0xe1, 0xa4, 0xa3, 0xcf, 0xb2, 0x3f, 0x9b,
-	0x5f, 0xed, 0x93, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x6a, 0x98, 0xb0, 0xb3, 0x05, 0x00,
-	0x00,
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x4f, 0xdb, 0x30,
+	0x18, 0x6e, 0xda, 0x42, 0x87, 0xcb, 0xd7, 0x2c, 0x06, 0x21, 0x48, 0x81, 0x75, 0x1b, 0xab, 0x10,
+	0x73, 0x28, 0x9b, 0x76, 0x60, 0x27, 0x40, 0xfb, 0x3a, 0xf4, 0xb0, 0x88, 0x5d, 0x76, 0xa9, 0xdc,
+	0xc4, 0x98, 0x08, 0x1a, 0x57, 0xb1, 0xc3, 0xd2, 0xdb, 0xb4, 0x5f, 0xb0, 0xdf, 0x31, 0x69, 0x12,
+	0x3f, 0x83, 0xc3, 0x0e, 0x1c, 0x77, 0x98, 0xf6, 0x01, 0x93, 0xf8, 0x1b, 0x53, 0x12, 0x3b, 0x7c,
+	0xb5, 0x51, 0xa5, 0x5d, 0x76, 0xc2, 0xf6, 0xf3, 0xbc, 0x2f, 0xcf, 0xf3, 0xbc, 0xae, 0x03, 0x16,
+	0x1c, 0xc6, 0x3b, 0x8c, 0x5b, 0xd8, 0x71, 0x58, 0xe8, 0x0b, 0x6e, 0x1d, 0x36, 0x2c, 0x11, 0xa1,
+	0x6e, 0xc0, 0x04, 0x83, 0x30, 0x05, 0x91, 0x02, 0xd1, 0x61, 0xc3, 0x98, 0xa7, 0x8c, 0xd1, 0x03,
+	0x62, 0x25, 0x8c, 0x76, 0xb8, 0x6b, 0x61, 0xbf, 0x97, 0xd2, 0x8d, 0x39, 0xd9, 0xab, 0xc3, 0x69,
+	0xdc, 0xa6, 0xc3, 0xa9, 0x04, 0x4c, 0x09, 0xb4, 0x31, 0x27, 0xd6, 0x61, 0xa3, 0x4d, 0x04, 0x6e,
+	0x58, 0x0e, 0xf3, 0x7c, 0x89, 0x1b, 0x12, 0x17, 0x51, 0x86, 0x2a, 0x0d, 0xc6, 0x0c, 0x65, 0x94,
+	0x25, 0x4b, 0x2b, 0x5e, 0xa5, 0xa7, 0xb5, 0x3f, 0x1a, 0xa8, 0x34, 0x39, 0x7d, 0xed, 0x7b, 0x02,
+	0xce, 0x82, 0x51, 0x4e, 0x7c, 0x97, 0x04, 0xba, 0xb6, 0xa4, 0xd5, 0xc7, 0x6c, 0xb9, 0x83, 0x77,
+	0xc1, 0xb8, 0x14, 0xde, 0x12, 0xbd, 0x2e, 0xd1, 0x8b, 0x09, 0x5a, 0x95, 0x67, 0x3b, 0xbd, 0x2e,
+	0x81, 0x08, 0x54, 0x3a, 0x84, 0x73, 0x4c, 0x89, 0x5e, 0x5a, 0xd2, 0xea, 0xd5, 0xf5, 0x19, 0x94,
+	0xda, 0x43, 0xca, 0x1e, 0xda, 0xf4, 0x7b, 0xb6, 0x22, 0x41, 0x0c, 0x46, 0x76, 0x43, 0xdf, 0xe5,
+	0x7a, 0x79, 0xa9, 0x54, 0xaf, 0xae, 0xcf, 0x23, 0x19, 0x50, 0x6c, 0x0c, 0x49, 0xe9, 0x68, 0x9b,
+	0x79, 0xfe, 0xd6, 0xda, 0xf1, 0x8f, 0xc5, 0xc2, 0xe7, 0x9f, 0x8b, 0x75, 0xea, 0x89, 0xbd, 0xb0,
+	0x8d, 0x1c, 0xd6, 0xb1, 0xa4, 0xcb, 0xf4, 0xcf, 0x23, 0xee, 0xee, 0x5b, 0xb1, 0x2e, 0x9e, 0x14,
+	0x70, 0x3b, 0xed, 0xbc, 0x51, 0xfd, 0x78, 0x7e, 0xb4, 0x22, 0x2d, 0xd4, 0x0e, 0xc0, 0x94, 0x74,
+	0x69, 0x13, 0xde, 0x65, 0x3e, 0x27, 0xf0, 0x21, 0x98, 0x52, 0xae, 0xb0, 0xeb, 0x06, 0x84, 0x73,
+	0x69, 0x7b, 0x52, 0x1e, 0x6f, 0xa6, 0xa7, 0x70, 0x0d, 0xdc, 0x0a, 0x64, 0x91, 0x5e, 0xcc, 0x31,
+	0x97, 0xb1, 0x6a, 0xdf, 0x35, 0x00, 0x9a, 0x9c, 0x3e, 0x8f, 0x88, 0x13, 0x0a, 0x32, 0x30, 0xd7,
+	0x59, 0x30, 0x2a, 0x70, 0x40, 0x89, 0x90, 0x89, 0xca, 0xdd, 0x7f, 0x1f, 0xe6, 0x0b, 0x00, 0x2f,
+	0xdc, 0x65, 0x79, 0x5e, 0x8e, 0x49, 0x1b, 0x2a, 0xa6, 0x2f, 0x69, 0x4c, 0x4d, 0x8f, 0x06, 0x38,
+	0x27, 0xa6, 0x3e, 0x83, 0x2a, 0xf6, 0x1d, 0xd4, 0xf5, 0x7b, 0x5a, 0xca, 0xbd, 0xa7, 0xe5, 0x21,
+	0xa2, 0xed, 0xe7, 0x5b, 0xca, 0xfd, 0x07, 0xdf, 0xbb, 0x60, 0xfa, 0x22, 0xbf, 0xad, 0xd0, 0x77,
+	0x0f, 0x08, 0xd4, 0x41, 0xa5, 0x9d, 0xac, 0x94, 0x7b, 0xb5, 0x85, 0x2b, 0xa0, 0x24, 0xa2, 0xd8,
+	0x72, 0x3c, 0x5b, 0x5d, 0xcd, 0x56, 0x44, 0xd9, 0x64, 0x77, 0x22, 0x1b, 0xbf, 0xb7, 0x63, 0xd2,
+	0xc6, 0x78, 0x2c, 0x57, 0x55, 0xd6, 0xbe, 0x6a, 0xe0, 0x76, 0xda, 0xde, 0xdd, 0x89, 0x32, 0xbd,
+	0xcf, 0xc0, 0x24, 0x89, 0x88, 0xd3, 0x52, 0x72, 0xe2, 0x6b, 0x5f, 0x1a, 0xa8, 0x7a, 0x22, 0xe6,
+	0xaa, 0x5a, 0x0e, 0x67, 0xc0, 0x08, 0x09, 0x02, 0x16, 0xc8, 0x09, 0xa4, 0x1b, 0xf8, 0x14, 0xcc,
+	0xe1, 0x50, 0xec, 0x11, 0x5f, 0x78, 0x0e, 0x16, 0x1e, 0xf3, 0x5b, 0x14, 0xf3, 0x56, 0xc8, 0x89,
+	0x9b, 0xcc, 0xa0, 0x6c, 0xdf, 0xb9, 0x0a, 0xbf, 0xc4, 0xfc, 0x2d, 0x27, 0x2e, 0x5c, 0x05, 0x90,
+	0x24, 0x29, 0x5c, 0x29, 0x29, 0x27, 0x25, 0xd3, 0x19, 0x22, 0xd9, 0xb5, 0x16, 0xd0, 0xaf, 0xc7,
+	0x96, 0x99, 0xda, 0x06, 0x63, 0xd7, 0xfd, 0x3c, 0x40, 0x37, 0x1f, 0x5d, 0x74, 0x23, 0x0e, 0xfb,
+	0xa2, 0x6e, 0xfd, 0x77, 0x11, 0x94, 0x9a, 0x9c, 0xc2, 0x57, 0xa0, 0x9c, 0xbc, 0x87, 0x0b, 0xfd,
+	0x3a, 0xc8, 0x67, 0xc4, 0xb8, 0x97, 0x03, 0x66, 0xb2, 0xde, 0x80, 0x8a, 0x7a, 0x04, 0xcc, 0x01,
+	0x7c, 0x89, 0x1b, 0xcb, 0xf9, 0xf8, 0xe5, 0x96, 0xea, 0x07, 0x33, 0xa8, 0xa5, 0xc4, 0x8d, 0xe5,
+	0x7c, 0x3c, 0x6b, 0xe9, 0x80, 0x89, 0xab, 0x97, 0xf1, 0x7e, 0xbe, 0x96, 0x94, 0x65, 0xac, 0x0e,
+	0xc3, 0x52, 0xff, 0xc4, 0x18, 0xf9, 0x70, 0x7e, 0xb4, 0xa2, 0x6d, 0x3d, 0x39, 0x3e, 0x35, 0xb5,
+	0x93, 0x53, 0x53, 0xfb, 0x75, 0x6a, 0x6a, 0x9f, 0xce, 0xcc, 0xc2, 0xc9, 0x99, 0x59, 0xf8, 0x76,
+	0x66, 0x16, 0xde, 0xc9, 0x6f, 0x17, 0x77, 0xf7, 0x91, 0xc7, 0xac, 0xe8, 0xf2, 0x87, 0xb4, 0x3d,
+	0x9a, 0xdc, 0xc9, 0xc7, 0x7f, 0x07, 0x00, 0x45, 0x26, 0xf7, 0xfd, 0x65, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -508,6 +660,11 @@ type MsgClient interface {
 	Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOption) (*MsgInitResponse, error)
 	// Execute executes a message to the target account.
 	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
+	// Migrate migrates an account to a new account type, keeping its address,
+	// account number and state.
+	//
+	// Since: cosmos-sdk 0.51
+	Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error)
@@ -539,6 +696,15 @@ func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error) {
+	out := new(MsgMigrateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error) {
 	out := new(MsgExecuteBundleResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/ExecuteBundle", in, out, opts...)
@@ -554,6 +720,11 @@ type MsgServer interface {
 	Init(context.Context, *MsgInit) (*MsgInitResponse, error)
 	// Execute executes a message to the target account.
 	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
+	// Migrate migrates an account to a new account type, keeping its address,
+	// account number and state.
+	//
+	// Since: cosmos-sdk 0.51
+	Migrate(context.Context, *MsgMigrate) (*MsgMigrateResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error)
@@ -569,6 +740,9 @@ func (*UnimplementedMsgServer) Init(ctx context.Context, req *MsgInit) (*MsgInit
 func (*UnimplementedMsgServer) Execute(ctx context.Context, req *MsgExecute) (*MsgExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
+func (*UnimplementedMsgServer) Migrate(ctx context.Context, req *MsgMigrate) (*MsgMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
 func (*UnimplementedMsgServer) ExecuteBundle(ctx context.Context, req *MsgExecuteBundle) (*MsgExecuteBundleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBundle not implemented")
 }
@@ -613,6 +787,24 @@ func _Msg_Execute_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accounts.v1.Msg/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Migrate(ctx, req.(*MsgMigrate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ExecuteBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecuteBundle)
 	if err := dec(in); err != nil {
@@ -643,6 +835,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Execute",
 			Handler:    _Msg_Execute_Handler,
 		},
+		{
+			MethodName: "Migrate",
+			Handler:    _Msg_Migrate_Handler,
+		},
 		{
 			MethodName: "ExecuteBundle",
 			Handler:    _Msg_ExecuteBundle_Handler,
@@ -855,6 +1051,97 @@ func (m *MsgExecuteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExecuteBundle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -919,6 +1206,16 @@ func (m *BundledTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionGasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionGasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuthenticationGasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuthenticationGasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
@@ -926,17 +1223,19 @@ func (m *BundledTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ExecResponses != nil {
-		{
-			size, err := m.ExecResponses.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ExecResponses) > 0 {
+		for iNdEx := len(m.ExecResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -1073,6 +1372,44 @@ func (m *MsgExecuteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgExecuteBundle) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1098,14 +1435,22 @@ func (m *BundledTxResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.ExecResponses != nil {
-		l = m.ExecResponses.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExecResponses) > 0 {
+		for _, e := range m.ExecResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	l = len(m.Error)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.AuthenticationGasUsed != 0 {
+		n += 1 + sovTx(uint64(m.AuthenticationGasUsed))
+	}
+	if m.ExecutionGasUsed != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionGasUsed))
+	}
 	return n
 }
 
@@ -1702,7 +2047,7 @@ func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,10 +2070,278 @@ func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteBundle: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &types.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteBundle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1876,10 +2489,8 @@ func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecResponses == nil {
-				m.ExecResponses = &types.Any{}
-			}
-			if err := m.ExecResponses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExecResponses = append(m.ExecResponses, &types.Any{})
+			if err := m.ExecResponses[len(m.ExecResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1915,6 +2526,44 @@ func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationGasUsed", wireType)
+			}
+			m.AuthenticationGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticationGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasUsed", wireType)
+			}
+			m.ExecutionGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18,6 +18,7 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -57,7 +58,9 @@ type AccountAbstractionKeeper interface {
 // SigVerificationDecorator verifies all signatures for a tx and returns an
 // error if any are invalid.
 // It will populate an account's public key if that is not present only if
-// PubKey.Address() == Account.Address().
+// PubKey.Address() == Account.Address(). Once set, signatures are always verified
+// against the account's public key, which may differ from the one deriving the
+// account address if the account rotated its key (see MsgRotatePubKey).
 // Note, the SigVerificationDecorator will not check
 // signatures on ReCheckTx. It will also increase the sequence number, and consume
 // gas for signature verification.
@@ -246,6 +249,12 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 		newlyCreated = true
 	}
 
+	// the account already has a pubkey, which may have been rotated: the pubkey
+	// provided in the tx, if any, must be the current one.
+	if accPubKey := acc.GetPubKey(); accPubKey != nil && txPubKey != nil && ctx.ExecMode() != sdk.ExecModeSimulate && !accPubKey.Equals(txPubKey) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "pubkey provided in tx does not match the pubkey of account %s; it may have been rotated", acc.GetAddress())
+	}
+
 	// the account is without a pubkey, let's attempt to check if in the
 	// tx we were correctly provided a valid pubkey.
 	if acc.GetPubKey() == nil {
@@ -501,6 +510,10 @@ func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.Si
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *bls12_381.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostBls12381(), "ante verify: bls12_381")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
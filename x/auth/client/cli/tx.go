@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/auth transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRotatePubKeyCmd(),
+	)
+
+	return txCmd
+}
+
+// NewRotatePubKeyCmd returns a CLI command handler for creating a MsgRotatePubKey transaction.
+func NewRotatePubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-pubkey [new_key_name]",
+		Short: "Replace the public key of the --from account with the public key of another key",
+		Long: `Replace the public key of the --from account with the public key of [new_key_name].
+The transaction is signed with the current key of the account, while [new_key_name]
+signs a proof of possession of the new key. The account keeps its address, account
+number, balances, delegations and grants.
+
+After the rotation, transactions of the account must be signed with [new_key_name].
+As the address of [new_key_name] differs from the account address, generate them with
+--generate-only and sign them with 'tx sign --from [new_key_name] --offline', providing
+the account number and sequence of the rotated account.`,
+		Example: fmt.Sprintf("%s tx auth rotate-pubkey new-key --from old-key", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			newKey, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+
+			newPubKey, err := newKey.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			address, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			acc, err := clientCtx.AccountRetriever.GetAccount(clientCtx, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			signBytes := types.RotatePubKeySignBytes(clientCtx.ChainID, address, acc.GetAccountNumber(), acc.GetPubKey())
+			proof, _, err := clientCtx.Keyring.Sign(args[0], signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotatePubKey(address, newPubKey, proof)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,372 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	authclient "cosmossdk.io/x/auth/client"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// MultisigTxStatus reports the members of a multisig which signed a pending
+// multisig transaction.
+type MultisigTxStatus struct {
+	Multisig  string   `json:"multisig"`
+	Threshold uint32   `json:"threshold"`
+	Signed    []string `json:"signed"`
+	Missing   []string `json:"missing"`
+	Complete  bool     `json:"complete"`
+}
+
+// GetMultisigTxCommand returns the command coordinating the signature of a
+// transaction by the members of a multisig.
+func GetMultisigTxCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig-tx",
+		Short: "Coordinate the signature of a transaction by the members of a multisig",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Coordinate the signature of a transaction by the members of a multisig
+through a bundle file holding the unsigned transaction and the signatures collected so far.
+
+The bundle file is passed around between the members, possibly offline, each member adding
+its signature. Once the threshold of the multisig is met, the signatures are assembled into
+the multisig signature and the transaction is broadcast, or printed with --offline.
+
+Example:
+$ %[1]s tx bank send k1k2k3 cosmos1... 10stake --generate-only > tx.json
+$ %[1]s tx multisig-tx create k1k2k3 tx.json --output-document bundle.json
+$ %[1]s tx multisig-tx sign bundle.json --from k1
+$ %[1]s tx multisig-tx add-signatures bundle.json k2sig.json
+$ %[1]s tx multisig-tx status bundle.json
+
+The multisig transactions are signed in the amino-json sign mode.
+`, version.AppName),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetMultisigTxCreateCommand(),
+		GetMultisigTxSignCommand(),
+		GetMultisigTxAddSignaturesCommand(),
+		GetMultisigTxStatusCommand(),
+	)
+
+	return cmd
+}
+
+// GetMultisigTxCreateCommand returns the command creating a pending multisig transaction.
+func GetMultisigTxCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [multisig] [file]",
+		Short: "Create a multisig transaction bundle pending the signatures of the members of the multisig",
+		Long: `Create a bundle holding the unsigned transaction read from [file], created with the
+--generate-only flag, and pending the signatures of the members of the multisig key [multisig].
+
+If the --offline flag is on, the account number and sequence of the multisig are not queried
+and must be set with the --account-number and --sequence flags.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			addr, name, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			k, err := clientCtx.Keyring.Key(name)
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := k.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+			if !ok {
+				return fmt.Errorf("%s is not a multisig key", args[0])
+			}
+
+			if !clientCtx.Offline {
+				accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+				if err != nil {
+					return err
+				}
+
+				txFactory = txFactory.WithAccountNumber(accNum).WithSequence(seq)
+			}
+
+			parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			unsignedTx, ok := parsedTx.(authsigning.Tx)
+			if !ok {
+				return fmt.Errorf("expected %T, got %T", (authsigning.Tx)(nil), parsedTx)
+			}
+
+			multisigTx, err := tx.NewMultisigTx(txFactory.ChainID(), txFactory.AccountNumber(), txFactory.Sequence(), multisigPub, unsignedTx)
+			if err != nil {
+				return err
+			}
+
+			bz, err := tx.MarshalMultisigTxJSON(clientCtx, multisigTx)
+			if err != nil {
+				return err
+			}
+
+			closeFunc, err := setOutputFile(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFunc()
+
+			cmd.Printf("%s\n", bz)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The bundle is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+// GetMultisigTxSignCommand returns the command signing a pending multisig transaction.
+func GetMultisigTxSignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [bundle]",
+		Short: "Sign a multisig transaction bundle with the --from member of the multisig",
+		Long: `Sign the transaction of the bundle read from [bundle] with the --from key, which must be
+a member of the multisig, and add the signature to the bundle file.
+
+Once the threshold of the multisig is met, the transaction is assembled and broadcast. If the
+--offline flag is on, the signed transaction is printed instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			multisigTx, err := readMultisigTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := multisigTx.Sign(cmd.Context(), clientCtx.TxConfig, clientCtx.Keyring, clientCtx.FromName); err != nil {
+				return err
+			}
+
+			return updateMultisigTx(cmd, clientCtx, args[0], multisigTx)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signed transaction is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMultisigTxAddSignaturesCommand returns the command adding signatures to a
+// pending multisig transaction.
+func GetMultisigTxAddSignaturesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-signatures [bundle] [signature]...",
+		Short: "Add signatures of members of the multisig to a multisig transaction bundle",
+		Long: `Add the signatures read from the [signature] files to the bundle file read from [bundle].
+The signature files are created with 'tx sign --multisig --signature-only'. Each signature is
+checked against the members of the multisig and the transaction of the bundle.
+
+Once the threshold of the multisig is met, the transaction is assembled and broadcast. If the
+--offline flag is on, the signed transaction is printed instead.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			multisigTx, err := readMultisigTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, file := range args[1:] {
+				sigs, err := unmarshalSignatureJSON(clientCtx, file)
+				if err != nil {
+					return err
+				}
+
+				for _, sig := range sigs {
+					if err := multisigTx.AddSignature(cmd.Context(), clientCtx.TxConfig, sig); err != nil {
+						return err
+					}
+				}
+			}
+
+			return updateMultisigTx(cmd, clientCtx, args[0], multisigTx)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signed transaction is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMultisigTxStatusCommand returns the command reporting the members which
+// signed a pending multisig transaction.
+func GetMultisigTxStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [bundle]",
+		Short: "Report the members of the multisig which signed a multisig transaction bundle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			multisigTx, err := readMultisigTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return printMultisigTxStatus(clientCtx, multisigTx)
+		},
+	}
+
+	flags.AddKeyringFlags(cmd.Flags())
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+func readMultisigTx(clientCtx client.Context, file string) (*tx.MultisigTx, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.UnmarshalMultisigTxJSON(clientCtx, bz)
+}
+
+// updateMultisigTx writes the multisig transaction back to its bundle file.
+// Once the threshold of the multisig is met, it assembles the signed
+// transaction and broadcasts it, or prints it when offline.
+func updateMultisigTx(cmd *cobra.Command, clientCtx client.Context, file string, multisigTx *tx.MultisigTx) error {
+	bz, err := tx.MarshalMultisigTxJSON(clientCtx, multisigTx)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(file, bz, 0o644); err != nil {
+		return err
+	}
+
+	if !multisigTx.HasThreshold() {
+		return printMultisigTxStatus(clientCtx, multisigTx)
+	}
+
+	signedTx, err := multisigTx.BuildSignedTx(clientCtx.TxConfig)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.Offline || clientCtx.GenerateOnly {
+		json, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+		if err != nil {
+			return err
+		}
+
+		closeFunc, err := setOutputFile(cmd)
+		if err != nil {
+			return err
+		}
+		defer closeFunc()
+
+		cmd.Printf("%s\n", json)
+		return nil
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(signedTx)
+	if err != nil {
+		return err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+func printMultisigTxStatus(clientCtx client.Context, multisigTx *tx.MultisigTx) error {
+	multisig, err := clientCtx.AddressCodec.BytesToString(multisigTx.PubKey.Address())
+	if err != nil {
+		return err
+	}
+
+	signed, missing := multisigTx.Signers()
+	status := MultisigTxStatus{
+		Multisig:  multisig,
+		Threshold: multisigTx.PubKey.Threshold,
+		Complete:  multisigTx.HasThreshold(),
+	}
+
+	if status.Signed, err = addressStrings(clientCtx, signed); err != nil {
+		return err
+	}
+
+	if status.Missing, err = addressStrings(clientCtx, missing); err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintRaw(bz)
+}
+
+func addressStrings(clientCtx client.Context, pubKeys []cryptotypes.PubKey) ([]string, error) {
+	addrs := make([]string, len(pubKeys))
+	for i, pk := range pubKeys {
+		addr, err := clientCtx.AddressCodec.BytesToString(sdk.AccAddress(pk.Address()))
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addr
+	}
+
+	return addrs, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,6 +36,16 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, entry := range data.PubKeyHistory {
+		addr, err := ak.addressCodec.StringToBytes(entry.Address)
+		if err != nil {
+			return err
+		}
+		if err := ak.PubKeyHistory.Set(ctx, collections.Join(sdk.AccAddress(addr), entry.RotatedAtHeight), entry); err != nil {
+			return err
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 	return nil
 }
@@ -52,5 +63,14 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	err = ak.PubKeyHistory.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, int64], entry types.PubKeyHistoryEntry) (stop bool, err error) {
+		genState.PubKeyHistory = append(genState.PubKeyHistory, entry)
+		return false, nil
+	})
+	return genState, err
 }
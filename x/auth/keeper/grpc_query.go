@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -247,3 +248,34 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// PubKeyHistory returns the previous public keys of an account.
+func (s queryServer) PubKeyHistory(ctx context.Context, req *types.QueryPubKeyHistoryRequest) (*types.QueryPubKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	history, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.PubKeyHistory,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, int64], value types.PubKeyHistoryEntry) (types.PubKeyHistoryEntry, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, int64](addr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPubKeyHistoryResponse{History: history, Pagination: pageRes}, nil
+}
@@ -100,6 +100,8 @@ type AccountKeeper struct {
 	AccountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// PubKeyHistory key: AccAddr+RotationHeight | value: PubKeyHistoryEntry
+	PubKeyHistory collections.Map[collections.Pair[sdk.AccAddress, int64], types.PubKeyHistoryEntry]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -132,6 +134,7 @@ func NewAccountKeeper(
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		PubKeyHistory: collections.NewMap(sb, types.PubKeyHistoryPrefix, "pub_key_history", collections.PairKeyCodec(sdk.AccAddressKey, collections.Int64Key), codec.CollValue[types.PubKeyHistoryEntry](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
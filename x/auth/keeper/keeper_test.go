@@ -1,12 +1,16 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/runtime/protoiface"
 
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/router"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth"
@@ -40,6 +44,7 @@ type KeeperTestSuite struct {
 
 	ctx sdk.Context
 
+	msgRouter     *mockMsgRouter
 	queryClient   types.QueryClient
 	accountKeeper keeper.AccountKeeper
 	msgServer     types.MsgServer
@@ -51,7 +56,10 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
-	env := runtime.NewEnvironment(storeService, log.NewNopLogger())
+	suite.msgRouter = &mockMsgRouter{}
+	env := runtime.NewEnvironment(storeService, log.NewNopLogger(), func(env *appmodule.Environment) {
+		env.RouterService = mockRouterService{msgRouter: suite.msgRouter}
+	})
 	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
 	suite.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{})
 
@@ -232,3 +240,35 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
 }
+
+// mockRouterService routes the messages sent by the keeper to a mockMsgRouter.
+type mockRouterService struct {
+	msgRouter *mockMsgRouter
+}
+
+func (m mockRouterService) QueryRouterService() router.Router {
+	return nil
+}
+
+func (m mockRouterService) MessageRouterService() router.Router {
+	return m.msgRouter
+}
+
+// mockMsgRouter records the messages invoked by the keeper, and fails them with err when set.
+type mockMsgRouter struct {
+	msgs []protoiface.MessageV1
+	err  error
+}
+
+func (m *mockMsgRouter) CanInvoke(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockMsgRouter) InvokeTyped(_ context.Context, req, _ protoiface.MessageV1) error {
+	m.msgs = append(m.msgs, req)
+	return m.err
+}
+
+func (m *mockMsgRouter) InvokeUntyped(ctx context.Context, req protoiface.MessageV1) (protoiface.MessageV1, error) {
+	return nil, m.InvokeTyped(ctx, req, nil)
+}
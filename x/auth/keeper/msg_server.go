@@ -4,7 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	protocolpoolv1 "cosmossdk.io/api/cosmos/protocolpool/v1"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -37,3 +43,46 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) RotatePubKey(ctx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	newPubKey, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "expecting cryptotypes.PubKey, got %T", msg.NewPubKey.GetCachedValue())
+	}
+
+	acc := ms.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	// the new key must prove that its owner consents to be bound to this account.
+	chainID := ms.ak.environment.HeaderService.GetHeaderInfo(ctx).ChainID
+	signBytes := types.RotatePubKeySignBytes(chainID, msg.Address, acc.GetAccountNumber(), acc.GetPubKey())
+	if !newPubKey.VerifySignature(signBytes, msg.NewPubKeyProof) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid proof of possession of the new pubkey")
+	}
+
+	if err := ms.ak.RotatePubKey(ctx, addr, newPubKey); err != nil {
+		return nil, err
+	}
+
+	if fee := ms.ak.GetParams(ctx).PubKeyRotationFee; !fee.IsZero() {
+		amount := make([]*basev1beta1.Coin, len(fee))
+		for i, coin := range fee {
+			amount[i] = &basev1beta1.Coin{Denom: coin.Denom, Amount: coin.Amount.String()}
+		}
+		if err := ms.ak.environment.RouterService.MessageRouterService().InvokeTyped(ctx, &protocolpoolv1.MsgFundCommunityPool{
+			Depositor: msg.Address,
+			Amount:    amount,
+		}, &protocolpoolv1.MsgFundCommunityPoolResponse{}); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to pay pubkey rotation fee")
+		}
+	}
+
+	return &types.MsgRotatePubKeyResponse{}, nil
+}
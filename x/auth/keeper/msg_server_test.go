@@ -1,7 +1,16 @@
 package keeper_test
 
 import (
+	"errors"
+	"time"
+
+	protocolpoolv1 "cosmossdk.io/api/cosmos/protocolpool/v1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -109,3 +118,122 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRotatePubKey() {
+	oldPrivKey := secp256k1.GenPrivKey()
+	newPrivKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(oldPrivKey.PubKey().Address())
+
+	acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.Require().NoError(acc.SetPubKey(oldPrivKey.PubKey()))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	s.Require().NoError(s.accountKeeper.Params.Set(s.ctx, types.DefaultParams()))
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Unix(1000, 0), ChainID: "test-chain"})
+	signBytes := types.RotatePubKeySignBytes("test-chain", addr.String(), acc.GetAccountNumber(), oldPrivKey.PubKey())
+
+	validProof, err := newPrivKey.Sign(signBytes)
+	s.Require().NoError(err)
+	invalidProof, err := oldPrivKey.Sign(signBytes)
+	s.Require().NoError(err)
+
+	msg, err := types.NewMsgRotatePubKey(addr.String(), newPrivKey.PubKey(), invalidProof)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotatePubKey(ctx, msg)
+	s.Require().ErrorContains(err, "invalid proof of possession")
+
+	msg, err = types.NewMsgRotatePubKey(addr.String(), newPrivKey.PubKey(), validProof)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotatePubKey(ctx, msg)
+	s.Require().NoError(err)
+
+	// the account keeps its address but uses the new key.
+	pk, err := s.accountKeeper.GetPubKey(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().True(pk.Equals(newPrivKey.PubKey()))
+
+	// the previous key is kept in history.
+	res, err := s.queryClient.PubKeyHistory(ctx, &types.QueryPubKeyHistoryRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.History, 1)
+	s.Require().Equal(int64(10), res.History[0].RotatedAtHeight)
+	entry, err := s.accountKeeper.PubKeyHistory.Get(ctx, collections.Join(addr, int64(10)))
+	s.Require().NoError(err)
+	s.Require().True(entry.CryptoPubKey().Equals(oldPrivKey.PubKey()))
+
+	// rotating again is rate limited.
+	thirdPrivKey := secp256k1.GenPrivKey()
+	signBytes = types.RotatePubKeySignBytes("test-chain", addr.String(), acc.GetAccountNumber(), newPrivKey.PubKey())
+	proof, err := thirdPrivKey.Sign(signBytes)
+	s.Require().NoError(err)
+	msg, err = types.NewMsgRotatePubKey(addr.String(), thirdPrivKey.PubKey(), proof)
+	s.Require().NoError(err)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Unix(2000, 0), ChainID: "test-chain"})
+	_, err = s.msgServer.RotatePubKey(ctx, msg)
+	s.Require().ErrorContains(err, "rate limited")
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 12, Time: time.Unix(1000, 0).Add(types.DefaultPubKeyRotationCooldown), ChainID: "test-chain"})
+	_, err = s.msgServer.RotatePubKey(ctx, msg)
+	s.Require().NoError(err)
+
+	// genesis round trip keeps the history and validates the rotated account.
+	genState, err := s.accountKeeper.ExportGenesis(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(genState.PubKeyHistory, 2)
+	s.Require().NoError(types.ValidateGenesis(*genState))
+}
+
+func (s *KeeperTestSuite) TestRotatePubKeyFee() {
+	privKeys := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	addr := sdk.AccAddress(privKeys[0].PubKey().Address())
+
+	acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.Require().NoError(acc.SetPubKey(privKeys[0].PubKey()))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	params := types.DefaultParams()
+	params.PubKeyRotationFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.Require().NoError(s.accountKeeper.Params.Set(s.ctx, params))
+
+	rotate := func(ctx sdk.Context, oldPrivKey, newPrivKey *secp256k1.PrivKey) error {
+		signBytes := types.RotatePubKeySignBytes("test-chain", addr.String(), acc.GetAccountNumber(), oldPrivKey.PubKey())
+		proof, err := newPrivKey.Sign(signBytes)
+		s.Require().NoError(err)
+		msg, err := types.NewMsgRotatePubKey(addr.String(), newPrivKey.PubKey(), proof)
+		s.Require().NoError(err)
+		_, err = s.msgServer.RotatePubKey(ctx, msg)
+		return err
+	}
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Unix(1000, 0), ChainID: "test-chain"})
+
+	// the rotation fails if the fee cannot be paid.
+	s.msgRouter.err = errors.New("insufficient funds")
+	cacheCtx, _ := ctx.CacheContext()
+	s.Require().ErrorContains(rotate(cacheCtx, privKeys[0], privKeys[1]), "failed to pay pubkey rotation fee")
+	pk, err := s.accountKeeper.GetPubKey(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().True(pk.Equals(privKeys[0].PubKey()))
+
+	// the fee is paid to the community pool by the rotated account.
+	s.msgRouter.err = nil
+	s.msgRouter.msgs = nil
+	s.Require().NoError(rotate(ctx, privKeys[0], privKeys[1]))
+	s.Require().Len(s.msgRouter.msgs, 1)
+	fund, ok := s.msgRouter.msgs[0].(*protocolpoolv1.MsgFundCommunityPool)
+	s.Require().True(ok)
+	s.Require().Equal(addr.String(), fund.Depositor)
+	s.Require().Len(fund.Amount, 1)
+	s.Require().Equal("stake", fund.Amount[0].Denom)
+	s.Require().Equal("100", fund.Amount[0].Amount)
+	pk, err = s.accountKeeper.GetPubKey(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().True(pk.Equals(privKeys[1].PubKey()))
+
+	// the cooldown is still enforced after a paid rotation, without charging the fee.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Unix(2000, 0), ChainID: "test-chain"})
+	s.Require().ErrorContains(rotate(ctx, privKeys[1], privKeys[2]), "rate limited")
+	s.Require().Len(s.msgRouter.msgs, 1)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RotatePubKey replaces the public key of the account at addr with newPubKey and
+// records the previous public key in the account public key history.
+// It enforces the rotation cooldown configured in the module parameters, but does
+// not verify any proof of possession of the new key: this is the responsibility of the caller.
+func (ak AccountKeeper) RotatePubKey(ctx context.Context, addr sdk.AccAddress, newPubKey cryptotypes.PubKey) error {
+	if newPubKey == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new pubkey cannot be nil")
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot rotate the pubkey of module account %s", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no pubkey to rotate", addr)
+	}
+	if oldPubKey.Equals(newPubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new pubkey is equal to the current pubkey")
+	}
+
+	headerInfo := ak.environment.HeaderService.GetHeaderInfo(ctx)
+	last, found, err := ak.lastPubKeyRotation(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if found {
+		if last.RotatedAtHeight >= headerInfo.Height {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "pubkey can be rotated at most once per block")
+		}
+
+		params := ak.GetParams(ctx)
+		if next := last.RotatedAt.Add(params.PubKeyRotationCooldown); headerInfo.Time.Before(next) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "pubkey rotation is rate limited; next rotation allowed at %s", next)
+		}
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return err
+	}
+
+	entry := types.PubKeyHistoryEntry{
+		Address:         acc.GetAddress().String(),
+		PubKey:          pkAny,
+		RotatedAtHeight: headerInfo.Height,
+		RotatedAt:       headerInfo.Time,
+	}
+	if err := ak.PubKeyHistory.Set(ctx, collections.Join(addr, headerInfo.Height), entry); err != nil {
+		return err
+	}
+
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return err
+	}
+	ak.SetAccount(ctx, acc)
+
+	return nil
+}
+
+// lastPubKeyRotation returns the most recent public key history entry of the
+// account at addr, if any.
+func (ak AccountKeeper) lastPubKeyRotation(ctx context.Context, addr sdk.AccAddress) (types.PubKeyHistoryEntry, bool, error) {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, int64](addr).Descending()
+	iter, err := ak.PubKeyHistory.Iterate(ctx, rng)
+	if err != nil {
+		return types.PubKeyHistoryEntry{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.PubKeyHistoryEntry{}, false, nil
+	}
+
+	entry, err := iter.Value()
+	if err != nil {
+		return types.PubKeyHistoryEntry{}, false, err
+	}
+	return entry, true, nil
+}
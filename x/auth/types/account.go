@@ -23,6 +23,10 @@ var (
 	_ sdk.ModuleAccountI                 = (*ModuleAccount)(nil)
 )
 
+// ErrAddressPubKeyMismatch is returned when the public key of an account does not
+// derive the account address. This is expected for accounts that rotated their key.
+var ErrAddressPubKeyMismatch = errors.New("account address and pubkey address do not match")
+
 // NewBaseAccount creates a new BaseAccount object.
 func NewBaseAccount(address sdk.AccAddress, pubKey cryptotypes.PubKey, accountNumber, sequence uint64) *BaseAccount {
 	acc := &BaseAccount{
@@ -127,7 +131,7 @@ func (acc BaseAccount) Validate() error {
 	}
 
 	if !bytes.Equal(acc.GetPubKey().Address().Bytes(), accAddr.Bytes()) {
-		return errors.New("account address and pubkey address do not match")
+		return ErrAddressPubKeyMismatch
 	}
 
 	return nil
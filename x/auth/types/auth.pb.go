@@ -7,18 +7,25 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -174,6 +181,16 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// pub_key_rotation_cooldown is the minimum duration that must elapse between
+	// two public key rotations of the same account. Zero disables rate limiting.
+	//
+	// Since: cosmos-sdk 0.51
+	PubKeyRotationCooldown time.Duration `protobuf:"bytes,6,opt,name=pub_key_rotation_cooldown,json=pubKeyRotationCooldown,proto3,stdduration" json:"pub_key_rotation_cooldown"`
+	// pub_key_rotation_fee is the fee charged for rotating the public key of an
+	// account. It is deposited into the community pool.
+	//
+	// Since: cosmos-sdk 0.51
+	PubKeyRotationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=pub_key_rotation_fee,json=pubKeyRotationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pub_key_rotation_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -244,63 +261,170 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetPubKeyRotationCooldown() time.Duration {
+	if m != nil {
+		return m.PubKeyRotationCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetPubKeyRotationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PubKeyRotationFee
+	}
+	return nil
+}
+
+// PubKeyHistoryEntry records a public key that was previously set on an account,
+// together with the point at which it was replaced.
+//
+// Since: cosmos-sdk 0.51
+type PubKeyHistoryEntry struct {
+	// address is the address of the account owning the public key.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pub_key is the public key that was replaced.
+	PubKey *types.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// rotated_at_height is the block height at which pub_key was replaced. The key
+	// was valid for signatures included in blocks strictly before this height.
+	RotatedAtHeight int64 `protobuf:"varint,3,opt,name=rotated_at_height,json=rotatedAtHeight,proto3" json:"rotated_at_height,omitempty"`
+	// rotated_at is the block time at which pub_key was replaced.
+	RotatedAt time.Time `protobuf:"bytes,4,opt,name=rotated_at,json=rotatedAt,proto3,stdtime" json:"rotated_at"`
+}
+
+func (m *PubKeyHistoryEntry) Reset()         { *m = PubKeyHistoryEntry{} }
+func (m *PubKeyHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PubKeyHistoryEntry) ProtoMessage()    {}
+func (*PubKeyHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *PubKeyHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyHistoryEntry.Merge(m, src)
+}
+func (m *PubKeyHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyHistoryEntry proto.InternalMessageInfo
+
+func (m *PubKeyHistoryEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PubKeyHistoryEntry) GetPubKey() *types.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *PubKeyHistoryEntry) GetRotatedAtHeight() int64 {
+	if m != nil {
+		return m.RotatedAtHeight
+	}
+	return 0
+}
+
+func (m *PubKeyHistoryEntry) GetRotatedAt() time.Time {
+	if m != nil {
+		return m.RotatedAt
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*PubKeyHistoryEntry)(nil), "cosmos.auth.v1beta1.PubKeyHistoryEntry")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x4f, 0xe3, 0x46,
-	0x18, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x11,
-	0x2a, 0x4e, 0x13, 0x44, 0xa5, 0xe6, 0x46, 0xd2, 0xaa, 0x42, 0x14, 0x8a, 0x1c, 0x2d, 0x07, 0x2e,
-	0xd6, 0xd8, 0x1e, 0xcc, 0x28, 0xb1, 0xc7, 0xeb, 0x19, 0xa3, 0x98, 0xf3, 0x1e, 0xd0, 0x9e, 0x56,
-	0xfb, 0x0b, 0xd8, 0xfd, 0x05, 0x1c, 0xf8, 0x11, 0xab, 0x3d, 0xa1, 0xbd, 0xec, 0x9e, 0xa2, 0x55,
-	0x38, 0x80, 0x56, 0xfb, 0x23, 0x56, 0x9e, 0x71, 0x20, 0x41, 0xb9, 0x44, 0x9e, 0xf7, 0xde, 0xf7,
-	0x7d, 0xef, 0x7b, 0x9e, 0x18, 0x94, 0x6d, 0x42, 0x3d, 0x42, 0x6b, 0x30, 0x62, 0xa7, 0xb5, 0xb3,
-	0xba, 0x85, 0x18, 0xac, 0xf3, 0x83, 0x1e, 0x84, 0x84, 0x11, 0x79, 0x59, 0xf0, 0x3a, 0x87, 0x52,
-	0xbe, 0xb4, 0x04, 0x3d, 0xec, 0x93, 0x1a, 0xff, 0x15, 0xba, 0xd2, 0xaa, 0xd0, 0x99, 0xfc, 0x54,
-	0x4b, 0x8b, 0x04, 0x55, 0x74, 0x89, 0x4b, 0x04, 0x9e, 0x3c, 0x8d, 0x0a, 0x5c, 0x42, 0xdc, 0x1e,
-	0xaa, 0xf1, 0x93, 0x15, 0x9d, 0xd4, 0xa0, 0x1f, 0x0b, 0xaa, 0xf2, 0x26, 0x0b, 0x0a, 0x2d, 0x48,
-	0xd1, 0x8e, 0x6d, 0x93, 0xc8, 0x67, 0x72, 0x03, 0xcc, 0x42, 0xc7, 0x09, 0x11, 0xa5, 0x8a, 0xa4,
-	0x49, 0xd5, 0xb9, 0x96, 0xf2, 0xe1, 0x7a, 0xb3, 0x98, 0xce, 0xd8, 0x11, 0x4c, 0x87, 0x85, 0xd8,
-	0x77, 0x8d, 0x91, 0x50, 0x3e, 0x02, 0xb3, 0x41, 0x64, 0x99, 0x5d, 0x14, 0x2b, 0x59, 0x4d, 0xaa,
-	0x16, 0x1a, 0x45, 0x5d, 0x0c, 0xd4, 0x47, 0x03, 0xf5, 0x1d, 0x3f, 0x6e, 0xad, 0x7f, 0x19, 0xa8,
-	0xc5, 0x20, 0xb2, 0x7a, 0xd8, 0x4e, 0xb4, 0xbf, 0x13, 0x0f, 0x33, 0xe4, 0x05, 0x2c, 0x7e, 0x7b,
-	0x77, 0xb5, 0x01, 0x1e, 0x09, 0x63, 0x26, 0x88, 0xac, 0x3d, 0x14, 0xcb, 0xbf, 0x82, 0x45, 0x28,
-	0x6c, 0x99, 0x7e, 0xe4, 0x59, 0x28, 0x54, 0x72, 0x9a, 0x54, 0xcd, 0x1b, 0x0b, 0x29, 0x7a, 0xc0,
-	0x41, 0xb9, 0x04, 0xbe, 0xa7, 0xe8, 0x79, 0x84, 0x7c, 0x1b, 0x29, 0x79, 0x2e, 0x78, 0x38, 0x37,
-	0xdb, 0x17, 0x97, 0x6a, 0xe6, 0xfe, 0x52, 0xcd, 0xbc, 0xbf, 0xde, 0xfc, 0x65, 0x4a, 0xbc, 0x7a,
-	0xba, 0xf7, 0xee, 0xcb, 0xbb, 0xab, 0x8d, 0x15, 0x21, 0xd8, 0xa4, 0x4e, 0xb7, 0x36, 0x96, 0x49,
-	0xe5, 0xab, 0x04, 0x16, 0xf6, 0x89, 0x13, 0xf5, 0x1e, 0x52, 0xda, 0x05, 0xf3, 0x16, 0xa4, 0xc8,
-	0x4c, 0x8d, 0xf0, 0xa8, 0x0a, 0x0d, 0x4d, 0x9f, 0x36, 0x61, 0xac, 0x53, 0x2b, 0x7f, 0x33, 0x50,
-	0x25, 0xa3, 0x60, 0x8d, 0x05, 0x2e, 0x83, 0xbc, 0x0f, 0x3d, 0xc4, 0x93, 0x9b, 0x33, 0xf8, 0xb3,
-	0xac, 0x81, 0x42, 0x80, 0x42, 0x0f, 0x53, 0x8a, 0x89, 0x4f, 0x95, 0x9c, 0x96, 0xab, 0xce, 0x19,
-	0xe3, 0x50, 0xf3, 0xf8, 0x42, 0xec, 0x54, 0x99, 0x36, 0x71, 0xc2, 0x2b, 0xdf, 0x4c, 0x19, 0xdb,
-	0x6c, 0x82, 0x7d, 0x7d, 0x77, 0xb5, 0xb1, 0xe8, 0x71, 0x64, 0xb4, 0x4c, 0xe5, 0x85, 0x04, 0x7e,
-	0x14, 0xa2, 0x76, 0x88, 0x1c, 0xe4, 0x33, 0x0c, 0x7b, 0xb2, 0x0a, 0x0a, 0xa9, 0x8c, 0xbb, 0xe5,
-	0x77, 0xc3, 0x00, 0x02, 0x3a, 0x48, 0x3c, 0xaf, 0x83, 0x1f, 0x1c, 0x14, 0xe2, 0x33, 0xc8, 0x30,
-	0xf1, 0x93, 0xd7, 0x48, 0x95, 0xac, 0x96, 0xab, 0xce, 0x1b, 0x8b, 0x8f, 0xf0, 0x1e, 0x8a, 0x69,
-	0xf3, 0xb7, 0xc4, 0xd0, 0xda, 0x98, 0xa1, 0x7f, 0x43, 0x12, 0x05, 0xa9, 0x9f, 0xc7, 0x89, 0x95,
-	0x8f, 0x59, 0x30, 0x73, 0x08, 0x43, 0xe8, 0x51, 0x59, 0x07, 0xcb, 0x1e, 0xec, 0x9b, 0x1e, 0xf2,
-	0x88, 0x69, 0x9f, 0xc2, 0x10, 0xda, 0x0c, 0x85, 0xe2, 0x82, 0xe6, 0x8d, 0x25, 0x0f, 0xf6, 0xf7,
-	0x91, 0x47, 0xda, 0x0f, 0x84, 0xac, 0x81, 0x79, 0xd6, 0x37, 0x29, 0x76, 0xcd, 0x1e, 0xf6, 0x30,
-	0xe3, 0xd9, 0xe6, 0x0d, 0xc0, 0xfa, 0x1d, 0xec, 0xfe, 0x97, 0x20, 0xf2, 0x1f, 0xe0, 0x27, 0xae,
-	0x38, 0x47, 0xa6, 0x4d, 0x28, 0x33, 0x03, 0x14, 0x9a, 0x56, 0xcc, 0x50, 0x7a, 0xc3, 0x96, 0x12,
-	0xe9, 0x39, 0x6a, 0x13, 0xca, 0x0e, 0x51, 0xd8, 0x8a, 0x19, 0x92, 0xff, 0x07, 0x3f, 0x27, 0x0d,
-	0xcf, 0x50, 0x88, 0x4f, 0x62, 0x51, 0x84, 0x9c, 0xc6, 0xf6, 0x76, 0xfd, 0x2f, 0x71, 0xe9, 0x5a,
-	0xca, 0x70, 0xa0, 0x16, 0x3b, 0xd8, 0x3d, 0xe2, 0x8a, 0xa4, 0xf4, 0x9f, 0xbf, 0x39, 0x6f, 0x14,
-	0xe9, 0x04, 0x2a, 0xaa, 0xe4, 0x67, 0x60, 0xf5, 0x69, 0x43, 0x8a, 0xec, 0xa0, 0xb1, 0xfd, 0x67,
-	0xb7, 0xae, 0x7c, 0xc7, 0x5b, 0x96, 0x86, 0x03, 0x75, 0x65, 0xa2, 0x65, 0x67, 0xa4, 0x30, 0x56,
-	0xe8, 0x54, 0xbc, 0xb9, 0x76, 0x7f, 0xa9, 0x4a, 0x4f, 0xdf, 0x79, 0x5f, 0x7c, 0x73, 0x44, 0x9c,
-	0xad, 0xad, 0x77, 0xc3, 0xb2, 0x74, 0x33, 0x2c, 0x4b, 0x9f, 0x87, 0x65, 0xe9, 0xd5, 0x6d, 0x39,
-	0x73, 0x73, 0x5b, 0xce, 0x7c, 0xba, 0x2d, 0x67, 0x8e, 0xd3, 0x2f, 0x0b, 0x75, 0xba, 0x3a, 0x26,
-	0xa3, 0x2a, 0x16, 0x07, 0x88, 0x5a, 0x33, 0xfc, 0xbf, 0xbc, 0xf5, 0x2d, 0x00, 0x00, 0xff, 0xff,
-	0x78, 0xbd, 0x49, 0x53, 0xc5, 0x04, 0x00, 0x00,
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x17, 0x2d, 0x7d, 0xed, 0xf8, 0x64, 0x3b, 0x5f, 0x33, 0xaa, 0x4b, 0x0b, 0x85, 0xa8, 0x08,
+	0x68, 0x23, 0x08, 0x35, 0x55, 0x2b, 0x70, 0x81, 0x7a, 0x33, 0x95, 0x9f, 0x48, 0x93, 0x1a, 0x74,
+	0x9b, 0x21, 0x0b, 0x71, 0x24, 0xcf, 0xd4, 0xc1, 0x22, 0x8f, 0xe5, 0x1d, 0x5d, 0x31, 0x63, 0xd1,
+	0x21, 0xe8, 0x14, 0x64, 0x2a, 0x3a, 0xb9, 0x9d, 0x8a, 0x4e, 0x1a, 0xf2, 0x47, 0x04, 0x99, 0xdc,
+	0x4e, 0x9d, 0xe4, 0x42, 0x1e, 0x14, 0x14, 0xfd, 0x23, 0x0a, 0xde, 0x9d, 0x7e, 0xd9, 0x1e, 0x8a,
+	0x2e, 0x82, 0xf8, 0x3e, 0x9f, 0xf7, 0xde, 0xe7, 0xbd, 0xfb, 0xf0, 0x08, 0x2a, 0x2e, 0xa1, 0x01,
+	0xa1, 0x4d, 0x98, 0xb0, 0x4e, 0xf3, 0x78, 0xdb, 0x41, 0x0c, 0x6e, 0xf3, 0x07, 0x23, 0x8a, 0x09,
+	0x23, 0xea, 0x0d, 0x81, 0x1b, 0x3c, 0x24, 0xf1, 0xf2, 0x3a, 0x0c, 0x70, 0x48, 0x9a, 0xfc, 0x57,
+	0xf0, 0xca, 0x9b, 0x82, 0x67, 0xf3, 0xa7, 0xa6, 0x4c, 0x12, 0x50, 0xc9, 0x27, 0x3e, 0x11, 0xf1,
+	0xec, 0xdf, 0x38, 0xc1, 0x27, 0xc4, 0xef, 0xa2, 0x26, 0x7f, 0x72, 0x92, 0xc3, 0x26, 0x0c, 0x53,
+	0x09, 0x55, 0x2e, 0x42, 0x5e, 0x12, 0x43, 0x86, 0x49, 0x28, 0x71, 0xfd, 0x22, 0xce, 0x70, 0x80,
+	0x28, 0x83, 0x41, 0x34, 0x2e, 0x20, 0x87, 0x72, 0x20, 0x45, 0x93, 0xa1, 0x5c, 0x82, 0x65, 0x81,
+	0xda, 0x4f, 0x0b, 0xa0, 0x68, 0x42, 0x8a, 0xf6, 0x5c, 0x97, 0x24, 0x21, 0x53, 0x5b, 0x60, 0x09,
+	0x7a, 0x5e, 0x8c, 0x28, 0xd5, 0x94, 0xaa, 0x52, 0x5f, 0x36, 0xb5, 0xdf, 0x5f, 0x6f, 0x95, 0xe4,
+	0x10, 0x7b, 0x02, 0x39, 0x60, 0x31, 0x0e, 0x7d, 0x6b, 0x4c, 0x54, 0x9f, 0x82, 0xa5, 0x28, 0x71,
+	0xec, 0x23, 0x94, 0x6a, 0x0b, 0x55, 0xa5, 0x5e, 0x6c, 0x95, 0x0c, 0x21, 0xcb, 0x18, 0xcb, 0x32,
+	0xf6, 0xc2, 0xd4, 0xbc, 0xf5, 0xd7, 0x40, 0x2f, 0x45, 0x89, 0xd3, 0xc5, 0x6e, 0xc6, 0xfd, 0x98,
+	0x04, 0x98, 0xa1, 0x20, 0x62, 0xe9, 0xcf, 0xa3, 0x7e, 0x03, 0x4c, 0x01, 0x6b, 0x31, 0x4a, 0x9c,
+	0x47, 0x28, 0x55, 0x3f, 0x04, 0x6b, 0x50, 0xc8, 0xb2, 0xc3, 0x24, 0x70, 0x50, 0xac, 0xe5, 0xab,
+	0x4a, 0xbd, 0x60, 0xad, 0xca, 0xe8, 0x13, 0x1e, 0x54, 0xcb, 0xe0, 0x1a, 0x45, 0x5f, 0x27, 0x28,
+	0x74, 0x91, 0x56, 0xe0, 0x84, 0xc9, 0xf3, 0x6e, 0xfb, 0xc5, 0x89, 0x9e, 0x7b, 0x77, 0xa2, 0xe7,
+	0xde, 0xbe, 0xde, 0xfa, 0xe0, 0x8a, 0xf3, 0x33, 0xe4, 0xdc, 0x0f, 0xbf, 0x1f, 0xf5, 0x1b, 0x1b,
+	0x82, 0xb0, 0x45, 0xbd, 0xa3, 0xe6, 0xcc, 0x4e, 0x6a, 0x7f, 0x2b, 0x60, 0xf5, 0x31, 0xf1, 0x92,
+	0xee, 0x64, 0x4b, 0x0f, 0xc1, 0x4a, 0xb6, 0x50, 0x5b, 0x0a, 0xe1, 0xab, 0x2a, 0xb6, 0xaa, 0xc6,
+	0x55, 0x1d, 0x66, 0x2a, 0x99, 0x85, 0xd3, 0x81, 0xae, 0x58, 0x45, 0x67, 0x66, 0xe1, 0x2a, 0x28,
+	0x84, 0x30, 0x40, 0x7c, 0x73, 0xcb, 0x16, 0xff, 0xaf, 0x56, 0x41, 0x31, 0x42, 0x71, 0x80, 0x29,
+	0xc5, 0x24, 0xa4, 0x5a, 0xbe, 0x9a, 0xaf, 0x2f, 0x5b, 0xb3, 0xa1, 0xdd, 0x67, 0x2f, 0xc4, 0x4c,
+	0xb5, 0xab, 0x3a, 0xce, 0x69, 0xe5, 0x93, 0x69, 0x33, 0x93, 0xcd, 0xa1, 0xaf, 0x46, 0xfd, 0xc6,
+	0x5a, 0xc0, 0x23, 0xe3, 0x61, 0x6a, 0xdf, 0x29, 0xe0, 0xff, 0x82, 0xd4, 0x8e, 0x91, 0x87, 0x42,
+	0x86, 0x61, 0x57, 0xd5, 0x41, 0x51, 0xd2, 0xb8, 0x5a, 0xee, 0x0d, 0x0b, 0x88, 0xd0, 0x93, 0x4c,
+	0xf3, 0x2d, 0x70, 0xdd, 0x43, 0x31, 0x3e, 0xe6, 0xee, 0xcc, 0x8e, 0x91, 0x6a, 0x0b, 0xd5, 0x7c,
+	0x7d, 0xc5, 0x5a, 0x9b, 0x86, 0x1f, 0xa1, 0x94, 0xee, 0x7e, 0x94, 0x09, 0xba, 0x39, 0x23, 0xe8,
+	0x7e, 0x4c, 0x92, 0x48, 0xea, 0x99, 0x76, 0xac, 0xfd, 0x56, 0x00, 0x8b, 0xfb, 0x30, 0x86, 0x01,
+	0x55, 0x0d, 0x70, 0x23, 0x80, 0x3d, 0x3b, 0x40, 0x01, 0xb1, 0xdd, 0x0e, 0x8c, 0xa1, 0xcb, 0x50,
+	0x2c, 0x0c, 0x5a, 0xb0, 0xd6, 0x03, 0xd8, 0x7b, 0x8c, 0x02, 0xd2, 0x9e, 0x00, 0x6a, 0x15, 0xac,
+	0xb0, 0x9e, 0x4d, 0xb1, 0x6f, 0x77, 0x71, 0x80, 0x19, 0xdf, 0x6d, 0xc1, 0x02, 0xac, 0x77, 0x80,
+	0xfd, 0xcf, 0xb3, 0x88, 0xfa, 0x09, 0x78, 0x8f, 0x33, 0x9e, 0x23, 0xdb, 0x25, 0x94, 0xd9, 0x11,
+	0x8a, 0x6d, 0x27, 0x65, 0x48, 0x3a, 0x6c, 0x3d, 0xa3, 0x3e, 0x47, 0x6d, 0x42, 0xd9, 0x3e, 0x8a,
+	0xcd, 0x94, 0x21, 0xf5, 0x0b, 0xf0, 0x7e, 0x56, 0xf0, 0x18, 0xc5, 0xf8, 0x30, 0x15, 0x49, 0xc8,
+	0x6b, 0xed, 0xec, 0x6c, 0x7f, 0x26, 0x4c, 0x67, 0x6a, 0xc3, 0x81, 0x5e, 0x3a, 0xc0, 0xfe, 0x53,
+	0xce, 0xc8, 0x52, 0xef, 0xde, 0xe1, 0xb8, 0x55, 0xa2, 0x73, 0x51, 0x91, 0xa5, 0x7e, 0x05, 0x36,
+	0x2f, 0x16, 0xa4, 0xc8, 0x8d, 0x5a, 0x3b, 0x9f, 0x1e, 0x6d, 0x6b, 0xff, 0xe3, 0x25, 0xcb, 0xc3,
+	0x81, 0xbe, 0x31, 0x57, 0xf2, 0x60, 0xcc, 0xb0, 0x36, 0xe8, 0x95, 0x71, 0xd5, 0x05, 0x9b, 0xf2,
+	0x65, 0xb4, 0x63, 0xc2, 0xc4, 0x69, 0xb8, 0x84, 0x74, 0x3d, 0xf2, 0x4d, 0xa8, 0x2d, 0x72, 0x9f,
+	0x6e, 0x5e, 0x7a, 0x3d, 0xef, 0xc8, 0x5b, 0xc5, 0x5c, 0x7d, 0x33, 0xd0, 0x73, 0x3f, 0x9c, 0xe9,
+	0xca, 0x2f, 0xa3, 0x7e, 0x43, 0xb1, 0x36, 0xc4, 0x9b, 0x68, 0xc9, 0x42, 0x6d, 0x59, 0x47, 0x7d,
+	0xa5, 0x80, 0xd2, 0xa5, 0x2e, 0x87, 0x08, 0x69, 0x4b, 0xd5, 0x3c, 0x6f, 0x20, 0x6d, 0x99, 0x19,
+	0x7d, 0x62, 0xcb, 0x36, 0xc1, 0xa1, 0x79, 0x2f, 0x6b, 0xf0, 0xeb, 0x99, 0x5e, 0xf7, 0x31, 0xeb,
+	0x24, 0x8e, 0xe1, 0x92, 0x40, 0x5e, 0x91, 0xcd, 0x19, 0x4b, 0xb0, 0x34, 0x42, 0x94, 0x27, 0xd0,
+	0x1f, 0x47, 0xfd, 0xc6, 0x4a, 0x17, 0xf9, 0xd0, 0xcd, 0x96, 0x84, 0x43, 0x2a, 0x94, 0xad, 0xcf,
+	0x2b, 0xbb, 0x87, 0xd0, 0xee, 0xcd, 0x77, 0x27, 0xba, 0x72, 0xd1, 0xed, 0x3d, 0x71, 0x9d, 0x0b,
+	0x23, 0xd5, 0xbe, 0x5d, 0x00, 0xea, 0x3e, 0x4f, 0x7c, 0x80, 0x29, 0x23, 0x71, 0x7a, 0x37, 0x64,
+	0x71, 0xfa, 0x9f, 0x2e, 0xbd, 0xfb, 0xff, 0xee, 0xd2, 0xd3, 0xde, 0x4e, 0x2b, 0xb9, 0x71, 0x1a,
+	0x31, 0x62, 0x08, 0x01, 0x93, 0x5b, 0xae, 0x01, 0xd6, 0xf9, 0x0a, 0x91, 0x67, 0x43, 0x66, 0x77,
+	0x10, 0xf6, 0x3b, 0x8c, 0xdb, 0x30, 0x6f, 0x5d, 0x97, 0xc0, 0x1e, 0x7b, 0xc0, 0xc3, 0x6a, 0x1b,
+	0x80, 0x29, 0x97, 0xfb, 0xae, 0xd8, 0x2a, 0x5f, 0xea, 0xfb, 0xe5, 0xf8, 0x1b, 0x60, 0x5e, 0xcb,
+	0xb6, 0xfd, 0xf2, 0x4c, 0x57, 0xac, 0xe5, 0x49, 0x29, 0xf3, 0xf6, 0x9b, 0x61, 0x45, 0x39, 0x1d,
+	0x56, 0x94, 0x3f, 0x87, 0x15, 0xe5, 0xe5, 0x79, 0x25, 0x77, 0x7a, 0x5e, 0xc9, 0xfd, 0x71, 0x5e,
+	0xc9, 0x3d, 0x93, 0x5f, 0x2e, 0xea, 0x1d, 0x19, 0x98, 0x8c, 0x57, 0xc7, 0xcf, 0xc2, 0x59, 0xe4,
+	0xd5, 0x6f, 0xff, 0x33, 0x00, 0x41, 0x23, 0xcd, 0x49, 0x25, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -337,6 +461,17 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.PubKeyRotationCooldown != that1.PubKeyRotationCooldown {
+		return false
+	}
+	if len(this.PubKeyRotationFee) != len(that1.PubKeyRotationFee) {
+		return false
+	}
+	for i := range this.PubKeyRotationFee {
+		if !this.PubKeyRotationFee[i].Equal(&that1.PubKeyRotationFee[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -501,6 +636,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKeyRotationFee) > 0 {
+		for iNdEx := len(m.PubKeyRotationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyRotationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PubKeyRotationCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationCooldown):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuth(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -529,6 +686,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RotatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RotatedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuth(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.RotatedAtHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.RotatedAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -626,6 +838,36 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationCooldown)
+	n += 1 + l + sovAuth(uint64(l))
+	if len(m.PubKeyRotationFee) > 0 {
+		for _, e := range m.PubKeyRotationFee {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PubKeyHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.RotatedAtHeight != 0 {
+		n += 1 + sovAuth(uint64(m.RotatedAtHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RotatedAt)
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -1179,6 +1421,243 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PubKeyRotationCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyRotationFee = append(m.PubKeyRotationFee, types1.Coin{})
+			if err := m.PubKeyRotationFee[len(m.PubKeyRotationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedAtHeight", wireType)
+			}
+			m.RotatedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotatedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RotatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
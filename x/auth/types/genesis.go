@@ -40,6 +40,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, entry := range g.PubKeyHistory {
+		if err := entry.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -72,7 +77,37 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	rotated, err := validatePubKeyHistory(data.PubKeyHistory)
+	if err != nil {
+		return err
+	}
+
+	return validateGenAccounts(genAccs, rotated)
+}
+
+// validatePubKeyHistory validates the public key history entries and returns the
+// set of addresses that rotated their public key.
+func validatePubKeyHistory(history []PubKeyHistoryEntry) (map[string]bool, error) {
+	rotated := make(map[string]bool, len(history))
+	seen := make(map[string]bool, len(history))
+	for _, entry := range history {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return nil, fmt.Errorf("invalid address in pub key history: %w", err)
+		}
+		if entry.CryptoPubKey() == nil {
+			return nil, fmt.Errorf("invalid pub key in pub key history of %s", entry.Address)
+		}
+		if entry.RotatedAtHeight < 0 {
+			return nil, fmt.Errorf("invalid rotation height %d in pub key history of %s", entry.RotatedAtHeight, entry.Address)
+		}
+		key := fmt.Sprintf("%s/%d", entry.Address, entry.RotatedAtHeight)
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate pub key history entry for %s at height %d", entry.Address, entry.RotatedAtHeight)
+		}
+		seen[key] = true
+		rotated[entry.Address] = true
+	}
+	return rotated, nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -124,6 +159,12 @@ func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 
 // ValidateGenAccounts validates an array of GenesisAccounts and checks for duplicates
 func ValidateGenAccounts(accounts GenesisAccounts) error {
+	return validateGenAccounts(accounts, nil)
+}
+
+// validateGenAccounts validates an array of GenesisAccounts, allowing the accounts
+// listed in rotated to hold a public key that does not derive their address.
+func validateGenAccounts(accounts GenesisAccounts, rotated map[string]bool) error {
 	addrMap := make(map[string]bool, len(accounts))
 
 	for _, acc := range accounts {
@@ -136,7 +177,7 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 		addrMap[addrStr] = true
 
 		// check account specific validation
-		if err := acc.Validate(); err != nil {
+		if err := acc.Validate(); err != nil && !(rotated[addrStr] && errors.Is(err, ErrAddressPubKeyMismatch)) {
 			return fmt.Errorf("invalid account found in genesis state; address: %s, error: %w", addrStr, err)
 		}
 	}
@@ -31,6 +31,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pub_key_history contains the previous public keys of rotated accounts.
+	//
+	// Since: cosmos-sdk 0.51
+	PubKeyHistory []PubKeyHistoryEntry `protobuf:"bytes,3,rep,name=pub_key_history,json=pubKeyHistory,proto3" json:"pub_key_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +84,13 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetPubKeyHistory() []PubKeyHistoryEntry {
+	if m != nil {
+		return m.PubKeyHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +98,27 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0x64, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x92, 0x10, 0xab,
-	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x20, 0xfe, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x1b, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x57, 0x9c, 0xf7, 0x5c, 0x01,
-	0x00, 0x00,
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x10, 0x80, 0xe3, 0xbf, 0xbf, 0x2a, 0x48, 0x41, 0x88, 0xd0, 0xa1, 0x2d, 0x92, 0x29, 0x2c, 0x54,
+	0x0c, 0x36, 0x6d, 0x77, 0x24, 0x2a, 0x21, 0x90, 0x58, 0x50, 0x11, 0x0b, 0x4b, 0xe5, 0x04, 0x93,
+	0x46, 0x25, 0xbe, 0x28, 0x76, 0x10, 0x7e, 0x0b, 0x1e, 0x83, 0x91, 0xc7, 0xe8, 0xd8, 0x0d, 0x26,
+	0x84, 0x92, 0x81, 0xd7, 0x40, 0xb1, 0x03, 0x62, 0xc8, 0x62, 0x9d, 0xee, 0xbe, 0xbb, 0xfb, 0xce,
+	0xee, 0x7e, 0x00, 0x32, 0x06, 0x49, 0x59, 0xa6, 0xe6, 0xf4, 0x71, 0xe8, 0x73, 0xc5, 0x86, 0x34,
+	0xe4, 0x82, 0xcb, 0x48, 0x92, 0x24, 0x05, 0x05, 0xde, 0x8e, 0x45, 0x48, 0x89, 0x90, 0x0a, 0xe9,
+	0x75, 0x43, 0x80, 0xf0, 0x81, 0x53, 0x83, 0xf8, 0xd9, 0x3d, 0x65, 0x42, 0x5b, 0xbe, 0xd7, 0x0e,
+	0x21, 0x04, 0x13, 0xd2, 0x32, 0xaa, 0xb2, 0xb8, 0x6e, 0x91, 0x19, 0x69, 0xeb, 0xdb, 0x2c, 0x8e,
+	0x04, 0x50, 0xf3, 0xda, 0xd4, 0xc1, 0x1b, 0x72, 0x37, 0xce, 0xad, 0xca, 0xb5, 0x62, 0x8a, 0x7b,
+	0x27, 0x6e, 0x33, 0x61, 0x29, 0x8b, 0x65, 0x07, 0xf5, 0xd1, 0xa0, 0x35, 0xda, 0x25, 0x35, 0x6a,
+	0xe4, 0xca, 0x20, 0x93, 0xf5, 0xe5, 0xc7, 0x9e, 0xf3, 0xf2, 0xf5, 0x7a, 0x84, 0xa6, 0x55, 0x97,
+	0x77, 0xec, 0xae, 0xb1, 0x20, 0x80, 0x4c, 0x28, 0xd9, 0xf9, 0xd7, 0x6f, 0x0c, 0x5a, 0xa3, 0x36,
+	0xb1, 0x77, 0x90, 0x9f, 0x3b, 0xc8, 0xa9, 0xd0, 0xd3, 0x5f, 0xca, 0xbb, 0x71, 0xb7, 0x92, 0xcc,
+	0x9f, 0x2d, 0xb8, 0x9e, 0xcd, 0x23, 0xa9, 0x20, 0xd5, 0x9d, 0x86, 0x69, 0x3c, 0xac, 0x5f, 0x9d,
+	0xf9, 0x97, 0x5c, 0x5f, 0x58, 0xf2, 0x4c, 0xa8, 0x54, 0x4f, 0xfe, 0x97, 0x1a, 0xd3, 0xcd, 0xe4,
+	0x6f, 0x65, 0x32, 0x5e, 0xe6, 0x18, 0xad, 0x72, 0x8c, 0x3e, 0x73, 0x8c, 0x9e, 0x0b, 0xec, 0xac,
+	0x0a, 0xec, 0xbc, 0x17, 0xd8, 0xb9, 0xed, 0xda, 0xb1, 0xf2, 0x6e, 0x41, 0x22, 0xa0, 0x4f, 0xf6,
+	0xbb, 0x94, 0x4e, 0xb8, 0xf4, 0x9b, 0xc6, 0x71, 0xfc, 0x3d, 0x00, 0x07, 0x13, 0x76, 0xc2, 0xb3,
+	0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +141,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKeyHistory) > 0 {
+		for iNdEx := len(m.PubKeyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +207,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PubKeyHistory) > 0 {
+		for _, e := range m.PubKeyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +318,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyHistory = append(m.PubKeyHistory, PubKeyHistoryEntry{})
+			if err := m.PubKeyHistory[len(m.PubKeyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -26,6 +26,9 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// PubKeyHistoryPrefix is the prefix for the previous public keys of rotated accounts.
+	PubKeyHistoryPrefix = collections.NewPrefix(3)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 )
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg                            = &MsgRotatePubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotatePubKey)(nil)
+	_ codectypes.UnpackInterfacesMessage = PubKeyHistoryEntry{}
+)
+
+// rotatePubKeyDomain is prepended to the bytes signed by the new public key
+// of a MsgRotatePubKey so that the proof cannot be confused with a transaction signature.
+const rotatePubKeyDomain = "cosmos-sdk/MsgRotatePubKey"
+
+// NewMsgRotatePubKey creates a new MsgRotatePubKey instance.
+func NewMsgRotatePubKey(address string, newPubKey cryptotypes.PubKey, proof []byte) (*MsgRotatePubKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotatePubKey{
+		Address:        address,
+		NewPubKey:      pkAny,
+		NewPubKeyProof: proof,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
+
+// RotatePubKeySignBytes returns the bytes that the new public key of a
+// MsgRotatePubKey must sign to prove its possession. The bytes are bound to the
+// chain, the account and the public key being replaced, so a proof cannot be
+// replayed on another chain, account or after a subsequent rotation.
+func RotatePubKeySignBytes(chainID, address string, accountNumber uint64, currentPubKey cryptotypes.PubKey) []byte {
+	h := sha256.New()
+	writeLengthPrefixed := func(bz []byte) {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(bz)))
+		h.Write(l[:])
+		h.Write(bz)
+	}
+
+	writeLengthPrefixed([]byte(rotatePubKeyDomain))
+	writeLengthPrefixed([]byte(chainID))
+	writeLengthPrefixed([]byte(address))
+	writeLengthPrefixed(sdk.Uint64ToBigEndian(accountNumber))
+	if currentPubKey != nil {
+		writeLengthPrefixed(currentPubKey.Bytes())
+	} else {
+		writeLengthPrefixed(nil)
+	}
+
+	return h.Sum(nil)
+}
+
+// CryptoPubKey returns the public key recorded in the history entry, or nil if it
+// cannot be decoded.
+func (e PubKeyHistoryEntry) CryptoPubKey() cryptotypes.PubKey {
+	if e.PubKey == nil {
+		return nil
+	}
+	pk, ok := e.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil
+	}
+	return pk
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e PubKeyHistoryEntry) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(e.PubKey, &pubKey)
+}
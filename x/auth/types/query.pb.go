@@ -962,6 +962,120 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryPubKeyHistoryRequest is the Query/PubKeyHistory request type.
+//
+// Since: cosmos-sdk 0.51
+type QueryPubKeyHistoryRequest struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryRequest) Reset()         { *m = QueryPubKeyHistoryRequest{} }
+func (m *QueryPubKeyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryRequest) ProtoMessage()    {}
+func (*QueryPubKeyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPubKeyHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPubKeyHistoryResponse is the Query/PubKeyHistory response type.
+//
+// Since: cosmos-sdk 0.51
+type QueryPubKeyHistoryResponse struct {
+	// history contains the previous public keys of the account.
+	History []PubKeyHistoryEntry `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryResponse) Reset()         { *m = QueryPubKeyHistoryResponse{} }
+func (m *QueryPubKeyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryResponse) ProtoMessage()    {}
+func (*QueryPubKeyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryResponse) GetHistory() []PubKeyHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryPubKeyHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -983,78 +1097,86 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryPubKeyHistoryRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryRequest")
+	proto.RegisterType((*QueryPubKeyHistoryResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1056 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x24, 0x2b, 0xf1, 0xe3, 0x54, 0xb3, 0x80, 0x7a, 0x58, 0x14, 0xdc,
-	0x15, 0x42, 0x1c, 0x88, 0x9c, 0xda, 0x49, 0x2d, 0x36, 0x9e, 0x6c, 0xec, 0xc0, 0x86, 0x2a, 0x17,
-	0x24, 0xa4, 0x5e, 0x90, 0x90, 0xe0, 0x0f, 0xd8, 0x03, 0xe2, 0xbc, 0x48, 0xe5, 0xc6, 0x1f, 0xb0,
-	0xda, 0xd3, 0x0a, 0x2e, 0x9c, 0x10, 0x6a, 0x91, 0xe0, 0xc6, 0xbf, 0x80, 0x32, 0xf3, 0xec, 0xd8,
-	0xed, 0x24, 0x71, 0xd9, 0x9b, 0x33, 0xf3, 0xde, 0xf7, 0x7d, 0xe6, 0xcd, 0xb3, 0xbf, 0x2d, 0x14,
-	0x0e, 0xa9, 0xd7, 0xa1, 0x9e, 0x66, 0xf6, 0xfd, 0x23, 0xed, 0xf3, 0xdd, 0xa6, 0xed, 0x9b, 0xbb,
-	0xda, 0x83, 0xbe, 0xdd, 0x1b, 0xa8, 0xdd, 0x1e, 0xf5, 0x29, 0x59, 0xe5, 0x01, 0xea, 0x28, 0x40,
-	0xc5, 0x00, 0x79, 0x07, 0xb3, 0x9a, 0xa6, 0x67, 0xf3, 0xe8, 0x30, 0xb7, 0x6b, 0xb6, 0x1d, 0xd7,
-	0xf4, 0x1d, 0xea, 0x72, 0x01, 0x39, 0xd3, 0xa6, 0x6d, 0xca, 0x1e, 0xb5, 0xd1, 0x13, 0xae, 0xbe,
-	0xdc, 0xa6, 0xb4, 0x7d, 0xdf, 0xd6, 0xd8, 0xaf, 0x66, 0xbf, 0xa5, 0x99, 0x2e, 0x56, 0x94, 0xd7,
-	0x70, 0xcb, 0xec, 0x3a, 0x9a, 0xe9, 0xba, 0xd4, 0x67, 0x6a, 0x1e, 0xee, 0xe6, 0x45, 0xc0, 0x0c,
-	0x0e, 0x85, 0xf9, 0x7e, 0x83, 0x57, 0x44, 0x78, 0xbe, 0x95, 0xc3, 0xd4, 0x00, 0x38, 0x7a, 0x4e,
-	0xe5, 0x53, 0xc8, 0x7c, 0x38, 0xfa, 0xb9, 0x77, 0x78, 0x48, 0xfb, 0xae, 0xef, 0x19, 0xf6, 0x83,
-	0xbe, 0xed, 0xf9, 0xe4, 0x3d, 0x80, 0xf1, 0x91, 0xb2, 0x52, 0x51, 0x2a, 0x2d, 0x57, 0x36, 0x55,
-	0xd4, 0x1d, 0x9d, 0x5f, 0xe5, 0x2a, 0x88, 0xa2, 0xd6, 0xcd, 0xb6, 0x8d, 0xb9, 0x46, 0x24, 0x53,
-	0x39, 0x95, 0xe0, 0xe6, 0x85, 0x02, 0x5e, 0x97, 0xba, 0x9e, 0x4d, 0x0c, 0x58, 0x32, 0x71, 0x2d,
-	0x2b, 0x15, 0xaf, 0x95, 0x96, 0x2b, 0x19, 0x95, 0xb7, 0x40, 0x0d, 0xba, 0xa3, 0xee, 0xb9, 0x03,
-	0xbd, 0xf8, 0xf4, 0xb4, 0xbc, 0x26, 0xb8, 0x0d, 0x15, 0x15, 0xf7, 0x8d, 0x50, 0x87, 0xbc, 0x1f,
-	0xa3, 0x9e, 0x63, 0xd4, 0x5b, 0x33, 0xa9, 0x39, 0x50, 0x0c, 0xfb, 0x00, 0x56, 0xa3, 0xd4, 0x41,
-	0x57, 0x2a, 0xb0, 0x68, 0x5a, 0x56, 0xcf, 0xf6, 0x3c, 0xd6, 0x92, 0xeb, 0x7a, 0xf6, 0xd7, 0xd3,
-	0x72, 0x06, 0xf5, 0xf7, 0xf8, 0xce, 0x81, 0xdf, 0x73, 0xdc, 0xb6, 0x11, 0x04, 0xbe, 0xb5, 0x74,
-	0xf2, 0xa8, 0x90, 0xfa, 0xe7, 0x51, 0x21, 0xa5, 0x1c, 0xc5, 0x7b, 0x1d, 0x76, 0xa2, 0x0e, 0x8b,
-	0x78, 0x02, 0x6c, 0xf4, 0xff, 0x6d, 0x44, 0x20, 0xa3, 0x64, 0x80, 0xb0, 0x4a, 0x75, 0xb3, 0x67,
-	0x76, 0x82, 0x3b, 0x55, 0xea, 0x78, 0xa8, 0x60, 0x15, 0xcb, 0xbf, 0x09, 0x0b, 0x5d, 0xb6, 0x82,
-	0xd5, 0x73, 0xaa, 0xa8, 0x08, 0x4f, 0xd2, 0xe7, 0x9f, 0xfc, 0x51, 0x48, 0x19, 0x98, 0xa0, 0xac,
-	0x81, 0xcc, 0x14, 0xef, 0x52, 0xab, 0x7f, 0xdf, 0xbe, 0x30, 0x43, 0xca, 0x17, 0x90, 0x13, 0xee,
-	0x62, 0xdd, 0x8f, 0x13, 0x0e, 0xc0, 0xe6, 0xd3, 0xd3, 0xb2, 0x22, 0x42, 0x8a, 0xe9, 0x46, 0xc6,
-	0x40, 0xb9, 0x0d, 0x85, 0xcb, 0x85, 0xf5, 0xc1, 0x07, 0x66, 0x27, 0x98, 0x51, 0x42, 0x60, 0xde,
-	0x35, 0x3b, 0x36, 0xbf, 0x46, 0x83, 0x3d, 0x2b, 0x5f, 0x42, 0x71, 0x72, 0x1a, 0x42, 0x7f, 0x94,
-	0xec, 0xae, 0x92, 0x32, 0x87, 0x37, 0x76, 0x13, 0x56, 0x75, 0xfb, 0xf0, 0xa8, 0x5a, 0xa9, 0xf7,
-	0xec, 0x96, 0xf3, 0x30, 0x68, 0xe1, 0xdb, 0x90, 0x89, 0x2f, 0x23, 0xc6, 0x06, 0xdc, 0x68, 0xb2,
-	0xf5, 0x46, 0x97, 0x6d, 0xe0, 0x39, 0xd2, 0xcd, 0x48, 0xb0, 0xa2, 0x43, 0x0e, 0x67, 0x52, 0x1f,
-	0xf8, 0xb6, 0x77, 0x8f, 0xe2, 0x68, 0x62, 0x0b, 0x36, 0xe0, 0x06, 0xce, 0x68, 0xa3, 0x39, 0xda,
-	0x67, 0x1a, 0x69, 0x23, 0x6d, 0x46, 0x72, 0x94, 0x77, 0x61, 0x4d, 0xac, 0x81, 0x20, 0xaf, 0xc2,
-	0x4a, 0x20, 0xe2, 0xb1, 0x1d, 0x24, 0x09, 0xa4, 0x79, 0xb8, 0x72, 0x27, 0x44, 0xe1, 0x0b, 0xf7,
-	0x28, 0x93, 0x0b, 0x50, 0x12, 0xaa, 0xbc, 0x13, 0xc2, 0x5c, 0x50, 0x19, 0x77, 0x65, 0xf6, 0x89,
-	0x0e, 0x20, 0x1f, 0x7d, 0x0b, 0xc3, 0xd3, 0xed, 0xdf, 0x19, 0xcf, 0xc6, 0x9c, 0x63, 0xb1, 0xdc,
-	0x6b, 0xfa, 0x5c, 0x56, 0x32, 0xe6, 0x1c, 0x8b, 0xac, 0x03, 0xe0, 0x55, 0x35, 0x1c, 0x8b, 0x7d,
-	0x59, 0xe6, 0x8d, 0xeb, 0xb8, 0xb2, 0x6f, 0x29, 0x16, 0x4e, 0x9c, 0x48, 0x14, 0xe1, 0xf6, 0xe0,
-	0xc5, 0x40, 0x21, 0xe9, 0x37, 0x64, 0xc5, 0x8c, 0xc9, 0x29, 0x77, 0xe1, 0xa5, 0x68, 0x95, 0x7d,
-	0xb7, 0x45, 0x9f, 0xe3, 0xcb, 0xa4, 0xd4, 0x21, 0x7b, 0x59, 0x0e, 0x69, 0x6b, 0x30, 0xef, 0xb8,
-	0x2d, 0x8a, 0x43, 0x5e, 0x14, 0x7e, 0x12, 0x74, 0xd3, 0x0b, 0x26, 0xd9, 0x60, 0xd1, 0x95, 0x7f,
-	0xd3, 0xf0, 0x02, 0x93, 0x24, 0xdf, 0x48, 0xb0, 0x14, 0xbc, 0xf1, 0x64, 0x5b, 0x98, 0x2e, 0xf2,
-	0x1d, 0x79, 0x27, 0x49, 0x28, 0x67, 0x54, 0x76, 0x4e, 0xfe, 0x7e, 0xbc, 0x23, 0x7d, 0xf5, 0xdb,
-	0x5f, 0xdf, 0xcd, 0x15, 0xc8, 0xba, 0x26, 0x74, 0xc8, 0x00, 0xe1, 0x7b, 0x09, 0x16, 0x51, 0x80,
-	0x94, 0x66, 0xd6, 0x08, 0x68, 0xb6, 0x13, 0x44, 0x22, 0x4c, 0x6d, 0x0c, 0xb3, 0x4d, 0xb6, 0xa6,
-	0xc2, 0x68, 0xc7, 0x78, 0x03, 0x43, 0xf2, 0xb3, 0x04, 0xe4, 0xf2, 0xcc, 0x90, 0xea, 0xcc, 0xba,
-	0x97, 0xc7, 0x56, 0xae, 0x5d, 0x2d, 0xe9, 0x0a, 0xdc, 0xe1, 0x3b, 0xd5, 0x70, 0x2c, 0xed, 0xd8,
-	0xb1, 0x86, 0xe4, 0x6b, 0x09, 0x16, 0xb8, 0x23, 0x90, 0xad, 0xc9, 0x65, 0x63, 0xf6, 0x23, 0x97,
-	0x66, 0x07, 0x22, 0x53, 0x69, 0xcc, 0xb4, 0x4e, 0x72, 0x42, 0x26, 0x6e, 0x40, 0xe4, 0x47, 0x09,
-	0x56, 0xe2, 0xf6, 0x42, 0xb4, 0xc9, 0x65, 0x84, 0x36, 0x25, 0xbf, 0x9e, 0x3c, 0x01, 0xf9, 0x76,
-	0xc7, 0x7c, 0x9b, 0xe4, 0x15, 0x21, 0x5f, 0x87, 0x65, 0x36, 0xc2, 0xf9, 0xfb, 0x45, 0x82, 0x55,
-	0x81, 0xaf, 0x90, 0x5a, 0xc2, 0xe2, 0x31, 0xf7, 0x92, 0x6f, 0x5f, 0x31, 0x0b, 0xb9, 0xdf, 0x18,
-	0x73, 0x97, 0xc9, 0x6b, 0x49, 0xb8, 0xb5, 0xe3, 0x91, 0x33, 0x0e, 0xc9, 0x89, 0x04, 0xe9, 0xa8,
-	0x11, 0x4d, 0x78, 0x87, 0x04, 0x16, 0x36, 0xe1, 0x1d, 0x12, 0xb9, 0x9a, 0xb2, 0x31, 0xf5, 0xca,
-	0xb9, 0xb7, 0x91, 0xc7, 0x12, 0x64, 0x44, 0x96, 0x44, 0xc4, 0xf7, 0x38, 0xc5, 0x01, 0xe5, 0xdd,
-	0x2b, 0x64, 0x20, 0x62, 0x75, 0x6a, 0xf7, 0x38, 0x62, 0xf8, 0x7e, 0x73, 0x17, 0x1a, 0x92, 0x9f,
-	0xc6, 0xc8, 0x31, 0xe3, 0x9a, 0x8e, 0x2c, 0x72, 0xca, 0xe9, 0xc8, 0x42, 0x57, 0x54, 0x6a, 0x0c,
-	0x59, 0x25, 0xb7, 0x12, 0x21, 0x73, 0xff, 0x1d, 0x92, 0x1f, 0x24, 0x58, 0x8e, 0x18, 0x03, 0xb9,
-	0x35, 0xf3, 0xeb, 0x12, 0xb1, 0x23, 0xb9, 0x9c, 0x30, 0x3a, 0xf9, 0x60, 0x86, 0xee, 0xeb, 0xb6,
-	0xe8, 0xf8, 0x03, 0xaa, 0x57, 0x9f, 0x9c, 0xe5, 0xa5, 0x67, 0x67, 0x79, 0xe9, 0xcf, 0xb3, 0xbc,
-	0xf4, 0xed, 0x79, 0x3e, 0xf5, 0xec, 0x3c, 0x9f, 0xfa, 0xfd, 0x3c, 0x9f, 0xfa, 0x04, 0xff, 0x23,
-	0xf2, 0xac, 0xcf, 0x54, 0x87, 0x6a, 0x0f, 0xb9, 0x9a, 0x3f, 0xe8, 0xda, 0x5e, 0x73, 0x81, 0xfd,
-	0xad, 0x56, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xde, 0xbd, 0x53, 0x06, 0x0e, 0x00, 0x00,
+	// 1151 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x6e, 0x48, 0xd2, 0x97, 0x1f, 0x48, 0x13, 0x57, 0xa4, 0x9b, 0xc4, 0x8e, 0x36,
+	0x90, 0x5f, 0x34, 0xbb, 0xcd, 0x8f, 0x4a, 0x40, 0x4f, 0x59, 0x5a, 0x4a, 0x84, 0x8a, 0xcc, 0xa6,
+	0x42, 0x88, 0x03, 0xd6, 0x3a, 0xbb, 0x71, 0x56, 0x6d, 0x76, 0x5c, 0xef, 0x1a, 0x6a, 0xa2, 0x5c,
+	0x90, 0x90, 0x72, 0x41, 0x42, 0x02, 0x89, 0x23, 0x3d, 0x20, 0x6e, 0xa0, 0x22, 0x85, 0x1b, 0x7f,
+	0x40, 0xd5, 0x53, 0x05, 0x17, 0x4e, 0x08, 0x25, 0x48, 0xf0, 0x67, 0x20, 0xcf, 0xbc, 0xfd, 0x95,
+	0x8c, 0xed, 0x75, 0xdb, 0x9b, 0x33, 0xf3, 0xde, 0xf7, 0x7d, 0xe6, 0xcd, 0xdb, 0xf7, 0x26, 0x50,
+	0xda, 0x61, 0xfe, 0x3e, 0xf3, 0x75, 0xab, 0x19, 0xec, 0xe9, 0x9f, 0xae, 0x56, 0x9d, 0xc0, 0x5a,
+	0xd5, 0xef, 0x37, 0x9d, 0x46, 0x4b, 0xab, 0x37, 0x58, 0xc0, 0xe8, 0x84, 0x30, 0xd0, 0xda, 0x06,
+	0x1a, 0x1a, 0x28, 0xcb, 0xe8, 0x55, 0xb5, 0x7c, 0x47, 0x58, 0x47, 0xbe, 0x75, 0xab, 0xe6, 0x7a,
+	0x56, 0xe0, 0x32, 0x4f, 0x08, 0x28, 0x85, 0x1a, 0xab, 0x31, 0xfe, 0x53, 0x6f, 0xff, 0xc2, 0xd5,
+	0xcb, 0x35, 0xc6, 0x6a, 0xf7, 0x1c, 0x9d, 0xff, 0x55, 0x6d, 0xee, 0xea, 0x96, 0x87, 0x11, 0x95,
+	0x69, 0xdc, 0xb2, 0xea, 0xae, 0x6e, 0x79, 0x1e, 0x0b, 0xb8, 0x9a, 0x8f, 0xbb, 0x45, 0x19, 0x30,
+	0x87, 0x43, 0x61, 0xb1, 0x5f, 0x11, 0x11, 0x11, 0x5e, 0x6c, 0x4d, 0xa1, 0x6b, 0x08, 0x9c, 0x3c,
+	0xa7, 0xfa, 0x09, 0x14, 0x3e, 0x68, 0xff, 0xb9, 0xb9, 0xb3, 0xc3, 0x9a, 0x5e, 0xe0, 0x9b, 0xce,
+	0xfd, 0xa6, 0xe3, 0x07, 0xf4, 0x1d, 0x80, 0xf8, 0x48, 0x93, 0x64, 0x96, 0x2c, 0x8e, 0xac, 0xcd,
+	0x6b, 0xa8, 0xdb, 0x3e, 0xbf, 0x26, 0x54, 0x10, 0x45, 0x2b, 0x5b, 0x35, 0x07, 0x7d, 0xcd, 0x84,
+	0xa7, 0x7a, 0x4c, 0xe0, 0xd2, 0x99, 0x00, 0x7e, 0x9d, 0x79, 0xbe, 0x43, 0x4d, 0x18, 0xb6, 0x70,
+	0x6d, 0x92, 0xcc, 0x5e, 0x58, 0x1c, 0x59, 0x2b, 0x68, 0x22, 0x05, 0x5a, 0x98, 0x1d, 0x6d, 0xd3,
+	0x6b, 0x19, 0xb3, 0x4f, 0x8e, 0x57, 0xa6, 0x25, 0xb7, 0xa1, 0xa1, 0xe2, 0x96, 0x19, 0xe9, 0xd0,
+	0x5b, 0x29, 0xea, 0x3c, 0xa7, 0x5e, 0xe8, 0x49, 0x2d, 0x80, 0x52, 0xd8, 0xdb, 0x30, 0x91, 0xa4,
+	0x0e, 0xb3, 0xb2, 0x06, 0x43, 0x96, 0x6d, 0x37, 0x1c, 0xdf, 0xe7, 0x29, 0xb9, 0x68, 0x4c, 0xfe,
+	0x7e, 0xbc, 0x52, 0x40, 0xfd, 0x4d, 0xb1, 0xb3, 0x1d, 0x34, 0x5c, 0xaf, 0x66, 0x86, 0x86, 0x6f,
+	0x0d, 0x1f, 0x3d, 0x2c, 0xe5, 0xfe, 0x7b, 0x58, 0xca, 0xa9, 0x7b, 0xe9, 0x5c, 0x47, 0x99, 0x28,
+	0xc3, 0x10, 0x9e, 0x00, 0x13, 0xfd, 0xac, 0x89, 0x08, 0x65, 0xd4, 0x02, 0x50, 0x1e, 0xa9, 0x6c,
+	0x35, 0xac, 0xfd, 0xf0, 0x4e, 0xd5, 0x32, 0x4c, 0xa4, 0x56, 0x31, 0xfc, 0x9b, 0x30, 0x58, 0xe7,
+	0x2b, 0x18, 0x7d, 0x4a, 0x93, 0x05, 0x11, 0x4e, 0xc6, 0xc0, 0xe3, 0xbf, 0x4a, 0x39, 0x13, 0x1d,
+	0xd4, 0x69, 0x50, 0xb8, 0xe2, 0x6d, 0x66, 0x37, 0xef, 0x39, 0x67, 0x6a, 0x48, 0xfd, 0x0c, 0xa6,
+	0xa4, 0xbb, 0x18, 0xf7, 0xa3, 0x8c, 0x05, 0x30, 0xff, 0xe4, 0x78, 0x45, 0x95, 0x21, 0xa5, 0x74,
+	0x13, 0x65, 0xa0, 0x5e, 0x83, 0xd2, 0xf9, 0xc0, 0x46, 0xeb, 0x7d, 0x6b, 0x3f, 0xac, 0x51, 0x4a,
+	0x61, 0xc0, 0xb3, 0xf6, 0x1d, 0x71, 0x8d, 0x26, 0xff, 0xad, 0x7e, 0x0e, 0xb3, 0x9d, 0xdd, 0x10,
+	0xfa, 0xc3, 0x6c, 0x77, 0x95, 0x95, 0x39, 0xba, 0xb1, 0x4b, 0x30, 0x61, 0x38, 0x3b, 0x7b, 0xeb,
+	0x6b, 0xe5, 0x86, 0xb3, 0xeb, 0x3e, 0x08, 0x53, 0x78, 0x1d, 0x0a, 0xe9, 0x65, 0xc4, 0x98, 0x83,
+	0xb1, 0x2a, 0x5f, 0xaf, 0xd4, 0xf9, 0x06, 0x9e, 0x63, 0xb4, 0x9a, 0x30, 0x56, 0x0d, 0x98, 0xc2,
+	0x9a, 0x34, 0x5a, 0x81, 0xe3, 0xdf, 0x61, 0x58, 0x9a, 0x98, 0x82, 0x39, 0x18, 0xc3, 0x1a, 0xad,
+	0x54, 0xdb, 0xfb, 0x5c, 0x63, 0xd4, 0x1c, 0xb5, 0x12, 0x3e, 0xea, 0x4d, 0x98, 0x96, 0x6b, 0x20,
+	0xc8, 0x6b, 0x30, 0x1e, 0x8a, 0xf8, 0x7c, 0x07, 0x49, 0x42, 0x69, 0x61, 0xae, 0xde, 0x88, 0x50,
+	0xc4, 0xc2, 0x1d, 0xc6, 0xe5, 0x42, 0x94, 0x8c, 0x2a, 0x6f, 0x47, 0x30, 0x67, 0x54, 0xe2, 0xac,
+	0xf4, 0x3e, 0xd1, 0x36, 0x14, 0x93, 0x5f, 0x61, 0x74, 0xba, 0xad, 0x1b, 0x71, 0x6d, 0xe4, 0x5d,
+	0x9b, 0xfb, 0x5e, 0x30, 0xf2, 0x93, 0xc4, 0xcc, 0xbb, 0x36, 0x9d, 0x01, 0xc0, 0xab, 0xaa, 0xb8,
+	0x36, 0xef, 0x2c, 0x03, 0xe6, 0x45, 0x5c, 0xd9, 0xb2, 0x55, 0x1b, 0x4a, 0x1d, 0x45, 0x11, 0x6e,
+	0x13, 0x5e, 0x0e, 0x15, 0xb2, 0xf6, 0x90, 0x71, 0x2b, 0x25, 0xa7, 0xde, 0x86, 0x57, 0x92, 0x51,
+	0xb6, 0xbc, 0x5d, 0xf6, 0x1c, 0x9d, 0x49, 0x2d, 0xc3, 0xe4, 0x79, 0x39, 0xa4, 0xdd, 0x80, 0x01,
+	0xd7, 0xdb, 0x65, 0x58, 0xe4, 0xb3, 0xd2, 0x96, 0x60, 0x58, 0x7e, 0x58, 0xc9, 0x26, 0xb7, 0x56,
+	0xbf, 0x23, 0x70, 0x59, 0xb4, 0x98, 0x66, 0xf5, 0x3d, 0xa7, 0xf5, 0xae, 0xeb, 0x07, 0xac, 0xd1,
+	0x7a, 0x0e, 0xc6, 0x33, 0x73, 0x28, 0xff, 0xcc, 0x73, 0xe8, 0x67, 0x02, 0x8a, 0x8c, 0x0c, 0x8f,
+	0x7b, 0x0b, 0x86, 0xf6, 0xc4, 0x12, 0xb6, 0xa2, 0x05, 0x79, 0x13, 0x4c, 0x3a, 0xdf, 0xf4, 0x82,
+	0x46, 0x0b, 0x1b, 0x62, 0xe8, 0xfd, 0xc2, 0x26, 0xd0, 0xda, 0xf7, 0xe3, 0xf0, 0x12, 0x07, 0xa6,
+	0x5f, 0x11, 0x18, 0x0e, 0x9b, 0x27, 0x5d, 0x92, 0x72, 0xc9, 0x46, 0xb8, 0xb2, 0x9c, 0xc5, 0x54,
+	0x44, 0x56, 0x97, 0x8f, 0xfe, 0x7d, 0xb4, 0x4c, 0xbe, 0xf8, 0xe3, 0x9f, 0x6f, 0xf2, 0x25, 0x3a,
+	0xa3, 0x4b, 0x1f, 0x1b, 0x21, 0xc2, 0xb7, 0x04, 0x86, 0x50, 0x80, 0x2e, 0xf6, 0x8c, 0x11, 0xd2,
+	0x2c, 0x65, 0xb0, 0x44, 0x98, 0x8d, 0x18, 0x66, 0x89, 0x2e, 0x74, 0x85, 0xd1, 0x0f, 0xb0, 0x50,
+	0x0e, 0xe9, 0xaf, 0x04, 0xe8, 0xf9, 0xcf, 0x8f, 0xae, 0xf7, 0x8c, 0x7b, 0xbe, 0x03, 0x28, 0x1b,
+	0xfd, 0x39, 0xf5, 0xc1, 0x1d, 0xb5, 0xa7, 0x8a, 0x6b, 0xeb, 0x07, 0xae, 0x7d, 0x48, 0xbf, 0x24,
+	0x30, 0x28, 0x86, 0x2b, 0x5d, 0xe8, 0x1c, 0x36, 0x35, 0xc9, 0x95, 0xc5, 0xde, 0x86, 0xc8, 0xb4,
+	0x18, 0x33, 0xcd, 0xd0, 0x29, 0x29, 0x93, 0x98, 0xe5, 0xf4, 0x47, 0x02, 0xe3, 0xe9, 0x49, 0x4d,
+	0xf5, 0xce, 0x61, 0xa4, 0x13, 0x5f, 0xb9, 0x9a, 0xdd, 0x01, 0xf9, 0x56, 0x63, 0xbe, 0x79, 0xfa,
+	0xaa, 0x94, 0x6f, 0x9f, 0x7b, 0x56, 0xa2, 0xfa, 0xfb, 0x8d, 0xc0, 0x84, 0x64, 0x44, 0xd3, 0x8d,
+	0x8c, 0xc1, 0x53, 0x0f, 0x01, 0xe5, 0x5a, 0x9f, 0x5e, 0xc8, 0xfd, 0x46, 0xcc, 0xbd, 0x42, 0x5f,
+	0xcf, 0xc2, 0xad, 0x1f, 0xb4, 0x1f, 0x19, 0x87, 0xf4, 0x88, 0xc0, 0x68, 0x72, 0xa6, 0x77, 0xf8,
+	0x86, 0x24, 0xaf, 0x01, 0x65, 0x29, 0x83, 0x25, 0xf2, 0xcd, 0x75, 0xbd, 0x72, 0xf1, 0x4c, 0xa0,
+	0x8f, 0x08, 0x14, 0x64, 0xd3, 0x9d, 0xca, 0xef, 0xb1, 0xcb, 0x63, 0x42, 0x59, 0xed, 0xc3, 0x03,
+	0x11, 0xd7, 0xbb, 0x66, 0x4f, 0x20, 0xea, 0x07, 0xa9, 0x81, 0x7e, 0x48, 0x7f, 0x89, 0x91, 0x53,
+	0x6f, 0x80, 0xee, 0xc8, 0xb2, 0x47, 0x87, 0xb2, 0xda, 0x87, 0x47, 0xf8, 0x85, 0x73, 0x64, 0x8d,
+	0x5e, 0xc9, 0x84, 0x2c, 0x9e, 0x32, 0x87, 0xf4, 0x07, 0x02, 0x23, 0x89, 0x19, 0x4b, 0xaf, 0xf4,
+	0xec, 0x2e, 0x89, 0xc9, 0xae, 0xac, 0x64, 0xb4, 0xce, 0x5e, 0x98, 0xd1, 0x43, 0xc6, 0xdb, 0x65,
+	0x89, 0x06, 0xfa, 0x13, 0x81, 0xb1, 0xd4, 0x80, 0xa3, 0x5a, 0x97, 0x36, 0x23, 0x19, 0xf0, 0x8a,
+	0x9e, 0xd9, 0x1e, 0x61, 0xaf, 0xc7, 0xb0, 0x57, 0xa9, 0x26, 0xef, 0x4e, 0xcd, 0x6a, 0xe5, 0xae,
+	0xd3, 0xaa, 0xe0, 0x80, 0x8d, 0x79, 0x8d, 0xf5, 0xc7, 0x27, 0x45, 0xf2, 0xf4, 0xa4, 0x48, 0xfe,
+	0x3e, 0x29, 0x92, 0xaf, 0x4f, 0x8b, 0xb9, 0xa7, 0xa7, 0xc5, 0xdc, 0x9f, 0xa7, 0xc5, 0xdc, 0xc7,
+	0xf8, 0xcf, 0xb0, 0x6f, 0xdf, 0xd5, 0x5c, 0xa6, 0x3f, 0x10, 0x82, 0x41, 0xab, 0xee, 0xf8, 0xd5,
+	0x41, 0xfe, 0x4c, 0x5f, 0xff, 0x7f, 0x00, 0xe9, 0xc5, 0xc6, 0x14, 0x01, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1106,6 +1228,11 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// PubKeyHistory returns the previous public keys of an account, ordered from the
+	// oldest to the most recent rotation.
+	//
+	// Since: cosmos-sdk 0.51
+	PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -1206,6 +1333,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error) {
+	out := new(QueryPubKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1245,6 +1381,11 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// PubKeyHistory returns the previous public keys of an account, ordered from the
+	// oldest to the most recent rotation.
+	//
+	// Since: cosmos-sdk 0.51
+	PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1281,6 +1422,9 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) PubKeyHistory(ctx context.Context, req *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1466,6 +1610,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PubKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyHistory(ctx, req.(*QueryPubKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1510,6 +1672,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "PubKeyHistory",
+			Handler:    _Query_PubKeyHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2146,6 +2312,97 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPubKeyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2413,6 +2670,42 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPubKeyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4030,6 +4323,244 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPubKeyHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, PubKeyHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
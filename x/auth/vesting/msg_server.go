@@ -0,0 +1,355 @@
+package vesting
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	keeper.AccountKeeper
+	types.BankKeeper
+	types.StakingKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
+// wrapping the corresponding keepers.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Clawback removes the unvested coins from a ClawbackVestingAccount and sends
+// them to the destination address. The coins are taken from the spendable
+// balance of the account first, then from its delegations and finally from its
+// unbonding delegations, which are transferred to the destination address.
+func (s msgServer) Clawback(ctx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	acc := s.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	vaFunder, err := s.AccountKeeper.AddressCodec().StringToBytes(va.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	authority, err := s.AccountKeeper.AddressCodec().StringToBytes(s.AccountKeeper.GetAuthority())
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(funder, vaFunder) && !bytes.Equal(funder, authority) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the funder of account %s nor the authority", msg.FunderAddress, msg.Address)
+	}
+
+	dest := vaFunder
+	if msg.DestAddress != "" {
+		dest, err = s.AccountKeeper.AddressCodec().StringToBytes(msg.DestAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+
+	if bytes.Equal(dest, addr) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "destination address cannot be the vesting account")
+	}
+
+	if s.BankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+	unvested := va.ComputeClawback(blockTime)
+	if unvested.IsZero() {
+		return &types.MsgClawbackResponse{ClawedBack: sdk.NewCoins()}, nil
+	}
+
+	// the destination account must exist to receive the transferred
+	// delegations once they are undelegated.
+	if !s.AccountKeeper.HasAccount(ctx, dest) {
+		s.AccountKeeper.SetAccount(ctx, s.AccountKeeper.NewAccountWithAddress(ctx, dest))
+	}
+
+	bondDenom, err := s.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := s.StakingKeeper.GetAllDelegatorDelegations(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	ubds, err := s.StakingKeeper.GetAllUnbondingDelegations(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	delegated, err := s.delegatedTokens(ctx, delegations, ubds)
+	if err != nil {
+		return nil, err
+	}
+
+	// with the unvested coins removed from the schedules, update the delegation
+	// tracking so that only the vested but still locked coins are restricted.
+	va.UpdateDelegation(blockTime, sdk.NewCoins(sdk.NewCoin(bondDenom, delegated)))
+	s.AccountKeeper.SetAccount(ctx, va)
+
+	// the coins held on the balance are not spendable, they are left to the
+	// account and the rest of the unvested coins is taken from the delegations.
+	clawedBack := unvested.Min(s.BankKeeper.SpendableCoins(ctx, addr))
+	if !clawedBack.IsZero() {
+		if err := s.BankKeeper.SendCoins(ctx, addr, dest, clawedBack); err != nil {
+			return nil, err
+		}
+	}
+
+	remaining := unvested.Sub(clawedBack...).AmountOf(bondDenom)
+	transferred, err := s.transferDelegations(ctx, addr, dest, remaining, delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	transferredUnbonding, err := s.transferUnbondingDelegations(ctx, addr, dest, remaining.Sub(transferred), ubds)
+	if err != nil {
+		return nil, err
+	}
+
+	transferred = transferred.Add(transferredUnbonding)
+	if transferred.IsPositive() {
+		clawedBack = clawedBack.Add(sdk.NewCoin(bondDenom, transferred))
+	}
+
+	// the account may have been updated by the staking hooks, reload it.
+	va, ok = s.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if va.OriginalVesting.IsZero() {
+		// nothing vested, the account no longer has any restriction.
+		s.AccountKeeper.SetAccount(ctx, va.BaseAccount)
+	} else {
+		va.UpdateDelegation(blockTime, sdk.NewCoins(sdk.NewCoin(bondDenom, delegated.Sub(transferred))))
+		s.AccountKeeper.SetAccount(ctx, va)
+	}
+
+	return &types.MsgClawbackResponse{ClawedBack: clawedBack}, nil
+}
+
+// CreateClawbackVestingAccount creates a ClawbackVestingAccount funded with the
+// coins of its vesting periods by the funder, who can claw back its unvested coins.
+func (s msgServer) CreateClawbackVestingAccount(ctx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "vesting periods cannot be empty")
+	}
+
+	for _, periods := range []types.Periods{msg.LockupPeriods, msg.VestingPeriods} {
+		for i, period := range periods {
+			if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "period #%d has invalid coins: %s", i, period.Amount)
+			}
+		}
+	}
+
+	totalCoins := types.Periods(msg.VestingPeriods).TotalAmount()
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if s.AccountKeeper.HasAccount(ctx, to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	newAccount := s.AccountKeeper.NewAccountWithAddress(ctx, to)
+	baseAccount, ok := newAccount.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", newAccount)
+	}
+
+	acc, err := types.NewClawbackVestingAccount(baseAccount, msg.FunderAddress, totalCoins, msg.StartTime, msg.LockupPeriods, msg.VestingPeriods)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	s.AccountKeeper.SetAccount(ctx, acc)
+
+	if err := s.BankKeeper.SendCoins(ctx, funder, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// delegatedTokens returns the amount of tokens bonded in the given delegations
+// or being unbonded in the given unbonding delegations.
+func (s msgServer) delegatedTokens(ctx context.Context, delegations []stakingtypes.Delegation, ubds []stakingtypes.UnbondingDelegation) (math.Int, error) {
+	total := math.ZeroInt()
+	for _, delegation := range delegations {
+		valAddr, err := s.StakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		validator, err := s.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		total = total.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+
+	for _, ubd := range ubds {
+		for _, entry := range ubd.Entries {
+			total = total.Add(entry.Balance)
+		}
+	}
+
+	return total, nil
+}
+
+// transferDelegations moves up to amount tokens of the given delegations of
+// from to to, keeping them bonded to the same validators. It returns the
+// amount of tokens transferred.
+func (s msgServer) transferDelegations(ctx context.Context, from, to sdk.AccAddress, amount math.Int, delegations []stakingtypes.Delegation) (math.Int, error) {
+	transferred := math.ZeroInt()
+	for _, delegation := range delegations {
+		want := amount.Sub(transferred)
+		if !want.IsPositive() {
+			break
+		}
+
+		valAddr, err := s.StakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		validator, err := s.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		shares, err := validator.SharesFromTokens(want)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		tokens, err := s.StakingKeeper.Unbond(ctx, from, valAddr, math.LegacyMinDec(shares, delegation.Shares))
+		if err != nil {
+			return math.Int{}, err
+		}
+		if tokens.IsZero() {
+			continue
+		}
+
+		validator, err = s.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		// the tokens never leave the pool of the validator.
+		if _, err := s.StakingKeeper.Delegate(ctx, to, tokens, validator.Status, validator, false); err != nil {
+			return math.Int{}, err
+		}
+
+		transferred = transferred.Add(tokens)
+	}
+
+	return transferred, nil
+}
+
+// transferUnbondingDelegations moves up to amount tokens of the given
+// unbonding delegations of from to to, keeping their completion time. It
+// returns the amount of tokens transferred.
+func (s msgServer) transferUnbondingDelegations(ctx context.Context, from, to sdk.AccAddress, amount math.Int, ubds []stakingtypes.UnbondingDelegation) (math.Int, error) {
+	transferred := math.ZeroInt()
+	for _, ubd := range ubds {
+		if !amount.Sub(transferred).IsPositive() {
+			break
+		}
+
+		valAddr, err := s.StakingKeeper.ValidatorAddressCodec().StringToBytes(ubd.ValidatorAddress)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		modified := false
+		for i, entry := range ubd.Entries {
+			tokens := math.MinInt(entry.Balance, amount.Sub(transferred))
+			if !tokens.IsPositive() {
+				continue
+			}
+
+			// entries left with a zero balance complete without paying out anything.
+			ubd.Entries[i].Balance = entry.Balance.Sub(tokens)
+			ubd.Entries[i].InitialBalance = entry.InitialBalance.Sub(math.MinInt(tokens, entry.InitialBalance))
+			modified = true
+
+			if err := s.addUnbondingEntry(ctx, to, valAddr, entry.CreationHeight, entry.CompletionTime, tokens); err != nil {
+				return math.Int{}, err
+			}
+
+			transferred = transferred.Add(tokens)
+		}
+
+		if modified {
+			if err := s.StakingKeeper.SetUnbondingDelegation(ctx, ubd); err != nil {
+				return math.Int{}, err
+			}
+		}
+	}
+
+	return transferred, nil
+}
+
+// addUnbondingEntry creates an unbonding delegation entry for delAddr and
+// queues it for completion.
+func (s msgServer) addUnbondingEntry(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, completionTime time.Time, tokens math.Int) error {
+	ubd, err := s.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, creationHeight, completionTime, tokens)
+	if err != nil {
+		return err
+	}
+
+	return s.StakingKeeper.InsertUBDQueue(ctx, ubd, completionTime)
+}
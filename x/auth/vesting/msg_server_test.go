@@ -0,0 +1,285 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/testutil"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	"cosmossdk.io/x/auth/vesting/types"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestClawback(t *testing.T) {
+	var (
+		accountKeeper keeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, funder := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+	original := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	quarter := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250))
+
+	acc, err := types.NewClawbackVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr), funder.String(), original, startTime.Unix(),
+		types.Periods{{Length: 4 * 3600, Amount: original}},
+		types.Periods{{Length: 3600, Amount: quarter}, {Length: 3600, Amount: quarter}, {Length: 3600, Amount: quarter}, {Length: 3600, Amount: quarter}},
+	)
+	require.NoError(t, err)
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = append(startupCfg.GenesisAccounts, simtestutil.GenesisAccount{GenesisAccount: acc, Coins: original})
+
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(testutil.AppConfig, depinject.Supply(log.NewNopLogger())),
+		startupCfg, &accountKeeper, &bankKeeper, &stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false).WithHeaderInfo(header.Info{Height: 2, Time: startTime})
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+	validator := validators[0]
+	valAddr, err := stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	require.NoError(t, err)
+
+	// delegate 600 coins and undelegate 100 of them, leaving 400 coins in the balance.
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(stakingKeeper)
+	_, err = stakingMsgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(addr.String(), validator.GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)))
+	require.NoError(t, err)
+	_, err = stakingMsgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(addr.String(), validator.GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	require.NoError(t, err)
+
+	msgServer := vesting.NewMsgServerImpl(accountKeeper, bankKeeper, stakingKeeper)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: startTime.Add(time.Hour)})
+
+	// require only the funder or the authority to claw back
+	_, err = msgServer.Clawback(ctx, types.NewMsgClawback(addr.String(), addr.String(), ""))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// require the clawback to take 400 coins from the balance and 350 coins from the delegation
+	res, err := msgServer.Clawback(ctx, types.NewMsgClawback(funder.String(), addr.String(), ""))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 750)), res.ClawedBack)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), bankKeeper.GetBalance(ctx, funder, sdk.DefaultBondDenom))
+	require.True(t, bankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).IsZero())
+
+	funderDelegation, err := stakingKeeper.Delegations.Get(ctx, collections.Join(funder, sdk.ValAddress(valAddr)))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(350), validator.TokensFromShares(funderDelegation.Shares).TruncateInt())
+	delegation, err := stakingKeeper.Delegations.Get(ctx, collections.Join(addr, sdk.ValAddress(valAddr)))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(150), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// require the vested coins to remain locked until the end of the lockup
+	va, ok := accountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, quarter, va.OriginalVesting)
+	require.Equal(t, quarter, va.DelegatedVesting)
+	require.True(t, va.DelegatedFree.IsZero())
+	require.NoError(t, va.Validate())
+
+	// require nothing left to claw back
+	res, err = msgServer.Clawback(ctx, types.NewMsgClawback(accountKeeper.GetAuthority(), addr.String(), ""))
+	require.NoError(t, err)
+	require.True(t, res.ClawedBack.IsZero())
+}
+
+func TestClawbackHeldCoins(t *testing.T) {
+	var (
+		accountKeeper keeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, funder := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+	original := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	quarter := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250))
+
+	acc, err := types.NewClawbackVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr), funder.String(), original, startTime.Unix(), nil,
+		types.Periods{{Length: 3600, Amount: quarter}, {Length: 3600, Amount: quarter}, {Length: 3600, Amount: quarter}, {Length: 3600, Amount: quarter}},
+	)
+	require.NoError(t, err)
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = append(startupCfg.GenesisAccounts, simtestutil.GenesisAccount{GenesisAccount: acc, Coins: original})
+
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(testutil.AppConfig, depinject.Supply(log.NewNopLogger())),
+		startupCfg, &accountKeeper, &bankKeeper, &stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false).WithHeaderInfo(header.Info{Height: 2, Time: startTime.Add(time.Hour)})
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+
+	// delegate 600 coins, and hold 200 of the 250 vested coins left in the balance.
+	_, err = stakingkeeper.NewMsgServerImpl(stakingKeeper).Delegate(ctx, stakingtypes.NewMsgDelegate(addr.String(), validators[0].GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)))
+	require.NoError(t, err)
+	held := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	require.NoError(t, bankKeeper.AddHold(ctx, addr, held, "order-1"))
+
+	// require the held coins to stay in the balance, the rest being taken from the delegation
+	res, err := vesting.NewMsgServerImpl(accountKeeper, bankKeeper, stakingKeeper).Clawback(ctx, types.NewMsgClawback(funder.String(), addr.String(), ""))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 750)), res.ClawedBack)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), bankKeeper.GetBalance(ctx, funder, sdk.DefaultBondDenom))
+	require.Equal(t, held, bankKeeper.GetAllBalances(ctx, addr))
+	require.Equal(t, held, bankKeeper.GetHold(ctx, addr, "order-1"))
+	require.True(t, bankKeeper.SpendableCoins(ctx, addr).IsZero())
+}
+
+func TestClawbackUnbonding(t *testing.T) {
+	var (
+		accountKeeper keeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, funder := testdata.KeyTestPubAddr()
+	_, _, dest := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+	original := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	acc, err := types.NewClawbackVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr), funder.String(), original, startTime.Unix(),
+		nil, types.Periods{{Length: 3600, Amount: original}},
+	)
+	require.NoError(t, err)
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = append(startupCfg.GenesisAccounts, simtestutil.GenesisAccount{GenesisAccount: acc, Coins: original})
+
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(testutil.AppConfig, depinject.Supply(log.NewNopLogger())),
+		startupCfg, &accountKeeper, &bankKeeper, &stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false).WithHeaderInfo(header.Info{Height: 2, Time: startTime})
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	validator := validators[0]
+	valAddr, err := stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	require.NoError(t, err)
+
+	// undelegate all the delegated coins to escape the clawback.
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(stakingKeeper)
+	_, err = stakingMsgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(addr.String(), validator.GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	require.NoError(t, err)
+	_, err = stakingMsgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(addr.String(), validator.GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	require.NoError(t, err)
+
+	res, err := vesting.NewMsgServerImpl(accountKeeper, bankKeeper, stakingKeeper).
+		Clawback(ctx, types.NewMsgClawback(accountKeeper.GetAuthority(), addr.String(), dest.String()))
+	require.NoError(t, err)
+	require.Equal(t, original, res.ClawedBack)
+
+	// require the unbonding delegation to be transferred to the destination address
+	ubd, err := stakingKeeper.GetUnbondingDelegation(ctx, dest, valAddr)
+	require.NoError(t, err)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, math.NewInt(1000), ubd.Entries[0].Balance)
+
+	ubd, err = stakingKeeper.GetUnbondingDelegation(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.True(t, ubd.Entries[0].Balance.IsZero())
+
+	// require the account to no longer be a vesting account
+	_, ok := accountKeeper.GetAccount(ctx, addr).(*authtypes.BaseAccount)
+	require.True(t, ok)
+
+	// require the destination address to receive the coins once the unbonding completes
+	params, err := stakingKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: startTime.Add(params.UnbondingTime)})
+	_, err = stakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), bankKeeper.GetBalance(ctx, dest, sdk.DefaultBondDenom))
+	require.True(t, bankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).IsZero())
+}
+
+func TestCreateClawbackVestingAccount(t *testing.T) {
+	var (
+		accountKeeper keeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, funder := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+	original := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	half := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = append(startupCfg.GenesisAccounts, simtestutil.GenesisAccount{GenesisAccount: authtypes.NewBaseAccountWithAddress(funder), Coins: original})
+
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(testutil.AppConfig, depinject.Supply(log.NewNopLogger())),
+		startupCfg, &accountKeeper, &bankKeeper, &stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false).WithHeaderInfo(header.Info{Height: 2, Time: startTime})
+	msgServer := vesting.NewMsgServerImpl(accountKeeper, bankKeeper, stakingKeeper)
+
+	lockupPeriods := types.Periods{{Length: 7200, Amount: original}}
+	vestingPeriods := types.Periods{{Length: 3600, Amount: half}, {Length: 3600, Amount: half}}
+
+	// require the vesting periods to be set
+	_, err = msgServer.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder.String(), addr.String(), startTime.Unix(), lockupPeriods, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// require the lockup schedule to release the original vesting coins
+	_, err = msgServer.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder.String(), addr.String(), startTime.Unix(), types.Periods{{Length: 7200, Amount: half}}, vestingPeriods))
+	require.ErrorContains(t, err, "lockup periods")
+
+	_, err = msgServer.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder.String(), addr.String(), startTime.Unix(), lockupPeriods, vestingPeriods))
+	require.NoError(t, err)
+
+	va, ok := accountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, funder.String(), va.FunderAddress)
+	require.Equal(t, original, va.OriginalVesting)
+	require.Equal(t, startTime.Unix()+7200, va.EndTime)
+	require.Equal(t, original, bankKeeper.GetAllBalances(ctx, addr))
+	require.True(t, bankKeeper.GetAllBalances(ctx, funder).IsZero())
+
+	// require the account not to exist yet
+	_, err = msgServer.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder.String(), addr.String(), startTime.Unix(), lockupPeriods, vestingPeriods))
+	require.ErrorContains(t, err, "already exists")
+
+	// require the funder to be able to claw back the unvested coins
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: startTime.Add(time.Hour)})
+	res, err := msgServer.Clawback(ctx, types.NewMsgClawback(funder.String(), addr.String(), ""))
+	require.NoError(t, err)
+	require.Equal(t, half, res.ClawedBack)
+	require.Equal(t, half, bankKeeper.GetAllBalances(ctx, funder))
+}
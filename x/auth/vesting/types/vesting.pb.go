@@ -6,6 +6,7 @@ package types
 import (
 	types "cosmossdk.io/x/auth/types"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -289,6 +290,58 @@ func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It vests
+// coins according to two independent schedules: the lockup schedule defines
+// when coins become transferable, while the vesting schedule defines when
+// coins are no longer subject to clawback by the funder. Coins are spendable
+// once they are both unlocked and vested.
+//
+// Since: cosmos-sdk 0.51
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// funder_address is the address allowed to claw back the unvested coins.
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// Vesting start time, as unix timestamp (in seconds).
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// lockup_periods defines the schedule at which coins become transferable.
+	LockupPeriods []Period `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3" json:"lockup_periods"`
+	// vesting_periods defines the schedule at which coins stop being subject to clawback.
+	VestingPeriods []Period `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()         { *m = ClawbackVestingAccount{} }
+func (m *ClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ClawbackVestingAccount) ProtoMessage()    {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{6}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
@@ -296,6 +349,7 @@ func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() {
@@ -303,46 +357,52 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x6b, 0x13, 0x4f,
-	0x1c, 0xce, 0x34, 0xfd, 0xe7, 0x6f, 0x27, 0xb5, 0x2f, 0x4b, 0x0d, 0x69, 0xa1, 0x9b, 0xb8, 0x8a,
-	0xc4, 0xa0, 0xbb, 0xb4, 0xde, 0xe2, 0xc9, 0x54, 0x0a, 0x82, 0x07, 0x09, 0xe2, 0xc1, 0x4b, 0x98,
-	0xdd, 0x1d, 0x37, 0x43, 0xb2, 0x33, 0x61, 0x67, 0x52, 0xcc, 0x37, 0x28, 0x22, 0xe2, 0xd9, 0x5e,
-	0x3c, 0x49, 0xf1, 0x94, 0x8f, 0x51, 0x10, 0x21, 0x47, 0x4f, 0x55, 0x92, 0x43, 0xbe, 0x86, 0xec,
-	0xcc, 0xec, 0x36, 0xe4, 0xe5, 0x1a, 0xbc, 0x24, 0x3b, 0xbf, 0xb7, 0xe7, 0x79, 0x7e, 0x79, 0x36,
-	0x03, 0xef, 0x7b, 0x8c, 0x87, 0x8c, 0x3b, 0x67, 0x98, 0x0b, 0x42, 0x03, 0xe7, 0xec, 0xc8, 0xc5,
-	0x02, 0x1d, 0x25, 0x67, 0xbb, 0x1b, 0x31, 0xc1, 0x8c, 0x82, 0xaa, 0xb2, 0x93, 0xa8, 0xae, 0x3a,
-	0xd8, 0x45, 0x21, 0xa1, 0xcc, 0x91, 0x9f, 0xaa, 0xf4, 0x60, 0x2f, 0x60, 0x01, 0x93, 0x8f, 0x4e,
-	0xfc, 0xa4, 0xa3, 0xa6, 0x86, 0x71, 0x11, 0xc7, 0x29, 0x86, 0xc7, 0x08, 0x9d, 0xc9, 0xa3, 0x9e,
-	0x68, 0xa5, 0xf9, 0xf8, 0xa0, 0xf2, 0xd6, 0xcf, 0x75, 0x68, 0xd4, 0x11, 0xc7, 0x6f, 0x14, 0x81,
-	0x67, 0x9e, 0xc7, 0x7a, 0x54, 0x18, 0x2f, 0xe0, 0x66, 0x3c, 0xb1, 0x89, 0xd4, 0xb9, 0x08, 0xca,
-	0xa0, 0x92, 0x3f, 0x2e, 0xdb, 0x9a, 0xae, 0x1c, 0xa0, 0xa7, 0xd9, 0x71, 0xbb, 0xee, 0xab, 0xaf,
-	0x0f, 0xaf, 0x4b, 0xa0, 0x91, 0x77, 0x6f, 0x42, 0xc6, 0x47, 0x00, 0x77, 0x58, 0x44, 0x02, 0x42,
-	0x51, 0xa7, 0xa9, 0x75, 0x16, 0xd7, 0xca, 0xd9, 0x4a, 0xfe, 0x78, 0x3f, 0x99, 0x17, 0xd7, 0xa7,
-	0xf3, 0x4e, 0x18, 0xa1, 0xf5, 0xd3, 0xab, 0xeb, 0x52, 0xe6, 0xfb, 0xef, 0x52, 0x25, 0x20, 0xa2,
-	0xd5, 0x73, 0x6d, 0x8f, 0x85, 0x8e, 0x96, 0xa2, 0xbe, 0x1e, 0x73, 0xbf, 0xed, 0x88, 0x7e, 0x17,
-	0x73, 0xd9, 0xc0, 0xbf, 0x4c, 0x06, 0xd5, 0xcd, 0x0e, 0x0e, 0x90, 0xd7, 0x6f, 0xc6, 0xfa, 0xf9,
-	0xe5, 0x64, 0x50, 0x05, 0x8d, 0xed, 0x04, 0x5a, 0x0b, 0x34, 0xce, 0x01, 0xdc, 0xf2, 0x71, 0x5c,
-	0x28, 0xb0, 0xdf, 0x7c, 0x17, 0x61, 0x5c, 0xcc, 0xae, 0x8a, 0xcc, 0xed, 0x14, 0xf8, 0x34, 0xc2,
-	0xd8, 0xf8, 0x04, 0xe0, 0xee, 0x0d, 0x95, 0x64, 0x35, 0xeb, 0xab, 0x62, 0xb3, 0x93, 0x62, 0x27,
-	0xbb, 0xd9, 0x87, 0xb7, 0x30, 0xf5, 0x9b, 0x82, 0x84, 0xb8, 0xf8, 0x5f, 0x19, 0x54, 0xb2, 0x8d,
-	0xff, 0x31, 0xf5, 0x5f, 0x93, 0x10, 0xd7, 0x1e, 0x9c, 0x7f, 0x2d, 0x65, 0x3e, 0x4c, 0x06, 0xd5,
-	0xc3, 0x29, 0x8c, 0x79, 0xe3, 0x58, 0x3f, 0x00, 0x2c, 0x9e, 0x30, 0x2a, 0x08, 0xed, 0xb1, 0x1e,
-	0x9f, 0x71, 0x95, 0x0b, 0xf7, 0xa4, 0xab, 0xb4, 0xd4, 0x19, 0x77, 0x55, 0xed, 0xc5, 0x2f, 0x83,
-	0x3d, 0x0f, 0xa3, 0x7d, 0x66, 0xb8, 0xf3, 0xce, 0x3d, 0x84, 0x90, 0x0b, 0x14, 0x09, 0xa5, 0x62,
-	0x4d, 0xaa, 0xd8, 0x90, 0x11, 0xa9, 0xe3, 0x51, 0xa2, 0xe3, 0xde, 0x94, 0x8e, 0x65, 0x84, 0xad,
-	0x6f, 0x00, 0xde, 0x79, 0x8e, 0x3b, 0xa8, 0x9f, 0xee, 0x68, 0x85, 0x52, 0x6a, 0x0f, 0x13, 0xae,
-	0xe5, 0x29, 0xae, 0x0b, 0xe9, 0x58, 0x17, 0x00, 0xe6, 0x5e, 0xe1, 0x88, 0x30, 0xdf, 0x28, 0xc0,
-	0x5c, 0x07, 0xd3, 0x40, 0xb4, 0x24, 0x97, 0x6c, 0x43, 0x9f, 0x8c, 0x3e, 0xcc, 0xa1, 0x50, 0x72,
-	0x5c, 0xd9, 0xcb, 0xa7, 0x01, 0xad, 0x8b, 0x35, 0x58, 0x50, 0xec, 0x88, 0xf7, 0xcf, 0x59, 0xc2,
-	0x68, 0xc0, 0xed, 0x04, 0xbd, 0x2b, 0x49, 0x72, 0xfd, 0x8f, 0x60, 0x2e, 0x43, 0x57, 0x5a, 0xea,
-	0x1b, 0xf1, 0x9a, 0x94, 0xd2, 0x2d, 0x5d, 0xa2, 0x32, 0xbc, 0x56, 0x4d, 0x7e, 0xba, 0xbb, 0x53,
-	0x0b, 0x5b, 0xbc, 0x02, 0xeb, 0x12, 0xc8, 0xed, 0x84, 0x88, 0x62, 0x2a, 0x5e, 0x32, 0xaf, 0x8d,
-	0xfd, 0x55, 0xba, 0x6c, 0x19, 0xd5, 0x05, 0x7c, 0xea, 0x4f, 0xaf, 0x46, 0x26, 0x18, 0x8e, 0x4c,
-	0xf0, 0x67, 0x64, 0x82, 0xcf, 0x63, 0x33, 0x33, 0x1c, 0x9b, 0x99, 0x5f, 0x63, 0x33, 0xf3, 0x56,
-	0x37, 0x73, 0xbf, 0x6d, 0x13, 0xe6, 0xbc, 0xd7, 0xf7, 0x8d, 0xbe, 0xfb, 0xa4, 0x53, 0xdc, 0x9c,
-	0xbc, 0x71, 0x9e, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x25, 0x0e, 0xad, 0x1a, 0x07, 0x00,
-	0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x4f, 0x13, 0x4d,
+	0x1c, 0xee, 0xd0, 0xd2, 0xf7, 0x65, 0x80, 0x02, 0x1b, 0x6c, 0x0a, 0x09, 0xdb, 0xba, 0x1a, 0x53,
+	0x1b, 0xd9, 0x06, 0xbc, 0xe1, 0xc1, 0x50, 0x0c, 0x89, 0x89, 0x07, 0x52, 0x8d, 0x07, 0x2f, 0x9b,
+	0xd9, 0xdd, 0x61, 0x99, 0xb4, 0x3b, 0xd3, 0xec, 0xcc, 0xa2, 0xfd, 0x06, 0xc4, 0x18, 0xe3, 0x59,
+	0x2e, 0x9e, 0x0c, 0xf1, 0x60, 0x38, 0xf8, 0x21, 0x48, 0x8c, 0x09, 0xf1, 0xe4, 0x09, 0x0d, 0x1c,
+	0xf8, 0x1a, 0x66, 0x67, 0x66, 0x4b, 0x2d, 0xe5, 0xe0, 0xc1, 0xea, 0xa5, 0xdd, 0x99, 0xdf, 0x9f,
+	0xe7, 0x79, 0x7e, 0xf3, 0xcc, 0x66, 0xe1, 0x4d, 0x8f, 0xf1, 0x90, 0xf1, 0xfa, 0x2e, 0xe6, 0x82,
+	0xd0, 0xa0, 0xbe, 0xbb, 0xe2, 0x62, 0x81, 0x56, 0xd2, 0xb5, 0xdd, 0x89, 0x98, 0x60, 0x46, 0x51,
+	0x65, 0xd9, 0xe9, 0xae, 0xce, 0x5a, 0x9c, 0x43, 0x21, 0xa1, 0xac, 0x2e, 0x7f, 0x55, 0xea, 0xe2,
+	0x7c, 0xc0, 0x02, 0x26, 0x1f, 0xeb, 0xc9, 0x93, 0xde, 0x5d, 0x50, 0x0d, 0x1c, 0x15, 0xd0, 0xdd,
+	0x54, 0xc8, 0xd4, 0x0c, 0x5c, 0xc4, 0x71, 0x0f, 0xde, 0x63, 0x84, 0x0e, 0xc4, 0x51, 0x2c, 0x76,
+	0x7a, 0xf1, 0x64, 0xa1, 0xe2, 0xd6, 0x97, 0x1c, 0x34, 0x1a, 0x88, 0xe3, 0xa7, 0x8a, 0xdb, 0xba,
+	0xe7, 0xb1, 0x98, 0x0a, 0xe3, 0x21, 0x9c, 0x4a, 0x3a, 0x3a, 0x48, 0xad, 0x4b, 0xa0, 0x02, 0xaa,
+	0x93, 0xab, 0x15, 0x5b, 0x63, 0xcb, 0x06, 0xba, 0x9b, 0x9d, 0x94, 0xeb, 0xba, 0x46, 0xee, 0xf8,
+	0xa4, 0x0c, 0x9a, 0x93, 0xee, 0xc5, 0x96, 0xf1, 0x0a, 0xc0, 0x59, 0x16, 0x91, 0x80, 0x50, 0xd4,
+	0x76, 0xf4, 0x08, 0x4a, 0x63, 0x95, 0x6c, 0x75, 0x72, 0x75, 0x21, 0xed, 0x97, 0xe4, 0xf7, 0xfa,
+	0x6d, 0x30, 0x42, 0x1b, 0x9b, 0x47, 0x27, 0xe5, 0xcc, 0x87, 0xef, 0xe5, 0x6a, 0x40, 0xc4, 0x4e,
+	0xec, 0xda, 0x1e, 0x0b, 0xb5, 0x70, 0xfd, 0xb7, 0xcc, 0xfd, 0x56, 0x5d, 0x74, 0x3b, 0x98, 0xcb,
+	0x02, 0xfe, 0xf6, 0xfc, 0xb0, 0x36, 0xd5, 0xc6, 0x01, 0xf2, 0xba, 0x4e, 0xa2, 0x9f, 0x1f, 0x9c,
+	0x1f, 0xd6, 0x40, 0x73, 0x26, 0x85, 0xd6, 0x02, 0x8d, 0x3d, 0x00, 0x0b, 0x3e, 0x4e, 0x12, 0x05,
+	0xf6, 0x9d, 0xed, 0x08, 0xe3, 0x52, 0x76, 0x54, 0x64, 0xa6, 0x7b, 0xc0, 0x9b, 0x11, 0xc6, 0xc6,
+	0x6b, 0x00, 0xe7, 0x2e, 0xa8, 0xa4, 0xa3, 0xc9, 0x8d, 0x8a, 0xcd, 0x6c, 0x0f, 0x3b, 0x9d, 0xcd,
+	0x02, 0xfc, 0x1f, 0x53, 0xdf, 0x11, 0x24, 0xc4, 0xa5, 0xf1, 0x0a, 0xa8, 0x66, 0x9b, 0xff, 0x61,
+	0xea, 0x3f, 0x21, 0x21, 0x5e, 0xbb, 0xb5, 0xf7, 0xae, 0x9c, 0x79, 0x79, 0x7e, 0x58, 0x5b, 0xea,
+	0xc3, 0xb8, 0x6c, 0x1c, 0xeb, 0x33, 0x80, 0xa5, 0x0d, 0x46, 0x05, 0xa1, 0x31, 0x8b, 0xf9, 0x80,
+	0xab, 0x5c, 0x38, 0x2f, 0x5d, 0xa5, 0xa5, 0x0e, 0xb8, 0xab, 0x66, 0x0f, 0xbf, 0x27, 0xf6, 0x65,
+	0x18, 0xed, 0x33, 0xc3, 0xbd, 0xec, 0xdc, 0x25, 0x08, 0xb9, 0x40, 0x91, 0x50, 0x2a, 0xc6, 0xa4,
+	0x8a, 0x09, 0xb9, 0x23, 0x75, 0xdc, 0x49, 0x75, 0xdc, 0xe8, 0xd3, 0x71, 0x15, 0x61, 0xeb, 0x3d,
+	0x80, 0xd7, 0x1e, 0xe0, 0x36, 0xea, 0x62, 0xff, 0xd7, 0xc8, 0x28, 0xa4, 0xac, 0xdd, 0x4e, 0xb9,
+	0x56, 0xfa, 0xb8, 0x0e, 0xa5, 0x63, 0xed, 0x03, 0x98, 0xdf, 0xc2, 0x11, 0x61, 0xbe, 0x51, 0x84,
+	0xf9, 0x36, 0xa6, 0x81, 0xd8, 0x91, 0x5c, 0xb2, 0x4d, 0xbd, 0x32, 0xba, 0x30, 0x8f, 0x42, 0xc9,
+	0x71, 0x64, 0x97, 0x4f, 0x03, 0x5a, 0xfb, 0x63, 0xb0, 0xa8, 0xd8, 0x11, 0xef, 0x9f, 0xb3, 0x84,
+	0xd1, 0x84, 0x33, 0x29, 0x7a, 0x47, 0x92, 0xe4, 0xfa, 0x8d, 0x60, 0x5e, 0x85, 0xae, 0xb4, 0x34,
+	0x26, 0x92, 0x31, 0x29, 0xa5, 0x05, 0x9d, 0xa2, 0x22, 0x7c, 0xad, 0x96, 0x1e, 0xdd, 0xf5, 0xbe,
+	0x81, 0x0d, 0x1f, 0x81, 0x75, 0x00, 0xe4, 0x74, 0x42, 0x44, 0x31, 0x15, 0x8f, 0x98, 0xd7, 0xc2,
+	0xfe, 0x28, 0x5d, 0x76, 0x15, 0xd5, 0x21, 0x7c, 0xac, 0x8f, 0x59, 0x58, 0xdc, 0x68, 0xa3, 0xe7,
+	0x2e, 0xf2, 0x5a, 0x7f, 0xe1, 0x20, 0xef, 0xc3, 0xc2, 0x76, 0x4c, 0x7d, 0x1c, 0x39, 0xc8, 0xf7,
+	0x23, 0xcc, 0xb9, 0x3c, 0xcc, 0x89, 0x46, 0xe9, 0xeb, 0xa7, 0xe5, 0x79, 0x0d, 0xb0, 0xae, 0x22,
+	0x8f, 0x45, 0x44, 0x68, 0xd0, 0x9c, 0x56, 0xf9, 0x7a, 0x73, 0xc0, 0x09, 0xd9, 0x41, 0x27, 0x6c,
+	0xc1, 0x42, 0x9b, 0x79, 0xad, 0xb8, 0xd3, 0x33, 0x42, 0xee, 0x77, 0x8d, 0x30, 0xad, 0x1a, 0xa8,
+	0x00, 0x1f, 0xe6, 0xad, 0xf1, 0x3f, 0xe2, 0xad, 0xe1, 0xa7, 0xd2, 0xb8, 0x77, 0x74, 0x6a, 0x82,
+	0xe3, 0x53, 0x13, 0xfc, 0x38, 0x35, 0xc1, 0x9b, 0x33, 0x33, 0x73, 0x7c, 0x66, 0x66, 0xbe, 0x9d,
+	0x99, 0x99, 0x67, 0xba, 0x98, 0xfb, 0x2d, 0x9b, 0xb0, 0xfa, 0x0b, 0xfd, 0x81, 0xa0, 0xaa, 0xd5,
+	0xd5, 0x76, 0xf3, 0xf2, 0x13, 0xe1, 0xee, 0xcf, 0x01, 0x00, 0x8e, 0xd4, 0xb6, 0x54, 0xe6, 0x08,
+	0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -633,6 +693,81 @@ func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -760,6 +895,38 @@ func (m *PermanentLockedAccount) Size() (n int) {
 	return n
 }
 
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1492,6 +1659,211 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
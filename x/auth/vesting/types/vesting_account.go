@@ -518,3 +518,200 @@ func (plva PermanentLockedAccount) Validate() error {
 
 	return plva.BaseVestingAccount.Validate()
 }
+
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. If no lockup
+// periods are given, coins are unlocked as soon as they are vested.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funderAddr string, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) (*ClawbackVestingAccount, error) {
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         startTime + max(lockupPeriods.TotalLength(), vestingPeriods.TotalLength()),
+	}
+
+	clawbackVestingAccount := &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funderAddr,
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+
+	return clawbackVestingAccount, clawbackVestingAccount.Validate()
+}
+
+// GetUnlockedOnly returns the coins released by the lockup schedule at
+// blockTime, regardless of the vesting schedule.
+func (cva ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(cva.StartTime, cva.LockupPeriods, blockTime)
+}
+
+// GetVestedOnly returns the coins released by the vesting schedule at
+// blockTime, regardless of the lockup schedule. Those coins can no longer be
+// clawed back.
+func (cva ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(cva.StartTime, cva.VestingPeriods, blockTime)
+}
+
+// GetVestedCoins returns the coins that are both vested and unlocked, i.e.
+// the coins that are no longer restricted by the account.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return cva.GetVestedOnly(blockTime).Min(cva.GetUnlockedOnly(blockTime))
+}
+
+// GetVestingCoins returns the coins that are either unvested or still locked.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and lockup start for a clawback
+// vesting account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetLockupPeriods returns the lockup periods associated with the clawback vesting account.
+func (cva ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return cva.LockupPeriods
+}
+
+// GetVestingPeriods returns the vesting periods associated with the clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// ComputeClawback removes the coins that are not vested at blockTime from the
+// account and returns them. The vesting schedule is truncated to the periods
+// that already ended, and the lockup schedule is capped to the remaining
+// original vesting coins, so vested coins keep being unlocked as before.
+//
+// The caller is responsible for moving the returned coins out of the account
+// and for updating the delegation tracking, see UpdateDelegation.
+func (cva *ClawbackVestingAccount) ComputeClawback(blockTime time.Time) sdk.Coins {
+	vested := cva.GetVestedOnly(blockTime)
+	unvested := cva.OriginalVesting.Sub(vested...)
+
+	cva.LockupPeriods = capPeriods(cva.LockupPeriods, vested)
+	cva.VestingPeriods = capPeriods(cva.VestingPeriods, vested)
+	cva.OriginalVesting = vested
+	cva.EndTime = cva.StartTime + max(Periods(cva.LockupPeriods).TotalLength(), Periods(cva.VestingPeriods).TotalLength())
+
+	return unvested
+}
+
+// UpdateDelegation resets the delegated vesting and delegated free coins of
+// the account, given the coins it has delegated, including the coins being
+// undelegated. Delegated coins are considered vesting first.
+func (cva *ClawbackVestingAccount) UpdateDelegation(blockTime time.Time, delegated sdk.Coins) {
+	cva.DelegatedVesting = delegated.Min(cva.GetVestingCoins(blockTime))
+	cva.DelegatedFree = delegated.Sub(cva.DelegatedVesting...)
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	if cva.FunderAddress == "" {
+		return errors.New("funder address cannot be empty")
+	}
+
+	if err := validateSchedule("lockup", cva.LockupPeriods, cva.OriginalVesting); err != nil {
+		return err
+	}
+	if err := validateSchedule("vesting", cva.VestingPeriods, cva.OriginalVesting); err != nil {
+		return err
+	}
+
+	endTime := cva.StartTime + max(Periods(cva.LockupPeriods).TotalLength(), Periods(cva.VestingPeriods).TotalLength())
+	if endTime != cva.EndTime {
+		return errors.New("vesting end time does not match length of the lockup and vesting periods")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+// readSchedule returns the sum of the amounts of the periods of a schedule
+// starting at startTime that ended at blockTime.
+func readSchedule(startTime int64, periods Periods, blockTime time.Time) sdk.Coins {
+	released := sdk.NewCoins()
+	periodEndTime := startTime
+	for _, period := range periods {
+		periodEndTime += period.Length
+		if blockTime.Unix() < periodEndTime {
+			break
+		}
+
+		released = released.Add(period.Amount...)
+	}
+
+	return released
+}
+
+// capPeriods returns periods with the same release times as the given ones,
+// such that the cumulative released amount never exceeds limit. Periods that
+// would release nothing are merged into the next one.
+func capPeriods(periods Periods, limit sdk.Coins) Periods {
+	var (
+		capped             Periods
+		total, cappedTotal sdk.Coins
+		length             int64
+	)
+	for _, period := range periods {
+		length += period.Length
+		total = total.Add(period.Amount...)
+
+		amount := total.Min(limit).Sub(cappedTotal...)
+		if amount.IsZero() {
+			continue
+		}
+
+		capped = append(capped, Period{Length: length, Amount: amount})
+		cappedTotal = cappedTotal.Add(amount...)
+		length = 0
+	}
+
+	return capped
+}
+
+// validateSchedule checks that the periods of a schedule are valid and that
+// they release exactly the original vesting coins.
+func validateSchedule(name string, periods Periods, originalVesting sdk.Coins) error {
+	for i, p := range periods {
+		if p.Length < 0 {
+			return fmt.Errorf("%s period #%d has a negative length: %d", name, i, p.Length)
+		}
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return fmt.Errorf("%s period #%d has invalid coins: %s", name, i, p.Amount.String())
+		}
+	}
+
+	if total := periods.TotalAmount(); !total.Equal(originalVesting) {
+		return fmt.Errorf("original vesting coins (%v) does not match the sum of all coins in %s periods (%v)", originalVesting, name, total)
+	}
+
+	return nil
+}
@@ -829,6 +829,151 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	cva, err := types.NewClawbackVestingAccount(bacc, funder.String(), origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), cva.GetEndTime())
+
+	// require no coins vested at the beginning of the schedules
+	require.True(t, cva.GetVestedCoins(now).IsZero())
+	require.Equal(t, origCoins, cva.GetVestingCoins(now))
+
+	// require coins vested but still locked to be vesting
+	require.Equal(t, vestingPeriods[0].Amount, cva.GetVestedOnly(now.Add(6*time.Hour)))
+	require.True(t, cva.GetUnlockedOnly(now.Add(6*time.Hour)).IsZero())
+	require.True(t, cva.GetVestedCoins(now.Add(6*time.Hour)).IsZero())
+
+	// require unlocked coins that are not vested yet to be vesting
+	require.Equal(t, origCoins, cva.GetUnlockedOnly(now.Add(12*time.Hour)))
+	require.Equal(t, vestingPeriods[0].Amount, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, origCoins.Sub(vestingPeriods[0].Amount...), cva.GetVestingCoins(now.Add(12*time.Hour)))
+
+	// require all coins vested at the end of both schedules
+	require.Equal(t, origCoins, cva.GetVestedCoins(now.Add(24*time.Hour)))
+	require.True(t, cva.GetVestingCoins(now.Add(24*time.Hour)).IsZero())
+
+	// require coins to be unlocked as they vest without lockup periods
+	cva, err = types.NewClawbackVestingAccount(bacc, funder.String(), origCoins, now.Unix(), nil, vestingPeriods)
+	require.NoError(t, err)
+	require.Equal(t, vestingPeriods[0].Amount, cva.GetVestedCoins(now.Add(6*time.Hour)))
+}
+
+func TestSpendableCoinsClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	cva, err := types.NewClawbackVestingAccount(bacc, funder.String(), origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+
+	// require that all coins are locked at the beginning of the schedules
+	require.Equal(t, origCoins, cva.LockedCoins(now))
+
+	// require that vested coins are locked until the end of the lockup
+	require.Equal(t, origCoins, cva.LockedCoins(now.Add(6*time.Hour)))
+
+	// require that unvested coins are locked after the end of the lockup
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.LockedCoins(now.Add(12*time.Hour)))
+
+	// require that delegated vesting coins are not locked
+	cva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, cva.LockedCoins(now.Add(12*time.Hour)))
+
+	// require that all coins are spendable at the end of both schedules
+	require.Equal(t, sdk.NewCoins(), cva.LockedCoins(now.Add(24*time.Hour)))
+}
+
+func TestTrackDelegationClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	// require the ability to delegate all vesting coins
+	cva, err := types.NewClawbackVestingAccount(bacc, funder.String(), origCoins, now.Unix(), nil, vestingPeriods)
+	require.NoError(t, err)
+	cva.TrackDelegation(now, origCoins, origCoins)
+	require.Equal(t, origCoins, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+
+	// require the maximum possible amount of vesting coins to be chosen for delegation
+	cva, err = types.NewClawbackVestingAccount(bacc, funder.String(), origCoins, now.Unix(), nil, vestingPeriods)
+	require.NoError(t, err)
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedFree)
+
+	// require undelegations to be taken from the delegated free coins first
+	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+	require.Equal(t, emptyCoins, cva.DelegatedFree)
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	cva, err := types.NewClawbackVestingAccount(bacc, funder.String(), origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+
+	// require the unvested coins to be returned, and the schedules to be truncated
+	unvested := cva.ComputeClawback(now.Add(6 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, unvested)
+	require.Equal(t, vestingPeriods[0].Amount, cva.OriginalVesting)
+	require.Equal(t, []types.Period{vestingPeriods[0]}, cva.VestingPeriods)
+	require.Equal(t, []types.Period{{Length: int64(12 * 60 * 60), Amount: vestingPeriods[0].Amount}}, cva.LockupPeriods)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), cva.GetEndTime())
+	require.NoError(t, cva.Validate())
+
+	// require the vested coins to remain locked until the end of the lockup
+	require.Equal(t, vestingPeriods[0].Amount, cva.LockedCoins(now.Add(6*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), cva.LockedCoins(now.Add(12*time.Hour)))
+
+	// require the delegation tracking to only consider the vested but locked coins
+	cva.UpdateDelegation(now.Add(6*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 35)}, cva.DelegatedFree)
+	require.NoError(t, cva.Validate())
+
+	// require nothing to be returned once all coins are vested
+	require.True(t, cva.ComputeClawback(now.Add(12*time.Hour)).IsZero())
+	require.Equal(t, vestingPeriods[0].Amount, cva.OriginalVesting)
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -907,6 +1052,32 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			func() authtypes.GenesisAccount {
+				acc, _ := types.NewClawbackVestingAccount(baseAcc, addr.String(), initialVesting, 0, nil, types.Periods{types.Period{Length: int64(100), Amount: initialVesting}})
+				return acc
+			}(),
+			false,
+		},
+		{
+			"invalid clawback vesting account without funder",
+			func() authtypes.GenesisAccount {
+				acc, _ := types.NewClawbackVestingAccount(baseAcc, "", initialVesting, 0, nil, types.Periods{types.Period{Length: int64(100), Amount: initialVesting}})
+				return acc
+			}(),
+			true,
+		},
+		{
+			"invalid clawback vesting period amounts",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				FunderAddress:      addr.String(),
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
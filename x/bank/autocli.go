@@ -87,6 +87,25 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms"}},
 				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Query the authority metadata of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query the factory denoms created by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "Holds",
+					Use:            "holds [address]",
+					Short:          "Query the coins held on the balance of an account",
+					Long:           "Query the coins held on the balance of an account. Held coins stay in the account balance, but are not spendable. To query the holds of a single reason use the --reason flag.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -128,6 +147,50 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom [subdenom]",
+					Short:          "Create a new factory denom, factory/{sender}/{subdenom}, administered by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [amount]",
+					Short:          "Mint coins of a factory denom administered by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"mint_to_address": {Name: "mint-to", Usage: "Address receiving the minted coins, defaults to the sender"},
+					},
+				},
+				{
+					RpcMethod:      "ChangeAdmin",
+					Use:            "change-admin [denom] [new_admin]",
+					Short:          "Change the admin of a factory denom administered by the sender",
+					Long:           "Change the admin of a factory denom administered by the sender. An empty new admin leaves the denom without admin.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata [metadata]",
+					Short:          "Set the metadata of a factory denom administered by the sender",
+					Example:        fmt.Sprintf(`%s tx bank set-denom-metadata '{"base":"factory/cosmos1.../foo","display":"foo","name":"Foo","symbol":"FOO","denom_units":[{"denom":"factory/cosmos1.../foo"}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "UpdateDenomMetadata",
+					Use:            "update-denom-metadata-proposal [metadata]",
+					Short:          "Submit a proposal to set the metadata of denoms",
+					Example:        fmt.Sprintf(`%s tx bank update-denom-metadata-proposal '{"base":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2","display":"atom","name":"Cosmos Hub Atom","symbol":"ATOM","denom_units":[{"denom":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},{"denom":"atom","exponent":6}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "RemoveDenomMetadata",
+					Use:            "remove-denom-metadata-proposal [denoms]",
+					Short:          "Submit a proposal to remove the metadata of denoms",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateDenom creates the factory denom factory/{creator}/{subdenom}, charging
+// the denom creation fee to the creator. The creator becomes the admin of the denom.
+func (k BaseKeeper) CreateDenom(ctx context.Context, creator []byte, subdenom string) (string, error) {
+	creatorStr, err := k.ak.AddressCodec().BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if k.HasSupply(ctx, denom) || k.HasDenomMetaData(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+	if has, err := k.DenomAuthority.Has(ctx, denom); err != nil {
+		return "", err
+	} else if has {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.DenomCreationFee.IsZero() {
+		if err := k.SendCoinsFromAccountToModule(ctx, creator, authtypes.FeeCollectorName, params.DenomCreationFee); err != nil {
+			return "", errorsmod.Wrap(err, "unable to pay the denom creation fee")
+		}
+	}
+
+	if err := k.DenomAuthority.Set(ctx, denom, types.DenomAuthorityMetadata{Admin: creatorStr}); err != nil {
+		return "", err
+	}
+
+	k.SetDenomMetaData(ctx, types.Metadata{
+		DenomUnits: []*types.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     subdenom,
+	})
+
+	return denom, k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDenom,
+		event.NewAttribute(types.AttributeKeyCreator, creatorStr),
+		event.NewAttribute(types.AttributeKeyNewTokenDenom, denom),
+	)
+}
+
+// GetDenomAuthority returns the authority metadata of a factory denom, or
+// collections.ErrNotFound if the denom was not created with CreateDenom.
+func (k BaseKeeper) GetDenomAuthority(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	return k.DenomAuthority.Get(ctx, denom)
+}
+
+// GetAllFactoryDenoms returns all the factory denoms and their authority metadata.
+func (k BaseKeeper) GetAllFactoryDenoms(ctx context.Context) []types.FactoryDenom {
+	factoryDenoms := make([]types.FactoryDenom, 0)
+	err := k.DenomAuthority.Walk(ctx, nil, func(denom string, authority types.DenomAuthorityMetadata) (stop bool, err error) {
+		factoryDenoms = append(factoryDenoms, types.FactoryDenom{Denom: denom, AuthorityMetadata: authority})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return factoryDenoms
+}
+
+// GetDenomsFromCreator returns the factory denoms created by creator.
+func (k BaseKeeper) GetDenomsFromCreator(ctx context.Context, creator string) ([]string, error) {
+	prefix := types.FactoryDenomPrefix + "/" + creator + "/"
+	rng := new(collections.Range[string]).Prefix(prefix)
+
+	denoms := make([]string, 0)
+	err := k.DenomAuthority.Walk(ctx, rng, func(denom string, _ types.DenomAuthorityMetadata) (stop bool, err error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	return denoms, err
+}
+
+// MintFactoryCoins mints coins of a factory denom to the bank module account,
+// which must have the Minter permission, and sends them to the recipient. The
+// sender must be the admin of the denom.
+func (k BaseKeeper) MintFactoryCoins(ctx context.Context, sender, recipient []byte, coin sdk.Coin) error {
+	if err := k.assertDenomAdmin(ctx, sender, coin.Denom); err != nil {
+		return err
+	}
+
+	// checked before minting, so nothing is minted if the send fails
+	if k.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%x is not allowed to receive funds", recipient)
+	}
+
+	amounts := sdk.NewCoins(coin)
+	if err := k.MintCoins(ctx, types.ModuleName, amounts); err != nil {
+		return err
+	}
+
+	return k.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amounts)
+}
+
+// ChangeDenomAdmin changes the admin of a factory denom. The sender must be
+// the current admin of the denom. An empty newAdmin leaves the denom without
+// admin.
+func (k BaseKeeper) ChangeDenomAdmin(ctx context.Context, sender []byte, denom, newAdmin string) error {
+	if err := k.assertDenomAdmin(ctx, sender, denom); err != nil {
+		return err
+	}
+
+	if newAdmin != "" {
+		if _, err := k.ak.AddressCodec().StringToBytes(newAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	if err := k.DenomAuthority.Set(ctx, denom, types.DenomAuthorityMetadata{Admin: newAdmin}); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeChangeAdmin,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+	)
+}
+
+// SetFactoryDenomMetadata sets the metadata of a factory denom. The sender
+// must be the admin of the denom.
+func (k BaseKeeper) SetFactoryDenomMetadata(ctx context.Context, sender []byte, metadata types.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.assertDenomAdmin(ctx, sender, metadata.Base); err != nil {
+		return err
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetDenomMetadata,
+		event.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+	)
+}
+
+// FactoryDenomSendRestriction returns a SendRestrictionFn which calls the
+// hook for each factory denom coin being sent, along with the admin of its
+// denom. Apps wire it with AppendSendRestriction, to let the admins of the
+// factory denoms restrict their transfers.
+func (k BaseKeeper) FactoryDenomSendRestriction(hook types.FactoryDenomSendHook) types.SendRestrictionFn {
+	return func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		for _, coin := range amt {
+			if !types.IsFactoryDenom(coin.Denom) {
+				continue
+			}
+
+			authority, err := k.DenomAuthority.Get(ctx, coin.Denom)
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+
+			if err := hook(ctx, authority.Admin, fromAddr, toAddr, coin); err != nil {
+				return nil, err
+			}
+		}
+		return toAddr, nil
+	}
+}
+
+// assertDenomAdmin returns an error if the sender is not the admin of the
+// factory denom.
+func (k BaseKeeper) assertDenomAdmin(ctx context.Context, sender []byte, denom string) error {
+	authority, err := k.GetDenomAuthority(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "denom %s does not exist", denom)
+	} else if err != nil {
+		return err
+	}
+
+	senderStr, err := k.ak.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return err
+	}
+
+	if authority.Admin == "" || authority.Admin != senderStr {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", senderStr, denom)
+	}
+	return nil
+}
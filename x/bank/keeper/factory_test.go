@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	feeCollectorAcc = authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	bankAcc         = authtypes.NewEmptyModuleAccount(banktypes.ModuleName, authtypes.Minter)
+)
+
+// createFactoryDenom creates the factory denom subdenom administered by the
+// given account, and returns it.
+func (suite *KeeperTestSuite) createFactoryDenom(admin sdk.AccAddress, subdenom string) string {
+	res, err := suite.msgServer.CreateDenom(suite.ctx, &banktypes.MsgCreateDenom{
+		Sender:   admin.String(),
+		Subdenom: subdenom,
+	})
+	suite.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+// mockMintFactoryCoins mocks the minting of factory coins through the bank
+// module account.
+func (suite *KeeperTestSuite) mockMintFactoryCoins() {
+	suite.authKeeper.EXPECT().GetModuleAccount(suite.ctx, banktypes.ModuleName).Return(bankAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(banktypes.ModuleName).Return(bankAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, bankAcc.GetAddress()).Return(bankAcc).AnyTimes()
+}
+
+func (suite *KeeperTestSuite) TestMsgCreateDenom() {
+	creator := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	params := banktypes.DefaultParams()
+	params.DenomCreationFee = fee
+	suite.Require().NoError(suite.bankKeeper.SetParams(suite.ctx, params))
+
+	// the creator cannot pay the fee
+	suite.authKeeper.EXPECT().GetModuleAccount(suite.ctx, authtypes.FeeCollectorName).Return(feeCollectorAcc)
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, creator.GetAddress()).Return(creator)
+	_, err := suite.msgServer.CreateDenom(suite.ctx, &banktypes.MsgCreateDenom{Sender: creator.Address, Subdenom: "foo"})
+	suite.Require().ErrorContains(err, "unable to pay the denom creation fee")
+
+	suite.mockFundAccount(creator.GetAddress())
+	suite.Require().NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, creator.GetAddress(), fee))
+
+	suite.mockSendCoinsFromAccountToModule(creator, feeCollectorAcc)
+	res, err := suite.msgServer.CreateDenom(suite.ctx, &banktypes.MsgCreateDenom{Sender: creator.Address, Subdenom: "foo"})
+	suite.Require().NoError(err)
+
+	denom := "factory/" + creator.Address + "/foo"
+	suite.Require().Equal(denom, res.NewTokenDenom)
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, creator.GetAddress()).IsZero())
+	suite.Require().Equal(fee, suite.bankKeeper.GetAllBalances(suite.ctx, feeCollectorAcc.GetAddress()))
+
+	metadata, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(denom, metadata.Base)
+
+	authority, err := suite.queryClient.DenomAuthorityMetadata(suite.ctx, &banktypes.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(creator.Address, authority.AuthorityMetadata.Admin)
+
+	// the denom already exists
+	_, err = suite.msgServer.CreateDenom(suite.ctx, &banktypes.MsgCreateDenom{Sender: creator.Address, Subdenom: "foo"})
+	suite.Require().ErrorIs(err, banktypes.ErrDenomExists)
+
+	// the subdenom is too long
+	_, err = suite.msgServer.CreateDenom(suite.ctx, &banktypes.MsgCreateDenom{Sender: creator.Address, Subdenom: string(make([]byte, 45))})
+	suite.Require().ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+}
+
+func (suite *KeeperTestSuite) TestMsgMintAndBurnFactoryDenom() {
+	admin, other := accAddrs[0], accAddrs[1]
+	denom := suite.createFactoryDenom(admin, "foo")
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgMint
+		expErrMsg string
+	}{
+		{
+			name:      "not the admin",
+			input:     &banktypes.MsgMint{Sender: other.String(), Amount: sdk.NewInt64Coin(denom, 100)},
+			expErrMsg: "is not the admin",
+		},
+		{
+			name:      "not a factory denom",
+			input:     &banktypes.MsgMint{Sender: admin.String(), Amount: sdk.NewInt64Coin(fooDenom, 100)},
+			expErrMsg: "invalid factory denom",
+		},
+		{
+			name:      "invalid amount",
+			input:     &banktypes.MsgMint{Sender: admin.String(), Amount: sdk.NewInt64Coin(denom, 0)},
+			expErrMsg: "invalid coins",
+		},
+		{
+			name:      "blocked recipient",
+			input:     &banktypes.MsgMint{Sender: admin.String(), Amount: sdk.NewInt64Coin(denom, 100), MintToAddress: accAddrs[4].String()},
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name:  "mint to the admin",
+			input: &banktypes.MsgMint{Sender: admin.String(), Amount: sdk.NewInt64Coin(denom, 100)},
+		},
+		{
+			name:  "mint to another account",
+			input: &banktypes.MsgMint{Sender: admin.String(), Amount: sdk.NewInt64Coin(denom, 50), MintToAddress: other.String()},
+		},
+	}
+
+	suite.mockMintFactoryCoins()
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.Mint(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	suite.Require().Equal(int64(100), suite.bankKeeper.GetBalance(suite.ctx, admin, denom).Amount.Int64())
+	suite.Require().Equal(int64(50), suite.bankKeeper.GetBalance(suite.ctx, other, denom).Amount.Int64())
+	suite.Require().Equal(int64(150), suite.bankKeeper.GetSupply(suite.ctx, denom).Amount.Int64())
+
+	// only the admin can burn the factory denom, holders cannot burn their own coins
+	burn := sdk.NewInt64Coin(denom, 30)
+	_, err := suite.msgServer.Burn(suite.ctx, &banktypes.MsgBurn{FromAddress: other.String(), Amount: []*sdk.Coin{&burn}})
+	suite.Require().ErrorIs(err, banktypes.ErrUnauthorized)
+	suite.Require().Equal(int64(50), suite.bankKeeper.GetBalance(suite.ctx, other, denom).Amount.Int64())
+
+	adminAcc := authtypes.NewBaseAccountWithAddress(admin)
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, admin).Return(adminAcc).AnyTimes()
+	_, err = suite.msgServer.Burn(suite.ctx, &banktypes.MsgBurn{FromAddress: admin.String(), Amount: []*sdk.Coin{&burn}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(70), suite.bankKeeper.GetBalance(suite.ctx, admin, denom).Amount.Int64())
+	suite.Require().Equal(int64(120), suite.bankKeeper.GetSupply(suite.ctx, denom).Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestMsgChangeAdmin() {
+	admin, newAdmin := accAddrs[0], accAddrs[1]
+	denom := suite.createFactoryDenom(admin, "foo")
+
+	_, err := suite.msgServer.ChangeAdmin(suite.ctx, &banktypes.MsgChangeAdmin{Sender: newAdmin.String(), Denom: denom, NewAdmin: newAdmin.String()})
+	suite.Require().ErrorIs(err, banktypes.ErrUnauthorized)
+
+	_, err = suite.msgServer.ChangeAdmin(suite.ctx, &banktypes.MsgChangeAdmin{Sender: admin.String(), Denom: denom, NewAdmin: "invalid"})
+	suite.Require().ErrorContains(err, "invalid new admin address")
+
+	_, err = suite.msgServer.ChangeAdmin(suite.ctx, &banktypes.MsgChangeAdmin{Sender: admin.String(), Denom: denom, NewAdmin: newAdmin.String()})
+	suite.Require().NoError(err)
+
+	suite.mockMintFactoryCoins()
+	// the previous admin cannot mint anymore
+	_, err = suite.msgServer.Mint(suite.ctx, &banktypes.MsgMint{Sender: admin.String(), Amount: sdk.NewInt64Coin(denom, 1)})
+	suite.Require().ErrorIs(err, banktypes.ErrUnauthorized)
+	_, err = suite.msgServer.Mint(suite.ctx, &banktypes.MsgMint{Sender: newAdmin.String(), Amount: sdk.NewInt64Coin(denom, 1)})
+	suite.Require().NoError(err)
+
+	// renounce the admin rights
+	_, err = suite.msgServer.ChangeAdmin(suite.ctx, &banktypes.MsgChangeAdmin{Sender: newAdmin.String(), Denom: denom})
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.ctx, &banktypes.MsgMint{Sender: newAdmin.String(), Amount: sdk.NewInt64Coin(denom, 1)})
+	suite.Require().ErrorIs(err, banktypes.ErrUnauthorized)
+
+	// the denom still belongs to its creator
+	res, err := suite.queryClient.DenomsFromCreator(suite.ctx, &banktypes.QueryDenomsFromCreatorRequest{Creator: admin.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom}, res.Denoms)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetDenomMetadata() {
+	admin := accAddrs[0]
+	denom := suite.createFactoryDenom(admin, "foo")
+
+	metadata := banktypes.Metadata{
+		Description: "The foo token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "foo", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "foo",
+		Name:    "Foo",
+		Symbol:  "FOO",
+	}
+
+	_, err := suite.msgServer.SetDenomMetadata(suite.ctx, &banktypes.MsgSetDenomMetadata{Sender: accAddrs[1].String(), Metadata: metadata})
+	suite.Require().ErrorIs(err, banktypes.ErrUnauthorized)
+
+	invalid := metadata
+	invalid.Symbol = ""
+	_, err = suite.msgServer.SetDenomMetadata(suite.ctx, &banktypes.MsgSetDenomMetadata{Sender: admin.String(), Metadata: invalid})
+	suite.Require().ErrorContains(err, "symbol field cannot be blank")
+
+	// the metadata of non factory denoms cannot be set
+	nonFactory := metadata
+	nonFactory.Base = fooDenom
+	nonFactory.DenomUnits = []*banktypes.DenomUnit{{Denom: fooDenom}, {Denom: "foo2", Exponent: 6}}
+	nonFactory.Display = "foo2"
+	_, err = suite.msgServer.SetDenomMetadata(suite.ctx, &banktypes.MsgSetDenomMetadata{Sender: admin.String(), Metadata: nonFactory})
+	suite.Require().ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+
+	_, err = suite.msgServer.SetDenomMetadata(suite.ctx, &banktypes.MsgSetDenomMetadata{Sender: admin.String(), Metadata: metadata})
+	suite.Require().NoError(err)
+
+	stored, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, stored)
+}
+
+func (suite *KeeperTestSuite) TestFactoryDenomSendRestriction() {
+	admin := accAddrs[0]
+	denom := suite.createFactoryDenom(admin, "foo")
+	frozen := accAddrs[2]
+
+	var admins []string
+	restriction := suite.bankKeeper.FactoryDenomSendRestriction(func(_ context.Context, admin string, fromAddr, _ sdk.AccAddress, _ sdk.Coin) error {
+		admins = append(admins, admin)
+		if fromAddr.Equals(frozen) {
+			return errors.New("frozen")
+		}
+		return nil
+	})
+
+	// non factory denoms are not checked
+	toAddr, err := restriction(suite.ctx, frozen, accAddrs[1], sdk.NewCoins(newFooCoin(10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(accAddrs[1], toAddr)
+	suite.Require().Empty(admins)
+
+	toAddr, err = restriction(suite.ctx, admin, accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 10), newFooCoin(10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(accAddrs[1], toAddr)
+	suite.Require().Equal([]string{admin.String()}, admins)
+
+	_, err = restriction(suite.ctx, frozen, accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorContains(err, "frozen")
+}
+
+func (suite *KeeperTestSuite) TestFactoryDenomsGenesis() {
+	denom := suite.createFactoryDenom(accAddrs[0], "foo")
+	suite.createFactoryDenom(accAddrs[1], "bar")
+
+	genesis := suite.bankKeeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genesis.FactoryDenoms, 2)
+	suite.Require().NoError(genesis.Validate())
+
+	suite.SetupTest()
+	suite.bankKeeper.InitGenesis(suite.ctx, genesis)
+
+	authority, err := suite.bankKeeper.GetDenomAuthority(suite.ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(accAddrs[0].String(), authority.Admin)
+	suite.Require().Equal(genesis.FactoryDenoms, suite.bankKeeper.ExportGenesis(suite.ctx).FactoryDenoms)
+
+	// the admin is decoded with the address codec of the keeper
+	genesis.FactoryDenoms[0].AuthorityMetadata.Admin = sdk.MustBech32ifyAddressBytes("osmo", accAddrs[0])
+	suite.SetupTest()
+	suite.Require().Panics(func() { suite.bankKeeper.InitGenesis(suite.ctx, genesis) })
+}
@@ -53,6 +53,32 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, hold := range genState.Holds {
+		if err := hold.Validate(k.ak.AddressCodec()); err != nil {
+			panic(err)
+		}
+
+		addr, err := k.ak.AddressCodec().StringToBytes(hold.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, coin := range hold.Amount {
+			if err := k.BaseViewKeeper.Holds.Set(ctx, collections.Join3(sdk.AccAddress(addr), hold.Reason, coin.Denom), coin.Amount); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for _, factoryDenom := range genState.FactoryDenoms {
+		if err := factoryDenom.Validate(k.ak.AddressCodec()); err != nil {
+			panic(err)
+		}
+		if err := k.DenomAuthority.Set(ctx, factoryDenom.Denom, factoryDenom.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -69,5 +95,7 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.FactoryDenoms = k.GetAllFactoryDenoms(ctx)
+	rv.Holds = k.GetAllHolds(ctx)
 	return rv
 }
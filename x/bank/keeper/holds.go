@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AddHold holds coins on the balance of an account for the given reason. The
+// held coins stay in the account balance, but are excluded from its spendable
+// coins until they are released with ReleaseHold. Only spendable coins can be
+// held, and holding coins again for the same reason adds to the existing hold.
+func (k BaseKeeper) AddHold(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins, reason string) error {
+	if err := types.ValidateHoldReason(reason); err != nil {
+		return err
+	}
+
+	if !coins.IsValid() || coins.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, coins.String())
+	}
+
+	spendable := k.SpendableCoins(ctx, addr)
+	if !spendable.IsAllGTE(coins) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, coins)
+	}
+
+	for _, coin := range coins {
+		key := collections.Join3(addr, reason, coin.Denom)
+		held, err := k.BaseViewKeeper.Holds.Get(ctx, key)
+		if errors.Is(err, collections.ErrNotFound) {
+			held = math.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+
+		if err := k.BaseViewKeeper.Holds.Set(ctx, key, held.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return k.emitHoldEvent(ctx, types.EventTypeHold, addr, coins, reason)
+}
+
+// ReleaseHold releases coins held on the balance of an account for the given
+// reason, making them spendable again. It fails if less coins are held for the
+// reason.
+func (k BaseKeeper) ReleaseHold(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins, reason string) error {
+	if !coins.IsValid() || coins.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, coins.String())
+	}
+
+	held := k.GetHold(ctx, addr, reason)
+	if !held.IsAllGTE(coins) {
+		return errorsmod.Wrapf(types.ErrInsufficientHold, "%s held for %s is smaller than %s", held, reason, coins)
+	}
+
+	for _, coin := range coins {
+		key := collections.Join3(addr, reason, coin.Denom)
+		remaining := held.AmountOf(coin.Denom).Sub(coin.Amount)
+
+		var err error
+		if remaining.IsZero() {
+			err = k.BaseViewKeeper.Holds.Remove(ctx, key)
+		} else {
+			err = k.BaseViewKeeper.Holds.Set(ctx, key, remaining)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.emitHoldEvent(ctx, types.EventTypeReleaseHold, addr, coins, reason)
+}
+
+// GetAllHolds returns all the holds of all the accounts.
+func (k BaseKeeper) GetAllHolds(ctx context.Context) []types.Hold {
+	holds, err := k.collectHolds(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	return holds
+}
+
+// collectHolds returns the holds in the given range, grouping the held coins
+// of each account by reason.
+func (k BaseKeeper) collectHolds(ctx context.Context, rng collections.Ranger[collections.Triple[sdk.AccAddress, string, string]]) ([]types.Hold, error) {
+	holds := make([]types.Hold, 0)
+	var (
+		lastAddr   sdk.AccAddress
+		lastReason string
+	)
+	err := k.BaseViewKeeper.Holds.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, string, string], amount math.Int) (stop bool, err error) {
+		coin := sdk.NewCoin(key.K3(), amount)
+		if len(holds) > 0 && lastAddr.Equals(key.K1()) && lastReason == key.K2() {
+			holds[len(holds)-1].Amount = holds[len(holds)-1].Amount.Add(coin)
+			return false, nil
+		}
+
+		addrStr, err := k.ak.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		holds = append(holds, types.Hold{Address: addrStr, Reason: key.K2(), Amount: sdk.NewCoins(coin)})
+		lastAddr, lastReason = key.K1(), key.K2()
+		return false, nil
+	})
+	return holds, err
+}
+
+func (k BaseKeeper) emitHoldEvent(ctx context.Context, eventType string, addr sdk.AccAddress, coins sdk.Coins, reason string) error {
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		eventType,
+		event.NewAttribute(types.AttributeKeyHolder, addrStr),
+		event.NewAttribute(types.AttributeKeyReason, reason),
+		event.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+	)
+}
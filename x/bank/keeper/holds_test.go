@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (suite *KeeperTestSuite) TestHolds() {
+	ctx := suite.ctx
+	require := suite.Require()
+	acc := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	addr := acc.GetAddress()
+	suite.authKeeper.EXPECT().GetAccount(ctx, addr).Return(acc).AnyTimes()
+
+	suite.mockFundAccount(addr)
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, addr, sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	// invalid holds
+	require.ErrorIs(suite.bankKeeper.AddHold(ctx, addr, sdk.NewCoins(newFooCoin(10)), " "), banktypes.ErrInvalidHold)
+	require.ErrorIs(suite.bankKeeper.AddHold(ctx, addr, sdk.NewCoins(), "order-1"), sdkerrors.ErrInvalidCoins)
+	require.ErrorIs(suite.bankKeeper.AddHold(ctx, addr, sdk.NewCoins(newFooCoin(101)), "order-1"), sdkerrors.ErrInsufficientFunds)
+
+	require.NoError(suite.bankKeeper.AddHold(ctx, addr, sdk.NewCoins(newFooCoin(30), newBarCoin(10)), "order-1"))
+	require.NoError(suite.bankKeeper.AddHold(ctx, addr, sdk.NewCoins(newFooCoin(20)), "order-1"))
+	require.NoError(suite.bankKeeper.AddHold(ctx, addr, sdk.NewCoins(newFooCoin(40)), "order-2"))
+
+	// the held coins stay in the balance, but are not spendable
+	require.Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(50)), suite.bankKeeper.GetAllBalances(ctx, addr))
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(10)), suite.bankKeeper.GetHeldCoins(ctx, addr))
+	require.Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(40)), suite.bankKeeper.SpendableCoins(ctx, addr))
+	require.Equal(newFooCoin(10), suite.bankKeeper.SpendableCoin(ctx, addr, fooDenom))
+
+	// only the spendable coins can be held, sent, burned or delegated
+	unspendable := sdk.NewCoins(newFooCoin(11))
+	require.ErrorIs(suite.bankKeeper.AddHold(ctx, addr, unspendable, "order-3"), sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, addr, accAddrs[1], unspendable), sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(suite.bankKeeper.InputOutputCoins(ctx,
+		banktypes.Input{Address: addr.String(), Coins: unspendable},
+		[]banktypes.Output{{Address: accAddrs[1].String(), Coins: unspendable}},
+	), sdkerrors.ErrInsufficientFunds)
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, burnerAcc.Name).Return(burnerAcc)
+	require.ErrorIs(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, burnerAcc.Name, unspendable), sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(suite.bankKeeper.BurnCoins(ctx, addr, unspendable), sdkerrors.ErrInsufficientFunds)
+	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc)
+	require.ErrorIs(suite.bankKeeper.DelegateCoins(ctx, addr, holderAcc.GetAddress(), unspendable), sdkerrors.ErrInsufficientFunds)
+
+	res, err := suite.queryClient.Holds(ctx, &banktypes.QueryHoldsRequest{Address: addr.String()})
+	require.NoError(err)
+	require.Equal([]banktypes.Hold{
+		{Address: addr.String(), Reason: "order-1", Amount: sdk.NewCoins(newFooCoin(50), newBarCoin(10))},
+		{Address: addr.String(), Reason: "order-2", Amount: sdk.NewCoins(newFooCoin(40))},
+	}, res.Holds)
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(10)), res.Total)
+
+	res, err = suite.queryClient.Holds(ctx, &banktypes.QueryHoldsRequest{Address: addr.String(), Reason: "order-2"})
+	require.NoError(err)
+	require.Equal([]banktypes.Hold{{Address: addr.String(), Reason: "order-2", Amount: sdk.NewCoins(newFooCoin(40))}}, res.Holds)
+
+	// release the holds
+	require.ErrorIs(suite.bankKeeper.ReleaseHold(ctx, addr, sdk.NewCoins(newFooCoin(41)), "order-2"), banktypes.ErrInsufficientHold)
+	require.ErrorIs(suite.bankKeeper.ReleaseHold(ctx, addr, sdk.NewCoins(newFooCoin(1)), "unknown"), banktypes.ErrInsufficientHold)
+	require.NoError(suite.bankKeeper.ReleaseHold(ctx, addr, sdk.NewCoins(newFooCoin(40)), "order-2"))
+	require.NoError(suite.bankKeeper.ReleaseHold(ctx, addr, sdk.NewCoins(newFooCoin(20)), "order-1"))
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(10)), suite.bankKeeper.GetHold(ctx, addr, "order-1"))
+	require.Empty(suite.bankKeeper.GetHold(ctx, addr, "order-2"))
+	require.Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(40)), suite.bankKeeper.SpendableCoins(ctx, addr))
+
+	// the holds are exported and imported in genesis
+	genesis := suite.bankKeeper.ExportGenesis(ctx)
+	require.Equal([]banktypes.Hold{{Address: addr.String(), Reason: "order-1", Amount: sdk.NewCoins(newFooCoin(30), newBarCoin(10))}}, genesis.Holds)
+	require.NoError(genesis.Validate())
+
+	suite.SetupTest()
+	suite.bankKeeper.InitGenesis(suite.ctx, genesis)
+	require.Equal(genesis.Holds, suite.bankKeeper.GetAllHolds(suite.ctx))
+}
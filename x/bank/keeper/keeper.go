@@ -36,9 +36,19 @@ type Keeper interface {
 	GetDenomMetaData(ctx context.Context, denom string) (types.Metadata, bool)
 	HasDenomMetaData(ctx context.Context, denom string) bool
 	SetDenomMetaData(ctx context.Context, denomMetaData types.Metadata)
+	RemoveDenomMetaData(ctx context.Context, denom string) error
 	GetAllDenomMetaData(ctx context.Context) []types.Metadata
 	IterateAllDenomMetaData(ctx context.Context, cb func(types.Metadata) bool)
 
+	CreateDenom(ctx context.Context, creator []byte, subdenom string) (string, error)
+	GetDenomAuthority(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error)
+	MintFactoryCoins(ctx context.Context, sender, recipient []byte, coin sdk.Coin) error
+	ChangeDenomAdmin(ctx context.Context, sender []byte, denom, newAdmin string) error
+	SetFactoryDenomMetadata(ctx context.Context, sender []byte, metadata types.Metadata) error
+
+	AddHold(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins, reason string) error
+	ReleaseHold(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins, reason string) error
+
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -131,6 +141,8 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 
 	balances := sdk.NewCoins()
 
+	held := k.GetHeldCoins(ctx, delegatorAddr)
+
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
 		if balance.IsLT(coin) {
@@ -138,6 +150,12 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", balance, amt,
 			)
 		}
+		// held coins cannot be delegated, unlike the coins locked by vesting accounts
+		if balance.Amount.Sub(held.AmountOf(coin.Denom)).LT(coin.Amount) {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is held on the balance %s", held, balance,
+			)
+		}
 
 		balances = balances.Add(balance)
 		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
@@ -258,6 +276,14 @@ func (k BaseKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types.Me
 	_ = k.BaseViewKeeper.DenomMetadata.Set(ctx, denomMetaData.Base, denomMetaData)
 }
 
+// RemoveDenomMetaData removes the denominations metadata of the given base denom.
+func (k BaseKeeper) RemoveDenomMetaData(ctx context.Context, denom string) error {
+	if !k.HasDenomMetaData(ctx, denom) {
+		return errorsmod.Wrapf(types.ErrDenomMetadataNotFound, "denom %s", denom)
+	}
+	return k.BaseViewKeeper.DenomMetadata.Remove(ctx, denom)
+}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // An error is returned if the module account does not exist or if
 // the recipient address is black-listed or if sending the tokens fails.
@@ -1,14 +1,23 @@
 package keeper
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
-	keeper BaseKeeper
+	keeper Keeper
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper BaseKeeper) Migrator {
+func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
 
@@ -26,3 +35,58 @@ func (m Migrator) Migrate2to3(ctx context.Context) error {
 func (m Migrator) Migrate3to4(ctx context.Context) error {
 	return nil
 }
+
+// BackfillDenomMetadata sets the given denom metadata for the denoms which have
+// no metadata yet. The metadata of the other denoms is left unchanged. It is
+// meant to be called from an upgrade handler, e.g. to name the IBC assets a
+// chain received.
+func (m Migrator) BackfillDenomMetadata(ctx context.Context, metadatas []types.Metadata) error {
+	for _, metadata := range metadatas {
+		if err := metadata.Validate(); err != nil {
+			return fmt.Errorf("invalid metadata for %s: %w", metadata.Base, err)
+		}
+	}
+
+	for _, metadata := range metadatas {
+		if m.keeper.HasDenomMetaData(ctx, metadata.Base) {
+			continue
+		}
+		m.keeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	return nil
+}
+
+// LoadDenomMetadata reads the denom metadata to backfill with BackfillDenomMetadata
+// from a JSON file holding a list of metadata, in the format of the denom_metadata
+// field of the bank genesis. The metadata is validated, and its denoms must be unique.
+func LoadDenomMetadata(cdc codec.JSONCodec, path string) ([]types.Metadata, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawMetadatas []json.RawMessage
+	if err := json.Unmarshal(bz, &rawMetadatas); err != nil {
+		return nil, fmt.Errorf("failed to parse denom metadata file %s: %w", path, err)
+	}
+
+	metadatas := make([]types.Metadata, len(rawMetadatas))
+	seen := make(map[string]bool, len(rawMetadatas))
+	for i, rawMetadata := range rawMetadatas {
+		if err := cdc.UnmarshalJSON(rawMetadata, &metadatas[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse denom metadata %d of %s: %w", i, path, err)
+		}
+
+		metadata := metadatas[i]
+		if err := metadata.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid metadata for %s: %w", metadata.Base, err)
+		}
+		if seen[metadata.Base] {
+			return nil, fmt.Errorf("duplicate metadata for %s", metadata.Base)
+		}
+		seen[metadata.Base] = true
+	}
+
+	return metadatas, nil
+}
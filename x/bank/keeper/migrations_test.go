@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/x/bank/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestBackfillDenomMetadata() {
+	addIBCMetadata(suite.ctx, suite.bankKeeper)
+	ibcDenom := getIBCDenom(ibcPath, ibcBaseDenom)
+	existing, _ := suite.bankKeeper.GetDenomMetaData(suite.ctx, ibcDenom)
+
+	migrator := keeper.NewMigrator(suite.bankKeeper)
+
+	suite.Require().ErrorContains(migrator.BackfillDenomMetadata(suite.ctx, []banktypes.Metadata{{Base: "foo"}}), "invalid metadata for foo")
+
+	suite.Require().NoError(migrator.BackfillDenomMetadata(suite.ctx, []banktypes.Metadata{
+		{
+			Description: "The foo token",
+			DenomUnits:  []*banktypes.DenomUnit{{Denom: "ufoo", Exponent: 0}, {Denom: "foo", Exponent: 6, Aliases: []string{"FOO"}}},
+			Base:        "ufoo",
+			Display:     "foo",
+			Name:        "Foo",
+			Symbol:      "FOO",
+		},
+		{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: ibcDenom, Exponent: 0}},
+			Base:       ibcDenom,
+			Display:    ibcDenom,
+			Name:       "Overwritten",
+			Symbol:     "OVERWRITTEN",
+		},
+	}))
+
+	foo, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, "ufoo")
+	suite.Require().True(found)
+	suite.Require().Equal("Foo", foo.Name)
+	suite.Require().Equal([]string{"FOO"}, foo.DenomUnits[1].Aliases)
+	suite.Require().Equal(uint32(6), foo.DenomUnits[1].Exponent)
+
+	// the existing metadata is left unchanged
+	ibc, _ := suite.bankKeeper.GetDenomMetaData(suite.ctx, ibcDenom)
+	suite.Require().Equal(existing, ibc)
+}
+
+func (suite *KeeperTestSuite) TestLoadDenomMetadata() {
+	const fooMetadata = `{
+		"description": "The foo token",
+		"denom_units": [{"denom": "ufoo", "exponent": 0}, {"denom": "foo", "exponent": 6, "aliases": ["FOO"]}],
+		"base": "ufoo",
+		"display": "foo",
+		"name": "Foo",
+		"symbol": "FOO"
+	}`
+
+	testCases := []struct {
+		name      string
+		content   string
+		expErrMsg string
+		expBases  []string
+	}{
+		{
+			name:     "valid metadata",
+			content:  `[` + fooMetadata + `]`,
+			expBases: []string{"ufoo"},
+		},
+		{
+			name:     "empty list",
+			content:  `[]`,
+			expBases: []string{},
+		},
+		{
+			name:      "not a list",
+			content:   fooMetadata,
+			expErrMsg: "failed to parse denom metadata file",
+		},
+		{
+			name:      "unknown field",
+			content:   `[{"base": "ufoo", "unknown": true}]`,
+			expErrMsg: "failed to parse denom metadata 0",
+		},
+		{
+			name:      "invalid metadata",
+			content:   `[{"base": "ufoo"}]`,
+			expErrMsg: "invalid metadata for ufoo",
+		},
+		{
+			name:      "duplicate metadata",
+			content:   `[` + fooMetadata + `,` + fooMetadata + `]`,
+			expErrMsg: "duplicate metadata for ufoo",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			path := filepath.Join(suite.T().TempDir(), "denom_metadata.json")
+			suite.Require().NoError(os.WriteFile(path, []byte(tc.content), 0o600))
+
+			metadatas, err := keeper.LoadDenomMetadata(suite.encCfg.Codec, path)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			bases := make([]string, len(metadatas))
+			for i, metadata := range metadatas {
+				bases[i] = metadata.Base
+			}
+			suite.Require().Equal(tc.expBases, bases)
+		})
+	}
+
+	// a missing file can be told apart to skip the backfill
+	_, err := keeper.LoadDenomMetadata(suite.encCfg.Codec, filepath.Join(suite.T().TempDir(), "missing.json"))
+	suite.Require().ErrorIs(err, fs.ErrNotExist)
+}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/go-metrics"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/bank/types"
 
@@ -205,6 +207,24 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, coins.String())
 	}
 
+	// factory denoms can only be burned by their admin
+	for _, coin := range coins {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+		authority, err := k.GetDenomAuthority(goCtx, coin.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			// the denom has the form of a factory denom but was not created
+			// with MsgCreateDenom
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if authority.Admin != msg.FromAddress {
+			return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", msg.FromAddress, coin.Denom)
+		}
+	}
+
 	err = k.BurnCoins(goCtx, from, coins)
 	if err != nil {
 		return nil, err
@@ -212,3 +232,122 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+// decodeAddress decodes the address of a token factory message with the address
+// codec of the keeper, field names the address in the returned error.
+func (k msgServer) decodeAddress(addr, field string) ([]byte, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	bz, err := base.ak.AddressCodec().StringToBytes(addr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid %s address: %s", field, err)
+	}
+	return bz, nil
+}
+
+func (k msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := k.decodeAddress(msg.Sender, "sender")
+	if err != nil {
+		return nil, err
+	}
+
+	denom, err := k.Keeper.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	if msg.MintToAddress == "" {
+		msg.MintToAddress = msg.Sender
+	}
+
+	sender, err := k.decodeAddress(msg.Sender, "sender")
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := k.decodeAddress(msg.MintToAddress, "mint to")
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := k.MintFactoryCoins(ctx, sender, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+func (k msgServer) ChangeAdmin(ctx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	sender, err := k.decodeAddress(msg.Sender, "sender")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ChangeDenomAdmin(ctx, sender, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	sender, err := k.decodeAddress(msg.Sender, "sender")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetFactoryDenomMetadata(ctx, sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) UpdateDenomMetadata(ctx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	seen := map[string]bool{}
+	for _, metadata := range msg.Metadata {
+		if seen[metadata.Base] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate metadata found for %q", metadata.Base)
+		}
+
+		seen[metadata.Base] = true
+
+		if err := metadata.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid metadata for %q: %s", metadata.Base, err)
+		}
+	}
+
+	for _, metadata := range msg.Metadata {
+		k.SetDenomMetaData(ctx, metadata)
+	}
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) RemoveDenomMetadata(ctx context.Context, msg *types.MsgRemoveDenomMetadata) (*types.MsgRemoveDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	for _, denom := range msg.Denoms {
+		if err := k.RemoveDenomMetaData(ctx, denom); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRemoveDenomMetadataResponse{}, nil
+}
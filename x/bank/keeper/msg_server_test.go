@@ -421,3 +421,76 @@ func (suite *KeeperTestSuite) TestMsgBurn() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateDenomMetadata() {
+	ibcDenom := getIBCDenom(ibcPath, ibcBaseDenom)
+	metadata := banktypes.Metadata{
+		Description: "IBC farboo",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: ibcDenom, Exponent: 0},
+			{Denom: ibcBaseDenom, Exponent: 6},
+		},
+		Base:    ibcDenom,
+		Display: ibcBaseDenom,
+		Name:    "Farboo",
+		Symbol:  "FARBOO",
+	}
+	invalid := metadata
+	invalid.DenomUnits = []*banktypes.DenomUnit{{Denom: ibcDenom, Exponent: 6}}
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgUpdateDenomMetadata
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     &banktypes.MsgUpdateDenomMetadata{Authority: "invalid", Metadata: []banktypes.Metadata{metadata}},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "invalid exponent",
+			input:     &banktypes.MsgUpdateDenomMetadata{Authority: suite.bankKeeper.GetAuthority(), Metadata: []banktypes.Metadata{invalid}},
+			expErrMsg: "the exponent for base denomination unit",
+		},
+		{
+			name:      "duplicate metadata",
+			input:     &banktypes.MsgUpdateDenomMetadata{Authority: suite.bankKeeper.GetAuthority(), Metadata: []banktypes.Metadata{metadata, metadata}},
+			expErrMsg: "duplicate metadata",
+		},
+		{
+			name:  "all good",
+			input: &banktypes.MsgUpdateDenomMetadata{Authority: suite.bankKeeper.GetAuthority(), Metadata: []banktypes.Metadata{metadata}},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateDenomMetadata(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			stored, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, ibcDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(metadata, stored)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveDenomMetadata() {
+	addIBCMetadata(suite.ctx, suite.bankKeeper)
+	ibcDenom := getIBCDenom(ibcPath, ibcBaseDenom)
+
+	_, err := suite.msgServer.RemoveDenomMetadata(suite.ctx, &banktypes.MsgRemoveDenomMetadata{Authority: "invalid", Denoms: []string{ibcDenom}})
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	_, err = suite.msgServer.RemoveDenomMetadata(suite.ctx, &banktypes.MsgRemoveDenomMetadata{Authority: suite.bankKeeper.GetAuthority(), Denoms: []string{fooDenom}})
+	suite.Require().ErrorIs(err, banktypes.ErrDenomMetadataNotFound)
+
+	_, err = suite.msgServer.RemoveDenomMetadata(suite.ctx, &banktypes.MsgRemoveDenomMetadata{Authority: suite.bankKeeper.GetAuthority(), Denoms: []string{ibcDenom}})
+	suite.Require().NoError(err)
+	suite.Require().False(suite.bankKeeper.HasDenomMetaData(suite.ctx, ibcDenom))
+}
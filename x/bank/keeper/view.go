@@ -31,6 +31,8 @@ type ViewKeeper interface {
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetHeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetHold(ctx context.Context, addr sdk.AccAddress, reason string) sdk.Coins
 
 	IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx context.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
@@ -66,20 +68,26 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	// DenomAuthority maps the factory denoms to their authority metadata.
+	DenomAuthority collections.Map[string, types.DenomAuthorityMetadata]
+	// Holds maps an account address, a hold reason and a denom to the amount held.
+	Holds collections.Map[collections.Triple[sdk.AccAddress, string, string], math.Int]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak types.AccountKeeper) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := BaseViewKeeper{
-		cdc:           cdc,
-		environment:   env,
-		ak:            ak,
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		cdc:            cdc,
+		environment:    env,
+		ak:             ak,
+		Supply:         collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:  collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:    collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		Balances:       collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthority: collections.NewMap(sb, types.DenomAuthorityPrefix, "denom_authority", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		Holds:          collections.NewMap(sb, types.HoldsPrefix, "holds", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.StringKey), sdk.IntValue),
 	}
 
 	schema, err := sb.Build()
@@ -176,19 +184,47 @@ func (k BaseViewKeeper) IterateAllBalances(ctx context.Context, cb func(sdk.AccA
 }
 
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
-// account by address. For standard accounts, the result will always be no coins.
-// For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// account by address. These are the coins held on the account balance and, for
+// vesting accounts, the coins locked by the concrete vesting account type.
 func (k BaseViewKeeper) LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := k.GetHeldCoins(ctx, addr)
+
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(k.environment.HeaderService.GetHeaderInfo(ctx).Time)
+			return locked.Add(vacc.LockedCoins(k.environment.HeaderService.GetHeaderInfo(ctx).Time)...)
 		}
 	}
 
-	return sdk.NewCoins()
+	return locked
+}
+
+// GetHeldCoins returns the coins held on the balance of an account, for all the
+// reasons.
+func (k BaseViewKeeper) GetHeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	held := sdk.NewCoins()
+	err := k.Holds.Walk(ctx, collections.NewPrefixedTripleRange[sdk.AccAddress, string, string](addr), func(key collections.Triple[sdk.AccAddress, string, string], amount math.Int) (stop bool, err error) {
+		held = held.Add(sdk.NewCoin(key.K3(), amount))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return held
+}
+
+// GetHold returns the coins held on the balance of an account for the given reason.
+func (k BaseViewKeeper) GetHold(ctx context.Context, addr sdk.AccAddress, reason string) sdk.Coins {
+	held := sdk.NewCoins()
+	err := k.Holds.Walk(ctx, collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, string](addr, reason), func(key collections.Triple[sdk.AccAddress, string, string], amount math.Int) (stop bool, err error) {
+		held = held.Add(sdk.NewCoin(key.K3(), amount))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return held
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
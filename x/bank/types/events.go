@@ -21,4 +21,19 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// factory denom events name and attributes
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+	AttributeKeyCreator       = "creator"
+	AttributeKeyNewTokenDenom = "new_token_denom"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyNewAdmin      = "new_admin"
+
+	// balance holds events name and attributes
+	EventTypeHold        = "hold"
+	EventTypeReleaseHold = "release_hold"
+	AttributeKeyHolder   = "holder"
+	AttributeKeyReason   = "reason"
 )
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of the denoms created with MsgCreateDenom.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory denom.
+	MaxSubdenomLength = 44
+)
+
+// GetFactoryDenom returns the factory denom created by creator with the given
+// subdenom, in the form factory/{creator}/{subdenom}.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+	return denom, nil
+}
+
+// DeconstructFactoryDenom returns the creator and the subdenom of a factory denom.
+func DeconstructFactoryDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+	if parts[1] == "" {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s has no creator", denom)
+	}
+	if len(parts[2]) > MaxSubdenomLength {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns whether the denom is in the form of a factory denom.
+func IsFactoryDenom(denom string) bool {
+	_, _, err := DeconstructFactoryDenom(denom)
+	return err == nil
+}
+
+// Validate performs a basic validation of the factory denom and of its admin,
+// decoded with the given address codec.
+func (fd FactoryDenom) Validate(addressCodec address.Codec) error {
+	if _, _, err := DeconstructFactoryDenom(fd.Denom); err != nil {
+		return err
+	}
+	if admin := fd.AuthorityMetadata.Admin; admin != "" {
+		if _, err := addressCodec.StringToBytes(admin); err != nil {
+			return fmt.Errorf("invalid admin of factory denom %s: %w", fd.Denom, err)
+		}
+	}
+	return nil
+}
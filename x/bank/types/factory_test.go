@@ -0,0 +1,62 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	denom, err := GetFactoryDenom(creator, "foo")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/foo", denom)
+	require.True(t, IsFactoryDenom(denom))
+
+	gotCreator, subdenom, err := DeconstructFactoryDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, gotCreator)
+	require.Equal(t, "foo", subdenom)
+
+	// subdenoms may contain slashes
+	denom, err = GetFactoryDenom(creator, "foo/bar")
+	require.NoError(t, err)
+	_, subdenom, err = DeconstructFactoryDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, "foo/bar", subdenom)
+
+	_, err = GetFactoryDenom(creator, strings.Repeat("a", MaxSubdenomLength+1))
+	require.ErrorIs(t, err, ErrInvalidFactoryDenom)
+	_, err = GetFactoryDenom(creator, "foo bar")
+	require.ErrorIs(t, err, ErrInvalidFactoryDenom)
+	_, err = GetFactoryDenom("cre/ator", "foo")
+	require.ErrorIs(t, err, ErrInvalidFactoryDenom)
+
+	for _, denom := range []string{"stake", "ibc/ABCD", "factory/" + creator, "factory//foo", "other/" + creator + "/foo"} {
+		require.False(t, IsFactoryDenom(denom), denom)
+	}
+}
+
+func TestFactoryDenomValidate(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+	denom := "factory/" + creator + "/foo"
+
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	require.NoError(t, FactoryDenom{Denom: denom, AuthorityMetadata: DenomAuthorityMetadata{Admin: creator}}.Validate(ac))
+	require.NoError(t, FactoryDenom{Denom: denom}.Validate(ac), "no admin")
+	require.Error(t, FactoryDenom{Denom: denom, AuthorityMetadata: DenomAuthorityMetadata{Admin: "invalid"}}.Validate(ac))
+	require.Error(t, FactoryDenom{Denom: denom, AuthorityMetadata: DenomAuthorityMetadata{Admin: creator}}.Validate(addresscodec.NewBech32Codec("osmo")), "other prefix")
+	require.Error(t, FactoryDenom{Denom: "stake", AuthorityMetadata: DenomAuthorityMetadata{Admin: creator}}.Validate(ac))
+
+	gs := DefaultGenesisState()
+	gs.FactoryDenoms = []FactoryDenom{{Denom: denom}, {Denom: denom}}
+	require.ErrorContains(t, gs.Validate(), "duplicate factory denom")
+	gs.FactoryDenoms = []FactoryDenom{{Denom: "stake"}}
+	require.ErrorIs(t, gs.Validate(), ErrInvalidFactoryDenom)
+}
@@ -62,6 +62,24 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenFactoryDenoms := make(map[string]bool)
+	for _, factoryDenom := range gs.FactoryDenoms {
+		if seenFactoryDenoms[factoryDenom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", factoryDenom.Denom)
+		}
+
+		// the admin is validated with the address codec at genesis init
+		if _, _, err := DeconstructFactoryDenom(factoryDenom.Denom); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[factoryDenom.Denom] = true
+	}
+
+	if err := validateHolds(gs.Holds, gs.Balances); err != nil {
+		return err
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -42,6 +42,14 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// factory_denoms defines the denoms created with MsgCreateDenom and their authority.
+	//
+	// Since: cosmos-sdk 0.51
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,6,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// holds defines the coins held on the balances of the accounts.
+	//
+	// Since: cosmos-sdk 0.51
+	Holds []Hold `protobuf:"bytes,7,rep,name=holds,proto3" json:"holds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -112,6 +120,78 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+// FactoryDenom defines a factory denom and its authority metadata, used in
+// the bank module's genesis state.
+//
+// Since: cosmos-sdk 0.51
+type FactoryDenom struct {
+	// denom is the factory denom, in the form factory/{creator}/{subdenom}.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// authority_metadata defines the authority of the denom.
+	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{1}
+}
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
+}
+func (m *FactoryDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
+
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
+	if m != nil {
+		return m.AuthorityMetadata
+	}
+	return DenomAuthorityMetadata{}
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -125,7 +205,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f007de11b420c6e, []int{1}
+	return fileDescriptor_8f007de11b420c6e, []int{2}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,42 +236,49 @@ var xxx_messageInfo_Balance proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v1beta1.FactoryDenom")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x90, 0x38, 0x3a, 0xb8, 0x05, 0x9c, 0xd0, 0x29, 0xaa,
-	0x54, 0x5b, 0x4d, 0x37, 0x06, 0x24, 0x5c, 0x01, 0x13, 0x3f, 0xd4, 0x6c, 0x2c, 0xd1, 0xd9, 0xf7,
-	0x64, 0xac, 0xc4, 0x77, 0x56, 0xde, 0x15, 0xc8, 0x7f, 0xc0, 0xc8, 0xcc, 0xd4, 0x11, 0xb1, 0xd0,
-	0x81, 0x3f, 0x80, 0xb1, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0x19, 0xca, 0x9f, 0x81, 0x7c, 0x77, 0x75,
-	0x2c, 0xe1, 0x99, 0xc5, 0x3e, 0xdd, 0xf7, 0xfb, 0x3e, 0xef, 0xc7, 0x3d, 0x72, 0x3f, 0x91, 0x98,
-	0x4b, 0x0c, 0x63, 0x26, 0x26, 0xe1, 0x9b, 0x83, 0x18, 0x14, 0x3b, 0x08, 0x53, 0x10, 0x80, 0x19,
-	0x06, 0xc5, 0x4c, 0x2a, 0x49, 0x6f, 0x1b, 0x4b, 0x50, 0x5a, 0x02, 0x6b, 0xd9, 0xd9, 0x4a, 0x65,
-	0x2a, 0xb5, 0x1e, 0x96, 0x27, 0x63, 0xdd, 0xf1, 0x2b, 0x1a, 0x42, 0x45, 0x4b, 0x64, 0x26, 0xfe,
-	0xd1, 0x6b, 0xd9, 0x34, 0xd7, 0xe8, 0xdb, 0x46, 0x1f, 0x1b, 0xb0, 0xcd, 0x6b, 0xa4, 0x5b, 0x2c,
-	0xcf, 0x84, 0x0c, 0xf5, 0xd7, 0x5c, 0xed, 0x7e, 0x69, 0x91, 0xee, 0x53, 0x53, 0xea, 0x48, 0x31,
-	0x05, 0xf4, 0x21, 0x69, 0x17, 0x6c, 0xc6, 0x72, 0xf4, 0xdc, 0xbe, 0x3b, 0xe8, 0x0c, 0xef, 0x04,
-	0x0d, 0xa5, 0x07, 0x2f, 0xb5, 0x25, 0xda, 0x3c, 0xff, 0xd9, 0x73, 0x3e, 0x5d, 0x9e, 0xed, 0xb9,
-	0xc7, 0x36, 0x8a, 0x1e, 0x91, 0x8d, 0x98, 0x4d, 0x99, 0x48, 0x00, 0xbd, 0x6b, 0xfd, 0xd6, 0xa0,
-	0x33, 0xbc, 0xdb, 0x48, 0x88, 0x8c, 0xa9, 0x8e, 0xa8, 0x02, 0xe9, 0x9c, 0xb4, 0xf1, 0xa4, 0x28,
-	0xa6, 0x73, 0xaf, 0xa5, 0x11, 0xdb, 0x2b, 0x04, 0x42, 0x85, 0x38, 0x92, 0x99, 0x88, 0x9e, 0x94,
-	0xf1, 0x9f, 0x7f, 0xf5, 0x06, 0x69, 0xa6, 0x5e, 0x9f, 0xc4, 0x41, 0x22, 0x73, 0xdb, 0xb4, 0xfd,
-	0xed, 0x23, 0x9f, 0x84, 0x6a, 0x5e, 0x00, 0xea, 0x00, 0xfc, 0x78, 0x79, 0xb6, 0xd7, 0x9d, 0x42,
-	0xca, 0x92, 0xf9, 0xb8, 0x1c, 0x2b, 0xda, 0xfa, 0x4d, 0x42, 0xfa, 0x82, 0xdc, 0xe4, 0x20, 0x64,
-	0x3e, 0xce, 0x41, 0x31, 0xce, 0x14, 0xf3, 0xae, 0xeb, 0x12, 0xee, 0x35, 0x76, 0xf1, 0xcc, 0x9a,
-	0xea, 0x6d, 0xdc, 0xd0, 0xf1, 0x57, 0x0a, 0x7d, 0x4e, 0xba, 0x08, 0x82, 0x8f, 0x41, 0xb0, 0x78,
-	0x0a, 0xdc, 0x5b, 0xd3, 0xb8, 0x7e, 0x23, 0x6e, 0x04, 0x82, 0x3f, 0x36, 0xbe, 0x3a, 0xb1, 0x83,
-	0xab, 0xfb, 0xdd, 0x6f, 0x2e, 0x59, 0xb7, 0xc3, 0xa3, 0x43, 0xb2, 0xce, 0x38, 0x9f, 0x01, 0x9a,
-	0xd7, 0xda, 0x8c, 0xbc, 0xef, 0x5f, 0xf7, 0xb7, 0x2c, 0xf9, 0x91, 0x51, 0x46, 0x6a, 0x96, 0x89,
-	0xf4, 0xf8, 0xca, 0x48, 0xdf, 0x92, 0x35, 0xdd, 0xb6, 0x7d, 0x9d, 0xff, 0x30, 0x5a, 0x93, 0xef,
-	0xc1, 0xc6, 0xfb, 0xd3, 0x9e, 0xf3, 0xe7, 0xb4, 0xe7, 0x44, 0x87, 0xe7, 0x0b, 0xdf, 0xbd, 0x58,
-	0xf8, 0xee, 0xef, 0x85, 0xef, 0x7e, 0x58, 0xfa, 0xce, 0xc5, 0xd2, 0x77, 0x7e, 0x2c, 0x7d, 0xe7,
-	0x95, 0x5d, 0x5e, 0xe4, 0x93, 0x20, 0x93, 0xe1, 0x3b, 0xb3, 0xe4, 0x3a, 0x43, 0xdc, 0xd6, 0x0b,
-	0x7b, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xa7, 0xab, 0xb1, 0x6e, 0x03, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xed, 0xb4, 0x49, 0x9a, 0x4b, 0xa8, 0xd4, 0x23, 0x83, 0x5b, 0xc0, 0x49, 0x33, 0x45,
+	0x45, 0xb5, 0xd5, 0x74, 0xeb, 0x80, 0xd4, 0x14, 0x0a, 0x0b, 0x7f, 0x94, 0x6c, 0x2c, 0xd1, 0xd9,
+	0xbe, 0x3a, 0x56, 0xec, 0xbb, 0xc8, 0x77, 0x01, 0xfc, 0x0d, 0x90, 0x58, 0x98, 0x99, 0x3a, 0x22,
+	0xa6, 0x0e, 0x7c, 0x80, 0x8e, 0x1d, 0x2b, 0x26, 0x26, 0x40, 0xc9, 0x50, 0x3e, 0x06, 0xf2, 0xdd,
+	0x25, 0x71, 0x83, 0xd7, 0x2e, 0xf9, 0xe3, 0xe7, 0x79, 0x7f, 0xcf, 0x7b, 0xaf, 0xde, 0x33, 0xd8,
+	0x75, 0x29, 0x8b, 0x28, 0xb3, 0x1d, 0x44, 0x46, 0xf6, 0xbb, 0x03, 0x07, 0x73, 0x74, 0x60, 0xfb,
+	0x98, 0x60, 0x16, 0x30, 0x6b, 0x1c, 0x53, 0x4e, 0xe1, 0x7d, 0x69, 0xb1, 0x52, 0x8b, 0xa5, 0x2c,
+	0x3b, 0x75, 0x9f, 0xfa, 0x54, 0xe8, 0x76, 0xfa, 0x4b, 0x5a, 0x77, 0xcc, 0x05, 0x8d, 0xe1, 0x05,
+	0xcd, 0xa5, 0x01, 0xf9, 0x4f, 0xcf, 0xa4, 0x09, 0xae, 0xd4, 0xb7, 0xa5, 0x3e, 0x90, 0x60, 0x95,
+	0x2b, 0xa5, 0x2d, 0x14, 0x05, 0x84, 0xda, 0xe2, 0x53, 0x3e, 0x6a, 0x5d, 0xae, 0x83, 0xda, 0x73,
+	0xd9, 0x6a, 0x9f, 0x23, 0x8e, 0xe1, 0x13, 0x50, 0x1a, 0xa3, 0x18, 0x45, 0xcc, 0xd0, 0x9b, 0x7a,
+	0xbb, 0xda, 0x79, 0x60, 0xe5, 0xb4, 0x6e, 0xbd, 0x11, 0x96, 0x6e, 0xe5, 0xea, 0x57, 0x43, 0xfb,
+	0x7a, 0x73, 0xb1, 0xa7, 0xf7, 0x54, 0x15, 0x3c, 0x01, 0x1b, 0x0e, 0x0a, 0x11, 0x71, 0x31, 0x33,
+	0x0a, 0xcd, 0xb5, 0x76, 0xb5, 0xf3, 0x30, 0x97, 0xd0, 0x95, 0xa6, 0x2c, 0x62, 0x51, 0x08, 0x13,
+	0x50, 0x62, 0x93, 0xf1, 0x38, 0x4c, 0x8c, 0x35, 0x81, 0xd8, 0x5e, 0x22, 0x18, 0x5e, 0x20, 0x4e,
+	0x68, 0x40, 0xba, 0xa7, 0x69, 0xfd, 0xb7, 0xdf, 0x8d, 0xb6, 0x1f, 0xf0, 0xe1, 0xc4, 0xb1, 0x5c,
+	0x1a, 0xa9, 0x43, 0xab, 0xaf, 0x7d, 0xe6, 0x8d, 0x6c, 0x9e, 0x8c, 0x31, 0x13, 0x05, 0xec, 0xcb,
+	0xcd, 0xc5, 0x5e, 0x2d, 0xc4, 0x3e, 0x72, 0x93, 0x41, 0x3a, 0x56, 0xa6, 0xfa, 0x97, 0x81, 0xf0,
+	0x35, 0xd8, 0xf4, 0x30, 0xa1, 0xd1, 0x20, 0xc2, 0x1c, 0x79, 0x88, 0x23, 0x63, 0x5d, 0xb4, 0xf0,
+	0x28, 0xf7, 0x14, 0x2f, 0x95, 0x29, 0x7b, 0x8c, 0x7b, 0xa2, 0x7e, 0xae, 0xc0, 0x57, 0xa0, 0xc6,
+	0x30, 0xf1, 0x06, 0x98, 0x20, 0x27, 0xc4, 0x9e, 0x51, 0x14, 0xb8, 0x66, 0x2e, 0xae, 0x8f, 0x89,
+	0xf7, 0x4c, 0xfa, 0xb2, 0xc4, 0x2a, 0x5b, 0x3e, 0x87, 0x7d, 0xb0, 0x79, 0x86, 0x5c, 0x4e, 0xe3,
+	0x64, 0x20, 0x82, 0x98, 0x51, 0x12, 0xc4, 0xdd, 0x5c, 0xe2, 0xa9, 0xb4, 0x3e, 0x4d, 0x9d, 0xb7,
+	0x9a, 0x3c, 0xcb, 0x08, 0x0c, 0x1e, 0x81, 0xe2, 0x90, 0x86, 0x1e, 0x33, 0xca, 0xab, 0xf3, 0xce,
+	0xb0, 0x5e, 0xd0, 0xf0, 0x56, 0x5b, 0xb2, 0xa4, 0xf5, 0x49, 0x07, 0xb5, 0x6c, 0x0c, 0xac, 0x83,
+	0xa2, 0xe8, 0x4c, 0x6c, 0x50, 0xa5, 0x27, 0xff, 0x40, 0x0c, 0x20, 0x9a, 0xf0, 0x21, 0x8d, 0x03,
+	0x9e, 0x2c, 0x87, 0x5b, 0x10, 0x4b, 0xf6, 0x38, 0x37, 0x4f, 0xd0, 0x8e, 0xe7, 0x35, 0x79, 0xa3,
+	0xde, 0x42, 0xab, 0x6a, 0xeb, 0x52, 0x07, 0x65, 0xb5, 0x5b, 0xb0, 0x03, 0xca, 0xc8, 0xf3, 0x62,
+	0xcc, 0xe4, 0x32, 0x57, 0xba, 0xc6, 0x8f, 0xef, 0xfb, 0x75, 0x15, 0x75, 0x2c, 0x95, 0x3e, 0x8f,
+	0x03, 0xe2, 0xf7, 0xe6, 0x46, 0xf8, 0x1e, 0x14, 0xc5, 0x56, 0x18, 0x85, 0xd5, 0x49, 0xdc, 0xd5,
+	0xe6, 0xc9, 0xbc, 0xa3, 0x8d, 0x8f, 0xe7, 0x0d, 0xed, 0xef, 0x79, 0x43, 0xeb, 0x1e, 0x5e, 0x4d,
+	0x4d, 0xfd, 0x7a, 0x6a, 0xea, 0x7f, 0xa6, 0xa6, 0xfe, 0x79, 0x66, 0x6a, 0xd7, 0x33, 0x53, 0xfb,
+	0x39, 0x33, 0xb5, 0xb7, 0xea, 0x6e, 0x33, 0x6f, 0x64, 0x05, 0xd4, 0xfe, 0x20, 0xdf, 0x01, 0x22,
+	0xc1, 0x29, 0x89, 0xfb, 0x7c, 0xf8, 0x6f, 0x00, 0x5e, 0xd2, 0xa0, 0xc2, 0x8d, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +301,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -283,6 +398,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Balance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -370,6 +525,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -596,6 +778,189 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxHoldReasonLength is the maximum length of the reason of a hold.
+const MaxHoldReasonLength = 128
+
+// ValidateHoldReason checks that the reason of a hold is not blank and not too long.
+func ValidateHoldReason(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return errorsmod.Wrap(ErrInvalidHold, "reason cannot be blank")
+	}
+	if len(reason) > MaxHoldReasonLength {
+		return errorsmod.Wrapf(ErrInvalidHold, "reason too long, max length is %d bytes", MaxHoldReasonLength)
+	}
+	return nil
+}
+
+// Validate checks for address, reason and amount correctness, the address is
+// decoded with the given address codec.
+func (h Hold) Validate(addressCodec address.Codec) error {
+	if _, err := addressCodec.StringToBytes(h.Address); err != nil {
+		return err
+	}
+
+	return h.validateReasonAndAmount()
+}
+
+// validateReasonAndAmount checks for reason and amount correctness.
+func (h Hold) validateReasonAndAmount() error {
+	if err := ValidateHoldReason(h.Reason); err != nil {
+		return err
+	}
+
+	if err := h.Amount.Validate(); err != nil {
+		return err
+	}
+	if h.Amount.Empty() {
+		return errorsmod.Wrapf(ErrInvalidHold, "empty amount held by %s for %s", h.Address, h.Reason)
+	}
+
+	return nil
+}
+
+// validateHolds checks the holds and that the coins held by each account do
+// not exceed its balance.
+func validateHolds(holds []Hold, balances []Balance) error {
+	seenHolds := make(map[string]bool)
+	held := make(map[string]sdk.Coins)
+
+	for _, hold := range holds {
+		key := hold.Address + "/" + hold.Reason
+		if seenHolds[key] {
+			return fmt.Errorf("duplicate hold for address %s and reason %s", hold.Address, hold.Reason)
+		}
+
+		// the address is validated with the address codec at genesis init
+		if err := hold.validateReasonAndAmount(); err != nil {
+			return err
+		}
+
+		seenHolds[key] = true
+		held[hold.Address] = held[hold.Address].Add(hold.Amount...)
+	}
+
+	balanceOf := make(map[string]sdk.Coins, len(balances))
+	for _, balance := range balances {
+		balanceOf[balance.Address] = balance.Coins
+	}
+
+	for _, hold := range holds {
+		if balance := balanceOf[hold.Address]; !balance.IsAllGTE(held[hold.Address]) {
+			return fmt.Errorf("coins held by %s exceed its balance: %s > %s", hold.Address, held[hold.Address], balance)
+		}
+	}
+
+	return nil
+}
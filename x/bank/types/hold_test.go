@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateHolds(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________").String()
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("foo", amt)) }
+	balances := []Balance{{Address: addr, Coins: coins(100)}}
+
+	require.NoError(t, validateHolds([]Hold{{addr, "a", coins(60)}, {addr, "b", coins(40)}}, balances))
+	require.ErrorContains(t, validateHolds([]Hold{{addr, "a", coins(60)}, {addr, "b", coins(41)}}, balances), "exceed its balance")
+	require.ErrorContains(t, validateHolds([]Hold{{addr, "a", coins(10)}, {addr, "a", coins(10)}}, balances), "duplicate hold")
+	require.ErrorContains(t, validateHolds([]Hold{{sdk.AccAddress("addr2_______________").String(), "a", coins(1)}}, balances), "exceed its balance")
+	require.ErrorIs(t, validateHolds([]Hold{{addr, "", coins(10)}}, balances), ErrInvalidHold)
+	require.ErrorIs(t, validateHolds([]Hold{{addr, "a", nil}}, balances), ErrInvalidHold)
+}
+
+func TestHoldValidate(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________").String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	require.NoError(t, Hold{addr, "a", coins}.Validate(ac))
+	require.Error(t, Hold{"invalid", "a", coins}.Validate(ac))
+	require.Error(t, Hold{addr, "a", coins}.Validate(addresscodec.NewBech32Codec("osmo")), "other prefix")
+	require.ErrorIs(t, Hold{addr, "", coins}.Validate(ac), ErrInvalidHold)
+	require.ErrorIs(t, Hold{addr, "a", nil}.Validate(ac), ErrInvalidHold)
+}
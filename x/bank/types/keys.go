@@ -39,6 +39,12 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// DenomAuthorityPrefix is the prefix for the authority metadata of the factory denoms.
+	DenomAuthorityPrefix = collections.NewPrefix(6)
+
+	// HoldsPrefix is the prefix for the coins held on the account balances.
+	HoldsPrefix = collections.NewPrefix(7)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
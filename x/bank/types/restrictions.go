@@ -97,3 +97,7 @@ func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionF
 		return toAddr, err
 	}
 }
+
+// A FactoryDenomSendHook can restrict the sends of a factory denom, given the
+// admin of the denom. The admin is empty when the denom has no admin.
+type FactoryDenomSendHook func(ctx context.Context, admin string, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) error
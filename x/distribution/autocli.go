@@ -70,6 +70,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorAutoCompoundValidators",
+					Use:       "auto-compound-validators [delegator-addr]",
+					Short:     "Query the validators of the auto-compounding delegations of a delegator",
+					Example:   fmt.Sprintf(`$ %s query distribution auto-compound-validators [delegator-address]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -106,6 +115,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards",
+					Short:     "Withdraw the rewards of the tokenize share records owned by the sender",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-tokenize-share-rewards --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "WithdrawAllDelegatorRewards",
+					Use:       "withdraw-all-delegator-rewards",
+					Short:     "Withdraw the rewards of all the delegations of the sender in a single message",
+					Long:      "Withdraw the rewards of all the delegations of the sender in a single message, and optionally the commission of the validator operated by the sender.",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-all-delegator-rewards --commission --from mykey", version.AppName),
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"withdraw_commission":     {Name: "commission", Usage: "Also withdraw the commission of the validator operated by the sender"},
+						"start_validator_address": {Usage: "Validator of the first delegation to withdraw, the next validator address of the previous withdrawal"},
+					},
+				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [validator-addr] [enabled]",
+					Short:     "Opt a delegation in or out of the auto-compounding of its rewards",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "enabled"},
+					},
+				},
 				{
 					RpcMethod: "DepositValidatorRewardsPool",
 					Use:       "fund-validator-rewards-pool [validator-addr] [amount]",
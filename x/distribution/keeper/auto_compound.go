@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoCompound opts the delegation of a delegator to a validator in or out
+// of auto-compounding.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join(delAddr, valAddr)
+	if !enabled {
+		return k.AutoCompoundDelegations.Remove(ctx, key)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.AutoCompoundInterval == 0 {
+		return types.ErrAutoCompoundNotEnabled
+	}
+
+	if _, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr); err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrNoDelegationExists
+		}
+		return err
+	}
+
+	// rewards sent to another withdraw address cannot be delegated back
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+	if !withdrawAddr.Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	return k.AutoCompoundDelegations.Set(ctx, key)
+}
+
+// AutoCompoundDelegationRewards withdraws the rewards of the delegations opted in to
+// auto-compounding and delegates them back to the same validator.
+//
+// A pass over the delegations starts every AutoCompoundInterval blocks and
+// processes at most AutoCompoundBatchSize delegations per block, the position
+// of a pass spanning several blocks is kept in AutoCompoundCursor.
+func (k Keeper) AutoCompoundDelegationRewards(ctx context.Context) error {
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	inProgress := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if !inProgress {
+		// nothing to do until a delegation is opted in
+		iter, err := k.AutoCompoundDelegations.Iterate(ctx, nil)
+		if err != nil {
+			return err
+		}
+		empty := !iter.Valid()
+		if err := iter.Close(); err != nil {
+			return err
+		}
+		if empty {
+			return nil
+		}
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundInterval == 0 {
+		// auto-compounding was disabled, abandon the pass in progress
+		if inProgress {
+			return k.AutoCompoundCursor.Remove(ctx)
+		}
+		return nil
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	if !inProgress && height%params.AutoCompoundInterval != 0 {
+		return nil
+	}
+
+	rng := new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]])
+	if inProgress {
+		rng = rng.StartExclusive(cursor)
+	}
+
+	iter, err := k.AutoCompoundDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	// the keys are collected first as auto-compounding writes to the store
+	keys := make([]collections.Pair[sdk.AccAddress, sdk.ValAddress], 0, params.AutoCompoundBatchSize)
+	for ; iter.Valid() && len(keys) < int(params.AutoCompoundBatchSize); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		keys = append(keys, key)
+	}
+	done := !iter.Valid()
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	for _, key := range keys {
+		if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.autoCompoundDelegation(ctx, key.K1(), key.K2())
+		}); err != nil {
+			// purposely ignoring the error here not to halt the chain if a
+			// single delegation fails to auto-compound
+			delegator, _ := k.authKeeper.AddressCodec().BytesToString(key.K1())
+			validator, _ := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+			logger.Error("failed to auto-compound delegation", "delegator", delegator, "validator", validator, "error", err)
+		}
+	}
+
+	if done {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, keys[len(keys)-1])
+}
+
+// autoCompoundDelegation withdraws the rewards of a delegation and delegates
+// the bond denom rewards back to the validator.
+func (k Keeper) autoCompoundDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	// rewards sent to another withdraw address are not held by the delegator
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+	if !withdrawAddr.Equals(delAddr) {
+		return nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoCompound,
+		event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+	)
+}
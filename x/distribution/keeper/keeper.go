@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -52,6 +53,11 @@ type Keeper struct {
 	PreviousProposer           collections.Item[sdk.ConsAddress]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompoundDelegations key: delAddr+valAddr of the delegations opted in to auto-compounding
+	AutoCompoundDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// AutoCompoundCursor stores the last auto-compounded delegation of the
+	// pass in progress, it is absent when no pass is in progress.
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -130,6 +136,18 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompoundDelegations: collections.NewKeySet(
+			sb,
+			types.AutoCompoundDelegationPrefix,
+			"auto_compound_delegations",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+		),
+		AutoCompoundCursor: collections.NewItem(
+			sb,
+			types.AutoCompoundCursorKey,
+			"auto_compound_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -209,6 +227,176 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the tokenized
+// delegations of all the tokenize share records of the owner, and sends them
+// from the record module accounts to the owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	records, err := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range records {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		// the rewards are withdrawn to the record module account, where the
+		// rewards withdrawn on changes of the delegation also accumulate
+		recordAddr := record.GetModuleAddress()
+		if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	return totalRewards, nil
+}
+
+// WithdrawAllDelegatorRewards withdraws the rewards of the delegations of a
+// delegator, from the delegation to startValAddr or from the first one if nil,
+// and, if withdrawCommission is set, the commission of the validator operated
+// by the delegator. At most MaxWithdrawAllDelegations delegations are
+// withdrawn, the returned next validator address is the start of the next
+// delegations to withdraw, or nil if all the delegations were withdrawn. It
+// returns the amounts withdrawn per validator.
+func (k Keeper) WithdrawAllDelegatorRewards(
+	ctx context.Context, delAddr sdk.AccAddress, startValAddr sdk.ValAddress, withdrawCommission bool,
+) ([]types.WithdrawnValidatorRewards, sdk.Coins, sdk.ValAddress, error) {
+	// the delegations are collected before withdrawing their rewards, one more
+	// is collected to know where the next withdrawal starts
+	var (
+		valAddrs []sdk.ValAddress
+		next     sdk.ValAddress
+		iterErr  error
+	)
+	err := k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		if bytes.Compare(valAddr, startValAddr) < 0 {
+			return false
+		}
+		if len(valAddrs) == types.MaxWithdrawAllDelegations {
+			next = valAddr
+			return true
+		}
+		valAddrs = append(valAddrs, valAddr)
+		return false
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if iterErr != nil {
+		return nil, nil, nil, iterErr
+	}
+
+	// the validator operated by the delegator is checked before withdrawing
+	// anything, so that the message fails early
+	var operator sdk.ValAddress
+	if withdrawCommission {
+		operator = sdk.ValAddress(delAddr)
+		if _, err := k.stakingKeeper.GetValidator(ctx, operator); err != nil {
+			return nil, nil, nil, errorsmod.Wrap(types.ErrNoValidatorExists, err.Error())
+		}
+	}
+
+	withdrawn := make([]types.WithdrawnValidatorRewards, 0, len(valAddrs)+1)
+	total := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		// zero rewards are returned as a zero coin of the base denom
+		rewards = sdk.NewCoins(rewards...)
+		commission := sdk.NewCoins()
+		if operator != nil && operator.Equals(sdk.ValAddress(valAddr)) {
+			if commission, err = k.withdrawCommissionIfAny(ctx, operator); err != nil {
+				return nil, nil, nil, err
+			}
+			operator = nil
+		}
+
+		withdrawn = append(withdrawn, types.WithdrawnValidatorRewards{
+			ValidatorAddress: valAddrStr,
+			Rewards:          rewards,
+			Commission:       commission,
+		})
+		total = total.Add(rewards...).Add(commission...)
+	}
+
+	// the operator does not delegate to its own validator, or not in this batch
+	if operator != nil {
+		commission, err := k.withdrawCommissionIfAny(ctx, operator)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(operator)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		withdrawn = append(withdrawn, types.WithdrawnValidatorRewards{
+			ValidatorAddress: valAddr,
+			Rewards:          sdk.NewCoins(),
+			Commission:       commission,
+		})
+		total = total.Add(commission...)
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	err = k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeWithdrawAllRewards,
+		event.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return withdrawn, total, next, nil
+}
+
+// withdrawCommissionIfAny withdraws the commission of a validator, returning no
+// coins instead of an error when there is no commission to withdraw.
+func (k Keeper) withdrawCommissionIfAny(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	commission, err := k.WithdrawValidatorCommission(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorCommission) {
+		return sdk.NewCoins(), nil
+	}
+
+	return commission, err
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v4 "cosmossdk.io/x/distribution/migrations/v4"
+	v5 "cosmossdk.io/x/distribution/migrations/v5"
 	"cosmossdk.io/x/distribution/types"
 )
 
@@ -40,6 +41,13 @@ func (m Migrator) Migrate3to4(ctx context.Context) error {
 	return m.migrateFunds(ctx)
 }
 
+// Migrate4to5 migrates the x/distribution module state from the consensus
+// version 4 to version 5. Specifically, it sets the auto-compounding params to
+// their defaults.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.Params)
+}
+
 func (m Migrator) migrateFunds(ctx context.Context) error {
 	macc := m.keeper.GetDistributionAccount(ctx)
 	poolMacc := m.keeper.authKeeper.GetModuleAccount(ctx, types.ProtocolPoolModuleName)
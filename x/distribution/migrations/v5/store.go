@@ -0,0 +1,26 @@
+package v5
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/distribution/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The migration
+// includes:
+//
+// Addition of the auto-compounding params.
+func MigrateStore(ctx context.Context, paramsCollection collections.Item[types.Params]) error {
+	params, err := paramsCollection.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get distribution params: %w", err)
+	}
+
+	defaultParams := types.DefaultParams()
+	params.AutoCompoundInterval = defaultParams.AutoCompoundInterval
+	params.AutoCompoundBatchSize = defaultParams.AutoCompoundBatchSize
+
+	return paramsCollection.Set(ctx, params)
+}
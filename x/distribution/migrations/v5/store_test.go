@@ -0,0 +1,45 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution"
+	v5 "cosmossdk.io/x/distribution/migrations/v5"
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{}).Codec
+	distrKey := storetypes.NewKVStoreKey("distribution")
+	ctx := testutil.DefaultContext(distrKey, storetypes.NewTransientStoreKey("transient_test"))
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(distrKey))
+	paramsCollection := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// set defaults without newly added fields
+	previousParams := types.DefaultParams()
+	previousParams.WithdrawAddrEnabled = false
+	previousParams.AutoCompoundInterval = 0
+	previousParams.AutoCompoundBatchSize = 0
+	require.NoError(t, paramsCollection.Set(ctx, previousParams))
+
+	// Run migrations.
+	require.NoError(t, v5.MigrateStore(ctx, paramsCollection))
+
+	// Check params
+	newParams, err := paramsCollection.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, newParams.ValidateBasic())
+	require.Equal(t, types.DefaultParams().AutoCompoundInterval, newParams.AutoCompoundInterval)
+	require.Equal(t, types.DefaultParams().AutoCompoundBatchSize, newParams.AutoCompoundBatchSize)
+	require.Equal(t, previousParams.WithdrawAddrEnabled, newParams.WithdrawAddrEnabled)
+}
@@ -38,6 +38,12 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_interval is the number of blocks between the start of two
+	// passes over the auto-compounding delegations. Zero disables auto-compounding.
+	AutoCompoundInterval int64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// auto_compound_batch_size is the maximum number of auto-compounding
+	// delegations processed in a single block.
+	AutoCompoundBatchSize uint32 `protobuf:"varint,6,opt,name=auto_compound_batch_size,json=autoCompoundBatchSize,proto3" json:"auto_compound_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +86,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() int64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundBatchSize() uint32 {
+	if m != nil {
+		return m.AutoCompoundBatchSize
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -637,6 +657,48 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCompoundDelegation identifies a delegation whose rewards are
+// periodically withdrawn and delegated back to the same validator.
+type AutoCompoundDelegation struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundDelegation) Reset()         { *m = AutoCompoundDelegation{} }
+func (m *AutoCompoundDelegation) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundDelegation) ProtoMessage()    {}
+func (*AutoCompoundDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoCompoundDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundDelegation.Merge(m, src)
+}
+func (m *AutoCompoundDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundDelegation proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -650,6 +712,7 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCompoundDelegation)(nil), "cosmos.distribution.v1beta1.AutoCompoundDelegation")
 }
 
 func init() {
@@ -657,73 +720,79 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
+	// 1148 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	0x14, 0xf6, 0x38, 0x8e, 0x93, 0x4c, 0x7e, 0x35, 0x1b, 0x27, 0xdd, 0xb8, 0xc5, 0x76, 0x2d, 0x2a,
+	0x4c, 0x20, 0x36, 0x69, 0x11, 0x20, 0x5f, 0x50, 0xe2, 0xa4, 0xa2, 0x52, 0x81, 0x68, 0x83, 0x40,
+	0x82, 0xc3, 0x6a, 0xbc, 0x3b, 0xb1, 0x87, 0xec, 0xee, 0x2c, 0x33, 0x63, 0x27, 0xe9, 0x05, 0x8e,
+	0x2d, 0x07, 0xe0, 0x06, 0xe2, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0x2e, 0xfc, 0x07, 0x15, 0xa7,
+	0x2a, 0x02, 0x84, 0x38, 0x04, 0x48, 0x0e, 0x41, 0xfc, 0x15, 0x68, 0x76, 0xc6, 0x6b, 0xc7, 0x04,
+	0x14, 0x52, 0x45, 0xbd, 0x58, 0x9e, 0xf7, 0x76, 0xde, 0xf7, 0xbd, 0xf7, 0xbe, 0x79, 0x33, 0xb0,
+	0xec, 0x50, 0xee, 0x53, 0x5e, 0x71, 0x09, 0x17, 0x8c, 0xd4, 0x5b, 0x82, 0xd0, 0xa0, 0xd2, 0x5e,
+	0xac, 0x63, 0x81, 0x16, 0x4f, 0x18, 0xcb, 0x21, 0xa3, 0x82, 0x1a, 0x57, 0xd4, 0xf7, 0xe5, 0x13,
+	0x2e, 0xfd, 0x7d, 0x36, 0xd3, 0xa0, 0x0d, 0x1a, 0x7d, 0x57, 0x91, 0xff, 0xd4, 0x96, 0x6c, 0x4e,
+	0x43, 0xd4, 0x11, 0xc7, 0x71, 0x68, 0x87, 0x12, 0x1d, 0x32, 0x3b, 0xa7, 0xfc, 0xb6, 0xda, 0xa8,
+	0xe3, 0x2b, 0xd7, 0x14, 0xf2, 0x49, 0x40, 0x2b, 0xd1, 0xaf, 0x32, 0x15, 0xef, 0xa7, 0x60, 0x7a,
+	0x0d, 0x31, 0xe4, 0x73, 0xe3, 0x03, 0x38, 0xee, 0x50, 0xdf, 0x6f, 0x05, 0x44, 0xec, 0xd8, 0x02,
+	0x6d, 0x9b, 0xa0, 0x00, 0x4a, 0x23, 0xcb, 0xaf, 0x3c, 0x3a, 0xc8, 0x27, 0x7e, 0x3d, 0xc8, 0x6b,
+	0xaa, 0xdc, 0xdd, 0x2c, 0x13, 0x5a, 0xf1, 0x91, 0x68, 0x96, 0xef, 0xe0, 0x06, 0x72, 0x76, 0x56,
+	0xb0, 0xb3, 0xbf, 0xb7, 0x00, 0x35, 0xd2, 0x0a, 0x76, 0x1e, 0x1e, 0xef, 0xce, 0x03, 0x6b, 0x2c,
+	0x0e, 0xf6, 0x0e, 0xda, 0x36, 0x3e, 0x84, 0x19, 0x49, 0x58, 0xb2, 0x0a, 0x29, 0xc7, 0xcc, 0x66,
+	0x78, 0x0b, 0x31, 0xd7, 0x4c, 0x46, 0x18, 0xaf, 0x9d, 0x0f, 0xc3, 0x04, 0x96, 0x21, 0xa3, 0xae,
+	0xe9, 0xa0, 0x56, 0x14, 0xd3, 0xf0, 0xe0, 0x4c, 0x9d, 0x06, 0x2d, 0xfe, 0x0f, 0xb0, 0x81, 0x27,
+	0x04, 0x9b, 0x8e, 0xc2, 0xf6, 0xa1, 0xdd, 0x80, 0x33, 0x5b, 0x44, 0x34, 0x5d, 0x86, 0xb6, 0x6c,
+	0xe4, 0xba, 0xcc, 0xc6, 0x01, 0xaa, 0x7b, 0xd8, 0x35, 0x53, 0x05, 0x50, 0x1a, 0xb6, 0xa6, 0x3b,
+	0xce, 0x25, 0xd7, 0x65, 0xab, 0xca, 0x65, 0xbc, 0x0c, 0x67, 0x51, 0x4b, 0x50, 0xdb, 0xa1, 0x7e,
+	0x48, 0x5b, 0x81, 0x6b, 0x93, 0x40, 0x60, 0xd6, 0x46, 0x9e, 0x39, 0x58, 0x00, 0xa5, 0x01, 0x2b,
+	0x23, 0xbd, 0x35, 0xed, 0xbc, 0xad, 0x7d, 0xc6, 0xab, 0xd0, 0x3c, 0xb9, 0xab, 0x8e, 0x84, 0xd3,
+	0xb4, 0x39, 0xb9, 0x8b, 0xcd, 0x74, 0x01, 0x94, 0xc6, 0xad, 0x99, 0xde, 0x7d, 0xcb, 0xd2, 0xbb,
+	0x4e, 0xee, 0xe2, 0xea, 0xf5, 0x4f, 0x8f, 0x77, 0xe7, 0x0b, 0x2a, 0x9f, 0x05, 0xee, 0x6e, 0x56,
+	0xb6, 0x4f, 0x0a, 0x54, 0x09, 0xa0, 0xf8, 0x33, 0x80, 0xd9, 0x77, 0x91, 0x47, 0x5c, 0x24, 0x28,
+	0x7b, 0x83, 0x70, 0x41, 0x19, 0x71, 0x90, 0xa7, 0xf2, 0xe4, 0xc6, 0x67, 0x00, 0x5e, 0x76, 0x5a,
+	0x7e, 0xcb, 0x43, 0x82, 0xb4, 0xb1, 0xae, 0xa9, 0xcd, 0x90, 0x20, 0xd4, 0x04, 0x85, 0x81, 0xd2,
+	0xe8, 0x8d, 0xab, 0x5a, 0xfe, 0x65, 0xd9, 0x94, 0x8e, 0x8c, 0x65, 0x01, 0x6b, 0x94, 0x04, 0xaa,
+	0xee, 0xdf, 0xfd, 0x96, 0x7f, 0xa1, 0x41, 0x44, 0xb3, 0x55, 0x2f, 0x3b, 0xd4, 0xd7, 0xf2, 0xac,
+	0xf4, 0x50, 0x13, 0x3b, 0x21, 0xe6, 0x9d, 0x3d, 0x5c, 0x49, 0x69, 0xa6, 0x0b, 0xab, 0xc8, 0x58,
+	0x12, 0xd4, 0x78, 0x0e, 0x4e, 0x32, 0xbc, 0x81, 0x19, 0x0e, 0x1c, 0x6c, 0x3b, 0xb4, 0x15, 0x88,
+	0x48, 0x4e, 0xe3, 0xd6, 0x44, 0x6c, 0xae, 0x49, 0x6b, 0xf1, 0x5b, 0x00, 0x2f, 0xc7, 0x89, 0xd5,
+	0x5a, 0x8c, 0xe1, 0x40, 0x74, 0xb2, 0x0a, 0xe1, 0x90, 0xca, 0x84, 0x5f, 0x70, 0x12, 0x1d, 0x18,
+	0x63, 0x16, 0xa6, 0x43, 0xcc, 0x08, 0x55, 0xe2, 0x4f, 0x59, 0x7a, 0x55, 0xfc, 0x0a, 0xc0, 0x5c,
+	0xcc, 0x72, 0xc9, 0xd1, 0x39, 0x63, 0xb7, 0x46, 0x7d, 0x9f, 0x70, 0x4e, 0x68, 0x60, 0xb4, 0x21,
+	0x74, 0xe2, 0xd5, 0x05, 0xf3, 0xed, 0x41, 0x2a, 0x7e, 0x0e, 0xe0, 0x95, 0x98, 0xda, 0xdb, 0x2d,
+	0xc1, 0x05, 0x0a, 0x5c, 0x12, 0x34, 0x9e, 0x5a, 0x11, 0x25, 0xa3, 0xe9, 0x98, 0xd1, 0xba, 0x87,
+	0x78, 0x73, 0xb5, 0x8d, 0x03, 0x61, 0x3c, 0x0f, 0x2f, 0xb5, 0x3b, 0x66, 0x5b, 0x97, 0x19, 0x44,
+	0x65, 0x9e, 0x8c, 0xed, 0x6b, 0x91, 0xd9, 0x78, 0x13, 0x0e, 0x6f, 0x30, 0xe4, 0xc8, 0x13, 0xa0,
+	0xc7, 0xd0, 0xe2, 0xff, 0x9e, 0x0c, 0x56, 0x1c, 0xa2, 0x78, 0x1f, 0xc0, 0xcc, 0x29, 0x8c, 0xb8,
+	0xf1, 0x11, 0x9c, 0xed, 0x52, 0xe2, 0xd2, 0x61, 0xe3, 0xc8, 0xa3, 0x6b, 0xf5, 0x52, 0xf9, 0x3f,
+	0x2e, 0x81, 0xf2, 0x29, 0x21, 0x97, 0x47, 0x24, 0x4f, 0x55, 0x90, 0x4c, 0xfb, 0x14, 0xc8, 0xe2,
+	0x27, 0x49, 0x38, 0x74, 0x0b, 0xe3, 0x35, 0x4a, 0x3d, 0xe3, 0x63, 0x38, 0xd1, 0x1d, 0xeb, 0x21,
+	0xa5, 0xde, 0x99, 0x5a, 0x54, 0x3d, 0x6f, 0x8b, 0x4c, 0x60, 0x75, 0xaf, 0x91, 0x88, 0x80, 0x80,
+	0x63, 0x2e, 0x76, 0x88, 0x8f, 0x3c, 0x05, 0x9f, 0x3c, 0x03, 0xfc, 0xcd, 0x73, 0xc0, 0x5b, 0xa3,
+	0x1a, 0x46, 0xa2, 0x16, 0xbf, 0x4c, 0xc2, 0x6c, 0xad, 0x97, 0xc7, 0x7a, 0x88, 0x03, 0x57, 0xcd,
+	0x6e, 0xe4, 0x19, 0x19, 0x38, 0x28, 0x88, 0xf0, 0xb0, 0xba, 0xe4, 0x2c, 0xb5, 0x30, 0x0a, 0x70,
+	0xd4, 0xc5, 0xdc, 0x61, 0x24, 0xec, 0xaa, 0xc2, 0xea, 0x35, 0x19, 0x57, 0xe1, 0x08, 0xc3, 0x0e,
+	0x09, 0x09, 0x0e, 0x84, 0xba, 0x4f, 0xac, 0xae, 0xc1, 0xd8, 0x81, 0x69, 0xe4, 0x47, 0x83, 0x28,
+	0x15, 0x25, 0x39, 0x77, 0x6a, 0x92, 0x51, 0x86, 0xb7, 0x74, 0x86, 0xa5, 0x33, 0x64, 0x18, 0xa5,
+	0xf7, 0xf5, 0xf1, 0xee, 0xfc, 0x98, 0x17, 0xc9, 0xd0, 0x76, 0xba, 0x27, 0x42, 0x03, 0x56, 0x4b,
+	0xf7, 0x1e, 0xe4, 0x13, 0x7f, 0x3e, 0xc8, 0x27, 0x7e, 0xd8, 0x5b, 0xc8, 0x6a, 0xd4, 0x06, 0x6d,
+	0xf7, 0x80, 0x06, 0x42, 0x72, 0x06, 0xc5, 0x1f, 0x01, 0x9c, 0x59, 0xc1, 0x32, 0x92, 0x54, 0x8d,
+	0x40, 0x4c, 0x90, 0xa0, 0x71, 0x3b, 0xd8, 0x88, 0x06, 0x6a, 0xc8, 0x70, 0x9b, 0x50, 0x79, 0x77,
+	0xf6, 0x9e, 0x9d, 0x89, 0x8e, 0x59, 0x1f, 0x9d, 0x3b, 0x70, 0x90, 0x0b, 0xb4, 0x89, 0xcd, 0xe4,
+	0x13, 0x3d, 0x11, 0x54, 0x10, 0x63, 0x05, 0xa6, 0x9b, 0x98, 0x34, 0x9a, 0xaa, 0xa0, 0xa9, 0xe5,
+	0x17, 0xff, 0x3a, 0xc8, 0x4f, 0x3a, 0x0c, 0x23, 0x59, 0x71, 0x5b, 0xb9, 0xbe, 0x39, 0xde, 0x9d,
+	0xef, 0xb7, 0xe9, 0x02, 0xa8, 0x45, 0xf1, 0x0f, 0x00, 0xe7, 0x74, 0x5a, 0x84, 0x06, 0x71, 0x82,
+	0xfa, 0x96, 0x7e, 0x0b, 0x4e, 0x75, 0x0f, 0xa1, 0xbc, 0xa6, 0x31, 0xe7, 0xfa, 0x81, 0x73, 0x6d,
+	0x7f, 0x6f, 0xe1, 0x19, 0x4d, 0xad, 0x3b, 0x7f, 0xd5, 0x27, 0xeb, 0x82, 0xc9, 0x31, 0x77, 0xa9,
+	0xdd, 0x67, 0x37, 0x02, 0x98, 0x8e, 0x5f, 0x30, 0x17, 0x39, 0xf0, 0x34, 0x4a, 0x35, 0x25, 0xdb,
+	0x5b, 0xfc, 0x09, 0xc0, 0xeb, 0xff, 0x2e, 0xea, 0xf7, 0x88, 0x68, 0xae, 0xe0, 0x90, 0x72, 0x22,
+	0x2e, 0x48, 0xdf, 0xb3, 0x3d, 0xfa, 0x96, 0x2e, 0xbd, 0x32, 0x4c, 0x38, 0xe4, 0x2a, 0xe0, 0xe8,
+	0x01, 0x33, 0x62, 0x75, 0x96, 0xd5, 0x67, 0xef, 0x9d, 0x45, 0x92, 0xdf, 0x03, 0x38, 0xbb, 0xd4,
+	0xf3, 0x74, 0xe9, 0xf6, 0xd1, 0x58, 0x85, 0x53, 0x6e, 0xa7, 0x97, 0x7d, 0x8d, 0x33, 0xf7, 0xf7,
+	0x16, 0x32, 0x3a, 0x6e, 0x5f, 0xbf, 0xe2, 0x2d, 0x9d, 0x7e, 0x9d, 0xda, 0xff, 0xe4, 0xb9, 0xfb,
+	0x5f, 0x1d, 0xee, 0x1c, 0xb7, 0xe5, 0xd7, 0x1f, 0x1e, 0xe6, 0xc0, 0xa3, 0xc3, 0x1c, 0x78, 0x7c,
+	0x98, 0x03, 0xbf, 0x1f, 0xe6, 0xc0, 0x17, 0x47, 0xb9, 0xc4, 0xe3, 0xa3, 0x5c, 0xe2, 0x97, 0xa3,
+	0x5c, 0xe2, 0xfd, 0x6b, 0x27, 0x8e, 0x44, 0xdf, 0xbb, 0x2b, 0x6a, 0x78, 0x3d, 0x1d, 0xbd, 0xc4,
+	0x6f, 0xfe, 0x3d, 0x00, 0xfe, 0x89, 0x90, 0x89, 0x3c, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +826,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.AutoCompoundBatchSize != that1.AutoCompoundBatchSize {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1167,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1622,6 +1707,43 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1648,6 +1770,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBatchSize))
+	}
 	return n
 }
 
@@ -1859,6 +1987,23 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2016,6 +2161,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBatchSize", wireType)
+			}
+			m.AutoCompoundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3344,6 +3527,120 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -348,6 +348,8 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegations defines the delegations opted in to auto-compounding.
+	AutoCompoundDelegations []AutoCompoundDelegation `protobuf:"bytes,11,rep,name=auto_compound_delegations,json=autoCompoundDelegations,proto3" json:"auto_compound_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,66 +401,68 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x1a, 0x10, 0x54, 0x45, 0x80, 0x1a, 0xb7, 0xe5, 0xc7, 0x01, 0x22, 0x5b, 0x02,
-	0x81, 0x90, 0xac, 0xf1, 0xee, 0x78, 0x3d, 0xaa, 0xbd, 0x63, 0xcd, 0x8c, 0xd7, 0x80, 0xc4, 0x81,
-	0x53, 0x11, 0xe2, 0xc0, 0x09, 0x71, 0xac, 0x38, 0x55, 0x48, 0x48, 0x1c, 0xfa, 0x47, 0xf4, 0x58,
-	0x55, 0x1c, 0x38, 0x01, 0x72, 0x0e, 0x20, 0x8e, 0xfc, 0x05, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xd9,
-	0xed, 0xd6, 0x0d, 0xf5, 0x25, 0xf1, 0xce, 0xbc, 0xf7, 0xbe, 0xef, 0x7b, 0xef, 0xed, 0x7b, 0x0b,
-	0x9e, 0xf7, 0x28, 0x1f, 0x50, 0x5e, 0xf7, 0x09, 0x17, 0x8c, 0x74, 0x46, 0x82, 0xd0, 0xb0, 0x1e,
-	0x5d, 0xea, 0x60, 0x81, 0x2e, 0xd5, 0x03, 0x1c, 0x62, 0x4e, 0xb8, 0x3b, 0x64, 0x54, 0x50, 0x78,
-	0x46, 0x99, 0xba, 0xa6, 0xa9, 0xab, 0x4d, 0x37, 0xd7, 0x02, 0x1a, 0x50, 0x69, 0x57, 0x8f, 0x7f,
-	0x29, 0x97, 0x4d, 0x47, 0x47, 0xef, 0x20, 0x8e, 0xd3, 0xa8, 0x1e, 0x25, 0xa1, 0xbe, 0x77, 0xcb,
-	0xd0, 0xa7, 0x70, 0x94, 0xfd, 0x86, 0xb2, 0x6f, 0x2b, 0x20, 0xcd, 0x47, 0x5d, 0xad, 0xa2, 0x01,
-	0x09, 0x69, 0x5d, 0xfe, 0x55, 0x47, 0xe7, 0x7f, 0xb6, 0xc0, 0xe9, 0x6b, 0xb8, 0x8f, 0x03, 0x24,
-	0x28, 0xfb, 0x88, 0x88, 0x9e, 0xcf, 0xd0, 0xf8, 0xdd, 0xb0, 0x4b, 0xe1, 0x75, 0xb0, 0xea, 0x27,
-	0x17, 0x6d, 0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x5b, 0x5b, 0xd6, 0xc5, 0xe5, 0x3d, 0xfb, 0xc1, 0xdd,
-	0x9d, 0x35, 0x1d, 0xf9, 0xaa, 0xba, 0x69, 0x09, 0x46, 0xc2, 0xa0, 0x79, 0x32, 0x75, 0xd1, 0xe7,
-	0xb0, 0x01, 0x4e, 0x8e, 0x75, 0xd8, 0x34, 0x4a, 0xf5, 0x11, 0x51, 0x9e, 0x49, 0x3c, 0xf4, 0xf1,
-	0x95, 0xa5, 0xaf, 0x6f, 0xd7, 0x2a, 0x7f, 0xdf, 0xae, 0x55, 0xce, 0xdf, 0xaa, 0x82, 0xed, 0x0f,
-	0x51, 0x9f, 0xf8, 0x31, 0xc6, 0x07, 0x23, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0x8f, 0x11, 0xf3,
-	0x79, 0x13, 0x7b, 0x94, 0xf9, 0xf0, 0x7d, 0xb0, 0x1a, 0x25, 0x46, 0x87, 0xb8, 0x6f, 0x3f, 0xb8,
-	0xbb, 0x73, 0x4e, 0xa3, 0xa6, 0x81, 0x0e, 0x89, 0x88, 0x0e, 0x9d, 0xc3, 0x5b, 0x16, 0x38, 0x45,
-	0x33, 0xb0, 0x36, 0x53, 0x68, 0x76, 0x75, 0x6b, 0xe1, 0xe2, 0xca, 0xee, 0x59, 0x5d, 0x22, 0x37,
-	0x2e, 0x61, 0x52, 0x6d, 0xf7, 0x1a, 0xf6, 0x1a, 0x94, 0x84, 0x7b, 0x97, 0xef, 0xfd, 0x5e, 0xab,
-	0xfc, 0xf4, 0x47, 0xed, 0xc5, 0x80, 0x88, 0xde, 0xa8, 0xe3, 0x7a, 0x74, 0xa0, 0xab, 0xa2, 0xff,
-	0xed, 0x70, 0xff, 0x66, 0x5d, 0x7c, 0x3e, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xfe, 0xfa, 0xe5, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0x7f, 0x2c, 0x70, 0x21, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xdd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2d,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2d, 0xb0, 0x95, 0x06, 0x79, 0x87, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x8f,
-	0x35, 0xf5, 0x13, 0xfc, 0x14, 0x1c, 0x4f, 0x1a, 0x60, 0x41, 0x8a, 0x7f, 0x6d, 0x36, 0xf1, 0x39,
-	0xde, 0xa6, 0xf0, 0x24, 0xa4, 0x21, 0xfa, 0x57, 0x0b, 0x9c, 0x4b, 0x9d, 0x1b, 0x23, 0xc6, 0x70,
-	0x28, 0xe6, 0xab, 0xf8, 0xe3, 0x4c, 0x99, 0x2a, 0xeb, 0x2b, 0xb3, 0x29, 0x9b, 0x26, 0xf7, 0x08,
-	0x59, 0x3f, 0x56, 0xc1, 0x99, 0x74, 0xe4, 0xb4, 0x04, 0x62, 0x82, 0x84, 0x41, 0x3c, 0x72, 0xb4,
-	0xa8, 0x27, 0x34, 0x78, 0x0a, 0x73, 0x53, 0x3d, 0x7a, 0x6e, 0x3a, 0xe0, 0x69, 0xae, 0xc9, 0xb6,
-	0x49, 0xd8, 0xa5, 0xba, 0xf6, 0xbb, 0xa5, 0x19, 0x2a, 0xd4, 0x69, 0xe6, 0xe7, 0x04, 0x37, 0x2e,
-	0x8c, 0x24, 0x7d, 0x5f, 0x05, 0x1b, 0x29, 0xb5, 0x56, 0x1f, 0xf1, 0xde, 0xf5, 0x48, 0x66, 0x78,
-	0x5e, 0x9d, 0xde, 0xc3, 0x24, 0xe8, 0x89, 0xa4, 0xd3, 0xd5, 0x93, 0xf1, 0x06, 0x2c, 0x4c, 0xbd,
-	0x01, 0x14, 0x9c, 0xce, 0xf0, 0x79, 0xcc, 0xae, 0x8d, 0x63, 0x7a, 0xf6, 0x31, 0x99, 0x93, 0x97,
-	0x66, 0xeb, 0x9a, 0x4c, 0x96, 0x99, 0x91, 0x53, 0x51, 0xfe, 0xde, 0x48, 0xcc, 0xb7, 0xcb, 0xe0,
-	0xc4, 0xdb, 0x6a, 0xe7, 0xb6, 0x04, 0x12, 0x18, 0xde, 0x00, 0x8b, 0x43, 0xc4, 0xd0, 0x40, 0x25,
-	0x60, 0x65, 0xf7, 0xb9, 0x52, 0xf0, 0x7d, 0x69, 0x6a, 0xe2, 0x69, 0x6f, 0xf8, 0x1e, 0x58, 0xea,
-	0x62, 0xdc, 0x1e, 0x52, 0xda, 0xd7, 0xcd, 0x7f, 0xa1, 0x34, 0xd2, 0x0d, 0x8c, 0xf7, 0x29, 0xed,
-	0x4f, 0x35, 0x7b, 0x57, 0x9d, 0xc1, 0x31, 0xb0, 0xb3, 0x16, 0x4e, 0xd7, 0x5f, 0xdc, 0x35, 0xf1,
-	0xc8, 0x58, 0x98, 0xbd, 0x6d, 0xcc, 0x8d, 0x6c, 0x22, 0xad, 0xfb, 0x45, 0x16, 0x3c, 0x7e, 0x77,
-	0x86, 0x0c, 0x47, 0x84, 0x8e, 0xe4, 0x07, 0xc0, 0x90, 0x72, 0xcc, 0x64, 0x51, 0x4a, 0xdf, 0x9d,
-	0xc4, 0x65, 0x5f, 0x7b, 0xc0, 0x2f, 0x8a, 0xd7, 0xdd, 0x53, 0x92, 0xfa, 0x9b, 0xb3, 0x55, 0xf7,
-	0x61, 0xcb, 0xd9, 0x94, 0x51, 0xb0, 0xe1, 0xe0, 0x0f, 0x16, 0xd8, 0x36, 0x9a, 0x3b, 0x5b, 0x07,
-	0x6d, 0x2f, 0xdd, 0x18, 0xdc, 0x5e, 0x94, 0x54, 0xae, 0xfe, 0x8f, 0xad, 0x93, 0x67, 0x53, 0x8b,
-	0x4a, 0x1d, 0x38, 0xfc, 0xc6, 0x02, 0x67, 0x33, 0x6a, 0xbd, 0x74, 0x9c, 0xa7, 0x09, 0x3a, 0x2e,
-	0x59, 0xbd, 0x71, 0xc4, 0x75, 0x90, 0x67, 0xb4, 0x19, 0x3d, 0xd4, 0x18, 0x7e, 0x65, 0x81, 0x8d,
-	0x8c, 0x8c, 0xa7, 0x26, 0x70, 0xca, 0x64, 0x49, 0x32, 0xb9, 0x72, 0x94, 0xf1, 0x9d, 0xa7, 0xf1,
-	0x6c, 0x54, 0x6c, 0x09, 0xbf, 0x34, 0xfb, 0x7c, 0x6a, 0x3a, 0x72, 0x7b, 0x59, 0x32, 0xb8, 0xfc,
-	0xf8, 0xe3, 0x31, 0x8f, 0x9f, 0x75, 0xbb, 0x69, 0xc7, 0xe1, 0x18, 0xac, 0x17, 0x8e, 0x21, 0x6e,
-	0x03, 0x09, 0xfe, 0xea, 0xe3, 0xce, 0xa1, 0x3c, 0xf4, 0x5a, 0xc1, 0x34, 0x32, 0x96, 0xd9, 0xde,
-	0x5b, 0x77, 0x26, 0x8e, 0x75, 0x6f, 0xe2, 0x58, 0xf7, 0x27, 0x8e, 0xf5, 0xe7, 0xc4, 0xb1, 0xbe,
-	0x3b, 0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x93, 0x6d, 0x85, 0xcf, 0xfd,
-	0x9b, 0x2e, 0xa1, 0xf5, 0xcf, 0xa6, 0xbf, 0xe1, 0xe5, 0x27, 0x5f, 0x67, 0x51, 0x7e, 0x87, 0xbf,
-	0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x53, 0xd9, 0xb1, 0x65, 0x0c, 0x00, 0x00,
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x21, 0x4d, 0xc6, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0xd6, 0x4e, 0x4a, 0x0f,
+	0x05, 0x94, 0x35, 0x4d, 0x11, 0x54, 0x45, 0x80, 0x12, 0xa7, 0xe5, 0xc7, 0x01, 0xa2, 0x44, 0x02,
+	0x81, 0x90, 0xac, 0xf1, 0xee, 0xc4, 0x1e, 0xd5, 0xde, 0x59, 0xcd, 0xcc, 0xae, 0xa1, 0x12, 0x07,
+	0x4e, 0xad, 0x38, 0x71, 0x42, 0x1c, 0x2b, 0x4e, 0x15, 0x12, 0x12, 0x87, 0xfe, 0x11, 0x3d, 0x56,
+	0x15, 0x07, 0x4e, 0x80, 0x92, 0x03, 0x88, 0x23, 0x7f, 0x01, 0xda, 0x99, 0xd9, 0xdd, 0x71, 0x76,
+	0xbb, 0x71, 0x42, 0x7d, 0x49, 0xec, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0xbd, 0x7d, 0xcf, 0x0b,
+	0x5e, 0x76, 0x29, 0x1f, 0x50, 0xde, 0xf4, 0x08, 0x17, 0x8c, 0x74, 0x42, 0x41, 0xa8, 0xdf, 0x8c,
+	0xae, 0x76, 0xb0, 0x40, 0x57, 0x9b, 0x5d, 0xec, 0x63, 0x4e, 0xb8, 0x13, 0x30, 0x2a, 0x28, 0x3c,
+	0xaf, 0x4c, 0x1d, 0xd3, 0xd4, 0xd1, 0xa6, 0xcb, 0x0b, 0x5d, 0xda, 0xa5, 0xd2, 0xae, 0x19, 0x7f,
+	0x52, 0x2e, 0xcb, 0x75, 0x1d, 0xbd, 0x83, 0x38, 0x4e, 0xa3, 0xba, 0x94, 0xf8, 0xfa, 0xde, 0x29,
+	0x43, 0x1f, 0xc1, 0x51, 0xf6, 0x4b, 0xca, 0xbe, 0xad, 0x80, 0x34, 0x1f, 0x75, 0x35, 0x8f, 0x06,
+	0xc4, 0xa7, 0x4d, 0xf9, 0x57, 0x1d, 0x5d, 0xfa, 0xd9, 0x02, 0xe7, 0xb6, 0x70, 0x1f, 0x77, 0x91,
+	0xa0, 0xec, 0x53, 0x22, 0x7a, 0x1e, 0x43, 0xc3, 0x0f, 0xfc, 0x3d, 0x0a, 0x6f, 0x82, 0x79, 0x2f,
+	0xb9, 0x68, 0x23, 0xcf, 0x63, 0x98, 0x73, 0xdb, 0x5a, 0xb1, 0xae, 0xcc, 0x6d, 0xda, 0x4f, 0x1e,
+	0xae, 0x2d, 0xe8, 0xc8, 0x1b, 0xea, 0x66, 0x57, 0x30, 0xe2, 0x77, 0x77, 0xce, 0xa4, 0x2e, 0xfa,
+	0x1c, 0xb6, 0xc0, 0x99, 0xa1, 0x0e, 0x9b, 0x46, 0xa9, 0x1e, 0x11, 0xe5, 0x85, 0xc4, 0x43, 0x1f,
+	0xdf, 0x98, 0xbd, 0x77, 0xbf, 0x51, 0xf9, 0xfb, 0x7e, 0xa3, 0x72, 0xe9, 0x6e, 0x15, 0xac, 0x7e,
+	0x82, 0xfa, 0xc4, 0x8b, 0x31, 0x3e, 0x0e, 0x05, 0x17, 0xc8, 0xf7, 0x62, 0x1f, 0x3c, 0x44, 0xcc,
+	0xe3, 0x3b, 0xd8, 0xa5, 0xcc, 0x83, 0x1f, 0x81, 0xf9, 0x28, 0x31, 0x3a, 0xc4, 0x7d, 0xf5, 0xc9,
+	0xc3, 0xb5, 0x8b, 0x1a, 0x35, 0x0d, 0x74, 0x48, 0x44, 0x74, 0xe8, 0x1c, 0xde, 0xb5, 0xc0, 0x59,
+	0x9a, 0x81, 0xb5, 0x99, 0x42, 0xb3, 0xab, 0x2b, 0x53, 0x57, 0x6a, 0xeb, 0x17, 0x74, 0x89, 0x9c,
+	0xb8, 0x84, 0x49, 0xb5, 0x9d, 0x2d, 0xec, 0xb6, 0x28, 0xf1, 0x37, 0xaf, 0x3f, 0xfa, 0xbd, 0x51,
+	0xf9, 0xe9, 0x8f, 0xc6, 0xab, 0x5d, 0x22, 0x7a, 0x61, 0xc7, 0x71, 0xe9, 0x40, 0x57, 0x45, 0xff,
+	0x5b, 0xe3, 0xde, 0xed, 0xa6, 0xf8, 0x2a, 0xc0, 0x3c, 0xf1, 0xe1, 0x0f, 0xfe, 0xfa, 0xe5, 0x15,
+	0x6b, 0x07, 0xd2, 0x9c, 0x3e, 0x23, 0x13, 0xff, 0x58, 0xe0, 0x72, 0x26, 0xc0, 0x75, 0xc3, 0x41,
+	0xd8, 0x47, 0x02, 0x7b, 0x2d, 0x3a, 0x18, 0x10, 0xce, 0x09, 0xf5, 0x27, 0x94, 0x8c, 0x1e, 0xa8,
+	0xa1, 0x0c, 0x4e, 0x16, 0xb3, 0xb6, 0xfe, 0x96, 0x53, 0xd2, 0xf9, 0x4e, 0x39, 0xcf, 0xcd, 0xb9,
+	0x38, 0x45, 0x4a, 0xb3, 0x19, 0xda, 0x10, 0xfb, 0xaf, 0x05, 0x56, 0xd2, 0x20, 0xef, 0x13, 0x2e,
+	0x28, 0x23, 0x2e, 0xea, 0x4f, 0xb6, 0xea, 0x8b, 0x60, 0x26, 0xc0, 0x8c, 0x50, 0xa5, 0x71, 0x7a,
+	0x47, 0x7f, 0x83, 0x5f, 0x80, 0x53, 0x49, 0x03, 0x4c, 0x49, 0xf1, 0x6f, 0x8e, 0x27, 0x3e, 0xc7,
+	0xdb, 0x14, 0x9e, 0x84, 0x34, 0x44, 0xff, 0x6a, 0x81, 0x8b, 0xa9, 0x73, 0x2b, 0x64, 0x0c, 0xfb,
+	0x62, 0xb2, 0x8a, 0x3f, 0xcb, 0x94, 0xa9, 0xb2, 0xbe, 0x3e, 0x9e, 0xb2, 0x51, 0x72, 0x47, 0xc8,
+	0xfa, 0xb1, 0x0a, 0xce, 0xa7, 0x23, 0x67, 0x57, 0x20, 0x26, 0x88, 0xdf, 0x8d, 0x47, 0x8e, 0x16,
+	0xf5, 0x8c, 0x06, 0x4f, 0x61, 0x6e, 0xaa, 0x27, 0xcf, 0x4d, 0x07, 0x3c, 0xcf, 0x35, 0xd9, 0x36,
+	0xf1, 0xf7, 0xa8, 0xae, 0xfd, 0x7a, 0x69, 0x86, 0x0a, 0x75, 0x9a, 0xf9, 0x39, 0xcd, 0x8d, 0x0b,
+	0x23, 0x49, 0xdf, 0x57, 0xc1, 0x52, 0x4a, 0x6d, 0xb7, 0x8f, 0x78, 0xef, 0x66, 0x24, 0x33, 0x3c,
+	0xa9, 0x4e, 0xef, 0x61, 0xd2, 0xed, 0x89, 0xa4, 0xd3, 0xd5, 0x37, 0xe3, 0x09, 0x98, 0x1a, 0x79,
+	0x02, 0x28, 0x38, 0x97, 0xe1, 0xf3, 0x98, 0x5d, 0x1b, 0xc7, 0xf4, 0xec, 0x69, 0x99, 0x93, 0xd7,
+	0xc6, 0xeb, 0x9a, 0x4c, 0x96, 0x99, 0x91, 0xb3, 0x51, 0xfe, 0xde, 0x48, 0xcc, 0x3d, 0x00, 0x4e,
+	0xbf, 0xa7, 0x76, 0xee, 0xae, 0x40, 0x02, 0xc3, 0x5b, 0x60, 0x26, 0x40, 0x0c, 0x0d, 0x54, 0x02,
+	0x6a, 0xeb, 0x2f, 0x95, 0x82, 0x6f, 0x4b, 0x53, 0x13, 0x4f, 0x7b, 0xc3, 0x0f, 0xc1, 0xec, 0x1e,
+	0xc6, 0xed, 0x80, 0xd2, 0xbe, 0x6e, 0xfe, 0xcb, 0xa5, 0x91, 0x6e, 0x61, 0xbc, 0x4d, 0x69, 0x7f,
+	0xa4, 0xd9, 0xf7, 0xd4, 0x19, 0x1c, 0x02, 0x3b, 0x6b, 0xe1, 0x74, 0xfd, 0xc5, 0x5d, 0x13, 0x8f,
+	0x8c, 0xa9, 0xf1, 0xdb, 0xc6, 0xdc, 0xc8, 0x26, 0xd2, 0xa2, 0x57, 0x64, 0xc1, 0xe3, 0x67, 0x27,
+	0x60, 0x38, 0x22, 0x34, 0x94, 0x3f, 0x00, 0x02, 0xca, 0x31, 0xb3, 0xa7, 0x8f, 0x7a, 0x76, 0x12,
+	0x97, 0x6d, 0xed, 0x01, 0xef, 0x14, 0xaf, 0xbb, 0xe7, 0x24, 0xf5, 0x77, 0xc6, 0xab, 0xee, 0xd3,
+	0x96, 0xb3, 0x29, 0xa3, 0x60, 0xc3, 0xc1, 0x1f, 0x2c, 0xb0, 0x6a, 0x34, 0x77, 0xb6, 0x0e, 0xda,
+	0x6e, 0xba, 0x31, 0xb8, 0x3d, 0x23, 0xa9, 0x6c, 0xfc, 0x8f, 0xad, 0x93, 0x67, 0xd3, 0x88, 0x4a,
+	0x1d, 0x38, 0xfc, 0xd6, 0x02, 0x17, 0x32, 0x6a, 0xbd, 0x74, 0x9c, 0xa7, 0x09, 0x3a, 0x25, 0x59,
+	0xbd, 0x7d, 0xc2, 0x75, 0x90, 0x67, 0xb4, 0x1c, 0x3d, 0xd5, 0x18, 0x7e, 0x63, 0x81, 0xa5, 0x8c,
+	0x8c, 0xab, 0x26, 0x70, 0xca, 0x64, 0x56, 0x32, 0xb9, 0x71, 0x92, 0xf1, 0x9d, 0xa7, 0xf1, 0x62,
+	0x54, 0x6c, 0x09, 0xbf, 0x36, 0xfb, 0x7c, 0x64, 0x3a, 0x72, 0x7b, 0x4e, 0x32, 0xb8, 0x7e, 0xfc,
+	0xf1, 0x98, 0xc7, 0x5f, 0xf4, 0x8a, 0xec, 0x38, 0x1c, 0x82, 0xc5, 0xc2, 0x31, 0xc4, 0x6d, 0x20,
+	0xc1, 0xdf, 0x38, 0xee, 0x1c, 0xca, 0x43, 0x2f, 0x14, 0x4c, 0x23, 0x0e, 0xef, 0x80, 0x25, 0x14,
+	0x0a, 0x1a, 0x77, 0x64, 0x40, 0x43, 0xdf, 0x6b, 0x6b, 0x82, 0xb2, 0x35, 0x6b, 0x12, 0xfb, 0x5a,
+	0x29, 0xf6, 0x46, 0x28, 0x68, 0x4b, 0x3b, 0x6f, 0xa5, 0xbe, 0x23, 0x39, 0x47, 0x85, 0x26, 0xc6,
+	0x22, 0xdd, 0x7c, 0xf7, 0xc1, 0x7e, 0xdd, 0x7a, 0xb4, 0x5f, 0xb7, 0x1e, 0xef, 0xd7, 0xad, 0x3f,
+	0xf7, 0xeb, 0xd6, 0x77, 0x07, 0xf5, 0xca, 0xe3, 0x83, 0x7a, 0xe5, 0xb7, 0x83, 0x7a, 0xe5, 0xf3,
+	0x55, 0x85, 0xcf, 0xbd, 0xdb, 0x0e, 0xa1, 0xcd, 0x2f, 0x47, 0xdf, 0x1f, 0xe4, 0xcf, 0xcd, 0xce,
+	0x8c, 0x7c, 0x07, 0xb8, 0xf6, 0xdf, 0x00, 0x7b, 0x65, 0x12, 0xd4, 0xe1, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -784,6 +788,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1103,6 +1121,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for _, e := range m.AutoCompoundDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2371,6 +2395,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegations = append(m.AutoCompoundDelegations, AutoCompoundDelegation{})
+			if err := m.AutoCompoundDelegations[len(m.AutoCompoundDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
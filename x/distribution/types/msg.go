@@ -13,6 +13,7 @@ var (
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -53,3 +54,36 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new
+// MsgWithdrawTokenizeShareRecordReward for the owner of tokenize share records.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr string) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+	}
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound opting the delegation
+// of a delegator to a validator in or out of auto-compounding.
+func NewMsgSetAutoCompound(delAddr, valAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+// MaxWithdrawAllDelegations is the maximum number of delegations whose rewards
+// are withdrawn by a single MsgWithdrawAllDelegatorRewards, it bounds the gas
+// consumed by the message. The rewards of the next delegations are withdrawn by
+// another message starting from the next validator address of the response.
+const MaxWithdrawAllDelegations = 100
+
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of all the delegations of a delegator.
+func NewMsgWithdrawAllDelegatorRewards(delAddr string, withdrawCommission bool) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress:   delAddr,
+		WithdrawCommission: withdrawCommission,
+	}
+}
@@ -13,12 +13,19 @@ func DefaultParams() Params {
 		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
 		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
 		WithdrawAddrEnabled: true,
+		// auto-compound once a day with 6 second blocks
+		AutoCompoundInterval:  14400,
+		AutoCompoundBatchSize: 100,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateAutoCompound(p.AutoCompoundInterval, p.AutoCompoundBatchSize)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -39,3 +46,14 @@ func validateCommunityTax(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompound(interval int64, batchSize uint32) error {
+	if interval < 0 {
+		return fmt.Errorf("auto-compound interval must not be negative: %d", interval)
+	}
+	if interval > 0 && batchSize == 0 {
+		return fmt.Errorf("auto-compound batch size must be positive when auto-compounding is enabled")
+	}
+
+	return nil
+}
@@ -867,6 +867,101 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryDelegatorAutoCompoundValidatorsRequest is the request type for the
+// Query/DelegatorAutoCompoundValidators RPC method.
+type QueryDelegatorAutoCompoundValidatorsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Reset() {
+	*m = QueryDelegatorAutoCompoundValidatorsRequest{}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorAutoCompoundValidatorsRequest) ProtoMessage() {}
+func (*QueryDelegatorAutoCompoundValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundValidatorsResponse is the response type for the
+// Query/DelegatorAutoCompoundValidators RPC method.
+type QueryDelegatorAutoCompoundValidatorsResponse struct {
+	// validators defines the validators of the auto-compounding delegations.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Reset() {
+	*m = QueryDelegatorAutoCompoundValidatorsResponse{}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorAutoCompoundValidatorsResponse) ProtoMessage() {}
+func (*QueryDelegatorAutoCompoundValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 //
@@ -881,7 +976,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,7 +1021,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -981,6 +1076,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundValidatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundValidatorsRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundValidatorsResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -990,88 +1087,92 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x46, 0xc8, 0xd9, 0xa6, 0x4e, 0xba,
-	0xa1, 0x4d, 0xd4, 0x28, 0xde, 0x36, 0x95, 0x42, 0x49, 0xa8, 0x20, 0x76, 0x12, 0x8a, 0x88, 0xd2,
-	0xd6, 0x2d, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0xdd, 0x38, 0x4b, 0xed, 0x1d, 0x67, 0x67, 0x1d, 0x13,
-	0x55, 0xbd, 0x94, 0x4b, 0xa9, 0x38, 0x20, 0xb8, 0x70, 0xe4, 0x88, 0x38, 0x71, 0xe8, 0x81, 0x1b,
-	0xdc, 0xe8, 0xb1, 0x2a, 0x12, 0xe2, 0x04, 0x28, 0x41, 0xa2, 0x1c, 0x40, 0xdc, 0xb8, 0xa2, 0x9d,
-	0x99, 0xb5, 0x77, 0xed, 0xf5, 0xfa, 0x9f, 0x7c, 0x49, 0xac, 0xd9, 0x79, 0xdf, 0xf7, 0xbe, 0xf7,
-	0xde, 0xcc, 0x7e, 0x36, 0xcc, 0xe6, 0x08, 0x2d, 0x12, 0xaa, 0x68, 0x06, 0xb5, 0x2d, 0x23, 0x5b,
-	0xb6, 0x0d, 0x62, 0x2a, 0xfb, 0x97, 0xb2, 0xba, 0xad, 0x5e, 0x52, 0xf6, 0xca, 0xba, 0x75, 0x90,
-	0x28, 0x59, 0xc4, 0x26, 0xf8, 0x34, 0xdf, 0x98, 0xf0, 0x6e, 0x4c, 0x88, 0x8d, 0xd2, 0x05, 0x81,
-	0x92, 0x55, 0xa9, 0xce, 0xa3, 0xaa, 0x18, 0x25, 0x35, 0x6f, 0x98, 0x2a, 0xdb, 0xcd, 0x80, 0xa4,
-	0xf1, 0x3c, 0xc9, 0x13, 0xf6, 0x51, 0x71, 0x3e, 0x89, 0xd5, 0xc9, 0x3c, 0x21, 0xf9, 0x82, 0xae,
-	0xa8, 0x25, 0x43, 0x51, 0x4d, 0x93, 0xd8, 0x2c, 0x84, 0x8a, 0xa7, 0x71, 0x2f, 0xbe, 0x8b, 0x9c,
-	0x23, 0x86, 0x8b, 0x99, 0x08, 0x53, 0xe1, 0xcb, 0x98, 0xef, 0x9f, 0xe0, 0xfb, 0x33, 0x3c, 0x0d,
-	0xa1, 0x8c, 0x3f, 0x8a, 0xaa, 0x45, 0xc3, 0x24, 0x0a, 0xfb, 0xcb, 0x97, 0xe4, 0x71, 0xc0, 0x37,
-	0x1d, 0x4d, 0x37, 0x54, 0x4b, 0x2d, 0xd2, 0xb4, 0xbe, 0x57, 0xd6, 0xa9, 0x2d, 0xdf, 0x81, 0x53,
-	0xbe, 0x55, 0x5a, 0x22, 0x26, 0xd5, 0xf1, 0x06, 0x0c, 0x97, 0xd8, 0x4a, 0x0c, 0x4d, 0xa3, 0xb9,
-	0x13, 0x8b, 0x33, 0x89, 0x90, 0xc2, 0x25, 0x78, 0x70, 0x72, 0xe4, 0xc9, 0x2f, 0x53, 0x03, 0x5f,
-	0xfd, 0xf1, 0xcd, 0x05, 0x94, 0x16, 0xd1, 0x72, 0x05, 0xce, 0x31, 0xf8, 0x77, 0xd4, 0x82, 0xa1,
-	0xa9, 0x36, 0xb1, 0xd6, 0x3c, 0xf1, 0x6f, 0x9a, 0x3b, 0x44, 0xe4, 0x81, 0xb7, 0x20, 0xba, 0xef,
-	0xee, 0xc9, 0xa8, 0x9a, 0x66, 0xe9, 0x94, 0x73, 0x8f, 0x24, 0xcf, 0x3e, 0x7b, 0xbc, 0x70, 0x46,
-	0xd0, 0x57, 0x71, 0x56, 0xf9, 0x96, 0x5b, 0xb6, 0x65, 0x98, 0xf9, 0xf4, 0xd8, 0x7e, 0xdd, 0xba,
-	0xfc, 0x77, 0x04, 0xce, 0xb7, 0x62, 0x16, 0x5a, 0x37, 0x61, 0x8c, 0x94, 0x74, 0xab, 0x3b, 0xe6,
-	0x51, 0x37, 0x54, 0x2c, 0xe3, 0x07, 0x08, 0xa2, 0x54, 0x2f, 0xec, 0x64, 0xb2, 0xc4, 0xd4, 0x32,
-	0x96, 0x5e, 0x51, 0x2d, 0x8d, 0xc6, 0x22, 0xd3, 0x83, 0x73, 0x27, 0x16, 0x27, 0xdd, 0x2a, 0x3a,
-	0x13, 0x50, 0xad, 0xde, 0x9a, 0x9e, 0x4b, 0x11, 0xc3, 0x4c, 0x5e, 0x71, 0xca, 0xf7, 0xf5, 0xaf,
-	0x53, 0xf3, 0x79, 0xc3, 0xde, 0x2d, 0x67, 0x13, 0x39, 0x52, 0x14, 0x4d, 0x15, 0xff, 0x16, 0xa8,
-	0x76, 0x57, 0xb1, 0x0f, 0x4a, 0x3a, 0x75, 0x63, 0x28, 0xaf, 0xf6, 0xa8, 0x43, 0x98, 0x24, 0xa6,
-	0x96, 0xe6, 0x74, 0x78, 0x0f, 0x20, 0x47, 0x8a, 0x45, 0x83, 0x52, 0x83, 0x98, 0xb1, 0xc1, 0x36,
-	0xc8, 0x2f, 0x77, 0x41, 0x9e, 0xf6, 0x90, 0xc8, 0x07, 0x30, 0xeb, 0xaf, 0xf7, 0xf5, 0xb2, 0x4d,
-	0x6d, 0xd5, 0xd4, 0x9c, 0x2a, 0xf1, 0xb4, 0xfa, 0xd5, 0xeb, 0x8f, 0x11, 0xcc, 0xb5, 0xe6, 0x16,
-	0xdd, 0xbe, 0x03, 0xc7, 0xdd, 0xa6, 0xf0, 0xd1, 0xbe, 0x12, 0x3a, 0xda, 0x21, 0x90, 0xde, 0x79,
-	0x77, 0x31, 0xe5, 0x3d, 0x98, 0xf2, 0xa7, 0x92, 0xaa, 0x96, 0xa8, 0x5f, 0xf2, 0x1f, 0x21, 0x98,
-	0x6e, 0xce, 0x29, 0x64, 0xef, 0xf8, 0x26, 0x82, 0x2b, 0x5f, 0x69, 0x4f, 0xf9, 0x6a, 0x2e, 0x57,
-	0x2e, 0x96, 0x0b, 0xaa, 0xad, 0x6b, 0x35, 0x60, 0xaf, 0x78, 0xef, 0x18, 0x3c, 0x8a, 0xc0, 0xa4,
-	0x3f, 0x99, 0x5b, 0x05, 0x95, 0xee, 0xea, 0xfd, 0x6a, 0x3e, 0x9e, 0x85, 0x51, 0x6a, 0xab, 0x96,
-	0x6d, 0x98, 0xf9, 0xcc, 0xae, 0x6e, 0xe4, 0x77, 0xed, 0x58, 0x64, 0x1a, 0xcd, 0x0d, 0xa5, 0x5f,
-	0x70, 0x97, 0xaf, 0xb1, 0x55, 0x3c, 0x03, 0x27, 0x75, 0xd6, 0x3e, 0x77, 0xdb, 0x20, 0xdb, 0xf6,
-	0x7f, 0xbe, 0x28, 0x36, 0x6d, 0x00, 0xd4, 0xae, 0xfa, 0xd8, 0x10, 0x2b, 0xd3, 0x79, 0xdf, 0xc1,
-	0xe1, 0x6f, 0x93, 0xda, 0xcd, 0x97, 0xd7, 0x85, 0xb2, 0xb4, 0x27, 0x72, 0x79, 0xe8, 0xe1, 0x97,
-	0x53, 0x03, 0xf2, 0x77, 0x08, 0xce, 0x34, 0x29, 0x86, 0x68, 0xcb, 0xdb, 0x70, 0x9c, 0xf2, 0xa5,
-	0x18, 0x62, 0xa7, 0xf4, 0x62, 0x7b, 0x3d, 0x61, 0x38, 0xeb, 0xfb, 0xba, 0x69, 0xfb, 0xa6, 0x50,
-	0x60, 0xe1, 0x37, 0x7c, 0x32, 0x22, 0x4c, 0xc6, 0x6c, 0x4b, 0x19, 0x3c, 0x27, 0xaf, 0x0e, 0xf9,
-	0x7b, 0x57, 0xc1, 0x9a, 0x5e, 0xd0, 0xf3, 0x6c, 0xad, 0xee, 0x30, 0xaf, 0x43, 0x54, 0xe3, 0xcf,
-	0x1a, 0xfa, 0x19, 0x7b, 0xf6, 0x78, 0x61, 0x5c, 0x90, 0xd6, 0xb5, 0xb1, 0x1a, 0xe2, 0xb6, 0x31,
-	0x70, 0x2c, 0x22, 0x5d, 0x8f, 0xc5, 0xf2, 0xff, 0x9c, 0x06, 0x3c, 0x77, 0x9a, 0xf0, 0x19, 0x82,
-	0x78, 0x33, 0x09, 0xa2, 0x0b, 0x25, 0xef, 0x9d, 0xd0, 0xcf, 0x8b, 0xba, 0x7a, 0x4d, 0x94, 0x41,
-	0xae, 0xcb, 0xe9, 0x36, 0xb1, 0xd5, 0x42, 0x5f, 0x6a, 0xeb, 0xa9, 0xc5, 0x3f, 0x08, 0x66, 0x42,
-	0x79, 0x45, 0x41, 0xde, 0xaf, 0x2f, 0xc8, 0x52, 0xe8, 0x58, 0xd6, 0xd0, 0xd6, 0x5c, 0x6e, 0x8e,
-	0x18, 0x74, 0x45, 0xe2, 0x02, 0x1c, 0xb3, 0x1d, 0xd2, 0x3e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x9a, 0x59, 0x75, 0x84, 0xfa, 0x57, 0xe6, 0x4d, 0x71, 0x21, 0x07, 0x72, 0x8a, 0x12,
-	0xc7, 0x01, 0xaa, 0x43, 0xcb, 0xab, 0x3c, 0x92, 0xf6, 0xac, 0x78, 0xd0, 0x2a, 0xf0, 0x92, 0x1f,
-	0x6d, 0xdb, 0xb0, 0x77, 0x35, 0x4b, 0xad, 0x08, 0xe2, 0xbe, 0xc9, 0xd8, 0x17, 0xe6, 0xad, 0x39,
-	0xb1, 0xd0, 0x92, 0x82, 0xb1, 0x8a, 0x78, 0xd4, 0x36, 0xf1, 0x68, 0xc5, 0x0f, 0xe6, 0xe1, 0x9d,
-	0x82, 0x09, 0xc6, 0xeb, 0xbc, 0x6d, 0xca, 0xa6, 0x61, 0x1f, 0xdc, 0x20, 0xa4, 0x20, 0x54, 0x2e,
-	0x47, 0x62, 0x48, 0xfe, 0x04, 0x81, 0x14, 0xb4, 0x43, 0xa4, 0xf3, 0x01, 0x0c, 0x95, 0x08, 0x29,
-	0xf4, 0xf9, 0x2c, 0x33, 0x0e, 0x27, 0x9d, 0xc5, 0x1f, 0xa2, 0x70, 0x8c, 0xa5, 0x83, 0xbf, 0x40,
-	0x30, 0xcc, 0xcd, 0x30, 0x56, 0x42, 0x4f, 0x4c, 0xa3, 0x13, 0x97, 0x2e, 0xb6, 0x1f, 0xc0, 0x75,
-	0xca, 0xf3, 0x0f, 0x7e, 0xfc, 0xfd, 0xf3, 0xc8, 0x39, 0x3c, 0xa3, 0x84, 0x7d, 0x71, 0xe0, 0x4e,
-	0x1c, 0xff, 0x89, 0x60, 0xa2, 0xa9, 0x17, 0xc6, 0xc9, 0xd6, 0xe4, 0xad, 0x2c, 0xbc, 0x94, 0xea,
-	0x09, 0x43, 0x68, 0x4a, 0x31, 0x4d, 0x57, 0xf1, 0x4a, 0xa8, 0xa6, 0xda, 0x39, 0x51, 0xee, 0x35,
-	0xbc, 0x36, 0xee, 0xe3, 0x8f, 0x22, 0x70, 0x3a, 0xc4, 0xb8, 0xe1, 0xb5, 0x0e, 0x32, 0x6d, 0x6a,
-	0x63, 0xa5, 0xf5, 0x1e, 0x51, 0x84, 0xe2, 0x6d, 0xa6, 0xf8, 0x26, 0xbe, 0xde, 0x83, 0x62, 0x85,
-	0xd4, 0xf0, 0xdd, 0xef, 0x1c, 0xf8, 0x10, 0xc1, 0xa9, 0x00, 0x4b, 0x88, 0x5f, 0xed, 0x20, 0xef,
-	0x06, 0xf7, 0x2a, 0x5d, 0xed, 0x32, 0x5a, 0xa8, 0xdd, 0x62, 0x6a, 0xaf, 0xe1, 0x8d, 0x5e, 0xd4,
-	0xd6, 0xfc, 0x26, 0xfe, 0x09, 0xc1, 0x58, 0xbd, 0xbb, 0xc2, 0xaf, 0x74, 0x90, 0xa3, 0xdf, 0x9e,
-	0x4a, 0xcb, 0xdd, 0x84, 0x0a, 0x6d, 0x6f, 0x31, 0x6d, 0xeb, 0x38, 0xd5, 0x8b, 0x36, 0xd7, 0xc2,
-	0xfd, 0x85, 0x20, 0xda, 0xe0, 0x58, 0x70, 0x1b, 0xe9, 0x35, 0x73, 0x6a, 0xd2, 0x4a, 0x57, 0xb1,
-	0x42, 0x5b, 0x86, 0x69, 0x7b, 0x17, 0x6f, 0x87, 0x6a, 0xab, 0xbe, 0x4c, 0xa8, 0x72, 0xaf, 0xe1,
-	0x5d, 0x74, 0x5f, 0x11, 0x93, 0x19, 0x78, 0x66, 0x9f, 0x23, 0x78, 0x31, 0xd8, 0x95, 0xe0, 0xd7,
-	0x3a, 0x49, 0x3c, 0xc0, 0x47, 0x49, 0xaf, 0x77, 0x0f, 0xd0, 0x51, 0x6b, 0xdb, 0x93, 0xcf, 0x0e,
-	0x66, 0x80, 0x35, 0x68, 0xe7, 0x60, 0x36, 0x77, 0x31, 0xed, 0x1c, 0xcc, 0x10, 0x3f, 0xd2, 0xe6,
-	0xc1, 0x6c, 0xa1, 0xb0, 0x36, 0xdb, 0xf8, 0x5f, 0x04, 0xb1, 0x66, 0xc6, 0x01, 0xaf, 0x76, 0x90,
-	0x6b, 0xb0, 0xdb, 0x91, 0x92, 0xbd, 0x40, 0x08, 0xcd, 0xb7, 0x99, 0xe6, 0x2d, 0xbc, 0xd9, 0x8b,
-	0xe6, 0x7a, 0xe7, 0x83, 0xbf, 0x45, 0x70, 0xd2, 0x67, 0x4c, 0xf0, 0x52, 0xeb, 0x5c, 0x83, 0xbc,
-	0x8e, 0xf4, 0x72, 0xc7, 0x71, 0x42, 0xd8, 0x12, 0x13, 0xb6, 0x80, 0xe7, 0x43, 0x85, 0xe5, 0xdc,
-	0xd8, 0x8c, 0x63, 0x65, 0x1e, 0x46, 0x50, 0x72, 0xe5, 0xc9, 0x61, 0x1c, 0x3d, 0x3d, 0x8c, 0xa3,
-	0xdf, 0x0e, 0xe3, 0xe8, 0xd3, 0xa3, 0xf8, 0xc0, 0xd3, 0xa3, 0xf8, 0xc0, 0xcf, 0x47, 0xf1, 0x81,
-	0xf7, 0xce, 0x72, 0x20, 0xaa, 0xdd, 0x4d, 0x18, 0x44, 0xf9, 0xd0, 0x0f, 0xc8, 0xec, 0x51, 0x76,
-	0x98, 0xfd, 0xce, 0x78, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xd3, 0xf1, 0xe8, 0x8d,
-	0x15, 0x00, 0x00,
+	// 1350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6c, 0x1b, 0xc5,
+	0x17, 0xcf, 0xb8, 0x69, 0xfa, 0xcf, 0xeb, 0xbf, 0x24, 0x99, 0x44, 0xc8, 0xd9, 0xa6, 0x76, 0xba,
+	0x21, 0x4d, 0xd4, 0x10, 0x6f, 0x9b, 0x4a, 0xa1, 0x24, 0x44, 0x10, 0x3b, 0x09, 0xa9, 0x88, 0xd2,
+	0xd6, 0x0d, 0x8d, 0xf8, 0xa8, 0xac, 0xb5, 0x77, 0xe3, 0x2c, 0xb5, 0x77, 0x9c, 0xfd, 0x88, 0x89,
+	0xaa, 0x72, 0x28, 0x97, 0x52, 0x71, 0x40, 0xc0, 0x81, 0x23, 0x47, 0xc4, 0x89, 0x43, 0x0f, 0xdc,
+	0xe0, 0xd8, 0x63, 0x55, 0x24, 0xc4, 0xa9, 0xa0, 0x04, 0x89, 0x72, 0x00, 0x71, 0xe3, 0x8a, 0x76,
+	0x66, 0xd6, 0xde, 0xf5, 0xc7, 0xfa, 0x0b, 0x73, 0x69, 0xad, 0xd9, 0x79, 0xbf, 0xdf, 0xfb, 0xbd,
+	0xf7, 0x66, 0xf6, 0xb7, 0x81, 0xa9, 0x0c, 0x31, 0xf3, 0xc4, 0x94, 0x14, 0xcd, 0xb4, 0x0c, 0x2d,
+	0x6d, 0x5b, 0x1a, 0xd1, 0xa5, 0xfd, 0x8b, 0x69, 0xd5, 0x92, 0x2f, 0x4a, 0x7b, 0xb6, 0x6a, 0x1c,
+	0xc4, 0x0a, 0x06, 0xb1, 0x08, 0x3e, 0xcd, 0x36, 0xc6, 0xbc, 0x1b, 0x63, 0x7c, 0xa3, 0x70, 0x9e,
+	0xa3, 0xa4, 0x65, 0x53, 0x65, 0x51, 0x25, 0x8c, 0x82, 0x9c, 0xd5, 0x74, 0x99, 0xee, 0xa6, 0x40,
+	0xc2, 0x48, 0x96, 0x64, 0x09, 0xfd, 0x29, 0x39, 0xbf, 0xf8, 0xea, 0x58, 0x96, 0x90, 0x6c, 0x4e,
+	0x95, 0xe4, 0x82, 0x26, 0xc9, 0xba, 0x4e, 0x2c, 0x1a, 0x62, 0xf2, 0xa7, 0x11, 0x2f, 0xbe, 0x8b,
+	0x9c, 0x21, 0x9a, 0x8b, 0x19, 0x0b, 0x52, 0xe1, 0xcb, 0x98, 0xed, 0x1f, 0x65, 0xfb, 0x53, 0x2c,
+	0x0d, 0xae, 0x8c, 0x3d, 0x1a, 0x92, 0xf3, 0x9a, 0x4e, 0x24, 0xfa, 0x2f, 0x5b, 0x12, 0x47, 0x00,
+	0x5f, 0x77, 0x34, 0x5d, 0x93, 0x0d, 0x39, 0x6f, 0x26, 0xd5, 0x3d, 0x5b, 0x35, 0x2d, 0xf1, 0x16,
+	0x0c, 0xfb, 0x56, 0xcd, 0x02, 0xd1, 0x4d, 0x15, 0xaf, 0x41, 0x5f, 0x81, 0xae, 0x84, 0xd1, 0x38,
+	0x9a, 0x3e, 0x39, 0x37, 0x11, 0x0b, 0x28, 0x5c, 0x8c, 0x05, 0xc7, 0xfb, 0x1f, 0x3d, 0x8d, 0xf6,
+	0x7c, 0xf5, 0xdb, 0x37, 0xe7, 0x51, 0x92, 0x47, 0x8b, 0x45, 0x98, 0xa4, 0xf0, 0x37, 0xe5, 0x9c,
+	0xa6, 0xc8, 0x16, 0x31, 0x56, 0x3c, 0xf1, 0x57, 0xf4, 0x1d, 0xc2, 0xf3, 0xc0, 0x9b, 0x30, 0xb4,
+	0xef, 0xee, 0x49, 0xc9, 0x8a, 0x62, 0xa8, 0x26, 0xe3, 0xee, 0x8f, 0x9f, 0x7d, 0xf2, 0x70, 0xf6,
+	0x0c, 0xa7, 0x2f, 0xe1, 0x2c, 0xb3, 0x2d, 0x37, 0x2c, 0x43, 0xd3, 0xb3, 0xc9, 0xc1, 0xfd, 0x8a,
+	0x75, 0xf1, 0xcf, 0x10, 0x9c, 0x6b, 0xc4, 0xcc, 0xb5, 0x6e, 0xc0, 0x20, 0x29, 0xa8, 0x46, 0x7b,
+	0xcc, 0x03, 0x6e, 0x28, 0x5f, 0xc6, 0xf7, 0x10, 0x0c, 0x99, 0x6a, 0x6e, 0x27, 0x95, 0x26, 0xba,
+	0x92, 0x32, 0xd4, 0xa2, 0x6c, 0x28, 0x66, 0x38, 0x34, 0x7e, 0x6c, 0xfa, 0xe4, 0xdc, 0x98, 0x5b,
+	0x45, 0x67, 0x02, 0x4a, 0xd5, 0x5b, 0x51, 0x33, 0x09, 0xa2, 0xe9, 0xf1, 0xcb, 0x4e, 0xf9, 0xbe,
+	0xfe, 0x39, 0x3a, 0x93, 0xd5, 0xac, 0x5d, 0x3b, 0x1d, 0xcb, 0x90, 0x3c, 0x6f, 0x2a, 0xff, 0x6f,
+	0xd6, 0x54, 0x6e, 0x4b, 0xd6, 0x41, 0x41, 0x35, 0xdd, 0x18, 0x93, 0x55, 0x7b, 0xc0, 0x21, 0x8c,
+	0x13, 0x5d, 0x49, 0x32, 0x3a, 0xbc, 0x07, 0x90, 0x21, 0xf9, 0xbc, 0x66, 0x9a, 0x1a, 0xd1, 0xc3,
+	0xc7, 0x9a, 0x20, 0xbf, 0xd4, 0x06, 0x79, 0xd2, 0x43, 0x22, 0x1e, 0xc0, 0x94, 0xbf, 0xde, 0x57,
+	0x6d, 0xcb, 0xb4, 0x64, 0x5d, 0x71, 0xaa, 0xc4, 0xd2, 0xea, 0x56, 0xaf, 0x3f, 0x42, 0x30, 0xdd,
+	0x98, 0x9b, 0x77, 0xfb, 0x16, 0x9c, 0x70, 0x9b, 0xc2, 0x46, 0xfb, 0x72, 0xe0, 0x68, 0x07, 0x40,
+	0x7a, 0xe7, 0xdd, 0xc5, 0x14, 0xf7, 0x20, 0xea, 0x4f, 0x25, 0x51, 0x2a, 0x51, 0xb7, 0xe4, 0x3f,
+	0x40, 0x30, 0x5e, 0x9f, 0x93, 0xcb, 0xde, 0xf1, 0x4d, 0x04, 0x53, 0xbe, 0xd8, 0x9c, 0xf2, 0xe5,
+	0x4c, 0xc6, 0xce, 0xdb, 0x39, 0xd9, 0x52, 0x95, 0x32, 0xb0, 0x57, 0xbc, 0x77, 0x0c, 0x1e, 0x84,
+	0x60, 0xcc, 0x9f, 0xcc, 0x8d, 0x9c, 0x6c, 0xee, 0xaa, 0xdd, 0x6a, 0x3e, 0x9e, 0x82, 0x01, 0xd3,
+	0x92, 0x0d, 0x4b, 0xd3, 0xb3, 0xa9, 0x5d, 0x55, 0xcb, 0xee, 0x5a, 0xe1, 0xd0, 0x38, 0x9a, 0xee,
+	0x4d, 0x3e, 0xe7, 0x2e, 0xaf, 0xd3, 0x55, 0x3c, 0x01, 0xa7, 0x54, 0x5d, 0xf1, 0x6c, 0x3b, 0x46,
+	0xb7, 0xfd, 0x9f, 0x2d, 0xf2, 0x4d, 0x6b, 0x00, 0xe5, 0xab, 0x3e, 0xdc, 0x4b, 0xcb, 0x74, 0xce,
+	0x77, 0x70, 0xd8, 0xdb, 0xa4, 0x7c, 0xf3, 0x65, 0x55, 0xae, 0x2c, 0xe9, 0x89, 0x5c, 0xe8, 0xbd,
+	0xff, 0x65, 0xb4, 0x47, 0xfc, 0x0e, 0xc1, 0x99, 0x3a, 0xc5, 0xe0, 0x6d, 0x79, 0x13, 0x4e, 0x98,
+	0x6c, 0x29, 0x8c, 0xe8, 0x29, 0xbd, 0xd0, 0x5c, 0x4f, 0x28, 0xce, 0xea, 0xbe, 0xaa, 0x5b, 0xbe,
+	0x29, 0xe4, 0x58, 0xf8, 0x75, 0x9f, 0x8c, 0x10, 0x95, 0x31, 0xd5, 0x50, 0x06, 0xcb, 0xc9, 0xab,
+	0x43, 0xfc, 0xde, 0x55, 0xb0, 0xa2, 0xe6, 0xd4, 0x2c, 0x5d, 0xab, 0x38, 0xcc, 0xab, 0x30, 0xa4,
+	0xb0, 0x67, 0x55, 0xfd, 0x0c, 0x3f, 0x79, 0x38, 0x3b, 0xc2, 0x49, 0x2b, 0xda, 0x58, 0x0a, 0x71,
+	0xdb, 0x58, 0x73, 0x2c, 0x42, 0x6d, 0x8f, 0xc5, 0xc2, 0xff, 0x9c, 0x06, 0x3c, 0x73, 0x9a, 0xf0,
+	0x29, 0x82, 0x48, 0x3d, 0x09, 0xbc, 0x0b, 0x05, 0xef, 0x9d, 0xd0, 0xcd, 0x8b, 0xba, 0x74, 0x4d,
+	0xd8, 0x20, 0x56, 0xe4, 0xb4, 0x45, 0x2c, 0x39, 0xd7, 0x95, 0xda, 0x7a, 0x6a, 0xf1, 0x17, 0x82,
+	0x89, 0x40, 0x5e, 0x5e, 0x90, 0x77, 0x2a, 0x0b, 0x32, 0x1f, 0x38, 0x96, 0x65, 0xb4, 0x15, 0x97,
+	0x9b, 0x21, 0xd6, 0xba, 0x22, 0x71, 0x0e, 0x8e, 0x5b, 0x0e, 0x69, 0x97, 0x5f, 0x8a, 0x8c, 0x44,
+	0x34, 0xf8, 0x85, 0x5c, 0xca, 0xac, 0x34, 0x42, 0xdd, 0x2b, 0xf3, 0x06, 0x8c, 0xd7, 0xe7, 0xe4,
+	0x25, 0x8e, 0x00, 0x94, 0x86, 0x96, 0x55, 0xb9, 0x3f, 0xe9, 0x59, 0xf1, 0xa0, 0x15, 0xe1, 0x05,
+	0x3f, 0xda, 0xb6, 0x66, 0xed, 0x2a, 0x86, 0x5c, 0xe4, 0xc4, 0x5d, 0x93, 0xb1, 0x0f, 0x93, 0x0d,
+	0x88, 0xb9, 0x96, 0x04, 0x0c, 0x16, 0xf9, 0xa3, 0xa6, 0x89, 0x07, 0x8a, 0x7e, 0x30, 0x0f, 0xef,
+	0x07, 0x30, 0xe3, 0xe7, 0x5d, 0xb6, 0x2d, 0x92, 0x20, 0xf9, 0x02, 0xb1, 0x75, 0xe5, 0x3f, 0x68,
+	0xdf, 0x1e, 0xbc, 0xd8, 0x1c, 0x3f, 0x97, 0xbf, 0x5c, 0xdd, 0xca, 0x66, 0x2e, 0x2d, 0x4f, 0x90,
+	0x18, 0x85, 0x51, 0x4a, 0xe9, 0xbc, 0x60, 0x6d, 0x5d, 0xb3, 0x0e, 0xae, 0x11, 0x92, 0xe3, 0x02,
+	0x17, 0x42, 0x61, 0x24, 0x7e, 0x8c, 0x40, 0xa8, 0xb5, 0x83, 0xa7, 0xf0, 0x1e, 0xf4, 0x16, 0x08,
+	0xc9, 0x75, 0xf9, 0xfa, 0xa2, 0x1c, 0x4e, 0x3a, 0x73, 0x4f, 0x87, 0xe1, 0x38, 0x4d, 0x07, 0x7f,
+	0x81, 0xa0, 0x8f, 0xf9, 0x7f, 0x2c, 0x05, 0x5e, 0x12, 0xd5, 0x1f, 0x1f, 0xc2, 0x85, 0xe6, 0x03,
+	0x98, 0x4e, 0x71, 0xe6, 0xde, 0x0f, 0xbf, 0x7e, 0x16, 0x9a, 0xc4, 0x13, 0x52, 0xd0, 0xb7, 0x12,
+	0xfb, 0xf8, 0xc0, 0xbf, 0x23, 0x18, 0xad, 0x6b, 0xff, 0x71, 0xbc, 0x31, 0x79, 0xa3, 0xaf, 0x16,
+	0x21, 0xd1, 0x11, 0x06, 0xd7, 0x94, 0xa0, 0x9a, 0x96, 0xf0, 0x62, 0xa0, 0xa6, 0xf2, 0xb0, 0x48,
+	0x77, 0xaa, 0xde, 0x94, 0x77, 0xf1, 0x87, 0x21, 0x38, 0x1d, 0xe0, 0x55, 0xf1, 0x4a, 0x0b, 0x99,
+	0xd6, 0x75, 0xee, 0xc2, 0x6a, 0x87, 0x28, 0x5c, 0xf1, 0x36, 0x55, 0x7c, 0x1d, 0x5f, 0xed, 0x40,
+	0xb1, 0x44, 0xca, 0xf8, 0xee, 0x67, 0x16, 0x3e, 0x44, 0x30, 0x5c, 0xc3, 0x05, 0xe3, 0x57, 0x5a,
+	0xc8, 0xbb, 0xca, 0xb0, 0x0b, 0x4b, 0x6d, 0x46, 0x73, 0xb5, 0x9b, 0x54, 0xed, 0x3a, 0x5e, 0xeb,
+	0x44, 0x6d, 0xd9, 0x62, 0xe3, 0x1f, 0x11, 0x0c, 0x56, 0x1a, 0x4a, 0xfc, 0x72, 0x0b, 0x39, 0xfa,
+	0x1d, 0xb9, 0xb0, 0xd0, 0x4e, 0x28, 0xd7, 0xf6, 0x06, 0xd5, 0xb6, 0x8a, 0x13, 0x9d, 0x68, 0x73,
+	0x5d, 0xeb, 0x1f, 0x08, 0x86, 0xaa, 0x4c, 0x1a, 0x6e, 0x22, 0xbd, 0x7a, 0xe6, 0x54, 0x58, 0x6c,
+	0x2b, 0x96, 0x6b, 0x4b, 0x51, 0x6d, 0x6f, 0xe1, 0xed, 0x40, 0x6d, 0xa5, 0xf7, 0x88, 0x29, 0xdd,
+	0xa9, 0x7a, 0x0d, 0xdd, 0x95, 0xf8, 0x64, 0xd6, 0x3c, 0xb3, 0xcf, 0x10, 0x3c, 0x5f, 0xdb, 0x88,
+	0xe1, 0x57, 0x5b, 0x49, 0xbc, 0x86, 0x75, 0x14, 0x5e, 0x6b, 0x1f, 0xa0, 0xa5, 0xd6, 0x36, 0x27,
+	0x9f, 0x1e, 0xcc, 0x1a, 0x6e, 0xa8, 0x99, 0x83, 0x59, 0xdf, 0xb8, 0x09, 0x4b, 0x6d, 0x46, 0xb7,
+	0x74, 0x30, 0x1b, 0x28, 0x2c, 0xcf, 0x36, 0xfe, 0x1b, 0x41, 0xb8, 0x9e, 0x57, 0xc2, 0xcb, 0x2d,
+	0xe4, 0x5a, 0xdb, 0xe0, 0x09, 0xf1, 0x4e, 0x20, 0xb8, 0xe6, 0x2d, 0xaa, 0x79, 0x13, 0x6f, 0x74,
+	0xa2, 0xb9, 0xd2, 0xec, 0xe1, 0xcf, 0x43, 0x10, 0x6d, 0xe0, 0x96, 0xf0, 0x7a, 0x0b, 0xd9, 0x07,
+	0x1a, 0x3e, 0xe1, 0xca, 0xbf, 0x80, 0xc4, 0xcb, 0xf1, 0x2e, 0x2d, 0xc7, 0x4d, 0xbc, 0xd5, 0x49,
+	0x39, 0x64, 0xdb, 0x22, 0xa9, 0x0c, 0x27, 0x49, 0x79, 0x06, 0xe2, 0x5b, 0x04, 0xa7, 0x7c, 0x7e,
+	0x0d, 0xcf, 0x37, 0x4e, 0xbd, 0x96, 0x05, 0x14, 0x5e, 0x6a, 0x39, 0x8e, 0x0b, 0x9c, 0xa7, 0x02,
+	0x67, 0xf1, 0x4c, 0xa0, 0xc0, 0x8c, 0x1b, 0x9b, 0x72, 0x1c, 0xde, 0xfd, 0x10, 0x8a, 0x2f, 0x3e,
+	0x3a, 0x8c, 0xa0, 0xc7, 0x87, 0x11, 0xf4, 0xcb, 0x61, 0x04, 0x7d, 0x72, 0x14, 0xe9, 0x79, 0x7c,
+	0x14, 0xe9, 0xf9, 0xe9, 0x28, 0xd2, 0xf3, 0xf6, 0x59, 0x06, 0x64, 0x2a, 0xb7, 0x63, 0x1a, 0x91,
+	0xde, 0xf7, 0x03, 0x52, 0xd7, 0x98, 0xee, 0xa3, 0x7f, 0x71, 0xbe, 0xf4, 0xcf, 0x00, 0x46, 0x3b,
+	0x9f, 0xdc, 0x97, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1105,6 +1206,9 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompoundValidators queries the validators of the delegations
+	// of a delegator that are opted in to auto-compounding.
+	DelegatorAutoCompoundValidators(ctx context.Context, in *QueryDelegatorAutoCompoundValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundValidatorsResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1201,6 +1305,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompoundValidators(ctx context.Context, in *QueryDelegatorAutoCompoundValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundValidatorsResponse, error) {
+	out := new(QueryDelegatorAutoCompoundValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompoundValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -1232,6 +1345,9 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompoundValidators queries the validators of the delegations
+	// of a delegator that are opted in to auto-compounding.
+	DelegatorAutoCompoundValidators(context.Context, *QueryDelegatorAutoCompoundValidatorsRequest) (*QueryDelegatorAutoCompoundValidatorsResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1270,6 +1386,9 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompoundValidators(ctx context.Context, req *QueryDelegatorAutoCompoundValidatorsRequest) (*QueryDelegatorAutoCompoundValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompoundValidators not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1440,6 +1559,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompoundValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompoundValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompoundValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompoundValidators(ctx, req.(*QueryDelegatorAutoCompoundValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1498,6 +1635,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompoundValidators",
+			Handler:    _Query_DelegatorAutoCompoundValidators_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -2155,6 +2296,68 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2494,6 +2697,34 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4221,6 +4452,170 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
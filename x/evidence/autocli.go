@@ -28,6 +28,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all (paginated) submitted evidence",
 					Example:   fmt.Sprintf("%s query evidence --page=2 --page-limit=50", version.AppName),
 				},
+				{
+					RpcMethod:      "UntombstoneHistory",
+					Use:            "untombstone-history [consensus-address]",
+					Short:          "Query the (paginated) history of untombstone decisions, optionally of a single validator",
+					Example:        fmt.Sprintf("%s query evidence untombstone-history cosmosvalcons1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "consensus_address", Optional: true}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              evidencev1beta1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SubmitEvidence",
+					Skip:      true, // evidence is submitted through the commands of the evidence handlers
+				},
+				{
+					RpcMethod:      "Untombstone",
+					Use:            "untombstone [consensus-address] [justification]",
+					Short:          "Submit a proposal to untombstone a validator, optionally refunding its delegators from the community pool",
+					Example:        fmt.Sprintf("%s tx evidence untombstone cosmosvalcons1... \"false positive equivocation\" --refund 1000stake", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "consensus_address"}, {ProtoField: "justification"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
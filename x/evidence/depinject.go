@@ -1,6 +1,8 @@
 package evidence
 
 import (
+	"fmt"
+
 	modulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -11,6 +13,8 @@ import (
 	"cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -27,12 +31,15 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config           *modulev1.Module
 	Environment      appmodule.Environment
 	Cdc              codec.Codec
 	EvidenceHandlers []eviclient.EvidenceHandler `optional:"true"`
+	HandlerRoutes    []types.HandlerRoute        `optional:"true"`
 
 	StakingKeeper  types.StakingKeeper
 	SlashingKeeper types.SlashingKeeper
+	PoolKeeper     types.PoolKeeper `optional:"true"`
 	AddressCodec   address.Codec
 }
 
@@ -44,7 +51,30 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec)
+	// default to governance authority if not provided
+	authority := sdk.AccAddress(sdkaddress.Module(types.GovModuleName))
+	if in.Config.Authority != "" {
+		if addr, err := in.AddressCodec.StringToBytes(in.Config.Authority); err == nil {
+			authority = addr
+		} else {
+			authority = sdkaddress.Module(in.Config.Authority)
+		}
+	}
+
+	authStr, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(fmt.Errorf("unable to decode authority in evidence: %w", err))
+	}
+
+	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.PoolKeeper, in.AddressCodec, authStr)
+	// the app routes are supplied as HandlerRoute, SetRouter adds the vote
+	// extension equivocation route
+	router := types.NewRouter()
+	for _, r := range in.HandlerRoutes {
+		router.AddRoute(r.RouteKey, r.Handler)
+	}
+	k.SetRouter(router)
+
 	m := NewAppModule(*k, in.EvidenceHandlers...)
 
 	return ModuleOutputs{EvidenceKeeper: *k, Module: m}
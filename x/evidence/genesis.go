@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
@@ -31,6 +32,19 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	var nextID uint64
+	for _, record := range gs.UntombstoneRecords {
+		if err := k.SetUntombstoneRecord(ctx, record); err != nil {
+			panic(err)
+		}
+		if record.Id >= nextID {
+			nextID = record.Id + 1
+		}
+	}
+	if err := k.UntombstoneRecordID.Set(ctx, nextID); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the evidence module's exported genesis.
@@ -47,5 +61,13 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+
+	err = k.UntombstoneRecords.Walk(ctx, nil, func(_ collections.Pair[[]byte, uint64], record types.UntombstoneRecord) (stop bool, err error) {
+		gs.UntombstoneRecords = append(gs.UntombstoneRecords, record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
 	return gs
 }
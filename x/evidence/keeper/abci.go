@@ -14,9 +14,15 @@ import (
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
 // misbehavior submitted by CometBFT. Currently, only equivocation is handled.
+// It also records the power of the validators needed to handle vote extension
+// equivocations.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	if err := k.trackValidatorPowers(ctx); err != nil {
+		return err
+	}
+
 	bi := sdk.UnwrapSDKContext(ctx).CometInfo()
 
 	evidences := bi.Evidence
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
@@ -74,3 +75,34 @@ func (k Querier) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceReq
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidences, Pagination: pageRes}, nil
 }
+
+// UntombstoneHistory implements the Query/UntombstoneHistory gRPC method
+func (k Querier) UntombstoneHistory(ctx context.Context, req *types.QueryUntombstoneHistoryRequest) (*types.QueryUntombstoneHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[[]byte, uint64]])
+	if req.ConsensusAddress != "" {
+		consAddr, err := k.k.stakingKeeper.ConsensusAddressCodec().StringToBytes(req.ConsensusAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid consensus address: %s", err)
+		}
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[[]byte, uint64](consAddr))
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.k.UntombstoneRecords,
+		req.Pagination,
+		func(_ collections.Pair[[]byte, uint64], record types.UntombstoneRecord) (types.UntombstoneRecord, error) {
+			return record, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUntombstoneHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
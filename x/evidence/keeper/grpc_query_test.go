@@ -6,8 +6,11 @@ import (
 	"strings"
 
 	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -156,3 +159,73 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryUntombstoneHistory() {
+	consCodec := address.NewBech32Codec("cosmosvalcons")
+	suite.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(consCodec).AnyTimes()
+
+	consAddrs := []string{
+		sdk.ConsAddress(pubkeys[0].Address()).String(),
+		sdk.ConsAddress(pubkeys[1].Address()).String(),
+	}
+	for i := 0; i < 4; i++ {
+		suite.Require().NoError(suite.evidenceKeeper.SetUntombstoneRecord(suite.ctx, types.UntombstoneRecord{
+			Id:               uint64(i),
+			ConsensusAddress: consAddrs[i%2],
+			Height:           int64(i + 1),
+			Authority:        suite.evidenceKeeper.GetAuthority(),
+		}))
+	}
+
+	querier := keeper.NewQuerier(&suite.evidenceKeeper)
+
+	testCases := []struct {
+		msg       string
+		req       *types.QueryUntombstoneHistoryRequest
+		expErrMsg string
+		expIDs    []uint64
+	}{
+		{
+			msg:       "empty request",
+			expErrMsg: "empty request",
+		},
+		{
+			msg:       "invalid consensus address",
+			req:       &types.QueryUntombstoneHistoryRequest{ConsensusAddress: "invalid"},
+			expErrMsg: "invalid consensus address",
+		},
+		{
+			msg:    "all records",
+			req:    &types.QueryUntombstoneHistoryRequest{},
+			expIDs: []uint64{0, 1, 2, 3},
+		},
+		{
+			msg:    "records of a validator",
+			req:    &types.QueryUntombstoneHistoryRequest{ConsensusAddress: consAddrs[1]},
+			expIDs: []uint64{1, 3},
+		},
+		{
+			msg:    "paginated records of a validator",
+			req:    &types.QueryUntombstoneHistoryRequest{ConsensusAddress: consAddrs[0], Pagination: &query.PageRequest{Limit: 1}},
+			expIDs: []uint64{0},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			res, err := querier.UntombstoneHistory(suite.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			ids := make([]uint64, len(res.Records))
+			for i, record := range res.Records {
+				ids[i] = record.Id
+			}
+			suite.Require().ElementsMatch(tc.expIDs, ids)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -123,6 +124,37 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
+	err = k.slashDoubleSign(ctx, validator, consAddr, evidence.GetValidatorPower(), distributionHeight)
+	if err != nil {
+		return err
+	}
+
+	// Jail the validator if not already jailed. This will begin unbonding the
+	// validator if not already unbonding (tombstoned).
+	if !validator.IsJailed() {
+		err = k.slashingKeeper.Jail(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	if err != nil {
+		return err
+	}
+
+	err = k.slashingKeeper.Tombstone(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+}
+
+// slashDoubleSign slashes the validator by the double sign slash fraction and
+// records the tokens slashed from it and from each of its delegations, which
+// bound the refund of its delegators if it is untombstoned. Recording them walks
+// every delegation of the validator.
+func (k Keeper) slashDoubleSign(ctx context.Context, validator sdk.ValidatorI, consAddr sdk.ConsAddress, power, distributionHeight int64) error {
 	slashFractionDoubleSign, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
 	if err != nil {
 		return err
@@ -132,30 +164,53 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		ctx,
 		consAddr,
 		slashFractionDoubleSign,
-		evidence.GetValidatorPower(), distributionHeight,
+		power, distributionHeight,
 		st.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
 	if err != nil {
 		return err
 	}
 
-	// Jail the validator if not already jailed. This will begin unbonding the
-	// validator if not already unbonding (tombstoned).
-	if !validator.IsJailed() {
-		err = k.slashingKeeper.Jail(ctx, consAddr)
-		if err != nil {
-			return err
-		}
+	slashed, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
 	}
 
-	err = k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
 	if err != nil {
 		return err
 	}
 
-	err = k.slashingKeeper.Tombstone(ctx, consAddr)
+	if err := k.SlashedTokens.Set(ctx, valAddr, validator.GetTokens().Sub(slashed.GetTokens())); err != nil {
+		return err
+	}
+
+	// the validator was read before the slash, so the difference of the token
+	// worth of the shares is the stake slashed from each delegation
+	var iterErr error
+	err = k.stakingKeeper.IterateValidatorDelegations(ctx, valAddr, func(_ int64, del sdk.DelegationI) bool {
+		shares := del.GetShares()
+		delSlashed := validator.TokensFromShares(shares).Sub(slashed.TokensFromShares(shares)).TruncateInt()
+		if !delSlashed.IsPositive() {
+			return false
+		}
+
+		delAddr, err := k.addressCodec.StringToBytes(del.GetDelegatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		if err := k.SlashedDelegations.Set(ctx, collections.Join(valAddr, delAddr), delSlashed); err != nil {
+			iterErr = err
+			return true
+		}
+
+		return false
+	})
 	if err != nil {
 		return err
 	}
-	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+
+	return iterErr
 }
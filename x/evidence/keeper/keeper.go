@@ -12,10 +12,12 @@ import (
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper defines the evidence module's keeper. The keeper is responsible for
@@ -27,17 +29,38 @@ type Keeper struct {
 	router         types.Router
 	stakingKeeper  types.StakingKeeper
 	slashingKeeper types.SlashingKeeper
+	poolKeeper     types.PoolKeeper
 	addressCodec   address.Codec
 
+	// the address capable of executing a MsgUntombstone message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
 	Schema collections.Schema
 	// Evidences key: evidence hash bytes | value: Evidence
 	Evidences collections.Map[[]byte, exported.Evidence]
+	// UntombstoneRecords key: consAddr+recordID | value: UntombstoneRecord
+	UntombstoneRecords collections.Map[collections.Pair[[]byte, uint64], types.UntombstoneRecord]
+	// UntombstoneRecordID is the sequence of untombstone record ids
+	UntombstoneRecordID collections.Sequence
+	// ValidatorPowers key: consAddr+height | value: consensus power of the validator
+	// from that height until the next entry, only recorded when it changes
+	ValidatorPowers collections.Map[collections.Pair[[]byte, int64], int64]
+	// LastCommitPowers key: consAddr | value: consensus power of the validator in
+	// the last commit tracked in ValidatorPowers
+	LastCommitPowers collections.Map[[]byte, int64]
+	// SlashedTokens key: valAddr | value: tokens slashed from the validator when
+	// it was tombstoned, the maximum refund when it is untombstoned
+	SlashedTokens collections.Map[[]byte, math.Int]
+	// SlashedDelegations key: valAddr+delAddr | value: tokens slashed from the
+	// delegation when the validator was tombstoned, its share of the refund
+	SlashedDelegations collections.Map[collections.Pair[[]byte, []byte], math.Int]
 }
 
 // NewKeeper creates a new Keeper object.
 func NewKeeper(
 	cdc codec.BinaryCodec, env appmodule.Environment, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper, ac address.Codec,
+	slashingKeeper types.SlashingKeeper, poolKeeper types.PoolKeeper, ac address.Codec, authority string,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -45,8 +68,34 @@ func NewKeeper(
 		environment:    env,
 		stakingKeeper:  stakingKeeper,
 		slashingKeeper: slashingKeeper,
+		poolKeeper:     poolKeeper,
 		addressCodec:   ac,
+		authority:      authority,
 		Evidences:      collections.NewMap(sb, types.KeyPrefixEvidence, "evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		UntombstoneRecords: collections.NewMap(
+			sb,
+			types.KeyPrefixUntombstoneRecord,
+			"untombstone_records",
+			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
+			codec.CollValue[types.UntombstoneRecord](cdc),
+		),
+		UntombstoneRecordID: collections.NewSequence(sb, types.KeyUntombstoneRecordID, "untombstone_record_id"),
+		ValidatorPowers: collections.NewMap(
+			sb,
+			types.KeyPrefixValidatorPower,
+			"validator_powers",
+			collections.PairKeyCodec(collections.BytesKey, collections.Int64Key),
+			collections.Int64Value,
+		),
+		LastCommitPowers: collections.NewMap(sb, types.KeyPrefixLastCommitPower, "last_commit_powers", collections.BytesKey, collections.Int64Value),
+		SlashedTokens:    collections.NewMap(sb, types.KeyPrefixSlashedTokens, "slashed_tokens", collections.BytesKey, sdk.IntValue),
+		SlashedDelegations: collections.NewMap(
+			sb,
+			types.KeyPrefixSlashedDelegation,
+			"slashed_delegations",
+			collections.PairKeyCodec(collections.BytesKey, collections.BytesKey),
+			sdk.IntValue,
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -56,6 +105,11 @@ func NewKeeper(
 	return k
 }
 
+// GetAuthority returns the x/evidence module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger() log.Logger {
 	return k.environment.Logger.With("module", "x/"+types.ModuleName)
@@ -64,12 +118,17 @@ func (k Keeper) Logger() log.Logger {
 // SetRouter sets the Evidence Handler router for the x/evidence module. Note,
 // we allow the ability to set the router after the Keeper is constructed as a
 // given Handler may need access the Keeper before being constructed. The router
-// may only be set once and will be sealed if it's not already sealed.
+// may only be set once and will be sealed if it's not already sealed. The
+// VoteExtensionEquivocation handler is added to the router if it's not sealed
+// and has no handler registered for that route.
 func (k *Keeper) SetRouter(rtr types.Router) {
 	// It is vital to seal the Evidence Handler router as to not allow further
 	// handlers to be registered after the keeper is created since this
 	// could create invalid or non-deterministic behavior.
 	if !rtr.Sealed() {
+		if !rtr.HasRoute(types.RouteVoteExtensionEquivocation) {
+			rtr.AddRoute(types.RouteVoteExtensionEquivocation, NewVoteExtensionEquivocationHandler(k))
+		}
 		rtr.Seal()
 	}
 	if k.router != nil {
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"time"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -27,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -79,6 +82,7 @@ type KeeperTestSuite struct {
 	accountKeeper  *evidencetestutil.MockAccountKeeper
 	slashingKeeper *evidencetestutil.MockSlashingKeeper
 	stakingKeeper  *evidencetestutil.MockStakingKeeper
+	poolKeeper     *evidencetestutil.MockPoolKeeper
 	queryClient    types.QueryClient
 	encCfg         moduletestutil.TestEncodingConfig
 	msgServer      types.MsgServer
@@ -98,17 +102,21 @@ func (suite *KeeperTestSuite) SetupTest() {
 	slashingKeeper := evidencetestutil.NewMockSlashingKeeper(ctrl)
 	accountKeeper := evidencetestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := evidencetestutil.NewMockBankKeeper(ctrl)
+	poolKeeper := evidencetestutil.NewMockPoolKeeper(ctrl)
 
 	evidenceKeeper := keeper.NewKeeper(
 		encCfg.Codec,
 		env,
 		stakingKeeper,
 		slashingKeeper,
+		poolKeeper,
 		address.NewBech32Codec("cosmos"),
+		sdk.AccAddress(sdkaddress.Module(types.GovModuleName)).String(),
 	)
 
 	suite.stakingKeeper = stakingKeeper
 	suite.slashingKeeper = slashingKeeper
+	suite.poolKeeper = poolKeeper
 	suite.bankKeeper = bankKeeper
 
 	router := types.NewRouter()
@@ -223,7 +231,65 @@ func (suite *KeeperTestSuite) TestGetEvidenceHandler() {
 	suite.NoError(err)
 	suite.NotNil(handler)
 
+	// added by SetRouter
+	handler, err = suite.evidenceKeeper.GetEvidenceHandler((&types.VoteExtensionEquivocation{}).Route())
+	suite.NoError(err)
+	suite.NotNil(handler)
+
 	handler, err = suite.evidenceKeeper.GetEvidenceHandler("invalidHandler")
 	suite.Error(err)
 	suite.Nil(handler)
 }
+
+func (suite *KeeperTestSuite) TestTrackValidatorPowers() {
+	consAddr := sdk.ConsAddress(pubkeys[0].Address())
+	cp := suite.ctx.ConsensusParams()
+	cp.Evidence = &cmtproto.EvidenceParams{MaxAgeNumBlocks: 5}
+	beginBlock := func(height, power int64, voteExtensionsEnableHeight int64) {
+		cp.Abci = &cmtproto.ABCIParams{VoteExtensionsEnableHeight: voteExtensionsEnableHeight}
+		var votes []comet.VoteInfo
+		if power > 0 {
+			votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: consAddr, Power: power}, BlockIDFlag: comet.BlockIDFlagCommit})
+		}
+		ctx := suite.ctx.
+			WithConsensusParams(cp).
+			WithHeaderInfo(header.Info{Height: height}).
+			WithCometInfo(comet.Info{LastCommit: comet.CommitInfo{Votes: votes}})
+		suite.Require().NoError(suite.evidenceKeeper.BeginBlocker(ctx))
+	}
+	powers := func() map[int64]int64 {
+		res := map[int64]int64{}
+		suite.Require().NoError(suite.evidenceKeeper.ValidatorPowers.Walk(suite.ctx, nil, func(key collections.Pair[[]byte, int64], power int64) (bool, error) {
+			suite.Require().Equal([]byte(consAddr), key.K1())
+			res[key.K2()] = power
+			return false, nil
+		}))
+		return res
+	}
+
+	// nothing is recorded before vote extensions are enabled
+	beginBlock(2, 5, 0)
+	beginBlock(2, 5, 2)
+	suite.Require().Empty(powers())
+
+	// only the changes of power are recorded
+	beginBlock(3, 10, 2)
+	beginBlock(4, 10, 2)
+	beginBlock(5, 20, 2)
+	suite.Require().Equal(map[int64]int64{2: 10, 4: 20}, powers())
+
+	// the entries older than the max age are pruned, except the one still in
+	// effect at the max age
+	beginBlock(12, 30, 2)
+	suite.Require().Equal(map[int64]int64{4: 20, 11: 30}, powers())
+
+	// a validator leaving the validator set gets a zero power
+	beginBlock(13, 0, 2)
+	beginBlock(14, 0, 2)
+	suite.Require().Equal(map[int64]int64{4: 20, 11: 30, 12: 0}, powers())
+	_, err := suite.evidenceKeeper.LastCommitPowers.Get(suite.ctx, consAddr)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	beginBlock(15, 30, 2)
+	suite.Require().Equal(map[int64]int64{4: 20, 11: 30, 12: 0, 14: 30}, powers())
+}
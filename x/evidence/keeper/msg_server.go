@@ -44,3 +44,28 @@ func (ms msgServer) SubmitEvidence(ctx context.Context, msg *types.MsgSubmitEvid
 		Hash: evidence.Hash(),
 	}, nil
 }
+
+// Untombstone implements the MsgServer.Untombstone method.
+func (ms msgServer) Untombstone(ctx context.Context, msg *types.MsgUntombstone) (*types.MsgUntombstoneResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	consAddr, err := ms.stakingKeeper.ConsensusAddressCodec().StringToBytes(msg.ConsensusAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid consensus address: %s", err)
+	}
+
+	if msg.Refund != nil {
+		if err := msg.Refund.Validate(); err != nil {
+			return nil, errors.Wrap(types.ErrInvalidRefund, err.Error())
+		}
+	}
+
+	id, err := ms.Keeper.UntombstoneValidator(ctx, consAddr, msg.Refund, msg.Justification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUntombstoneResponse{Id: id}, nil
+}
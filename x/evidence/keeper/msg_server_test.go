@@ -3,8 +3,13 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -78,3 +83,184 @@ func (s *KeeperTestSuite) TestSubmitEvidence() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUntombstone() {
+	authority := s.evidenceKeeper.GetAuthority()
+	consAddr := sdk.ConsAddress(pubkeys[0].Address())
+	consAddrStr := consAddr.String()
+
+	validator := tombstonedValidator{operator: valAddress.String(), consAddr: consAddr}
+	valAC := address.NewBech32Codec("cosmosvaloper")
+
+	refund := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	delAddr1, delAddr2 := sdk.AccAddress("delegator1"), sdk.AccAddress("delegator2")
+	setSlashedDelegations := func() {
+		s.Require().NoError(s.evidenceKeeper.SlashedTokens.Set(s.ctx, valAddress, refund.Amount))
+		s.Require().NoError(s.evidenceKeeper.SlashedDelegations.Set(s.ctx, collections.Join(valAddress.Bytes(), delAddr1.Bytes()), math.NewInt(7)))
+		s.Require().NoError(s.evidenceKeeper.SlashedDelegations.Set(s.ctx, collections.Join(valAddress.Bytes(), delAddr2.Bytes()), math.NewInt(3)))
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUntombstone
+		malleate  func()
+		expErrMsg string
+		expID     uint64
+	}{
+		{
+			name:      "invalid authority",
+			req:       types.NewMsgUntombstone("invalid", consAddrStr, nil, ""),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid consensus address",
+			req:  types.NewMsgUntombstone(authority, "invalid", nil, ""),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons"))
+			},
+			expErrMsg: "invalid consensus address",
+		},
+		{
+			name: "invalid refund",
+			req:  types.NewMsgUntombstone(authority, consAddrStr, &sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)}, ""),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons"))
+			},
+			expErrMsg: "invalid refund",
+		},
+		{
+			name: "refund in another denom",
+			req:  types.NewMsgUntombstone(authority, consAddrStr, &sdk.Coin{Denom: "other", Amount: math.NewInt(10)}, ""),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons"))
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valAC)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+			},
+			expErrMsg: "invalid denom",
+		},
+		{
+			name: "refund without slashed tokens recorded",
+			req:  types.NewMsgUntombstone(authority, consAddrStr, &refund, ""),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons"))
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valAC)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+			},
+			expErrMsg: "no slashed tokens recorded",
+		},
+		{
+			name: "refund exceeding the slashed tokens",
+			req:  types.NewMsgUntombstone(authority, consAddrStr, &refund, ""),
+			malleate: func() {
+				s.Require().NoError(s.evidenceKeeper.SlashedTokens.Set(s.ctx, valAddress, math.NewInt(5)))
+				s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons"))
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valAC)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+			},
+			expErrMsg: "exceeds the 5 tokens slashed",
+		},
+		{
+			name: "refund without slashed delegations recorded",
+			req:  types.NewMsgUntombstone(authority, consAddrStr, &refund, ""),
+			malleate: func() {
+				s.Require().NoError(s.evidenceKeeper.SlashedTokens.Set(s.ctx, valAddress, refund.Amount))
+				s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons"))
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valAC)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+			},
+			expErrMsg: "no slashed delegations recorded",
+		},
+		{
+			name: "untombstone without refund",
+			req:  types.NewMsgUntombstone(authority, consAddrStr, nil, "false positive"),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).Times(2)
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valAC)
+				s.slashingKeeper.EXPECT().Untombstone(gomock.Any(), consAddr).Return(nil)
+			},
+			expID: 0,
+		},
+		{
+			name: "untombstone with refund",
+			req:  types.NewMsgUntombstone(authority, consAddrStr, &refund, "false positive"),
+			malleate: func() {
+				setSlashedDelegations()
+				s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).Times(2)
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valAC)
+				s.slashingKeeper.EXPECT().Untombstone(gomock.Any(), consAddr).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+				// the slashed delegators are refunded pro rata to their slashed tokens
+				s.poolKeeper.EXPECT().DistributeFromCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7)), delAddr1).Return(nil)
+				s.poolKeeper.EXPECT().DistributeFromCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)), delAddr2).Return(nil)
+			},
+			expID: 1,
+		},
+		{
+			name: "untombstone with partial refund",
+			req:  types.NewMsgUntombstone(authority, consAddrStr, &sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(5)}, "false positive"),
+			malleate: func() {
+				setSlashedDelegations()
+				s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).Times(2)
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valAC)
+				s.slashingKeeper.EXPECT().Untombstone(gomock.Any(), consAddr).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+				// the refund shares are truncated, the remainder stays in the community pool
+				s.poolKeeper.EXPECT().DistributeFromCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)), delAddr1).Return(nil)
+				s.poolKeeper.EXPECT().DistributeFromCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), delAddr2).Return(nil)
+			},
+			expID: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			res, err := s.msgServer.Untombstone(s.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expID, res.Id)
+
+			record, err := s.evidenceKeeper.UntombstoneRecords.Get(s.ctx, collections.Join(consAddr.Bytes(), res.Id))
+			s.Require().NoError(err)
+			s.Require().Equal(consAddrStr, record.ConsensusAddress)
+			s.Require().Equal(tc.req.Refund, record.Refund)
+			s.Require().Equal(authority, record.Authority)
+			s.Require().Equal(tc.req.Justification, record.Justification)
+
+			// the slashed tokens can only be refunded once
+			has, err := s.evidenceKeeper.SlashedTokens.Has(s.ctx, valAddress)
+			s.Require().NoError(err)
+			s.Require().False(has)
+			has, err = s.evidenceKeeper.SlashedDelegations.Has(s.ctx, collections.Join(valAddress.Bytes(), delAddr1.Bytes()))
+			s.Require().NoError(err)
+			s.Require().False(has)
+		})
+	}
+}
+
+// tombstonedValidator is a tombstoned validator, only implementing the
+// sdk.ValidatorI methods used to untombstone it.
+type tombstonedValidator struct {
+	sdk.ValidatorI
+
+	operator string
+	consAddr sdk.ConsAddress
+}
+
+func (v tombstonedValidator) GetOperator() string          { return v.operator }
+func (v tombstonedValidator) GetConsAddr() ([]byte, error) { return v.consAddr, nil }
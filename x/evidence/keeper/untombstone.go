@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UntombstoneValidator clears the tombstone of the validator with the given consensus
+// address and records the decision in the untombstone history. If a refund is
+// provided, it is transferred from the community pool to the delegators slashed
+// when the validator was tombstoned, pro rata to the tokens slashed from each of
+// them. The refund cannot exceed the tokens slashed from the validator. The id of
+// the recorded decision is returned.
+func (k Keeper) UntombstoneValidator(ctx context.Context, consAddr sdk.ConsAddress, refund *sdk.Coin, justification string) (uint64, error) {
+	// ValidatorByConsAddr gets the validator even if the key has been rotated.
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return 0, err
+	}
+	if validator == nil {
+		return 0, fmt.Errorf("validator %s does not exist", consAddr)
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return 0, err
+	}
+
+	// Get the consAddr from the validator read from the store, because the
+	// validator may have rotated its key.
+	consAddr, err = validator.GetConsAddr()
+	if err != nil {
+		return 0, err
+	}
+
+	hasRefund := refund != nil && refund.IsPositive()
+	if hasRefund {
+		if err := k.validateRefund(ctx, valAddr, *refund); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.slashingKeeper.Untombstone(ctx, consAddr); err != nil {
+		return 0, err
+	}
+
+	if hasRefund {
+		if err := k.refundDelegators(ctx, valAddr, *refund); err != nil {
+			return 0, err
+		}
+	}
+
+	// the slashed tokens can only be refunded once
+	if err := k.SlashedTokens.Remove(ctx, valAddr); err != nil {
+		return 0, err
+	}
+	if err := k.SlashedDelegations.Clear(ctx, collections.NewPrefixedPairRange[[]byte, []byte](valAddr)); err != nil {
+		return 0, err
+	}
+
+	id, err := k.UntombstoneRecordID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	consStr, err := k.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	headerInfo := k.environment.HeaderService.GetHeaderInfo(ctx)
+	record := types.UntombstoneRecord{
+		Id:               id,
+		ConsensusAddress: consStr,
+		Height:           headerInfo.Height,
+		Time:             headerInfo.Time,
+		Refund:           refund,
+		Authority:        k.authority,
+		Justification:    justification,
+	}
+	if err := k.UntombstoneRecords.Set(ctx, collections.Join(consAddr.Bytes(), id), record); err != nil {
+		return 0, err
+	}
+
+	refundStr := ""
+	if refund != nil {
+		refundStr = refund.String()
+	}
+
+	k.Logger().Info(
+		"untombstoned validator",
+		"validator", consStr,
+		"refund", refundStr,
+		"record_id", id,
+	)
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUntombstone,
+		event.NewAttribute(types.AttributeKeyValidator, consStr),
+		event.NewAttribute(types.AttributeKeyRefund, refundStr),
+		event.NewAttribute(types.AttributeKeyRecordID, fmt.Sprintf("%d", id)),
+	); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// validateRefund checks that the refund can be paid to the slashed delegators of
+// the validator.
+func (k Keeper) validateRefund(ctx context.Context, valAddr sdk.ValAddress, refund sdk.Coin) error {
+	if k.poolKeeper == nil {
+		return types.ErrInvalidRefund.Wrap("refunds are not supported without a community pool")
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	if refund.Denom != bondDenom {
+		return types.ErrInvalidRefund.Wrapf("invalid denom; expected %s, got %s", bondDenom, refund.Denom)
+	}
+
+	slashed, err := k.SlashedTokens.Get(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrInvalidRefund.Wrap("no slashed tokens recorded for the validator")
+		}
+		return err
+	}
+
+	if refund.Amount.GT(slashed) {
+		return types.ErrInvalidRefund.Wrapf("refund of %s exceeds the %s tokens slashed from the validator", refund.Amount, slashed)
+	}
+
+	iter, err := k.SlashedDelegations.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, []byte](valAddr))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.ErrInvalidRefund.Wrap("no slashed delegations recorded for the validator")
+	}
+
+	return nil
+}
+
+// refundDelegators transfers the refund from the community pool to the
+// delegators slashed when the validator was tombstoned, pro rata to the tokens
+// slashed from each of them, whether they still delegate to the validator or
+// not. The shares are truncated, so the remainder stays in the community pool.
+// It walks every slashed delegation of the validator, so its gas grows with
+// their number.
+func (k Keeper) refundDelegators(ctx context.Context, valAddr sdk.ValAddress, refund sdk.Coin) error {
+	slashed, err := k.SlashedTokens.Get(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[[]byte, []byte](valAddr)
+	return k.SlashedDelegations.Walk(ctx, rng, func(key collections.Pair[[]byte, []byte], delSlashed math.Int) (bool, error) {
+		amount := refund.Amount.Mul(delSlashed).Quo(slashed)
+		if !amount.IsPositive() {
+			return false, nil
+		}
+
+		return false, k.poolKeeper.DistributeFromCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(refund.Denom, amount)), key.K2())
+	})
+}
+
+// SetUntombstoneRecord stores a recorded untombstone decision.
+func (k Keeper) SetUntombstoneRecord(ctx context.Context, record types.UntombstoneRecord) error {
+	consAddr, err := k.stakingKeeper.ConsensusAddressCodec().StringToBytes(record.ConsensusAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.UntombstoneRecords.Set(ctx, collections.Join(consAddr, record.Id), record)
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// trackValidatorPowers records the consensus power of the validators of the
+// last commit when vote extensions were enabled at its height, so the vote
+// extension equivocation evidence can be checked against the validator set and
+// slashed with the power the validator had at the infraction height. Only the
+// changes of power are stored, a validator leaving the validator set gets a zero
+// power, and the entries older than the max age of the evidence are pruned.
+func (k Keeper) trackValidatorPowers(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cp := sdkCtx.ConsensusParams()
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	commitHeight := height - 1
+	if cp.Abci == nil || cp.Abci.VoteExtensionsEnableHeight == 0 || commitHeight < cp.Abci.VoteExtensionsEnableHeight {
+		return nil
+	}
+
+	pruneHeight := int64(0)
+	if cp.Evidence != nil {
+		pruneHeight = height - cp.Evidence.MaxAgeNumBlocks
+	}
+
+	votes := sdkCtx.CometInfo().LastCommit.Votes
+	inCommit := make(map[string]bool, len(votes))
+	for _, vote := range votes {
+		inCommit[string(vote.Validator.Address)] = true
+	}
+
+	// the keys are collected first as the validators which left the validator
+	// set are removed from the store
+	var removed [][]byte
+	err := k.LastCommitPowers.Walk(ctx, nil, func(consAddr []byte, _ int64) (bool, error) {
+		if !inCommit[string(consAddr)] {
+			removed = append(removed, consAddr)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, consAddr := range removed {
+		if err := k.LastCommitPowers.Remove(ctx, consAddr); err != nil {
+			return err
+		}
+		if err := k.setValidatorPower(ctx, consAddr, commitHeight, 0, pruneHeight); err != nil {
+			return err
+		}
+	}
+
+	for _, vote := range votes {
+		consAddr, power := vote.Validator.Address, vote.Validator.Power
+		lastPower, err := k.LastCommitPowers.Get(ctx, consAddr)
+		switch {
+		case err == nil && lastPower == power:
+			continue
+		case err != nil && !errors.Is(err, collections.ErrNotFound):
+			return err
+		}
+
+		if err := k.LastCommitPowers.Set(ctx, consAddr, power); err != nil {
+			return err
+		}
+		if err := k.setValidatorPower(ctx, consAddr, commitHeight, power, pruneHeight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setValidatorPower records the power of a validator at the given height and
+// removes the entries which are not needed for the heights above pruneHeight
+// anymore.
+func (k Keeper) setValidatorPower(ctx context.Context, consAddr []byte, height, power, pruneHeight int64) error {
+	iter, err := k.ValidatorPowers.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, int64](consAddr).EndInclusive(pruneHeight))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	// the latest entry at or below the prune height is still needed for the
+	// heights up to the next entry
+	for i := 0; i < len(keys)-1; i++ {
+		if err := k.ValidatorPowers.Remove(ctx, keys[i]); err != nil {
+			return err
+		}
+	}
+
+	return k.ValidatorPowers.Set(ctx, collections.Join(consAddr, height), power)
+}
+
+// validatorPowerAtHeight returns the consensus power the validator with the
+// given consensus address had at the given height. An error is returned if it
+// was not in the validator set at that height.
+func (k Keeper) validatorPowerAtHeight(ctx context.Context, consAddr []byte, height int64) (int64, error) {
+	rng := collections.NewPrefixedPairRange[[]byte, int64](consAddr).EndInclusive(height).Descending()
+	iter, err := k.ValidatorPowers.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	power := int64(0)
+	if iter.Valid() {
+		if power, err = iter.Value(); err != nil {
+			return 0, err
+		}
+	}
+	if power == 0 {
+		return 0, fmt.Errorf("not in the validator set at height %d: %w", height, collections.ErrNotFound)
+	}
+
+	return power, nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewVoteExtensionEquivocationHandler returns the evidence Handler of the
+// VoteExtensionEquivocation type. SetRouter registers it under the
+// types.RouteVoteExtensionEquivocation route unless another handler is.
+func NewVoteExtensionEquivocationHandler(k *Keeper) types.Handler {
+	return func(ctx context.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.VoteExtensionEquivocation)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", e)
+		}
+
+		return k.handleVoteExtensionEquivocation(ctx, evidence)
+	}
+}
+
+// handleVoteExtensionEquivocation implements the vote extension equivocation
+// evidence handler. Both vote extensions must be signed by the consensus key
+// carried in the evidence, whose address must have been in the validator set at
+// the height of the evidence. The validator may have rotated its consensus key
+// since. Assuming the evidence is valid, the validator committing the
+// misbehavior is slashed like a double signer, jailed and tombstoned.
+//
+// The evidence is considered invalid if:
+// - the evidence is not from a past height or is too old
+// - the consensus public key does not match the consensus address
+// - any of the vote extension signatures is invalid
+// - the consensus address was not in the validator set at the evidence height
+// - the validator is unbonded or does not exist
+// - the signing info does not exist
+// - the validator is already tombstoned
+func (k Keeper) handleVoteExtensionEquivocation(ctx context.Context, evidence *types.VoteExtensionEquivocation) error {
+	logger := k.Logger()
+	consAddr, err := k.stakingKeeper.ConsensusAddressCodec().StringToBytes(evidence.ConsensusAddress)
+	if err != nil {
+		return fmt.Errorf("invalid consensus address: %w", err)
+	}
+
+	headerInfo := k.environment.HeaderService.GetHeaderInfo(ctx)
+	infractionHeight := evidence.GetHeight()
+	if infractionHeight >= headerInfo.Height {
+		return fmt.Errorf("vote extensions must be from a past height; got %d, current height %d", infractionHeight, headerInfo.Height)
+	}
+
+	// Reject evidence if the vote extensions are too old. Contrary to comet
+	// evidence, the vote extensions do not carry a time so only the number of
+	// blocks is considered.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cp := sdkCtx.ConsensusParams()
+	if cp.Evidence != nil && headerInfo.Height-infractionHeight > cp.Evidence.MaxAgeNumBlocks {
+		return fmt.Errorf("evidence too old; infraction height %d, max age %d blocks", infractionHeight, cp.Evidence.MaxAgeNumBlocks)
+	}
+
+	pubKey, err := evidence.GetConsPubKey()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pubKey.Address(), consAddr) {
+		return fmt.Errorf("consensus public key does not match the consensus address %s", evidence.ConsensusAddress)
+	}
+
+	for _, vote := range []struct{ extension, signature []byte }{
+		{evidence.VoteExtensionA, evidence.ExtensionSignatureA},
+		{evidence.VoteExtensionB, evidence.ExtensionSignatureB},
+	} {
+		signBytes, err := types.VoteExtensionSignBytes(headerInfo.ChainID, infractionHeight, evidence.Round, vote.extension)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifySignature(signBytes, vote.signature) {
+			return fmt.Errorf("invalid vote extension signature of validator %s", evidence.ConsensusAddress)
+		}
+	}
+
+	// The power of the validator is not part of the evidence, the one recorded
+	// for the consensus address at the evidence height is used. It also ensures
+	// the key was in the validator set at that height.
+	power, err := k.validatorPowerAtHeight(ctx, consAddr, infractionHeight)
+	if err != nil {
+		return fmt.Errorf("validator %s: %w", evidence.ConsensusAddress, err)
+	}
+
+	// ValidatorByConsAddr gets the validator even if the key has been rotated.
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %s is unbonded or does not exist", evidence.ConsensusAddress)
+	}
+
+	// Get the consAddr from the validator read from the store and not from the
+	// evidence, because if the validator has rotated its key, the key in the
+	// evidence is outdated.
+	consAddr, err = validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+		return fmt.Errorf("expected signing info for validator %s but not found", consAddr)
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return fmt.Errorf("validator %s is already tombstoned", consAddr)
+	}
+
+	logger.Info(
+		"confirmed vote extension equivocation",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"infraction_round", evidence.Round,
+	)
+
+	// The stake distribution which signed the vote extensions is the one at the
+	// evidence height minus ValidatorUpdateDelay, see handleEquivocationEvidence.
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	err = k.slashDoubleSign(ctx, validator, consAddr, power, distributionHeight)
+	if err != nil {
+		return err
+	}
+
+	if !validator.IsJailed() {
+		err = k.slashingKeeper.Jail(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	if err != nil {
+		return err
+	}
+
+	return k.slashingKeeper.Tombstone(ctx, consAddr)
+}
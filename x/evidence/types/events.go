@@ -3,6 +3,10 @@ package types
 // evidence module events
 const (
 	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeUntombstone    = "untombstone"
 
 	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyRefund       = "refund"
+	AttributeKeyRecordID     = "record_id"
 )
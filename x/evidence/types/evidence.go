@@ -1,22 +1,35 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	protoio "github.com/cosmos/gogoproto/io"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/x/evidence/exported"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Evidence type constants
-const RouteEquivocation = "equivocation"
+const (
+	RouteEquivocation              = "equivocation"
+	RouteVoteExtensionEquivocation = "voteextensionequivocation"
+)
+
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &VoteExtensionEquivocation{}
 
-var _ exported.Evidence = &Equivocation{}
+	_ codectypes.UnpackInterfacesMessage = &VoteExtensionEquivocation{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -89,3 +102,86 @@ func FromABCIEvidence(e comet.Evidence, conAc address.Codec) *Equivocation {
 		Time:             e.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a VoteExtensionEquivocation type.
+func (e *VoteExtensionEquivocation) Route() string { return RouteVoteExtensionEquivocation }
+
+// Hash returns the hash of a VoteExtensionEquivocation object.
+func (e *VoteExtensionEquivocation) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a
+// VoteExtensionEquivocation object.
+func (e *VoteExtensionEquivocation) ValidateBasic() error {
+	if e.Height < 1 {
+		return fmt.Errorf("invalid vote extension equivocation height: %d", e.Height)
+	}
+	if e.Round < 0 {
+		return fmt.Errorf("invalid vote extension equivocation round: %d", e.Round)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid vote extension equivocation validator consensus address: %s", e.ConsensusAddress)
+	}
+	if e.ConsensusPubkey == nil {
+		return fmt.Errorf("invalid vote extension equivocation: missing consensus public key")
+	}
+	if len(e.ExtensionSignatureA) == 0 || len(e.ExtensionSignatureB) == 0 {
+		return fmt.Errorf("invalid vote extension equivocation: missing vote extension signature")
+	}
+	if bytes.Equal(e.VoteExtensionA, e.VoteExtensionB) {
+		return fmt.Errorf("invalid vote extension equivocation: vote extensions are identical")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at the height of
+// the VoteExtensionEquivocation infraction.
+func (e VoteExtensionEquivocation) GetConsensusAddress(consAc address.Codec) sdk.ConsAddress {
+	addr, _ := consAc.StringToBytes(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height at which the conflicting vote extensions were
+// signed.
+func (e VoteExtensionEquivocation) GetHeight() int64 {
+	return e.Height
+}
+
+// GetConsPubKey returns the consensus public key which signed the vote extensions.
+func (e VoteExtensionEquivocation) GetConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := e.ConsensusPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("expecting cryptotypes.PubKey, got %T", e.ConsensusPubkey.GetCachedValue())
+	}
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e *VoteExtensionEquivocation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(e.ConsensusPubkey, &pk)
+}
+
+// VoteExtensionSignBytes returns the bytes signed by a validator for a vote
+// extension, as defined by CometBFT.
+func VoteExtensionSignBytes(chainID string, height, round int64, extension []byte) ([]byte, error) {
+	cve := cmtproto.CanonicalVoteExtension{
+		Extension: extension,
+		Height:    height,
+		Round:     round,
+		ChainId:   chainID,
+	}
+
+	var buf bytes.Buffer
+	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(&cve); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
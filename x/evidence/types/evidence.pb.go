@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -75,8 +77,169 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// VoteExtensionEquivocation implements the Evidence interface and defines
+// evidence of a validator signing two conflicting vote extensions at the same
+// height and round.
+type VoteExtensionEquivocation struct {
+	// height is the height at which the vote extensions were signed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// round is the round at which the vote extensions were signed.
+	Round int64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// consensus_address is the consensus address of the validator at the height
+	// of the vote extensions.
+	ConsensusAddress string `protobuf:"bytes,3,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// vote_extension_a is the first vote extension.
+	VoteExtensionA []byte `protobuf:"bytes,4,opt,name=vote_extension_a,json=voteExtensionA,proto3" json:"vote_extension_a,omitempty"`
+	// extension_signature_a is the validator signature of the first vote extension.
+	ExtensionSignatureA []byte `protobuf:"bytes,5,opt,name=extension_signature_a,json=extensionSignatureA,proto3" json:"extension_signature_a,omitempty"`
+	// vote_extension_b is the second, conflicting, vote extension.
+	VoteExtensionB []byte `protobuf:"bytes,6,opt,name=vote_extension_b,json=voteExtensionB,proto3" json:"vote_extension_b,omitempty"`
+	// extension_signature_b is the validator signature of the second vote extension.
+	ExtensionSignatureB []byte `protobuf:"bytes,7,opt,name=extension_signature_b,json=extensionSignatureB,proto3" json:"extension_signature_b,omitempty"`
+	// consensus_pubkey is the consensus public key of the validator at the height
+	// of the vote extensions, which signed them.
+	ConsensusPubkey *types.Any `protobuf:"bytes,8,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+}
+
+func (m *VoteExtensionEquivocation) Reset()         { *m = VoteExtensionEquivocation{} }
+func (m *VoteExtensionEquivocation) String() string { return proto.CompactTextString(m) }
+func (*VoteExtensionEquivocation) ProtoMessage()    {}
+func (*VoteExtensionEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *VoteExtensionEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteExtensionEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteExtensionEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteExtensionEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteExtensionEquivocation.Merge(m, src)
+}
+func (m *VoteExtensionEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteExtensionEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteExtensionEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteExtensionEquivocation proto.InternalMessageInfo
+
+// UntombstoneRecord defines an auditable record of a governance decision to
+// untombstone a validator.
+type UntombstoneRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// consensus_address is the untombstoned validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// height is the height at which the validator was untombstoned.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time at which the validator was untombstoned.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// refund is the amount of slashed stake refunded to the validator's
+	// delegators from the community pool, if any.
+	Refund *types1.Coin `protobuf:"bytes,5,opt,name=refund,proto3" json:"refund,omitempty"`
+	// authority is the address that authorized the untombstoning.
+	Authority string `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+	// justification is the reason for the untombstoning.
+	Justification string `protobuf:"bytes,7,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (m *UntombstoneRecord) Reset()         { *m = UntombstoneRecord{} }
+func (m *UntombstoneRecord) String() string { return proto.CompactTextString(m) }
+func (*UntombstoneRecord) ProtoMessage()    {}
+func (*UntombstoneRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *UntombstoneRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UntombstoneRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UntombstoneRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UntombstoneRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UntombstoneRecord.Merge(m, src)
+}
+func (m *UntombstoneRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UntombstoneRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UntombstoneRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UntombstoneRecord proto.InternalMessageInfo
+
+func (m *UntombstoneRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UntombstoneRecord) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *UntombstoneRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *UntombstoneRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *UntombstoneRecord) GetRefund() *types1.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func (m *UntombstoneRecord) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *UntombstoneRecord) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*VoteExtensionEquivocation)(nil), "cosmos.evidence.v1beta1.VoteExtensionEquivocation")
+	proto.RegisterType((*UntombstoneRecord)(nil), "cosmos.evidence.v1beta1.UntombstoneRecord")
 }
 
 func init() {
@@ -84,31 +247,92 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xf6, 0xdf, 0xef, 0xc7, 0x08, 0x08, 0x63, 0xd5, 0x85, 0xc4, 0x2d, 0x12, 0x62, 0x1a,
+	0x12, 0x76, 0x53, 0x4c, 0x3c, 0x60, 0x3c, 0xb4, 0x84, 0x93, 0x89, 0x21, 0x8b, 0x9a, 0xe8, 0xa5,
+	0xd9, 0x3f, 0xc3, 0x32, 0x62, 0xe7, 0xad, 0x3b, 0xb3, 0x95, 0xfd, 0x06, 0xc6, 0x13, 0x1f, 0x81,
+	0x23, 0x47, 0x0e, 0x7c, 0x08, 0xe2, 0x89, 0x70, 0x32, 0x31, 0x51, 0xd3, 0x1e, 0xe0, 0x63, 0x98,
+	0xce, 0xce, 0x76, 0x2b, 0xb6, 0xd1, 0x70, 0xd9, 0xec, 0xfb, 0x67, 0x9e, 0x7d, 0x9e, 0x79, 0xde,
+	0x77, 0xd1, 0x23, 0x0f, 0x78, 0x1b, 0xb8, 0x45, 0xba, 0xd4, 0x27, 0xcc, 0x23, 0x56, 0xb7, 0xee,
+	0x12, 0xe1, 0xd4, 0x87, 0x09, 0xb3, 0x13, 0x82, 0x00, 0x7c, 0x3f, 0xe9, 0x33, 0x87, 0x69, 0xd5,
+	0xb7, 0x38, 0xef, 0xb4, 0x29, 0x03, 0x4b, 0x3e, 0x93, 0xde, 0xc5, 0x4a, 0x00, 0x01, 0xc8, 0x57,
+	0x6b, 0xf0, 0xa6, 0xb2, 0x0b, 0x01, 0x40, 0xf0, 0x9e, 0x58, 0x32, 0x72, 0xa3, 0x5d, 0xcb, 0x61,
+	0xb1, 0x2a, 0x55, 0xaf, 0x97, 0x04, 0x6d, 0x13, 0x2e, 0x9c, 0x76, 0x27, 0x3d, 0x9b, 0x7c, 0xbd,
+	0x95, 0x80, 0x2a, 0x2a, 0x49, 0xc9, 0x50, 0x02, 0x5c, 0x87, 0x67, 0xe4, 0x3d, 0xa0, 0x2c, 0xa9,
+	0x2f, 0x5f, 0x69, 0x68, 0x7a, 0xeb, 0x43, 0x44, 0xbb, 0xe0, 0x39, 0x82, 0x02, 0xc3, 0xf7, 0x50,
+	0x79, 0x8f, 0xd0, 0x60, 0x4f, 0xe8, 0xda, 0x92, 0x56, 0x2b, 0xd8, 0x2a, 0xc2, 0xcf, 0x50, 0x71,
+	0xf0, 0x59, 0x3d, 0xbf, 0xa4, 0xd5, 0x6e, 0xad, 0x2f, 0x9a, 0x09, 0x27, 0x33, 0xe5, 0x64, 0xbe,
+	0x4c, 0x39, 0x35, 0x67, 0xce, 0xbe, 0x57, 0x73, 0x87, 0x3f, 0xaa, 0xda, 0xf1, 0xe5, 0xc9, 0xaa,
+	0x66, 0xcb, 0x63, 0xb8, 0x82, 0x4a, 0x1d, 0xf8, 0x48, 0x42, 0xbd, 0x20, 0x51, 0x93, 0x00, 0x6f,
+	0xa1, 0x79, 0x0f, 0x18, 0x27, 0x8c, 0x47, 0xbc, 0xe5, 0xf8, 0x7e, 0x48, 0x38, 0xd7, 0x8b, 0x4b,
+	0x5a, 0x6d, 0xaa, 0xa9, 0x5f, 0x9c, 0xae, 0x55, 0x94, 0x94, 0x46, 0x52, 0xd9, 0x11, 0x21, 0x65,
+	0x81, 0x3d, 0x37, 0x3c, 0xa2, 0xf2, 0x1b, 0x2b, 0x9f, 0x8e, 0xaa, 0xb9, 0xab, 0xa3, 0x6a, 0xee,
+	0xf3, 0xe5, 0xc9, 0xaa, 0xb2, 0x62, 0x8d, 0xfb, 0xfb, 0xd6, 0xa8, 0xb2, 0xe5, 0x7e, 0x01, 0x2d,
+	0xbc, 0x06, 0x41, 0xb6, 0x0e, 0x04, 0x61, 0x9c, 0x02, 0xfb, 0x27, 0xdd, 0x15, 0x54, 0x0a, 0x21,
+	0x62, 0xbe, 0x14, 0x5e, 0xb0, 0x93, 0x00, 0xbf, 0x18, 0x47, 0xbc, 0x20, 0x89, 0x3f, 0xbc, 0x38,
+	0x5d, 0x7b, 0xa0, 0x88, 0x6f, 0x5e, 0x63, 0x3a, 0x49, 0x01, 0xae, 0xa1, 0xb9, 0x2e, 0x08, 0xd2,
+	0x22, 0x29, 0xb7, 0x96, 0x23, 0xef, 0x61, 0xda, 0x9e, 0xed, 0x8e, 0x52, 0x6e, 0xe0, 0x75, 0x74,
+	0x37, 0x6b, 0xe2, 0x34, 0x60, 0x8e, 0x88, 0x42, 0xd2, 0x72, 0xf4, 0x92, 0x6c, 0xbf, 0x33, 0x2c,
+	0xee, 0xa4, 0xb5, 0xc6, 0x18, 0x74, 0x57, 0x2f, 0x8f, 0x41, 0x6f, 0x4e, 0x42, 0x77, 0xf5, 0xff,
+	0x26, 0xa1, 0x37, 0xf1, 0x1b, 0x94, 0xe9, 0x69, 0x75, 0x22, 0x77, 0x9f, 0xc4, 0xfa, 0xff, 0x72,
+	0x4a, 0x2a, 0x7f, 0x4c, 0x49, 0x83, 0xc5, 0x4d, 0xfd, 0x4b, 0xe6, 0xac, 0x17, 0xc6, 0x1d, 0x01,
+	0xe6, 0x76, 0xe4, 0x3e, 0x27, 0xb1, 0x7d, 0x7b, 0x88, 0xb3, 0x2d, 0x61, 0x36, 0xea, 0xa3, 0xc6,
+	0xae, 0x8c, 0x18, 0x3b, 0xd1, 0xc7, 0xe5, 0x6f, 0x79, 0x34, 0xff, 0x8a, 0x09, 0x68, 0xbb, 0x5c,
+	0x00, 0x23, 0x36, 0xf1, 0x20, 0xf4, 0xf1, 0x2c, 0xca, 0x53, 0x5f, 0x3a, 0x5b, 0xb4, 0xf3, 0x74,
+	0x82, 0x7f, 0xf9, 0x9b, 0xfb, 0x97, 0x4d, 0x4f, 0x61, 0xec, 0xd6, 0x14, 0x6f, 0xb6, 0x35, 0x75,
+	0x54, 0x0e, 0xc9, 0xee, 0x60, 0xfa, 0x4a, 0x12, 0x60, 0xc1, 0x54, 0xc4, 0x06, 0xeb, 0x9c, 0xfe,
+	0x63, 0xcc, 0x4d, 0xa0, 0xcc, 0x56, 0x8d, 0xf8, 0x09, 0x9a, 0x72, 0x22, 0xb1, 0x07, 0x21, 0x15,
+	0xb1, 0x5e, 0xfe, 0xcb, 0x2a, 0x65, 0xad, 0x78, 0x05, 0xcd, 0xbc, 0x8b, 0xb8, 0xa0, 0xbb, 0x34,
+	0xb9, 0x48, 0xe9, 0xf8, 0x94, 0xfd, 0x7b, 0xb2, 0xf9, 0xf4, 0xb8, 0x67, 0x68, 0x67, 0x3d, 0x43,
+	0x3b, 0xef, 0x19, 0xda, 0xcf, 0x9e, 0xa1, 0x1d, 0xf6, 0x8d, 0xdc, 0x79, 0xdf, 0xc8, 0x7d, 0xed,
+	0x1b, 0xb9, 0xb7, 0xea, 0xda, 0xb8, 0xbf, 0x6f, 0x52, 0xb0, 0x0e, 0xb2, 0xbf, 0xa6, 0x88, 0x3b,
+	0x84, 0xbb, 0x65, 0x29, 0xfb, 0xf1, 0xaf, 0x01, 0x00, 0x3c, 0x57, 0x72, 0x8d, 0x55, 0x05, 0x00,
+	0x00,
 }
 
+func (this *UntombstoneRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UntombstoneRecord)
+	if !ok {
+		that2, ok := that.(UntombstoneRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.Refund.Equal(that1.Refund) {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Justification != that1.Justification {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +381,160 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteExtensionEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteExtensionEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteExtensionEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsensusPubkey != nil {
+		{
+			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExtensionSignatureB) > 0 {
+		i -= len(m.ExtensionSignatureB)
+		copy(dAtA[i:], m.ExtensionSignatureB)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ExtensionSignatureB)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VoteExtensionB) > 0 {
+		i -= len(m.VoteExtensionB)
+		copy(dAtA[i:], m.VoteExtensionB)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.VoteExtensionB)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExtensionSignatureA) > 0 {
+		i -= len(m.ExtensionSignatureA)
+		copy(dAtA[i:], m.ExtensionSignatureA)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ExtensionSignatureA)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VoteExtensionA) > 0 {
+		i -= len(m.VoteExtensionA)
+		copy(dAtA[i:], m.VoteExtensionA)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.VoteExtensionA)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UntombstoneRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UntombstoneRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UntombstoneRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Justification) > 0 {
+		i -= len(m.Justification)
+		copy(dAtA[i:], m.Justification)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Justification)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Refund != nil {
+		{
+			size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintEvidence(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +567,78 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *VoteExtensionEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovEvidence(uint64(m.Round))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.VoteExtensionA)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ExtensionSignatureA)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.VoteExtensionB)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ExtensionSignatureB)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *UntombstoneRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvidence(uint64(m.Id))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Refund != nil {
+		l = m.Refund.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.Justification)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +798,551 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteExtensionEquivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteExtensionEquivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteExtensionEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtensionA = append(m.VoteExtensionA[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtensionA == nil {
+				m.VoteExtensionA = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignatureA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignatureA = append(m.ExtensionSignatureA[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignatureA == nil {
+				m.ExtensionSignatureA = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtensionB = append(m.VoteExtensionB[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtensionB == nil {
+				m.VoteExtensionB = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignatureB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignatureB = append(m.ExtensionSignatureB[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignatureB == nil {
+				m.ExtensionSignatureB = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UntombstoneRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UntombstoneRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UntombstoneRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Refund == nil {
+				m.Refund = &types1.Coin{}
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,12 +6,16 @@ import (
 	"testing"
 	"time"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -72,6 +76,68 @@ func TestEquivocationValidateBasic(t *testing.T) {
 	}
 }
 
+func TestVoteExtensionEquivocationValidateBasic(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	addr := sdk.ConsAddress(pubKey.Address())
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+
+	valid := func() types.VoteExtensionEquivocation {
+		return types.VoteExtensionEquivocation{
+			Height:              100,
+			Round:               1,
+			ConsensusAddress:    addr.String(),
+			ConsensusPubkey:     pkAny,
+			VoteExtensionA:      []byte("price:1"),
+			ExtensionSignatureA: []byte("signature_a"),
+			VoteExtensionB:      []byte("price:2"),
+			ExtensionSignatureB: []byte("signature_b"),
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(e *types.VoteExtensionEquivocation)
+		expectErr bool
+	}{
+		{"valid", func(e *types.VoteExtensionEquivocation) {}, false},
+		{"valid with an empty vote extension", func(e *types.VoteExtensionEquivocation) { e.VoteExtensionA = nil }, false},
+		{"invalid height", func(e *types.VoteExtensionEquivocation) { e.Height = 0 }, true},
+		{"invalid round", func(e *types.VoteExtensionEquivocation) { e.Round = -1 }, true},
+		{"invalid address", func(e *types.VoteExtensionEquivocation) { e.ConsensusAddress = "" }, true},
+		{"missing consensus public key", func(e *types.VoteExtensionEquivocation) { e.ConsensusPubkey = nil }, true},
+		{"missing signature", func(e *types.VoteExtensionEquivocation) { e.ExtensionSignatureB = nil }, true},
+		{"identical vote extensions", func(e *types.VoteExtensionEquivocation) { e.VoteExtensionB = e.VoteExtensionA }, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			e := valid()
+			tc.malleate(&e)
+			require.Equal(t, tc.expectErr, e.ValidateBasic() != nil)
+			require.Equal(t, types.RouteVoteExtensionEquivocation, e.Route())
+			require.Equal(t, e.Height, e.GetHeight())
+		})
+	}
+}
+
+func TestVoteExtensionSignBytes(t *testing.T) {
+	extension := []byte("price:1")
+
+	bz, err := types.VoteExtensionSignBytes("test-chain", 100, 2, extension)
+	require.NoError(t, err)
+
+	// the sign bytes must match the ones signed by CometBFT
+	expected := cmttypes.VoteExtensionSignBytes("test-chain", &cmtproto.Vote{
+		Type:      cmtproto.PrecommitType,
+		Height:    100,
+		Round:     2,
+		Extension: extension,
+	})
+	require.Equal(t, expected, bz)
+}
+
 func TestEvidenceAddressConversion(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode("testcnclcons", "testcnclconspub")
 	tmEvidence := NewCometMisbehavior(1, 100, time.Now(), comet.DuplicateVote,
@@ -17,7 +17,10 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ConsensusAddressCodec() address.Codec
+		ValidatorAddressCodec() address.Codec
 		ValidatorByConsAddr(context.Context, sdk.ConsAddress) (sdk.ValidatorI, error)
+		IterateValidatorDelegations(context.Context, sdk.ValAddress, func(int64, sdk.DelegationI) bool) error
+		BondDenom(context.Context) (string, error)
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -27,6 +30,7 @@ type (
 		IsTombstoned(context.Context, sdk.ConsAddress) bool
 		HasValidatorSigningInfo(context.Context, sdk.ConsAddress) bool
 		Tombstone(context.Context, sdk.ConsAddress) error
+		Untombstone(context.Context, sdk.ConsAddress) error
 		Slash(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64) error
 		SlashWithInfractionReason(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, st.Infraction) error
 		SlashFractionDoubleSign(context.Context) (math.LegacyDec, error)
@@ -34,6 +38,12 @@ type (
 		JailUntil(context.Context, sdk.ConsAddress, time.Time) error
 	}
 
+	// PoolKeeper defines the protocolpool module interface contract needed by the
+	// evidence module to refund slashed stake from the community pool.
+	PoolKeeper interface {
+		DistributeFromCommunityPool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+	}
+
 	// AccountKeeper define the account keeper interface contracted needed by the evidence module
 	AccountKeeper interface {
 		SetAccount(ctx context.Context, acc sdk.AccountI)
@@ -51,6 +51,23 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	ids := make(map[uint64]bool, len(gs.UntombstoneRecords))
+	for _, record := range gs.UntombstoneRecords {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate untombstone record id %d", record.Id)
+		}
+		ids[record.Id] = true
+
+		if record.ConsensusAddress == "" {
+			return fmt.Errorf("untombstone record %d has an empty consensus address", record.Id)
+		}
+		if record.Refund != nil {
+			if err := record.Refund.Validate(); err != nil {
+				return fmt.Errorf("untombstone record %d has an invalid refund: %w", record.Id, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -7,12 +7,14 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaultGenesisState(t *testing.T) {
@@ -108,6 +110,46 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid untombstone records",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.UntombstoneRecords = []types.UntombstoneRecord{
+					{Id: 0, ConsensusAddress: pk.PubKey().Address().String()},
+					{Id: 1, ConsensusAddress: pk.PubKey().Address().String(), Refund: &sdk.Coin{Denom: "stake", Amount: math.NewInt(10)}},
+				}
+			},
+			true,
+		},
+		{
+			"duplicate untombstone record id",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.UntombstoneRecords = []types.UntombstoneRecord{
+					{Id: 1, ConsensusAddress: pk.PubKey().Address().String()},
+					{Id: 1, ConsensusAddress: pk.PubKey().Address().String()},
+				}
+			},
+			false,
+		},
+		{
+			"untombstone record without consensus address",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.UntombstoneRecords = []types.UntombstoneRecord{{Id: 1}}
+			},
+			false,
+		},
+		{
+			"untombstone record with invalid refund",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.UntombstoneRecords = []types.UntombstoneRecord{
+					{Id: 1, ConsensusAddress: pk.PubKey().Address().String(), Refund: &sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}},
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
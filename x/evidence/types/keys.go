@@ -8,9 +8,19 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	GovModuleName = "gov"
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = collections.NewPrefix(0)
+	KeyPrefixEvidence          = collections.NewPrefix(0)
+	KeyPrefixUntombstoneRecord = collections.NewPrefix(1)
+	KeyUntombstoneRecordID     = collections.NewPrefix(2)
+	KeyPrefixValidatorPower    = collections.NewPrefix(3)
+	KeyPrefixLastCommitPower   = collections.NewPrefix(4)
+	KeyPrefixSlashedTokens     = collections.NewPrefix(5)
+	KeyPrefixSlashedDelegation = collections.NewPrefix(6)
 )
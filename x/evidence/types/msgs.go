@@ -15,6 +15,7 @@ var (
 	_ sdk.Msg                       = &MsgSubmitEvidence{}
 	_ types.UnpackInterfacesMessage = MsgSubmitEvidence{}
 	_ exported.MsgSubmitEvidenceI   = &MsgSubmitEvidence{}
+	_ sdk.Msg                       = &MsgUntombstone{}
 )
 
 // NewMsgSubmitEvidence returns a new MsgSubmitEvidence with a signer/submitter.
@@ -55,3 +56,14 @@ func (m MsgSubmitEvidence) UnpackInterfaces(ctx types.AnyUnpacker) error {
 	var evi exported.Evidence
 	return ctx.UnpackAny(m.Evidence, &evi)
 }
+
+// NewMsgUntombstone returns a new MsgUntombstone. A nil refund refunds no
+// slashed stake.
+func NewMsgUntombstone(authority, consAddr string, refund *sdk.Coin, justification string) *MsgUntombstone {
+	return &MsgUntombstone{
+		Authority:        authority,
+		ConsensusAddress: consAddr,
+		Refund:           refund,
+		Justification:    justification,
+	}
+}
@@ -6,8 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -238,11 +240,126 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryUntombstoneHistoryRequest is the request type for the
+// Query/UntombstoneHistory RPC method.
+type QueryUntombstoneHistoryRequest struct {
+	// consensus_address defines an optional validator consensus address to
+	// filter the records by.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUntombstoneHistoryRequest) Reset()         { *m = QueryUntombstoneHistoryRequest{} }
+func (m *QueryUntombstoneHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUntombstoneHistoryRequest) ProtoMessage()    {}
+func (*QueryUntombstoneHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryUntombstoneHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUntombstoneHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUntombstoneHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUntombstoneHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUntombstoneHistoryRequest.Merge(m, src)
+}
+func (m *QueryUntombstoneHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUntombstoneHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUntombstoneHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUntombstoneHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryUntombstoneHistoryRequest) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *QueryUntombstoneHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUntombstoneHistoryResponse is the response type for the
+// Query/UntombstoneHistory RPC method.
+type QueryUntombstoneHistoryResponse struct {
+	// records defines the recorded untombstone decisions.
+	Records []UntombstoneRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUntombstoneHistoryResponse) Reset()         { *m = QueryUntombstoneHistoryResponse{} }
+func (m *QueryUntombstoneHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUntombstoneHistoryResponse) ProtoMessage()    {}
+func (*QueryUntombstoneHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryUntombstoneHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUntombstoneHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUntombstoneHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUntombstoneHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUntombstoneHistoryResponse.Merge(m, src)
+}
+func (m *QueryUntombstoneHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUntombstoneHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUntombstoneHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUntombstoneHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryUntombstoneHistoryResponse) GetRecords() []UntombstoneRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryUntombstoneHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryUntombstoneHistoryRequest)(nil), "cosmos.evidence.v1beta1.QueryUntombstoneHistoryRequest")
+	proto.RegisterType((*QueryUntombstoneHistoryResponse)(nil), "cosmos.evidence.v1beta1.QueryUntombstoneHistoryResponse")
 }
 
 func init() {
@@ -250,34 +367,45 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcb, 0x6e, 0x1a, 0x31,
-	0x18, 0x85, 0xf1, 0xf4, 0x22, 0x6a, 0xe8, 0xc6, 0xa2, 0x82, 0x8e, 0xda, 0x11, 0x1d, 0xa4, 0x42,
-	0x91, 0x6a, 0x03, 0x5d, 0x74, 0x0d, 0x52, 0x6f, 0xbb, 0x76, 0xba, 0xeb, 0xa6, 0xf2, 0x80, 0x3b,
-	0x8c, 0x4a, 0xed, 0x01, 0x0f, 0xa8, 0xa8, 0xea, 0xa6, 0x4f, 0x50, 0x29, 0xca, 0x2a, 0xca, 0xe3,
-	0x44, 0xca, 0x12, 0x29, 0x9b, 0x2c, 0x23, 0xc8, 0x83, 0x44, 0xd8, 0x1e, 0x20, 0x5c, 0x42, 0xb2,
-	0x83, 0xf9, 0xcf, 0x39, 0xff, 0xa7, 0xf3, 0x1b, 0x96, 0xda, 0x42, 0xfe, 0x12, 0x92, 0xb0, 0x51,
-	0xd8, 0x61, 0xbc, 0xcd, 0xc8, 0xa8, 0xee, 0xb3, 0x98, 0xd6, 0x49, 0x7f, 0xc8, 0x06, 0x63, 0x1c,
-	0x0d, 0x44, 0x2c, 0x50, 0x5e, 0x8b, 0x70, 0x22, 0xc2, 0x46, 0x64, 0x57, 0x8d, 0xdb, 0xa7, 0x92,
-	0x69, 0xc7, 0xc2, 0x1f, 0xd1, 0x20, 0xe4, 0x34, 0x0e, 0x05, 0xd7, 0x21, 0xf6, 0xd3, 0x40, 0x88,
-	0xa0, 0xc7, 0x88, 0xfa, 0xe7, 0x0f, 0x7f, 0x10, 0xca, 0x4d, 0xbe, 0xfd, 0xcc, 0x8c, 0x68, 0x14,
-	0x12, 0xca, 0xb9, 0x88, 0x95, 0x4f, 0xea, 0xa9, 0xfb, 0x15, 0xe6, 0xbe, 0xcc, 0xa3, 0xdf, 0x99,
-	0xed, 0x1e, 0xeb, 0x0f, 0x99, 0x8c, 0x51, 0x19, 0x3e, 0x4e, 0x80, 0xbe, 0x77, 0xa9, 0xec, 0x16,
-	0x40, 0x11, 0x54, 0xb2, 0x2d, 0xab, 0x00, 0xbc, 0x6c, 0x32, 0xf8, 0x48, 0x65, 0x17, 0x21, 0x78,
-	0x5f, 0xcd, 0xad, 0x22, 0xa8, 0x3c, 0xf2, 0xd4, 0x6f, 0xf7, 0x13, 0x7c, 0xb2, 0x16, 0x2a, 0x23,
-	0xc1, 0x25, 0x43, 0x35, 0x98, 0x4e, 0xcc, 0x2a, 0x30, 0xd3, 0xc8, 0x61, 0x8d, 0x87, 0x13, 0x72,
-	0xdc, 0xe4, 0x63, 0x6f, 0xa1, 0x72, 0x29, 0xcc, 0xab, 0xa8, 0x66, 0xaf, 0xb7, 0x8e, 0xf8, 0x1e,
-	0xc2, 0x65, 0x0f, 0x26, 0xee, 0x25, 0x36, 0x6d, 0xce, 0x4b, 0xc3, 0xba, 0x66, 0x53, 0x1a, 0xfe,
-	0x4c, 0x83, 0xc4, 0xeb, 0xad, 0x38, 0xdd, 0x43, 0x00, 0x0b, 0x9b, 0x3b, 0xb6, 0x12, 0xdf, 0xdb,
-	0x4f, 0x8c, 0x3e, 0x5c, 0xc3, 0xb2, 0x14, 0x56, 0x79, 0x2f, 0x96, 0x5e, 0xb7, 0xca, 0xd5, 0x38,
-	0xb1, 0xe0, 0x03, 0xc5, 0x85, 0x8e, 0x00, 0x4c, 0x27, 0x64, 0xe8, 0x35, 0xde, 0xf1, 0x60, 0xf0,
-	0xb6, 0x43, 0xda, 0xf8, 0xb6, 0x72, 0x4d, 0xe0, 0xd6, 0xfe, 0x9d, 0x5d, 0x1e, 0x58, 0x55, 0x54,
-	0x21, 0xbb, 0x1e, 0xef, 0xe2, 0xc3, 0x9f, 0xf9, 0xb1, 0xff, 0xa2, 0x63, 0x00, 0x33, 0x2b, 0xd5,
-	0xa1, 0xda, 0xcd, 0x1b, 0x37, 0x2f, 0x69, 0xd7, 0xef, 0xe0, 0x30, 0x98, 0xaf, 0x14, 0x66, 0x09,
-	0xbd, 0xd8, 0x8b, 0xd9, 0x7a, 0x7b, 0x3a, 0x75, 0xc0, 0x64, 0xea, 0x80, 0x8b, 0xa9, 0x03, 0xfe,
-	0xcf, 0x9c, 0xd4, 0x64, 0xe6, 0xa4, 0xce, 0x67, 0x4e, 0xea, 0xdb, 0x73, 0xed, 0x95, 0x9d, 0x9f,
-	0x38, 0x14, 0xe4, 0xf7, 0x32, 0x23, 0x1e, 0x47, 0x4c, 0xfa, 0x0f, 0xd5, 0x85, 0xdf, 0x5c, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x9c, 0x7a, 0xc6, 0xc7, 0x03, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0xb1, 0x6a, 0x3b, 0xad, 0xa0, 0x43, 0xa4, 0x71, 0xd1, 0x6d, 0x9b, 0x42, 0x1b,
+	0x83, 0x9d, 0x4d, 0xaa, 0x50, 0xaf, 0x89, 0x58, 0xab, 0x07, 0xd1, 0x2d, 0x5e, 0xbc, 0x84, 0x49,
+	0x32, 0x6e, 0x16, 0xd3, 0x99, 0x74, 0x67, 0x52, 0x0c, 0xe2, 0x45, 0xf0, 0x2e, 0x88, 0x27, 0xf1,
+	0xbf, 0x10, 0xf1, 0xe2, 0xbd, 0xc7, 0xa2, 0x17, 0x4f, 0x22, 0x89, 0x7f, 0x88, 0x64, 0x7e, 0x24,
+	0x69, 0x92, 0x6d, 0xac, 0x78, 0x9b, 0x9d, 0xf7, 0xbe, 0xdf, 0xf7, 0x99, 0x37, 0xf3, 0x16, 0xae,
+	0x56, 0xb9, 0xd8, 0xe3, 0xc2, 0xa3, 0x07, 0x61, 0x8d, 0xb2, 0x2a, 0xf5, 0x0e, 0x0a, 0x15, 0x2a,
+	0x49, 0xc1, 0xdb, 0x6f, 0xd1, 0xa8, 0x8d, 0x9b, 0x11, 0x97, 0x1c, 0x2d, 0xea, 0x24, 0x6c, 0x93,
+	0xb0, 0x49, 0x72, 0x72, 0x46, 0x5d, 0x21, 0x82, 0x6a, 0x45, 0x5f, 0xdf, 0x24, 0x41, 0xc8, 0x88,
+	0x0c, 0x39, 0xd3, 0x26, 0xce, 0x95, 0x80, 0xf3, 0xa0, 0x41, 0x3d, 0xf5, 0x55, 0x69, 0x3d, 0xf3,
+	0x08, 0x33, 0xfe, 0xce, 0x55, 0x13, 0x22, 0xcd, 0xd0, 0x23, 0x8c, 0x71, 0xa9, 0x74, 0xc2, 0x44,
+	0x53, 0x01, 0x0f, 0xb8, 0x5a, 0x7a, 0xbd, 0x95, 0xb5, 0xd3, 0xa5, 0xcb, 0x3a, 0x60, 0x00, 0x75,
+	0x68, 0x2d, 0xee, 0x4c, 0x7d, 0x7e, 0x95, 0x97, 0xd9, 0x85, 0xa9, 0xc7, 0x3d, 0xe6, 0xbb, 0x66,
+	0xdb, 0xa7, 0xfb, 0x2d, 0x2a, 0x24, 0x5a, 0x87, 0x17, 0x6c, 0x66, 0xb9, 0x4e, 0x44, 0x3d, 0x0d,
+	0x96, 0x41, 0x76, 0xa1, 0x94, 0x4c, 0x03, 0x7f, 0xc1, 0x06, 0x76, 0x88, 0xa8, 0x23, 0x04, 0x67,
+	0x54, 0x3c, 0xb9, 0x0c, 0xb2, 0x73, 0xbe, 0x5a, 0x67, 0xee, 0xc3, 0xcb, 0x23, 0xa6, 0xa2, 0xc9,
+	0x99, 0xa0, 0x28, 0x0f, 0x67, 0xad, 0x58, 0x19, 0xce, 0x6f, 0xa6, 0xb0, 0x3e, 0x37, 0xb6, 0x2d,
+	0xc1, 0x45, 0xd6, 0xf6, 0xfb, 0x59, 0x19, 0x02, 0x17, 0x95, 0x55, 0xb1, 0xd1, 0x18, 0x45, 0xdc,
+	0x86, 0x70, 0xd0, 0x60, 0x63, 0xb7, 0x86, 0x4d, 0x17, 0x7a, 0xb7, 0x81, 0xf5, 0xfd, 0x99, 0x93,
+	0xe3, 0x47, 0x24, 0xb0, 0x5a, 0x7f, 0x48, 0x99, 0x79, 0x0f, 0x60, 0x7a, 0xbc, 0xc6, 0x44, 0xe2,
+	0x33, 0xd3, 0x89, 0xd1, 0xbd, 0x63, 0x58, 0x49, 0x85, 0xb5, 0x3e, 0x15, 0x4b, 0x97, 0x3b, 0xc6,
+	0xf5, 0x05, 0x40, 0x57, 0x71, 0x3d, 0x61, 0x92, 0xef, 0x55, 0x84, 0xe4, 0x8c, 0xee, 0x84, 0x42,
+	0xf2, 0xa8, 0x6d, 0x5b, 0xf0, 0x10, 0x5e, 0xaa, 0xf6, 0x74, 0x4c, 0xb4, 0x44, 0x99, 0xd4, 0x6a,
+	0x11, 0x15, 0x42, 0x75, 0x62, 0xae, 0xb4, 0xf2, 0xed, 0xd3, 0xc6, 0x35, 0x53, 0xf5, 0x8e, 0xcd,
+	0x29, 0xea, 0x94, 0x5d, 0x19, 0x85, 0x2c, 0xf0, 0x2f, 0x56, 0x47, 0xf6, 0xd1, 0xf6, 0x04, 0xf6,
+	0x7f, 0x69, 0xe9, 0x67, 0x00, 0x97, 0x62, 0xd1, 0x4d, 0x67, 0x1f, 0xc0, 0xf3, 0x11, 0xad, 0xf2,
+	0xa8, 0x26, 0x4c, 0x63, 0x73, 0x38, 0x66, 0xc4, 0xf0, 0x90, 0x8b, 0xaf, 0x24, 0xa5, 0x99, 0xc3,
+	0x9f, 0x4b, 0x09, 0xdf, 0x1a, 0xfc, 0xb7, 0x9e, 0x6f, 0xbe, 0x99, 0x81, 0x67, 0x15, 0x38, 0xfa,
+	0x00, 0xe0, 0xac, 0x7d, 0x0d, 0x68, 0x23, 0x16, 0x6d, 0xd2, 0xf0, 0x38, 0xf8, 0x6f, 0xd3, 0x35,
+	0x41, 0x26, 0xff, 0xfa, 0xfb, 0xef, 0x77, 0xc9, 0x1c, 0xca, 0x7a, 0xd3, 0xa6, 0xd6, 0x7b, 0xd9,
+	0x1b, 0xb0, 0x57, 0xe8, 0x23, 0x80, 0xf3, 0x43, 0xcf, 0x15, 0xe5, 0x4f, 0xae, 0x38, 0x3e, 0x3d,
+	0x4e, 0xe1, 0x14, 0x0a, 0x83, 0x79, 0x5d, 0x61, 0xae, 0xa2, 0x95, 0xa9, 0x98, 0xe8, 0x2b, 0x80,
+	0x68, 0xfc, 0xee, 0xd1, 0xd6, 0xc9, 0x45, 0x63, 0x1f, 0xba, 0x73, 0xfb, 0xf4, 0x42, 0x03, 0x7d,
+	0x4b, 0x41, 0x63, 0x74, 0x23, 0x16, 0xba, 0x35, 0x10, 0x97, 0xeb, 0x5a, 0x5d, 0xda, 0x3a, 0xec,
+	0xb8, 0xe0, 0xa8, 0xe3, 0x82, 0x5f, 0x1d, 0x17, 0xbc, 0xed, 0xba, 0x89, 0xa3, 0xae, 0x9b, 0xf8,
+	0xd1, 0x75, 0x13, 0x4f, 0xcd, 0x4c, 0x89, 0xda, 0x73, 0x1c, 0x72, 0xef, 0xc5, 0xc0, 0x4e, 0xb6,
+	0x9b, 0x54, 0x54, 0xce, 0xa9, 0xbf, 0xc2, 0xcd, 0x3f, 0x03, 0x00, 0xb8, 0xad, 0x0f, 0xeb, 0x54,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +424,9 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// UntombstoneHistory queries the recorded untombstone decisions, optionally
+	// filtered by validator consensus address.
+	UntombstoneHistory(ctx context.Context, in *QueryUntombstoneHistoryRequest, opts ...grpc.CallOption) (*QueryUntombstoneHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -324,12 +455,24 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) UntombstoneHistory(ctx context.Context, in *QueryUntombstoneHistoryRequest, opts ...grpc.CallOption) (*QueryUntombstoneHistoryResponse, error) {
+	out := new(QueryUntombstoneHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/UntombstoneHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// UntombstoneHistory queries the recorded untombstone decisions, optionally
+	// filtered by validator consensus address.
+	UntombstoneHistory(context.Context, *QueryUntombstoneHistoryRequest) (*QueryUntombstoneHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -342,6 +485,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) UntombstoneHistory(ctx context.Context, req *QueryUntombstoneHistoryRequest) (*QueryUntombstoneHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntombstoneHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -383,6 +529,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UntombstoneHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUntombstoneHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UntombstoneHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/UntombstoneHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UntombstoneHistory(ctx, req.(*QueryUntombstoneHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -395,6 +559,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "UntombstoneHistory",
+			Handler:    _Query_UntombstoneHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -556,6 +724,97 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUntombstoneHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUntombstoneHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUntombstoneHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUntombstoneHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUntombstoneHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUntombstoneHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -629,6 +888,42 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUntombstoneHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUntombstoneHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1043,6 +1338,244 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUntombstoneHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUntombstoneHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUntombstoneHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUntombstoneHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUntombstoneHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUntombstoneHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, UntombstoneRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,12 +26,22 @@ type (
 		Sealed() bool
 	}
 
+	// HandlerRoute is a Handler registered on the Router under RouteKey when the
+	// module is wired with depinject.
+	HandlerRoute struct {
+		Handler  Handler
+		RouteKey string
+	}
+
 	router struct {
 		routes map[string]Handler
 		sealed bool
 	}
 )
 
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (HandlerRoute) IsManyPerContainerType() {}
+
 func NewRouter() Router {
 	return &router{
 		routes: make(map[string]Handler),
@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -121,39 +122,173 @@ func (m *MsgSubmitEvidenceResponse) GetHash() []byte {
 	return nil
 }
 
+// MsgUntombstone is the Msg/Untombstone request type.
+type MsgUntombstone struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// consensus_address is the tombstoned validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// refund is the amount of slashed stake to refund to the validator's
+	// delegators from the community pool. It must be denominated in the bond
+	// denom. No stake is refunded if unset.
+	Refund *types1.Coin `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	// justification is the reason for the untombstoning.
+	Justification string `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (m *MsgUntombstone) Reset()         { *m = MsgUntombstone{} }
+func (m *MsgUntombstone) String() string { return proto.CompactTextString(m) }
+func (*MsgUntombstone) ProtoMessage()    {}
+func (*MsgUntombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{2}
+}
+func (m *MsgUntombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUntombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUntombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUntombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUntombstone.Merge(m, src)
+}
+func (m *MsgUntombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUntombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUntombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUntombstone proto.InternalMessageInfo
+
+func (m *MsgUntombstone) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUntombstone) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *MsgUntombstone) GetRefund() *types1.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func (m *MsgUntombstone) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+// MsgUntombstoneResponse defines the Msg/Untombstone response type.
+type MsgUntombstoneResponse struct {
+	// id is the identifier of the recorded untombstone decision.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgUntombstoneResponse) Reset()         { *m = MsgUntombstoneResponse{} }
+func (m *MsgUntombstoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUntombstoneResponse) ProtoMessage()    {}
+func (*MsgUntombstoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{3}
+}
+func (m *MsgUntombstoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUntombstoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUntombstoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUntombstoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUntombstoneResponse.Merge(m, src)
+}
+func (m *MsgUntombstoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUntombstoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUntombstoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUntombstoneResponse proto.InternalMessageInfo
+
+func (m *MsgUntombstoneResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSubmitEvidence)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidence")
 	proto.RegisterType((*MsgSubmitEvidenceResponse)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidenceResponse")
+	proto.RegisterType((*MsgUntombstone)(nil), "cosmos.evidence.v1beta1.MsgUntombstone")
+	proto.RegisterType((*MsgUntombstoneResponse)(nil), "cosmos.evidence.v1beta1.MsgUntombstoneResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evidence/v1beta1/tx.proto", fileDescriptor_3e3242cb23c956e0) }
 
 var fileDescriptor_3e3242cb23c956e0 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x10, 0x29,
-	0xa8, 0x25, 0xfa, 0xb9, 0xc5, 0xe9, 0xfa, 0x65, 0x86, 0x20, 0x0a, 0x2a, 0x21, 0x98, 0x98, 0x9b,
-	0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x77, 0x18, 0xb9, 0x04, 0x7d, 0x8b, 0xd3, 0x83,
-	0x4b, 0x93, 0x72, 0x33, 0x4b, 0x5c, 0xa1, 0xae, 0x12, 0x32, 0xe3, 0xe2, 0x2c, 0x06, 0x8b, 0x94,
-	0xa4, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0xb5,
-	0xc6, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa1,
-	0x54, 0x28, 0x8c, 0x8b, 0x03, 0xe6, 0x33, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d,
-	0x88, 0x17, 0xf4, 0x60, 0x5e, 0xd0, 0x73, 0xcc, 0xab, 0x74, 0x52, 0x39, 0xb5, 0x45, 0x57, 0x01,
-	0x47, 0x50, 0xe8, 0xc1, 0x5c, 0x11, 0x04, 0x37, 0xcb, 0xca, 0xbc, 0x63, 0x81, 0x3c, 0xc3, 0x8b,
-	0x05, 0xf2, 0x0c, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0xec, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x06, 0x62,
-	0x8c, 0x6e, 0x71, 0x4a, 0xb6, 0x3e, 0x86, 0x47, 0x94, 0xf4, 0xb9, 0x24, 0x31, 0x04, 0x83, 0x52,
-	0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x84, 0xb8, 0x58, 0x32, 0x12, 0x8b, 0x33, 0x24, 0x58,
-	0x14, 0x18, 0x35, 0x78, 0x82, 0xc0, 0x6c, 0xa3, 0x3a, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x02,
-	0x2e, 0x3e, 0xb4, 0x20, 0xd1, 0xd2, 0xc3, 0xe5, 0x5e, 0x0c, 0x0b, 0xa4, 0x8c, 0x88, 0x57, 0x0b,
-	0x73, 0x8c, 0x14, 0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0xd6, 0x2b, 0x1e, 0xc9, 0x31, 0x9e,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x2c, 0xc4, 0xdc, 0xe2, 0x94, 0x6c,
-	0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0x44, 0x42, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07,
-	0xb2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xde, 0xaf, 0x50, 0x22, 0x88, 0x02, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0x12, 0x4f,
+	0x18, 0xc7, 0x19, 0xca, 0xaf, 0xf9, 0x31, 0x55, 0x22, 0x1b, 0xa2, 0x40, 0x74, 0x8b, 0x84, 0x44,
+	0x42, 0xc2, 0x4e, 0xc0, 0xc4, 0x26, 0xf5, 0x54, 0x1a, 0x8f, 0x78, 0xd8, 0x46, 0x0f, 0x5e, 0x9a,
+	0xfd, 0x33, 0x0c, 0x63, 0x65, 0x86, 0xec, 0xcc, 0x92, 0x72, 0x33, 0x9e, 0x8c, 0x27, 0x5f, 0x42,
+	0x8f, 0xc6, 0x13, 0x07, 0x5e, 0x84, 0xf1, 0xd4, 0xf4, 0xe4, 0xc1, 0x83, 0x81, 0x03, 0xbe, 0x0c,
+	0xc3, 0xec, 0xec, 0x02, 0x25, 0xd5, 0x5e, 0x60, 0x78, 0x9e, 0xcf, 0x7c, 0x9f, 0xe7, 0xf9, 0x3e,
+	0x03, 0xac, 0x78, 0x5c, 0x0c, 0xb8, 0x40, 0x78, 0x44, 0x7d, 0xcc, 0x3c, 0x8c, 0x46, 0x2d, 0x17,
+	0x4b, 0xa7, 0x85, 0xe4, 0xb9, 0x35, 0x0c, 0xb8, 0xe4, 0xc6, 0x83, 0x88, 0xb0, 0x62, 0xc2, 0xd2,
+	0x44, 0xb9, 0x40, 0x38, 0xe1, 0x8a, 0x41, 0xcb, 0x53, 0x84, 0x97, 0x4b, 0x84, 0x73, 0xf2, 0x0e,
+	0x23, 0xf5, 0xcb, 0x0d, 0x7b, 0xc8, 0x61, 0xe3, 0x38, 0x15, 0x29, 0x9d, 0x46, 0x77, 0xb4, 0x6c,
+	0x94, 0xd2, 0x45, 0xd0, 0x40, 0x10, 0x34, 0x6a, 0x2d, 0xbf, 0x74, 0x22, 0xef, 0x0c, 0x28, 0xe3,
+	0x48, 0x7d, 0xea, 0x90, 0xa9, 0x59, 0xd7, 0x11, 0xab, 0x76, 0x3d, 0x4e, 0x59, 0x94, 0xaf, 0xfe,
+	0x04, 0x30, 0xdf, 0x15, 0xe4, 0x24, 0x74, 0x07, 0x54, 0xbe, 0xd0, 0x5d, 0x1b, 0xcf, 0x60, 0x56,
+	0xa8, 0x88, 0xc4, 0x41, 0x11, 0x54, 0x40, 0x3d, 0xdb, 0x29, 0x5e, 0x4d, 0x9b, 0x05, 0xdd, 0xc6,
+	0x91, 0xef, 0x07, 0x58, 0x88, 0x13, 0x19, 0x50, 0x46, 0xec, 0x15, 0x6a, 0xbc, 0x86, 0xff, 0xc7,
+	0x93, 0x17, 0xd3, 0x15, 0x50, 0xdf, 0x6b, 0x17, 0xac, 0x68, 0x44, 0x2b, 0x1e, 0xd1, 0x3a, 0x62,
+	0xe3, 0x4e, 0xed, 0xfb, 0xb4, 0x59, 0xb9, 0xc1, 0x2a, 0x2b, 0xee, 0xc2, 0x4e, 0xb4, 0x0e, 0x0f,
+	0x3e, 0x5e, 0xec, 0xa7, 0x7e, 0x5f, 0xec, 0xa7, 0x3e, 0x2c, 0x26, 0x8d, 0x55, 0xbd, 0x4f, 0x8b,
+	0x49, 0xe3, 0x61, 0x24, 0xd3, 0x14, 0xfe, 0x19, 0xda, 0x1a, 0xa4, 0x8a, 0x60, 0x69, 0x2b, 0x68,
+	0x63, 0x31, 0xe4, 0x4c, 0x60, 0xc3, 0x80, 0x99, 0xbe, 0x23, 0xfa, 0xc5, 0x4c, 0x05, 0xd4, 0xef,
+	0xd8, 0xea, 0x5c, 0xfd, 0x9a, 0x86, 0xb9, 0xae, 0x20, 0xaf, 0x98, 0xe4, 0x03, 0x57, 0x48, 0xce,
+	0x94, 0x19, 0x4e, 0x28, 0xfb, 0x3c, 0xa0, 0x72, 0xfc, 0x6f, 0x33, 0x12, 0xd4, 0x78, 0x09, 0xf3,
+	0xde, 0xb2, 0x0e, 0x13, 0xa1, 0x38, 0x75, 0x22, 0x4a, 0xb9, 0x92, 0xed, 0x3c, 0xbe, 0x9a, 0x36,
+	0x1f, 0xe9, 0xfb, 0xc7, 0x31, 0xb3, 0x29, 0x74, 0xcf, 0xbb, 0x16, 0x37, 0x5a, 0x70, 0x37, 0xc0,
+	0xbd, 0x90, 0xf9, 0xc5, 0x1d, 0x65, 0x6d, 0xc9, 0xd2, 0x0a, 0xcb, 0xdd, 0x26, 0xee, 0x1d, 0x73,
+	0xca, 0x6c, 0x0d, 0x1a, 0x35, 0x78, 0xf7, 0x6d, 0x28, 0x24, 0xed, 0x51, 0xcf, 0x91, 0x94, 0x33,
+	0x35, 0x6a, 0xd6, 0xde, 0x0c, 0x1e, 0x1e, 0x28, 0x57, 0x93, 0xc6, 0x97, 0xae, 0xd6, 0xd6, 0x5c,
+	0x3d, 0x5f, 0xbd, 0xf7, 0x4d, 0x67, 0xaa, 0x75, 0x78, 0x7f, 0x33, 0x92, 0x58, 0x9b, 0x83, 0x69,
+	0xea, 0x2b, 0xb3, 0x32, 0x76, 0x9a, 0xfa, 0xed, 0x05, 0x80, 0x3b, 0x5d, 0x41, 0x8c, 0x21, 0xcc,
+	0x5d, 0x7b, 0x6a, 0x0d, 0xeb, 0xa6, 0x77, 0xb0, 0xb5, 0xb8, 0x72, 0xfb, 0xf6, 0x6c, 0xd2, 0x09,
+	0x81, 0x7b, 0xeb, 0xcb, 0x7c, 0xf2, 0x37, 0x89, 0x35, 0xb0, 0x8c, 0x6e, 0x09, 0xc6, 0x85, 0xca,
+	0xff, 0xbd, 0x5f, 0x4c, 0x1a, 0xa0, 0xf3, 0xfc, 0xcb, 0xcc, 0x04, 0xdf, 0x66, 0x26, 0xb8, 0x9c,
+	0x99, 0xe0, 0xd7, 0xcc, 0x04, 0x9f, 0xe7, 0x66, 0xea, 0x72, 0x6e, 0xa6, 0x7e, 0xcc, 0xcd, 0xd4,
+	0x1b, 0xbd, 0x74, 0xe1, 0x9f, 0x59, 0x94, 0xaf, 0x3b, 0x2b, 0xc7, 0x43, 0x2c, 0xdc, 0x5d, 0xf5,
+	0x2f, 0x79, 0xfa, 0x67, 0x00, 0x0a, 0xd3, 0xe2, 0x55, 0x69, 0x04, 0x00, 0x00,
 }
 
 func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
@@ -180,6 +315,63 @@ func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUntombstone) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUntombstone)
+	if !ok {
+		that2, ok := that.(MsgUntombstone)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	if !this.Refund.Equal(that1.Refund) {
+		return false
+	}
+	if this.Justification != that1.Justification {
+		return false
+	}
+	return true
+}
+func (this *MsgUntombstoneResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUntombstoneResponse)
+	if !ok {
+		that2, ok := that.(MsgUntombstoneResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -196,6 +388,10 @@ type MsgClient interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
+	// Untombstone defines a governance operation for clearing the tombstone of a
+	// validator, optionally refunding its delegators' slashed stake from the
+	// community pool. The authority defaults to the x/gov module account.
+	Untombstone(ctx context.Context, in *MsgUntombstone, opts ...grpc.CallOption) (*MsgUntombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -215,11 +411,24 @@ func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, o
 	return out, nil
 }
 
+func (c *msgClient) Untombstone(ctx context.Context, in *MsgUntombstone, opts ...grpc.CallOption) (*MsgUntombstoneResponse, error) {
+	out := new(MsgUntombstoneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Msg/Untombstone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
+	// Untombstone defines a governance operation for clearing the tombstone of a
+	// validator, optionally refunding its delegators' slashed stake from the
+	// community pool. The authority defaults to the x/gov module account.
+	Untombstone(context.Context, *MsgUntombstone) (*MsgUntombstoneResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -229,6 +438,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitEvidence(ctx context.Context, req *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
 }
+func (*UnimplementedMsgServer) Untombstone(ctx context.Context, req *MsgUntombstone) (*MsgUntombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Untombstone not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -252,6 +464,24 @@ func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Untombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUntombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Untombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Msg/Untombstone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Untombstone(ctx, req.(*MsgUntombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -260,6 +490,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEvidence",
 			Handler:    _Msg_SubmitEvidence_Handler,
 		},
+		{
+			MethodName: "Untombstone",
+			Handler:    _Msg_Untombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/tx.proto",
@@ -337,6 +571,90 @@ func (m *MsgSubmitEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUntombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUntombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUntombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Justification) > 0 {
+		i -= len(m.Justification)
+		copy(dAtA[i:], m.Justification)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Justification)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Refund != nil {
+		{
+			size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUntombstoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUntombstoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUntombstoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -378,6 +696,43 @@ func (m *MsgSubmitEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUntombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Refund != nil {
+		l = m.Refund.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Justification)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUntombstoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -586,6 +941,257 @@ func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUntombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUntombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUntombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Refund == nil {
+				m.Refund = &types1.Coin{}
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUntombstoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUntombstoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUntombstoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32,7 +32,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "Proposals",
 					Use:       "proposals",
 					Short:     "Query proposals with optional filters",
-					Example:   fmt.Sprintf("%[1]s query gov proposals --depositor cosmos1...\n%[1]s query gov proposals --voter cosmos1...\n%[1]s query gov proposals --proposal-status (PROPOSAL_STATUS_DEPOSIT_PERIOD|PROPOSAL_STATUS_VOTING_PERIOD|PROPOSAL_STATUS_PASSED|PROPOSAL_STATUS_REJECTED|PROPOSAL_STATUS_FAILED)", version.AppName),
+					Example:   fmt.Sprintf("%[1]s query gov proposals --depositor cosmos1...\n%[1]s query gov proposals --voter cosmos1...\n%[1]s query gov proposals --proposal-status (PROPOSAL_STATUS_DEPOSIT_PERIOD|PROPOSAL_STATUS_VOTING_PERIOD|PROPOSAL_STATUS_QUEUED|PROPOSAL_STATUS_PASSED|PROPOSAL_STATUS_REJECTED|PROPOSAL_STATUS_FAILED)", version.AppName),
 				},
 				{
 					RpcMethod: "Proposal",
@@ -94,6 +94,52 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "Delegate",
+					Use:       "delegate [delegate-address]",
+					Short:     "Query a governance delegate",
+					Example:   fmt.Sprintf("%s query gov delegate cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegate_address"},
+					},
+				},
+				{
+					RpcMethod: "Delegates",
+					Use:       "delegates",
+					Short:     "Query all the governance delegates",
+				},
+				{
+					RpcMethod: "DelegateDelegators",
+					Use:       "delegate-delegators [delegate-address]",
+					Short:     "Query the delegators which assigned their voting power to a governance delegate",
+					Example:   fmt.Sprintf("%s query gov delegate-delegators cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegate_address"},
+					},
+				},
+				{
+					RpcMethod: "DelegatorDelegate",
+					Use:       "delegator-delegate [delegator-address]",
+					Short:     "Query the governance delegate a delegator assigned its voting power to",
+					Example:   fmt.Sprintf("%s query gov delegator-delegate cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "QueuedProposals",
+					Use:       "queued-proposals",
+					Short:     "Query the passed proposals waiting for their execution time",
+				},
+				{
+					RpcMethod: "SimulateProposal",
+					Use:       "simulate-proposal [proposal-id]",
+					Short:     "Simulate the execution of the messages of a proposal by the governance module, without committing any state change",
+					Example:   fmt.Sprintf("%s query gov simulate-proposal 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -154,6 +200,49 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "RegisterDelegate",
+					Use:       "register-delegate",
+					Short:     "Register the sender as a governance delegate",
+					Long:      "Register the sender as a governance delegate. Delegators can assign their governance voting power to a delegate, which votes on their behalf unless they vote themselves.",
+					Example:   fmt.Sprintf(`$ %s tx gov register-delegate --metadata "ipfs://..." --from mykey`, version.AppName),
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"metadata": {Name: "metadata", Usage: "Add metadata to the delegate"},
+					},
+				},
+				{
+					RpcMethod: "UnregisterDelegate",
+					Use:       "unregister-delegate",
+					Short:     "Unregister the sender as a governance delegate, unassigning the voting power of its delegators",
+					Example:   fmt.Sprintf("$ %s tx gov unregister-delegate --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "AssignDelegate",
+					Use:       "assign-delegate [delegate-address]",
+					Short:     "Assign the governance voting power of the sender to a governance delegate",
+					Example:   fmt.Sprintf("$ %s tx gov assign-delegate cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegate_address"},
+					},
+				},
+				{
+					RpcMethod: "UnassignDelegate",
+					Use:       "unassign-delegate",
+					Short:     "Remove the assignment of the governance voting power of the sender",
+					Example:   fmt.Sprintf("$ %s tx gov unassign-delegate --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "CancelQueuedProposal",
+					Use:       "cancel-queued-proposal [proposal-id]",
+					Short:     "Cancel a passed proposal waiting for its execution time. Only a timelock guardian can cancel a queued proposal",
+					Example:   fmt.Sprintf(`$ %s tx gov cancel-queued-proposal 1 --reason "..." --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"reason": {Name: "reason", Usage: "Reason of the cancellation"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
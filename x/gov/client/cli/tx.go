@@ -29,6 +29,8 @@ const (
 	FlagMetadata  = "metadata"
 	FlagSummary   = "summary"
 	FlagExpedited = "expedited"
+	// FlagSimulateProposal runs the proposal messages before broadcasting the proposal.
+	FlagSimulateProposal = "simulate-proposal"
 
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
@@ -91,7 +93,10 @@ func NewCmdSubmitProposal() *cobra.Command {
 They should be defined in a JSON file.
 
 Example:
-$ %s tx gov submit-proposal path/to/proposal.json
+$ %[1]s tx gov submit-proposal path/to/proposal.json
+
+The execution of the proposal messages can be simulated before broadcasting the proposal:
+$ %[1]s tx gov submit-proposal path/to/proposal.json --simulate-proposal
 
 Where proposal.json contains:
 
@@ -143,15 +148,42 @@ metadata example:
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			if simulate, _ := cmd.Flags().GetBool(FlagSimulateProposal); simulate {
+				if err := simulateProposal(cmd, clientCtx, msgs); err != nil {
+					return err
+				}
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagSimulateProposal, false, "Simulate the execution of the proposal messages before broadcasting it, and abort if they fail")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// simulateProposal simulates the execution of the given proposal messages
+// by the governance module and returns an error if any of them fails.
+func simulateProposal(cmd *cobra.Command, clientCtx client.Context, msgs []sdk.Msg) error {
+	req, err := v1.NewQuerySimulateProposalRequest(msgs)
+	if err != nil {
+		return err
+	}
+
+	res, err := v1.NewQueryClient(clientCtx).SimulateProposal(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to simulate proposal: %w", err)
+	}
+
+	if res.Error != "" {
+		return fmt.Errorf("proposal simulation failed: %s", res.Error)
+	}
+
+	return nil
+}
+
 // NewCmdSubmitLegacyProposal implements submitting a proposal transaction command.
 // Deprecated: please use NewCmdSubmitProposal instead.
 func NewCmdSubmitLegacyProposal() *cobra.Command {
@@ -132,13 +132,20 @@ func (s *CLITestSuite) TestNewCmdSubmitProposal() {
 	validPropFile := testutil.WriteToNewTempFile(s.T(), validProp)
 	defer validPropFile.Close()
 
+	// client context whose node reports a failing proposal simulation.
+	bz, err := s.encCfg.Codec.Marshal(&v1.QuerySimulateProposalResponse{Error: "msg 0 failed on execution"})
+	s.Require().NoError(err)
+	failingSimulationCtx := s.baseCtx.WithClient(clitestutil.NewMockCometRPC(abci.ResponseQuery{Value: bz}))
+
 	testCases := []struct {
 		name         string
+		clientCtx    client.Context
 		args         []string
 		expectErrMsg string
 	}{
 		{
 			"invalid proposal",
+			s.clientCtx,
 			[]string{
 				invalidPropFile.Name(),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
@@ -148,8 +155,22 @@ func (s *CLITestSuite) TestNewCmdSubmitProposal() {
 		},
 		{
 			"valid proposal",
+			s.clientCtx,
+			[]string{
+				validPropFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			"",
+		},
+		{
+			"valid proposal with successful simulation",
+			s.clientCtx,
 			[]string{
 				validPropFile.Name(),
+				fmt.Sprintf("--%s", cli.FlagSimulateProposal),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -157,6 +178,19 @@ func (s *CLITestSuite) TestNewCmdSubmitProposal() {
 			},
 			"",
 		},
+		{
+			"valid proposal with failing simulation",
+			failingSimulationCtx,
+			[]string{
+				validPropFile.Name(),
+				fmt.Sprintf("--%s", cli.FlagSimulateProposal),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			"proposal simulation failed: msg 0 failed on execution",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -165,7 +199,7 @@ func (s *CLITestSuite) TestNewCmdSubmitProposal() {
 		s.Run(tc.name, func() {
 			cmd := cli.NewCmdSubmitProposal()
 
-			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			out, err := clitestutil.ExecTestCLICmd(tc.clientCtx, cmd, tc.args)
 			if tc.expectErrMsg != "" {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.expectErrMsg)
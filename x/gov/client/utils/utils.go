@@ -68,6 +68,8 @@ func NormalizeProposalStatus(status string) string {
 		return v1.StatusDepositPeriod.String()
 	case "VotingPeriod", "voting_period":
 		return v1.StatusVotingPeriod.String()
+	case "Queued", "queued":
+		return v1.StatusQueued.String()
 	case "Passed", "passed":
 		return v1.StatusPassed.String()
 	case "Rejected", "rejected":
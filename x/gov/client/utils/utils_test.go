@@ -71,6 +71,8 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		{"DepositPeriod", args{"DepositPeriod"}, "PROPOSAL_STATUS_DEPOSIT_PERIOD"},
 		{"voting_period", args{"deposit_period"}, "PROPOSAL_STATUS_DEPOSIT_PERIOD"},
 		{"VotingPeriod", args{"DepositPeriod"}, "PROPOSAL_STATUS_DEPOSIT_PERIOD"},
+		{"queued", args{"queued"}, "PROPOSAL_STATUS_QUEUED"},
+		{"Queued", args{"Queued"}, "PROPOSAL_STATUS_QUEUED"},
 		{"passed", args{"passed"}, "PROPOSAL_STATUS_PASSED"},
 		{"Passed", args{"Passed"}, "PROPOSAL_STATUS_PASSED"},
 		{"Rejected", args{"Rejected"}, "PROPOSAL_STATUS_REJECTED"},
@@ -67,12 +67,41 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 			if err != nil {
 				panic(err)
 			}
+		case v1.StatusQueued:
+			err := k.QueuedProposalsQueue.Set(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id), proposal.Id)
+			if err != nil {
+				panic(err)
+			}
 		}
 		if err := k.Proposals.Set(ctx, proposal.Id, *proposal); err != nil {
 			panic(err)
 		}
 	}
 
+	for _, delegate := range data.Delegates {
+		addr, err := ak.AddressCodec().StringToBytes(delegate.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.Delegates.Set(ctx, addr, *delegate); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, assignment := range data.DelegateAssignments {
+		delegator, err := ak.AddressCodec().StringToBytes(assignment.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		delegate, err := ak.AddressCodec().StringToBytes(assignment.DelegateAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetDelegateAssignment(ctx, delegator, delegate); err != nil {
+			panic(err)
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -130,12 +159,30 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		panic(err)
 	}
 
+	// export governance delegates
+	var delegates []*v1.GovernanceDelegate
+	err = k.Delegates.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.GovernanceDelegate) (stop bool, err error) {
+		delegates = append(delegates, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export delegate assignments
+	assignments, err := k.GetDelegateAssignments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:  startingProposalID,
+		Deposits:            proposalsDeposits,
+		Votes:               proposalsVotes,
+		Proposals:           proposals,
+		Params:              &params,
+		Constitution:        constitution,
+		Delegates:           delegates,
+		DelegateAssignments: assignments,
 	}, nil
 }
@@ -159,47 +159,27 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 		switch {
 		case passes:
-			var (
-				idx int
-				msg sdk.Msg
-			)
-
-			messages, err := proposal.GetMsgs()
+			delay, err := k.executionDelay(ctx, proposal)
 			if err != nil {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
-
-				break
+				return false, err
 			}
 
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
-				// execute all messages
-				for idx, msg = range messages {
-					if _, err := safeExecuteHandler(ctx, msg, k.environment.RouterService.MessageRouterService()); err != nil {
-						// `idx` and `err` are populated with the msg index and error.
-						proposal.Status = v1.StatusFailed
-						proposal.FailedReason = err.Error()
-						tagValue = types.AttributeValueProposalFailed
-						logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
-
-						return err
-					}
+			if delay > 0 {
+				// proposals with a timelock are queued and executed once their execution time is reached
+				executionTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(delay)
+				proposal.Status = v1.StatusQueued
+				proposal.ExecutionTime = &executionTime
+				if err := k.QueuedProposalsQueue.Set(ctx, collections.Join(executionTime, proposal.Id), proposal.Id); err != nil {
+					return false, err
 				}
 
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
+				tagValue = types.AttributeValueProposalQueued
+				logMsg = fmt.Sprintf("passed, queued for execution at %s", executionTime)
 
-				return nil
-			}); err != nil {
-				break // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+				break
 			}
+
+			tagValue, logMsg = k.executeProposal(ctx, &proposal)
 		case !burnDeposits && (proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED ||
 			proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC):
 			// When a non spammy expedited/optimistic proposal fails, it is converted
@@ -267,9 +247,115 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 		return false, nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// fetch queued proposals whose execution time has been reached
+	rng = collections.NewPrefixUntilPairRange[time.Time, uint64](k.environment.HeaderService.GetHeaderInfo(ctx).Time)
+	err = k.QueuedProposalsQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64], _ uint64) (bool, error) {
+		if err := k.QueuedProposalsQueue.Remove(ctx, key); err != nil {
+			return false, err
+		}
+
+		proposal, err := k.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			// if the proposal has an encoding error, this means it cannot be processed by x/gov
+			// instead of returning an error (i.e, halting the chain), we fail the proposal
+			if errors.Is(err, collections.ErrEncoding) {
+				proposal.Id = key.K2()
+				return false, failUnsupportedProposal(logger, ctx, k, proposal, err.Error(), true)
+			}
+
+			return false, err
+		}
+
+		var tagValue, logMsg string
+
+		vetoed, err := k.timelockVetoed(ctx, proposal)
+		if err != nil {
+			return false, err
+		}
+
+		if vetoed {
+			proposal.Status = v1.StatusRejected
+			proposal.FailedReason = "proposal was vetoed during its timelock"
+			tagValue = types.AttributeValueProposalTimelockVetoed
+			logMsg = "vetoed during timelock"
+		} else {
+			tagValue, logMsg = k.executeProposal(ctx, &proposal)
+		}
+
+		if err = k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return false, err
+		}
+
+		logger.Info(
+			"queued proposal processed",
+			"proposal", proposal.Id,
+			"proposal_type", proposal.ProposalType,
+			"status", proposal.Status.String(),
+			"title", proposal.Title,
+			"results", logMsg,
+		)
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(types.EventTypeQueuedProposal,
+			event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			event.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			event.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+		); err != nil {
+			logger.Error("failed to emit event", "error", err)
+		}
+
+		return false, nil
+	})
 	return err
 }
 
+// executeProposal executes the messages of a passed proposal and updates its status accordingly.
+// It returns the event tag value and the log message describing the execution result.
+func (k Keeper) executeProposal(ctx context.Context, proposal *v1.Proposal) (tagValue, logMsg string) {
+	var (
+		idx int
+		msg sdk.Msg
+	)
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", *proposal, err)
+	}
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	_ = k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+		// execute all messages
+		for idx, msg = range messages {
+			if _, err := safeExecuteHandler(ctx, msg, k.environment.RouterService.MessageRouterService()); err != nil {
+				// `idx` and `err` are populated with the msg index and error.
+				proposal.Status = v1.StatusFailed
+				proposal.FailedReason = err.Error()
+				tagValue = types.AttributeValueProposalFailed
+				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+
+				return err
+			}
+		}
+
+		proposal.Status = v1.StatusPassed
+		tagValue = types.AttributeValueProposalPassed
+		logMsg = "passed"
+
+		return nil
+	}) // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+
+	return tagValue, logMsg
+}
+
 // executes route(msg) and recovers from panic.
 func safeExecuteHandler(ctx context.Context, msg sdk.Msg, router router.Router) (res protoiface.MessageV1, err error) {
 	defer func() {
@@ -26,6 +26,8 @@ type Config struct {
 	MaxMetadataLen uint64
 	// MaxSummaryLen defines the amount of characters that can be used for proposal summary
 	MaxSummaryLen uint64
+	// MaxSimulateProposalGas defines the maximum gas that can be consumed by a proposal simulation
+	MaxSimulateProposalGas uint64
 	// CalculateVoteResultsAndVotingPowerFn is a function signature for calculating vote results and voting power
 	// Keeping it nil will use the default implementation
 	CalculateVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
@@ -37,6 +39,7 @@ func DefaultConfig() Config {
 		MaxTitleLen:                          255,
 		MaxMetadataLen:                       255,
 		MaxSummaryLen:                        10200,
+		MaxSimulateProposalGas:               10_000_000,
 		CalculateVoteResultsAndVotingPowerFn: nil,
 	}
 }
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterDelegate registers an account as a governance delegate.
+// An account which assigned its voting power to a delegate cannot become a delegate itself.
+func (k Keeper) RegisterDelegate(ctx context.Context, delegateAddr sdk.AccAddress, metadata string) error {
+	if err := k.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().BytesToString(delegateAddr)
+	if err != nil {
+		return err
+	}
+
+	has, err := k.Delegates.Has(ctx, delegateAddr)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errors.Wrapf(types.ErrDelegateAlreadyExists, "%s", delegate)
+	}
+
+	has, err = k.DelegatorDelegates.Has(ctx, delegateAddr)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errors.Wrapf(types.ErrInvalidDelegation, "%s has assigned its voting power to a delegate", delegate)
+	}
+
+	if err := k.Delegates.Set(ctx, delegateAddr, v1.GovernanceDelegate{Address: delegate, Metadata: metadata}); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(types.EventTypeRegisterDelegate,
+		event.NewAttribute(types.AttributeKeyDelegate, delegate),
+	)
+}
+
+// UnregisterDelegate removes a governance delegate and the assignments of all its delegators.
+func (k Keeper) UnregisterDelegate(ctx context.Context, delegateAddr sdk.AccAddress) error {
+	delegate, err := k.authKeeper.AddressCodec().BytesToString(delegateAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.assertDelegateExists(ctx, delegateAddr, delegate); err != nil {
+		return err
+	}
+
+	var delegators []sdk.AccAddress
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](delegateAddr)
+	if err := k.DelegateDelegators.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		delegators = append(delegators, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, delegator := range delegators {
+		if err := k.DelegatorDelegates.Remove(ctx, delegator); err != nil {
+			return err
+		}
+	}
+
+	if err := k.DelegateDelegators.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	if err := k.Delegates.Remove(ctx, delegateAddr); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(types.EventTypeUnregisterDelegate,
+		event.NewAttribute(types.AttributeKeyDelegate, delegate),
+	)
+}
+
+// AssignDelegate assigns the governance voting power of a delegator to a delegate,
+// replacing its previous assignment if any.
+func (k Keeper) AssignDelegate(ctx context.Context, delegatorAddr, delegateAddr sdk.AccAddress) error {
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().BytesToString(delegateAddr)
+	if err != nil {
+		return err
+	}
+
+	if delegatorAddr.Equals(delegateAddr) {
+		return errors.Wrap(types.ErrInvalidDelegation, "cannot assign voting power to oneself")
+	}
+
+	if err := k.assertDelegateExists(ctx, delegateAddr, delegate); err != nil {
+		return err
+	}
+
+	isDelegate, err := k.Delegates.Has(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+	if isDelegate {
+		return errors.Wrapf(types.ErrInvalidDelegation, "delegate %s cannot assign its voting power", delegator)
+	}
+
+	if err := k.removeDelegateAssignment(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	if err := k.SetDelegateAssignment(ctx, delegatorAddr, delegateAddr); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(types.EventTypeAssignDelegate,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyDelegate, delegate),
+	)
+}
+
+// UnassignDelegate removes the assignment of the governance voting power of a delegator.
+func (k Keeper) UnassignDelegate(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	delegateAddr, err := k.DelegatorDelegates.Get(ctx, delegatorAddr)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrapf(types.ErrInvalidDelegation, "%s has not assigned its voting power", delegator)
+		}
+		return err
+	}
+
+	if err := k.removeDelegateAssignment(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().BytesToString(delegateAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(types.EventTypeUnassignDelegate,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyDelegate, delegate),
+	)
+}
+
+// SetDelegateAssignment stores the assignment of the voting power of a delegator to a delegate,
+// keeping the delegate to delegators index in sync.
+func (k Keeper) SetDelegateAssignment(ctx context.Context, delegatorAddr, delegateAddr sdk.AccAddress) error {
+	if err := k.DelegatorDelegates.Set(ctx, delegatorAddr, delegateAddr); err != nil {
+		return err
+	}
+
+	return k.DelegateDelegators.Set(ctx, collections.Join(delegateAddr, delegatorAddr))
+}
+
+// GetDelegateAssignments returns all the assignments of voting power to governance delegates.
+func (k Keeper) GetDelegateAssignments(ctx context.Context) ([]*v1.DelegateAssignment, error) {
+	var assignments []*v1.DelegateAssignment
+	err := k.DelegatorDelegates.Walk(ctx, nil, func(delegatorAddr, delegateAddr sdk.AccAddress) (stop bool, err error) {
+		delegator, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+		if err != nil {
+			return true, err
+		}
+
+		delegate, err := k.authKeeper.AddressCodec().BytesToString(delegateAddr)
+		if err != nil {
+			return true, err
+		}
+
+		assignments = append(assignments, &v1.DelegateAssignment{DelegatorAddress: delegator, DelegateAddress: delegate})
+		return false, nil
+	})
+
+	return assignments, err
+}
+
+// removeDelegateAssignment removes the assignment of a delegator, if any.
+func (k Keeper) removeDelegateAssignment(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	delegateAddr, err := k.DelegatorDelegates.Get(ctx, delegatorAddr)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := k.DelegatorDelegates.Remove(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	return k.DelegateDelegators.Remove(ctx, collections.Join(delegateAddr, delegatorAddr))
+}
+
+// assertDelegateExists returns an error if the given address is not a registered delegate.
+func (k Keeper) assertDelegateExists(ctx context.Context, delegateAddr sdk.AccAddress, delegate string) error {
+	has, err := k.Delegates.Has(ctx, delegateAddr)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errors.Wrapf(types.ErrDelegateNotFound, "%s", delegate)
+	}
+
+	return nil
+}
@@ -16,3 +16,9 @@ func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins
 
 	return k.validateInitialDeposit(params, initialDeposit, proposalType)
 }
+
+// SetMaxSimulateProposalGas is a helper function used only in simulation tests which sets the
+// MaxSimulateProposalGas of the keeper config.
+func (k *Keeper) SetMaxSimulateProposalGas(gas uint64) {
+	k.config.MaxSimulateProposalGas = gas
+}
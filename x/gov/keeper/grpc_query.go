@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	stderrors "errors"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -334,6 +335,170 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// Delegate queries a governance delegate by its address.
+func (q queryServer) Delegate(ctx context.Context, req *v1.QueryDelegateRequest) (*v1.QueryDelegateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegateAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegate address")
+	}
+
+	delegateAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegateAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegate, err := q.k.Delegates.Get(ctx, delegateAddr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "delegate %s doesn't exist", req.DelegateAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryDelegateResponse{Delegate: &delegate}, nil
+}
+
+// Delegates queries all the governance delegates.
+func (q queryServer) Delegates(ctx context.Context, req *v1.QueryDelegatesRequest) (*v1.QueryDelegatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegates, pageRes, err := query.CollectionPaginate(ctx, q.k.Delegates, req.Pagination, func(_ sdk.AccAddress, delegate v1.GovernanceDelegate) (*v1.GovernanceDelegate, error) {
+		return &delegate, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryDelegatesResponse{Delegates: delegates, Pagination: pageRes}, nil
+}
+
+// DelegateDelegators queries the delegators which assigned their voting power to a delegate.
+func (q queryServer) DelegateDelegators(ctx context.Context, req *v1.QueryDelegateDelegatorsRequest) (*v1.QueryDelegateDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegateAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegate address")
+	}
+
+	delegateAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegateAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegators, pageRes, err := query.CollectionPaginate(ctx, q.k.DelegateDelegators, req.Pagination, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (string, error) {
+		return q.k.authKeeper.AddressCodec().BytesToString(key.K2())
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](delegateAddr))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryDelegateDelegatorsResponse{DelegatorAddresses: delegators, Pagination: pageRes}, nil
+}
+
+// DelegatorDelegate queries the delegate a delegator assigned its voting power to.
+func (q queryServer) DelegatorDelegate(ctx context.Context, req *v1.QueryDelegatorDelegateRequest) (*v1.QueryDelegatorDelegateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegatorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegateAddr, err := q.k.DelegatorDelegates.Get(ctx, delegatorAddr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "delegator %s has not assigned its voting power", req.DelegatorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	delegate, err := q.k.authKeeper.AddressCodec().BytesToString(delegateAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryDelegatorDelegateResponse{DelegateAddress: delegate}, nil
+}
+
+// QueuedProposals queries the passed proposals waiting for their execution time, ordered by execution time.
+func (q queryServer) QueuedProposals(ctx context.Context, req *v1.QueryQueuedProposalsRequest) (*v1.QueryQueuedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(ctx, q.k.QueuedProposalsQueue, req.Pagination, func(_ collections.Pair[time.Time, uint64], proposalID uint64) (*v1.Proposal, error) {
+		proposal, err := q.k.Proposals.Get(ctx, proposalID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &proposal, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryQueuedProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// SimulateProposal executes the messages of a proposal as the governance module account, discarding the state changes.
+func (q queryServer) SimulateProposal(ctx context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var (
+		messages []sdk.Msg
+		err      error
+	)
+	switch {
+	case req.ProposalId != 0 && len(req.Messages) > 0:
+		return nil, status.Error(codes.InvalidArgument, "proposal id and messages cannot be both set")
+	case req.ProposalId != 0:
+		proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		messages, err = proposal.GetMsgs()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	default:
+		messages, err = req.GetMsgs()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no messages to simulate")
+	}
+
+	res, err := q.k.SimulateProposal(ctx, messages)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
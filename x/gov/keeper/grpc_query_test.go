@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	v3 "cosmossdk.io/x/gov/migrations/v3"
+	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
 
@@ -1932,3 +1934,194 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryDelegates() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	delegate, delegator := addrs[0].String(), addrs[1].String()
+	suite.Require().NoError(suite.govKeeper.RegisterDelegate(ctx, addrs[0], "metadata"))
+	suite.Require().NoError(suite.govKeeper.AssignDelegate(ctx, addrs[1], addrs[0]))
+
+	suite.Run("delegate", func() {
+		_, err := queryClient.Delegate(gocontext.Background(), &v1.QueryDelegateRequest{})
+		suite.Require().ErrorContains(err, "empty delegate address")
+
+		_, err = queryClient.Delegate(gocontext.Background(), &v1.QueryDelegateRequest{DelegateAddress: delegator})
+		suite.Require().ErrorContains(err, "doesn't exist")
+
+		res, err := queryClient.Delegate(gocontext.Background(), &v1.QueryDelegateRequest{DelegateAddress: delegate})
+		suite.Require().NoError(err)
+		suite.Require().Equal(&v1.GovernanceDelegate{Address: delegate, Metadata: "metadata"}, res.Delegate)
+	})
+
+	suite.Run("delegates", func() {
+		res, err := queryClient.Delegates(gocontext.Background(), &v1.QueryDelegatesRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal([]*v1.GovernanceDelegate{{Address: delegate, Metadata: "metadata"}}, res.Delegates)
+	})
+
+	suite.Run("delegate delegators", func() {
+		_, err := queryClient.DelegateDelegators(gocontext.Background(), &v1.QueryDelegateDelegatorsRequest{})
+		suite.Require().ErrorContains(err, "empty delegate address")
+
+		res, err := queryClient.DelegateDelegators(gocontext.Background(), &v1.QueryDelegateDelegatorsRequest{DelegateAddress: delegate})
+		suite.Require().NoError(err)
+		suite.Require().Equal([]string{delegator}, res.DelegatorAddresses)
+	})
+
+	suite.Run("delegator delegate", func() {
+		_, err := queryClient.DelegatorDelegate(gocontext.Background(), &v1.QueryDelegatorDelegateRequest{})
+		suite.Require().ErrorContains(err, "empty delegator address")
+
+		_, err = queryClient.DelegatorDelegate(gocontext.Background(), &v1.QueryDelegatorDelegateRequest{DelegatorAddress: delegate})
+		suite.Require().ErrorContains(err, "has not assigned its voting power")
+
+		res, err := queryClient.DelegatorDelegate(gocontext.Background(), &v1.QueryDelegatorDelegateRequest{DelegatorAddress: delegator})
+		suite.Require().NoError(err)
+		suite.Require().Equal(delegate, res.DelegateAddress)
+	})
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryQueuedProposals() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	res, err := queryClient.QueuedProposals(gocontext.Background(), &v1.QueryQueuedProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Proposals)
+
+	// proposals are ordered by execution time
+	var proposals []*v1.Proposal
+	for i, delay := range []time.Duration{time.Minute, time.Hour} {
+		executionTime := ctx.HeaderInfo().Time.Add(delay)
+		proposal := v1.Proposal{Id: uint64(2 - i), Status: v1.StatusQueued, ExecutionTime: &executionTime}
+		suite.Require().NoError(suite.govKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+		suite.Require().NoError(suite.govKeeper.QueuedProposalsQueue.Set(ctx, collections.Join(executionTime, proposal.Id), proposal.Id))
+		proposals = append(proposals, &proposal)
+	}
+
+	res, err = queryClient.QueuedProposals(gocontext.Background(), &v1.QueryQueuedProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 2)
+	for i := range proposals {
+		suite.Require().Equal(proposals[i].Id, res.Proposals[i].Id)
+		suite.Require().Equal(proposals[i].ExecutionTime.UTC(), res.Proposals[i].ExecutionTime.UTC())
+	}
+
+	res, err = queryClient.QueuedProposals(gocontext.Background(), &v1.QueryQueuedProposalsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 1)
+	suite.Require().Equal(proposals[0].Id, res.Proposals[0].Id)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+	newParams := params
+	votingPeriod := *params.VotingPeriod * 2
+	newParams.VotingPeriod = &votingPeriod
+	invalidParams := params
+	invalidParams.Quorum = "2"
+
+	registerDelegate := v1.NewMsgRegisterDelegate(govAcctStr, "")
+	updateParams := &v1.MsgUpdateParams{Authority: govAcctStr, Params: newParams}
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{registerDelegate, updateParams}, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+
+	newRequest := func(msgs ...sdk.Msg) *v1.QuerySimulateProposalRequest {
+		req, err := v1.NewQuerySimulateProposalRequest(msgs)
+		suite.Require().NoError(err)
+		return req
+	}
+
+	testCases := []struct {
+		name         string
+		req          *v1.QuerySimulateProposalRequest
+		expErrMsg    string
+		expResponses int
+		expEvent     string
+		expFailure   string
+	}{
+		{
+			name:      "no messages",
+			req:       &v1.QuerySimulateProposalRequest{},
+			expErrMsg: "no messages to simulate",
+		},
+		{
+			name:      "proposal id and messages",
+			req:       &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id, Messages: newRequest(registerDelegate).Messages},
+			expErrMsg: "cannot be both set",
+		},
+		{
+			name:      "non existing proposal",
+			req:       &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id + 1},
+			expErrMsg: "doesn't exist",
+		},
+		{
+			name:         "valid messages",
+			req:          newRequest(registerDelegate, updateParams),
+			expResponses: 2,
+			expEvent:     types.EventTypeRegisterDelegate,
+		},
+		{
+			name:         "valid stored proposal",
+			req:          &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id},
+			expResponses: 2,
+			expEvent:     types.EventTypeRegisterDelegate,
+		},
+		{
+			name:         "failing message",
+			req:          newRequest(registerDelegate, &v1.MsgUpdateParams{Authority: govAcctStr, Params: invalidParams}),
+			expResponses: 1,
+			expEvent:     types.EventTypeRegisterDelegate,
+			expFailure:   "msg 1 (/cosmos.gov.v1.MsgUpdateParams) failed on execution",
+		},
+		{
+			name:       "message not signed by the governance account",
+			req:        newRequest(v1.NewMsgRegisterDelegate(addrs[0].String(), "")),
+			expFailure: "expected authority account as only signer",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			res, err := queryClient.SimulateProposal(gocontext.Background(), tc.req)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(res.MsgResponses, tc.expResponses)
+			suite.Require().Contains(res.Error, tc.expFailure)
+			if tc.expFailure == "" {
+				suite.Require().Empty(res.Error)
+			}
+			if tc.expEvent != "" {
+				suite.Require().Equal(tc.expEvent, res.Events[0].Type)
+				suite.Require().NotZero(res.GasUsed)
+			}
+
+			// the state changes of the simulation are discarded
+			has, err := suite.govKeeper.Delegates.Has(ctx, govAcct)
+			suite.Require().NoError(err)
+			suite.Require().False(has)
+			current, err := suite.govKeeper.Params.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(params, current)
+		})
+	}
+
+	// the simulation cannot consume more than the max simulation gas of the keeper config
+	suite.govKeeper.SetMaxSimulateProposalGas(1)
+	res, err := queryClient.SimulateProposal(gocontext.Background(), newRequest(registerDelegate))
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.MsgResponses)
+	suite.Require().Contains(res.Error, "msg 0 (/cosmos.gov.v1.MsgRegisterDelegate) failed on execution")
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
 	"cosmossdk.io/x/gov/types"
@@ -67,6 +68,14 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// QueuedProposalsQueue key: executionTime+proposalID | value: proposalID
+	QueuedProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64]
+	// Delegates key: delegateAddr | value: GovernanceDelegate
+	Delegates collections.Map[sdk.AccAddress, v1.GovernanceDelegate]
+	// DelegatorDelegates key: delegatorAddr | value: delegateAddr
+	DelegatorDelegates collections.Map[sdk.AccAddress, sdk.AccAddress]
+	// DelegateDelegators key: delegateAddr+delegatorAddr | value: none used (index key for DelegatorDelegates)
+	DelegateDelegators collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -108,6 +117,10 @@ func NewKeeper(
 	if config.MaxSummaryLen == 0 {
 		config.MaxSummaryLen = defaultConfig.MaxSummaryLen
 	}
+	// If MaxSimulateProposalGas not set by app developer, set to default value.
+	if config.MaxSimulateProposalGas == 0 {
+		config.MaxSimulateProposalGas = defaultConfig.MaxSimulateProposalGas
+	}
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -129,6 +142,10 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		QueuedProposalsQueue:   collections.NewMap(sb, types.QueuedProposalQueuePrefix, "queued_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
+		Delegates:              collections.NewMap(sb, types.DelegatesKeyPrefix, "delegates", sdk.AccAddressKey, codec.CollValue[v1.GovernanceDelegate](cdc)),
+		DelegatorDelegates:     collections.NewMap(sb, types.DelegatorDelegatesKeyPrefix, "delegator_delegates", sdk.AccAddressKey, collcodec.KeyToValueCodec(sdk.AccAddressKey)),
+		DelegateDelegators:     collections.NewKeySet(sb, types.DelegateDelegatorsKeyPrefix, "delegate_delegators", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -402,6 +402,84 @@ func (k msgServer) SudoExec(ctx context.Context, msg *v1.MsgSudoExec) (*v1.MsgSu
 	}, nil
 }
 
+// RegisterDelegate implements the MsgServer.RegisterDelegate method.
+func (k msgServer) RegisterDelegate(ctx context.Context, msg *v1.MsgRegisterDelegate) (*v1.MsgRegisterDelegateResponse, error) {
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegateAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", err)
+	}
+
+	if err := k.Keeper.RegisterDelegate(ctx, delegate, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRegisterDelegateResponse{}, nil
+}
+
+// UnregisterDelegate implements the MsgServer.UnregisterDelegate method.
+func (k msgServer) UnregisterDelegate(ctx context.Context, msg *v1.MsgUnregisterDelegate) (*v1.MsgUnregisterDelegateResponse, error) {
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegateAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", err)
+	}
+
+	if err := k.Keeper.UnregisterDelegate(ctx, delegate); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUnregisterDelegateResponse{}, nil
+}
+
+// AssignDelegate implements the MsgServer.AssignDelegate method.
+func (k msgServer) AssignDelegate(ctx context.Context, msg *v1.MsgAssignDelegate) (*v1.MsgAssignDelegateResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegateAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", err)
+	}
+
+	if err := k.Keeper.AssignDelegate(ctx, delegator, delegate); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgAssignDelegateResponse{}, nil
+}
+
+// UnassignDelegate implements the MsgServer.UnassignDelegate method.
+func (k msgServer) UnassignDelegate(ctx context.Context, msg *v1.MsgUnassignDelegate) (*v1.MsgUnassignDelegateResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UnassignDelegate(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUnassignDelegateResponse{}, nil
+}
+
+// CancelQueuedProposal implements the MsgServer.CancelQueuedProposal method.
+func (k msgServer) CancelQueuedProposal(ctx context.Context, msg *v1.MsgCancelQueuedProposal) (*v1.MsgCancelQueuedProposalResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Guardian); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid guardian address: %s", err)
+	}
+
+	if err := k.assertMetadataLength(msg.Reason); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelQueuedProposal(ctx, msg.ProposalId, msg.Guardian, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelQueuedProposalResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -2152,3 +2153,252 @@ func (suite *KeeperTestSuite) TestMsgSudoExec() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgDelegates() {
+	suite.reset()
+	delegate, delegator, other := suite.addrs[0].String(), suite.addrs[1].String(), suite.addrs[2].String()
+
+	// the test cases are run in order and share the same state
+	testCases := []struct {
+		name      string
+		malleate  func() error
+		expErrMsg string
+		postCheck func()
+	}{
+		{
+			name: "register delegate: invalid address",
+			malleate: func() error {
+				_, err := suite.msgSrvr.RegisterDelegate(suite.ctx, v1.NewMsgRegisterDelegate("invalid", ""))
+				return err
+			},
+			expErrMsg: "invalid delegate address",
+		},
+		{
+			name: "register delegate: metadata too long",
+			malleate: func() error {
+				_, err := suite.msgSrvr.RegisterDelegate(suite.ctx, v1.NewMsgRegisterDelegate(delegate, strings.Repeat("1", 300)))
+				return err
+			},
+			expErrMsg: "metadata too long",
+		},
+		{
+			name: "assign delegate: delegate not registered",
+			malleate: func() error {
+				_, err := suite.msgSrvr.AssignDelegate(suite.ctx, v1.NewMsgAssignDelegate(delegator, delegate))
+				return err
+			},
+			expErrMsg: "governance delegate not found",
+		},
+		{
+			name: "register delegate: valid",
+			malleate: func() error {
+				_, err := suite.msgSrvr.RegisterDelegate(suite.ctx, v1.NewMsgRegisterDelegate(delegate, "metadata"))
+				return err
+			},
+			postCheck: func() {
+				d, err := suite.govKeeper.Delegates.Get(suite.ctx, suite.addrs[0])
+				suite.Require().NoError(err)
+				suite.Require().Equal(v1.GovernanceDelegate{Address: delegate, Metadata: "metadata"}, d)
+			},
+		},
+		{
+			name: "register delegate: already registered",
+			malleate: func() error {
+				_, err := suite.msgSrvr.RegisterDelegate(suite.ctx, v1.NewMsgRegisterDelegate(delegate, ""))
+				return err
+			},
+			expErrMsg: "governance delegate already registered",
+		},
+		{
+			name: "assign delegate: self assignment",
+			malleate: func() error {
+				_, err := suite.msgSrvr.AssignDelegate(suite.ctx, v1.NewMsgAssignDelegate(delegate, delegate))
+				return err
+			},
+			expErrMsg: "cannot assign voting power to oneself",
+		},
+		{
+			name: "assign delegate: valid",
+			malleate: func() error {
+				_, err := suite.msgSrvr.AssignDelegate(suite.ctx, v1.NewMsgAssignDelegate(delegator, delegate))
+				return err
+			},
+			postCheck: func() {
+				d, err := suite.govKeeper.DelegatorDelegates.Get(suite.ctx, suite.addrs[1])
+				suite.Require().NoError(err)
+				suite.Require().Equal(suite.addrs[0], d)
+				has, err := suite.govKeeper.DelegateDelegators.Has(suite.ctx, collections.Join(suite.addrs[0], suite.addrs[1]))
+				suite.Require().NoError(err)
+				suite.Require().True(has)
+			},
+		},
+		{
+			name: "register delegate: delegator cannot become a delegate",
+			malleate: func() error {
+				_, err := suite.msgSrvr.RegisterDelegate(suite.ctx, v1.NewMsgRegisterDelegate(delegator, ""))
+				return err
+			},
+			expErrMsg: "has assigned its voting power to a delegate",
+		},
+		{
+			name: "unassign delegate: valid",
+			malleate: func() error {
+				_, err := suite.msgSrvr.UnassignDelegate(suite.ctx, v1.NewMsgUnassignDelegate(delegator))
+				return err
+			},
+			postCheck: func() {
+				has, err := suite.govKeeper.DelegatorDelegates.Has(suite.ctx, suite.addrs[1])
+				suite.Require().NoError(err)
+				suite.Require().False(has)
+				has, err = suite.govKeeper.DelegateDelegators.Has(suite.ctx, collections.Join(suite.addrs[0], suite.addrs[1]))
+				suite.Require().NoError(err)
+				suite.Require().False(has)
+			},
+		},
+		{
+			name: "unassign delegate: not assigned",
+			malleate: func() error {
+				_, err := suite.msgSrvr.UnassignDelegate(suite.ctx, v1.NewMsgUnassignDelegate(delegator))
+				return err
+			},
+			expErrMsg: "has not assigned its voting power",
+		},
+		{
+			name: "unregister delegate: not registered",
+			malleate: func() error {
+				_, err := suite.msgSrvr.UnregisterDelegate(suite.ctx, v1.NewMsgUnregisterDelegate(other))
+				return err
+			},
+			expErrMsg: "governance delegate not found",
+		},
+		{
+			name: "unregister delegate: valid, assignments are removed",
+			malleate: func() error {
+				if _, err := suite.msgSrvr.AssignDelegate(suite.ctx, v1.NewMsgAssignDelegate(delegator, delegate)); err != nil {
+					return err
+				}
+				if _, err := suite.msgSrvr.AssignDelegate(suite.ctx, v1.NewMsgAssignDelegate(other, delegate)); err != nil {
+					return err
+				}
+				_, err := suite.msgSrvr.UnregisterDelegate(suite.ctx, v1.NewMsgUnregisterDelegate(delegate))
+				return err
+			},
+			postCheck: func() {
+				has, err := suite.govKeeper.Delegates.Has(suite.ctx, suite.addrs[0])
+				suite.Require().NoError(err)
+				suite.Require().False(has)
+				assignments, err := suite.govKeeper.GetDelegateAssignments(suite.ctx)
+				suite.Require().NoError(err)
+				suite.Require().Empty(assignments)
+				rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](suite.addrs[0])
+				iter, err := suite.govKeeper.DelegateDelegators.Iterate(suite.ctx, rng)
+				suite.Require().NoError(err)
+				defer iter.Close()
+				suite.Require().False(iter.Valid())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.malleate()
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelQueuedProposal() {
+	suite.reset()
+	guardian, other := suite.addrs[0].String(), suite.addrs[1].String()
+
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.TimelockGuardians = []string{guardian}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	executionTime := suite.ctx.HeaderInfo().Time.Add(time.Hour)
+	queued := v1.Proposal{Id: 1, Status: v1.StatusQueued, ExecutionTime: &executionTime}
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, queued.Id, queued))
+	suite.Require().NoError(suite.govKeeper.QueuedProposalsQueue.Set(suite.ctx, collections.Join(executionTime, queued.Id), queued.Id))
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, 2, v1.Proposal{Id: 2, Status: v1.StatusVotingPeriod}))
+
+	// the test cases are run in order and share the same state
+	testCases := []struct {
+		name      string
+		msg       *v1.MsgCancelQueuedProposal
+		expErrMsg string
+		postCheck func()
+	}{
+		{
+			name:      "invalid guardian address",
+			msg:       v1.NewMsgCancelQueuedProposal(queued.Id, "invalid", ""),
+			expErrMsg: "invalid guardian address",
+		},
+		{
+			name:      "reason too long",
+			msg:       v1.NewMsgCancelQueuedProposal(queued.Id, guardian, strings.Repeat("1", 300)),
+			expErrMsg: "metadata too long",
+		},
+		{
+			name:      "signer is not a guardian",
+			msg:       v1.NewMsgCancelQueuedProposal(queued.Id, other, ""),
+			expErrMsg: "expected timelock guardian as signer",
+		},
+		{
+			name:      "proposal does not exist",
+			msg:       v1.NewMsgCancelQueuedProposal(3, guardian, ""),
+			expErrMsg: "proposal 3 doesn't exist",
+		},
+		{
+			name:      "proposal is not queued",
+			msg:       v1.NewMsgCancelQueuedProposal(2, guardian, ""),
+			expErrMsg: "proposal is not queued for execution",
+		},
+		{
+			name: "valid",
+			msg:  v1.NewMsgCancelQueuedProposal(queued.Id, guardian, "security issue"),
+			postCheck: func() {
+				proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, queued.Id)
+				suite.Require().NoError(err)
+				suite.Require().Equal(v1.StatusRejected, proposal.Status)
+				suite.Require().Contains(proposal.FailedReason, "security issue")
+
+				has, err := suite.govKeeper.QueuedProposalsQueue.Has(suite.ctx, collections.Join(executionTime, queued.Id))
+				suite.Require().NoError(err)
+				suite.Require().False(has)
+			},
+		},
+		{
+			name:      "already canceled",
+			msg:       v1.NewMsgCancelQueuedProposal(queued.Id, guardian, ""),
+			expErrMsg: "proposal is not queued for execution",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.CancelQueuedProposal(suite.ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// errSimulation is used to discard the state changes of a proposal simulation.
+var errSimulation = errors.New("proposal simulation")
+
+// SimulateProposal executes the given proposal messages as the governance module account,
+// like a passed proposal would be, in a branched context whose state changes are always
+// discarded. It reports the responses of the messages, the events they emitted and the
+// gas they consumed, up to the MaxSimulateProposalGas of the keeper config. The simulation
+// stops at the first failing message, whose error is reported in the result.
+func (k Keeper) SimulateProposal(ctx context.Context, messages []sdk.Msg) (*v1.QuerySimulateProposalResponse, error) {
+	result := &v1.QuerySimulateProposalResponse{}
+	gasLimit := min(k.environment.GasService.GetGasMeter(ctx).Remaining(), k.config.MaxSimulateProposalGas)
+
+	err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+		govAcct := k.GetGovernanceAccount(ctx).GetAddress()
+
+		var execErr error
+		result.GasUsed, execErr = k.environment.BranchService.ExecuteWithGasLimit(ctx, gasLimit, func(ctx context.Context) error {
+			defer func() {
+				result.Events = sdk.StringifyEvents(sdk.UnwrapSDKContext(ctx).EventManager().ABCIEvents())
+			}()
+
+			for idx, msg := range messages {
+				if err := k.simulateMsg(ctx, govAcct, msg, result); err != nil {
+					result.Error = fmt.Sprintf("msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+					return err
+				}
+			}
+
+			return nil
+		})
+		if execErr != nil && result.Error == "" {
+			// the simulation ran out of gas
+			result.Error = execErr.Error()
+		}
+
+		// discard the state changes of the simulation.
+		return errSimulation
+	})
+	if !errors.Is(err, errSimulation) {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// simulateMsg executes a proposal message and appends its response to the simulation result.
+func (k Keeper) simulateMsg(ctx context.Context, govAcct sdk.AccAddress, msg sdk.Msg, result *v1.QuerySimulateProposalResponse) error {
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return err
+	}
+
+	// the governance module account must be the only signer of the messages
+	if len(signers) != 1 || !bytes.Equal(signers[0], govAcct) {
+		return types.ErrInvalidSigner
+	}
+
+	res, err := safeExecuteHandler(ctx, msg, k.environment.RouterService.MessageRouterService())
+	if err != nil {
+		return err
+	}
+
+	msgResponse, err := codectypes.NewAnyWithValue(res)
+	if err != nil {
+		return err
+	}
+
+	result.MsgResponses = append(result.MsgResponses, msgResponse)
+	return nil
+}
@@ -235,7 +235,9 @@ func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Validat
 }
 
 // calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
-// and returns the votes results from voters
+// and returns the votes results from voters.
+// The voting power of a delegator is counted, by order of precedence, with its own vote, the vote of the
+// governance delegate it assigned its voting power to, or the vote of the validators it delegates to.
 func defaultCalculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	k Keeper,
@@ -245,27 +247,10 @@ func defaultCalculateVoteResultsAndVotingPower(
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
 
-	// iterate over all votes, tally up the voting power of each validator
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
-	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
-		// if validator, just record it in the map
-		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
-		if err != nil {
-			return false, err
-		}
-
-		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
-		if err != nil {
-			return false, err
-		}
-
-		if val, ok := validators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			validators[valAddrStr] = val
-		}
-
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
+	// tallyDelegations deducts the delegations of the voter from the delegated-to validators
+	// and tallies their voting power with the given options
+	tallyDelegations := func(voter sdk.AccAddress, options v1.WeightedVoteOptions) error {
+		return k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr()
 
 			if val, ok := validators[valAddrStr]; ok {
@@ -277,7 +262,7 @@ func defaultCalculateVoteResultsAndVotingPower(
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-				for _, option := range vote.Options {
+				for _, option := range options {
 					weight, _ := math.LegacyNewDecFromStr(option.Weight)
 					subPower := votingPower.Mul(weight)
 					results[option.Option] = results[option.Option].Add(subPower)
@@ -288,15 +273,72 @@ func defaultCalculateVoteResultsAndVotingPower(
 
 			return false
 		})
+	}
+
+	// voters records the accounts which voted, their vote takes precedence over the one of their delegate
+	voters := make(map[string]struct{})
+	// delegateVotes records the votes cast by governance delegates, in iteration order
+	var delegateVotes []v1.Vote
+
+	// iterate over all votes, tally up the voting power of each validator
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		// if validator, just record it in the map
+		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
 		if err != nil {
 			return false, err
 		}
 
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
+		if err != nil {
+			return false, err
+		}
+
+		if val, ok := validators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			validators[valAddrStr] = val
+		}
+
+		voters[string(voter)] = struct{}{}
+
+		isDelegate, err := k.Delegates.Has(ctx, voter)
+		if err != nil {
+			return false, err
+		}
+		if isDelegate {
+			delegateVotes = append(delegateVotes, vote)
+		}
+
+		// iterate over all delegations from voter, deduct from any delegated-to validators
+		if err := tallyDelegations(voter, vote.Options); err != nil {
+			return false, err
+		}
+
 		return false, k.Votes.Remove(ctx, collections.Join(vote.ProposalId, sdk.AccAddress(voter)))
 	}); err != nil {
 		return math.LegacyDec{}, nil, err
 	}
 
+	// iterate over the delegators of the delegates which voted and did not vote themselves
+	for _, vote := range delegateVotes {
+		delegate, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](delegate)
+		if err := k.DelegateDelegators.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+			delegator := key.K2()
+			if _, voted := voters[string(delegator)]; voted {
+				return false, nil
+			}
+
+			return false, tallyDelegations(delegator, vote.Options)
+		}); err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range validators {
 		if len(val.Vote) == 0 {
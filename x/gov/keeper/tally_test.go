@@ -52,6 +52,26 @@ var (
 		// validatorVote is like delegatorVote but without delegations
 		delegatorVote(s, sdk.AccAddress(voter), nil, vote)
 	}
+	assignDelegate = func(s tallyFixture, delegator, delegate sdk.AccAddress) {
+		has, err := s.keeper.Delegates.Has(s.ctx, delegate)
+		require.NoError(s.t, err)
+		if !has {
+			require.NoError(s.t, s.keeper.RegisterDelegate(s.ctx, delegate, ""))
+		}
+		require.NoError(s.t, s.keeper.AssignDelegate(s.ctx, delegator, delegate))
+	}
+	delegatorDelegations = func(s tallyFixture, delegator sdk.AccAddress, delegations []stakingtypes.Delegation) {
+		// delegatorDelegations mocks the delegations of a delegator which did not vote
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, delegator, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
 )
 
 func TestTally_Standard(t *testing.T) {
@@ -258,6 +278,83 @@ func TestTally_Standard(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			name: "delegator inherits the vote of its governance delegate over the one of its validator",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: s.delAddrs[0].String(),
+					ValidatorAddress: s.valAddrs[0].String(),
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				assignDelegate(s, s.delAddrs[0], s.delAddrs[1])
+				delegatorDelegations(s, s.delAddrs[0], delegations)
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "42",
+				AbstainCount:     "0",
+				NoCount:          "999958",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "42",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "999958",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "delegator vote overrides the vote of its governance delegate",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: s.delAddrs[0].String(),
+					ValidatorAddress: s.valAddrs[0].String(),
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				assignDelegate(s, s.delAddrs[0], s.delAddrs[1])
+				delegatorVote(s, s.delAddrs[0], delegations, v1.VoteOption_VOTE_OPTION_THREE)
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "999958",
+				AbstainCount:     "0",
+				NoCount:          "42",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "999958",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "42",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "delegator inherits the vote of its validator when its governance delegate did not vote",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				assignDelegate(s, s.delAddrs[0], s.delAddrs[1])
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "1000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "1000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
 		{
 			name: "quorum reached with only abstain: prop fails",
 			setup: func(s tallyFixture) {
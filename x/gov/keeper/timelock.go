@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CancelQueuedProposal cancels a passed proposal waiting for its execution time.
+// Only a timelock guardian can cancel a queued proposal.
+func (k Keeper) CancelQueuedProposal(ctx context.Context, proposalID uint64, guardian, reason string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(params.TimelockGuardians, guardian) {
+		return errors.Wrapf(types.ErrInvalidGuardian, "%s is not a timelock guardian", guardian)
+	}
+
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return types.ErrInvalidProposal.Wrapf("proposal %d doesn't exist", proposalID)
+		}
+		return err
+	}
+
+	if proposal.Status != v1.StatusQueued {
+		return errors.Wrapf(types.ErrProposalNotQueued, "%d", proposalID)
+	}
+
+	if err := k.QueuedProposalsQueue.Remove(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id)); err != nil {
+		return err
+	}
+
+	if err := k.deleteVotes(ctx, proposal.Id); err != nil {
+		return err
+	}
+
+	proposal.Status = v1.StatusRejected
+	proposal.FailedReason = fmt.Sprintf("canceled by timelock guardian %s", guardian)
+	if reason != "" {
+		proposal.FailedReason = fmt.Sprintf("%s: %s", proposal.FailedReason, reason)
+	}
+
+	if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(types.EventTypeCancelQueuedProposal,
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+		event.NewAttribute(types.AttributeKeyGuardian, guardian),
+	)
+}
+
+// executionDelay returns the timelock of a proposal, which is the longest
+// execution delay set in the message based params of its messages.
+func (k Keeper) executionDelay(ctx context.Context, proposal v1.Proposal) (time.Duration, error) {
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		// the proposal is not timelocked, so it fails on execution
+		return 0, nil
+	}
+
+	var delay time.Duration
+	for _, msg := range messages {
+		customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(msg))
+		if err != nil {
+			if stderrors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return 0, err
+		}
+
+		if customMessageParams.ExecutionDelay != nil && *customMessageParams.ExecutionDelay > delay {
+			delay = *customMessageParams.ExecutionDelay
+		}
+	}
+
+	return delay, nil
+}
+
+// timelockVetoed tallies the emergency veto votes cast on a queued proposal and
+// returns whether they exceed the timelock veto threshold of the total bonded tokens.
+// The votes of the proposal are deleted.
+func (k Keeper) timelockVetoed(ctx context.Context, proposal v1.Proposal) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if params.TimelockVetoThreshold == "" {
+		return false, k.deleteVotes(ctx, proposal.Id)
+	}
+
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	calculateVoteResultsAndVotingPowerFn := k.config.CalculateVoteResultsAndVotingPowerFn
+	if calculateVoteResultsAndVotingPowerFn == nil {
+		calculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
+	}
+
+	_, results, err := calculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	if err != nil {
+		return false, err
+	}
+
+	// the votes are deleted even if the veto cannot be reached
+	if err := k.deleteVotes(ctx, proposal.Id); err != nil {
+		return false, err
+	}
+
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if totalBonded.IsZero() {
+		return false, nil
+	}
+
+	vetoThreshold, err := math.LegacyNewDecFromStr(params.TimelockVetoThreshold)
+	if err != nil {
+		return false, err
+	}
+
+	return results[v1.OptionNoWithVeto].Quo(math.LegacyNewDecFromInt(totalBonded)).GT(vetoThreshold), nil
+}
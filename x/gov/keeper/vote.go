@@ -26,8 +26,19 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 
-	// check if proposal is in voting period.
-	if proposal.Status != v1.StatusVotingPeriod {
+	// check if proposal is in voting period, or queued for execution with the emergency veto enabled.
+	switch proposal.Status {
+	case v1.StatusVotingPeriod:
+	case v1.StatusQueued:
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if params.TimelockVetoThreshold == "" {
+			return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+		}
+	default:
 		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
@@ -36,9 +47,13 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	}
 
 	for _, option := range options {
+		if proposal.Status == v1.StatusQueued && option.Option != v1.OptionNoWithVeto {
+			return errors.Wrap(types.ErrInvalidVote, "queued proposals can only be vetoed")
+		}
+
 		switch proposal.ProposalType {
 		case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
-			if option.Option != v1.OptionNo {
+			if option.Option != v1.OptionNo && proposal.Status != v1.StatusQueued {
 				return errors.Wrap(types.ErrInvalidVote, "optimistic proposals can only be rejected")
 			}
 		case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
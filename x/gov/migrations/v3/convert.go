@@ -29,6 +29,11 @@ func ConvertToLegacyProposal(proposal v1.Proposal) (v1beta1.Proposal, error) {
 		TotalDeposit: types.NewCoins(proposal.TotalDeposit...),
 	}
 
+	// queued proposals have passed and wait for their execution, a status unknown to legacy proposals
+	if proposal.Status == v1.StatusQueued {
+		legacyProposal.Status = v1beta1.StatusPassed
+	}
+
 	legacyProposal.FinalTallyResult, err = ConvertToLegacyTallyResult(proposal.FinalTallyResult)
 	if err != nil {
 		return v1beta1.Proposal{}, err
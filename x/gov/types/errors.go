@@ -27,4 +27,9 @@ var (
 	ErrInvalidDepositDenom     = errors.Register(ModuleName, 23, "invalid deposit denom")
 	ErrTitleTooLong            = errors.Register(ModuleName, 24, "title too long")
 	ErrTooLateToCancel         = errors.Register(ModuleName, 25, "too late to cancel proposal")
+	ErrDelegateNotFound        = errors.Register(ModuleName, 26, "governance delegate not found")
+	ErrDelegateAlreadyExists   = errors.Register(ModuleName, 27, "governance delegate already registered")
+	ErrInvalidDelegation       = errors.Register(ModuleName, 28, "invalid governance delegation")
+	ErrProposalNotQueued       = errors.Register(ModuleName, 29, "proposal is not queued for execution")
+	ErrInvalidGuardian         = errors.Register(ModuleName, 30, "expected timelock guardian as signer")
 )
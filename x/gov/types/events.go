@@ -9,6 +9,14 @@ const (
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
 
+	EventTypeRegisterDelegate   = "register_delegate"
+	EventTypeUnregisterDelegate = "unregister_delegate"
+	EventTypeAssignDelegate     = "assign_delegate"
+	EventTypeUnassignDelegate   = "unassign_delegate"
+
+	EventTypeQueuedProposal       = "queued_proposal"
+	EventTypeCancelQueuedProposal = "cancel_queued_proposal"
+
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
 	AttributeKeyOption               = "option"
@@ -17,6 +25,10 @@ const (
 	AttributeKeyVotingPeriodStart    = "voting_period_start"
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
+	AttributeKeyDelegate             = "delegate"
+	AttributeKeyDelegator            = "delegator"
+	AttributeKeyExecutionTime        = "execution_time"
+	AttributeKeyGuardian             = "guardian"
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
@@ -25,6 +37,8 @@ const (
 	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // didn't meet optimistic vote quorum
 	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
 	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeValueProposalQueued             = "proposal_queued"              // passed, waiting for its execution time
+	AttributeValueProposalTimelockVetoed     = "proposal_timelock_vetoed"     // met the emergency veto threshold while queued
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
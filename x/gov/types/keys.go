@@ -26,6 +26,10 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	DelegatesKeyPrefix           = collections.NewPrefix(52) // DelegatesKeyPrefix stores the governance delegates.
+	DelegatorDelegatesKeyPrefix  = collections.NewPrefix(53) // DelegatorDelegatesKeyPrefix stores the delegate assigned by each delegator.
+	DelegateDelegatorsKeyPrefix  = collections.NewPrefix(54) // DelegateDelegatorsKeyPrefix indexes the delegators of each delegate.
+	QueuedProposalQueuePrefix    = collections.NewPrefix(55) // QueuedProposalQueuePrefix stores the passed proposals waiting for their execution time.
 )
 
 // Reserved kvstore keys
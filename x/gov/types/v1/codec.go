@@ -21,6 +21,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterDelegate{}, "cosmos-sdk/v1/MsgRegisterDelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgUnregisterDelegate{}, "cosmos-sdk/v1/MsgUnregisterDelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgAssignDelegate{}, "cosmos-sdk/v1/MsgAssignDelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgUnassignDelegate{}, "cosmos-sdk/v1/MsgUnassignDelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelQueuedProposal{}, "cosmos-sdk/v1/MsgCancelQueuedProposal")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -35,6 +40,11 @@ func RegisterInterfaces(registry registry.LegacyRegistry) {
 		&MsgUpdateParams{},
 		&MsgUpdateMessageParams{},
 		&MsgSudoExec{},
+		&MsgRegisterDelegate{},
+		&MsgUnregisterDelegate{},
+		&MsgAssignDelegate{},
+		&MsgUnassignDelegate{},
+		&MsgCancelQueuedProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
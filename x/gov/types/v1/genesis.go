@@ -34,8 +34,9 @@ func (data GenesisState) Empty() bool {
 
 // ValidateGenesis checks if gov genesis state is valid ranges
 // It checks if params are in valid ranges
-// It also makes sure that the provided proposal IDs are unique and
-// that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals
+// It also makes sure that the provided proposal IDs are unique, that queued proposals have an execution time,
+// and that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals.
+// Finally it checks that delegates are unique and that delegate assignments refer to registered delegates.
 func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 	if data.StartingProposalId == 0 {
 		return errors.New("starting proposal id must be greater than 0")
@@ -50,6 +51,10 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 			return fmt.Errorf("duplicate proposal id: %d", p.Id)
 		}
 
+		if p.Status == StatusQueued && p.ExecutionTime == nil {
+			return fmt.Errorf("queued proposal %d has no execution time", p.Id)
+		}
+
 		proposalIds[p.Id] = struct{}{}
 	}
 
@@ -99,6 +104,11 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// verify delegates and delegate assignments
+	errGroup.Go(func() error {
+		return validateDelegates(ac, data.Delegates, data.DelegateAssignments)
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -107,6 +117,46 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 	return errGroup.Wait()
 }
 
+// validateDelegates checks that the delegates are unique and that each delegator assigned
+// its voting power once, to a registered delegate other than itself.
+func validateDelegates(ac address.Codec, delegates []*GovernanceDelegate, assignments []*DelegateAssignment) error {
+	delegateAddrs := make(map[string]struct{})
+	for _, d := range delegates {
+		if _, err := ac.StringToBytes(d.Address); err != nil {
+			return fmt.Errorf("invalid delegate address %s: %w", d.Address, err)
+		}
+
+		if _, ok := delegateAddrs[d.Address]; ok {
+			return fmt.Errorf("duplicate delegate: %s", d.Address)
+		}
+
+		delegateAddrs[d.Address] = struct{}{}
+	}
+
+	delegatorAddrs := make(map[string]struct{})
+	for _, a := range assignments {
+		if _, err := ac.StringToBytes(a.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator address %s: %w", a.DelegatorAddress, err)
+		}
+
+		if _, ok := delegateAddrs[a.DelegateAddress]; !ok {
+			return fmt.Errorf("delegate assignment %v has non-existent delegate: %s", a, a.DelegateAddress)
+		}
+
+		if _, ok := delegateAddrs[a.DelegatorAddress]; ok {
+			return fmt.Errorf("delegate %s cannot assign its voting power", a.DelegatorAddress)
+		}
+
+		if _, ok := delegatorAddrs[a.DelegatorAddress]; ok {
+			return fmt.Errorf("duplicate delegate assignment: %v", a)
+		}
+
+		delegatorAddrs[a.DelegatorAddress] = struct{}{}
+	}
+
+	return nil
+}
+
 var _ types.UnpackInterfacesMessage = GenesisState{}
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
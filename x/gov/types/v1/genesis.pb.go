@@ -52,6 +52,15 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// delegates defines all the governance delegates present at genesis.
+	//
+	// Since: x/gov 1.0.0
+	Delegates []*GovernanceDelegate `protobuf:"bytes,10,rep,name=delegates,proto3" json:"delegates,omitempty"`
+	// delegate_assignments defines all the assignments of governance voting power
+	// to delegates present at genesis.
+	//
+	// Since: x/gov 1.0.0
+	DelegateAssignments []*DelegateAssignment `protobuf:"bytes,11,rep,name=delegate_assignments,json=delegateAssignments,proto3" json:"delegate_assignments,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -153,6 +162,20 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetDelegates() []*GovernanceDelegate {
+	if m != nil {
+		return m.Delegates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegateAssignments() []*DelegateAssignment {
+	if m != nil {
+		return m.DelegateAssignments
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -160,30 +183,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xc2, 0x40,
-	0x14, 0x85, 0x19, 0xfe, 0x84, 0xa1, 0xb8, 0x18, 0x7f, 0x68, 0xc0, 0x34, 0x0d, 0xab, 0xba, 0xb0,
-	0x15, 0x0c, 0x0f, 0x20, 0x21, 0x21, 0xee, 0x48, 0x35, 0x2e, 0xdc, 0x90, 0x4a, 0x9b, 0xa6, 0x11,
-	0x7a, 0x9b, 0xce, 0x75, 0x22, 0x6f, 0xe1, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x63, 0xb8, 0x31,
-	0x4c, 0x5b, 0x81, 0xea, 0xf6, 0x9e, 0xef, 0x9c, 0x7b, 0x72, 0x73, 0x69, 0x67, 0x06, 0x7c, 0x01,
-	0xdc, 0xf2, 0x41, 0x58, 0xa2, 0x67, 0xf9, 0x5e, 0xe8, 0xf1, 0x80, 0x9b, 0x51, 0x0c, 0x08, 0xac,
-	0x99, 0x88, 0xa6, 0x0f, 0xc2, 0x14, 0xbd, 0x76, 0x2b, 0xc7, 0x82, 0x48, 0xb8, 0xee, 0x77, 0x89,
-	0x2a, 0xe3, 0xc4, 0x79, 0x8f, 0x0e, 0x7a, 0xec, 0x9a, 0x9e, 0x72, 0x74, 0x62, 0x0c, 0x42, 0x7f,
-	0x1a, 0xc5, 0x10, 0x01, 0x77, 0xe6, 0xd3, 0xc0, 0x55, 0x89, 0x4e, 0x8c, 0xb2, 0xcd, 0x32, 0x6d,
-	0x92, 0x4a, 0x77, 0x2e, 0xeb, 0xd3, 0x9a, 0xeb, 0x45, 0xc0, 0x03, 0xe4, 0x6a, 0x51, 0x2f, 0x19,
-	0x8d, 0xfe, 0xb9, 0x79, 0xb0, 0xdd, 0x1c, 0x25, 0xb2, 0xfd, 0xcb, 0xb1, 0x4b, 0x5a, 0x11, 0x80,
-	0x1e, 0x57, 0x4b, 0xd2, 0x70, 0x92, 0x33, 0x3c, 0x02, 0x7a, 0x76, 0x42, 0xb0, 0x01, 0xad, 0x67,
-	0x3d, 0xb8, 0x5a, 0x96, 0x78, 0x2b, 0x87, 0x67, 0x65, 0xec, 0x1d, 0xc9, 0xc6, 0xf4, 0x38, 0xdd,
-	0x36, 0x8d, 0x9c, 0xd8, 0x59, 0x70, 0xb5, 0xa2, 0x13, 0xa3, 0xd1, 0xbf, 0xf8, 0xbf, 0xdb, 0x44,
-	0x32, 0xc3, 0xa2, 0x4a, 0xec, 0xa6, 0xbb, 0x3f, 0x62, 0x23, 0xda, 0x14, 0x90, 0x9c, 0x23, 0xc9,
-	0xa9, 0xca, 0x9c, 0xce, 0xdf, 0xca, 0xdb, 0xb3, 0xec, 0x62, 0x14, 0xb1, 0x37, 0x61, 0xb7, 0x54,
-	0x41, 0x67, 0x3e, 0x5f, 0x66, 0x21, 0x47, 0x32, 0xa4, 0x9d, 0x0b, 0x79, 0xd8, 0x22, 0x7b, 0x19,
-	0x0d, 0xdc, 0x0d, 0xd8, 0x15, 0xad, 0xa6, 0xe6, 0x9a, 0x34, 0x9f, 0xe5, 0xaf, 0x20, 0x45, 0x3b,
-	0x85, 0x58, 0x97, 0x2a, 0x33, 0x08, 0x39, 0x06, 0xf8, 0x8a, 0x01, 0x84, 0x6a, 0x5d, 0x27, 0x46,
-	0xdd, 0x3e, 0x98, 0x0d, 0x07, 0x1f, 0x6b, 0x8d, 0xac, 0xd6, 0x1a, 0xf9, 0x5a, 0x6b, 0xe4, 0x7d,
-	0xa3, 0x15, 0x56, 0x1b, 0xad, 0xf0, 0xb9, 0xd1, 0x0a, 0x4f, 0xe9, 0x73, 0x71, 0xf7, 0xc5, 0x0c,
-	0xc0, 0x7a, 0x93, 0x8f, 0x83, 0xcb, 0xc8, 0xe3, 0x96, 0xe8, 0x3d, 0x57, 0xe5, 0xef, 0xdc, 0xfc,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x61, 0x60, 0xe9, 0xdf, 0x82, 0x02, 0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0x6d, 0x2d, 0xab, 0xdb, 0x72, 0xf0, 0x06, 0xb3, 0x36, 0x14, 0x95, 0x9d, 0xca,
+	0x81, 0x84, 0x16, 0xed, 0x8c, 0x36, 0x55, 0xaa, 0xb8, 0x4d, 0x66, 0xe2, 0xc0, 0x25, 0x32, 0x8d,
+	0x15, 0x59, 0xa4, 0x7e, 0x51, 0x9e, 0xb1, 0xd8, 0xb7, 0x40, 0x7c, 0x2a, 0x8e, 0x3b, 0x72, 0x44,
+	0xed, 0x17, 0x99, 0xea, 0x24, 0x4b, 0x97, 0xed, 0x98, 0xf7, 0x7e, 0xff, 0x9f, 0x9f, 0x5e, 0x1e,
+	0x3d, 0x5b, 0x02, 0xae, 0x00, 0xa3, 0x14, 0x5c, 0xe4, 0xa6, 0x51, 0xaa, 0x8c, 0x42, 0x8d, 0x61,
+	0x5e, 0x80, 0x05, 0x36, 0x2a, 0x9b, 0x61, 0x0a, 0x2e, 0x74, 0xd3, 0xd3, 0x93, 0x16, 0x0b, 0xae,
+	0xe4, 0xce, 0xff, 0x74, 0xe9, 0x70, 0x51, 0x26, 0xbf, 0x58, 0x69, 0x15, 0xfb, 0x40, 0x8f, 0xd1,
+	0xca, 0xc2, 0x6a, 0x93, 0xc6, 0x79, 0x01, 0x39, 0xa0, 0xcc, 0x62, 0x9d, 0x70, 0x32, 0x26, 0x93,
+	0x03, 0xc1, 0xea, 0xde, 0x75, 0xd5, 0xfa, 0x9c, 0xb0, 0x19, 0x3d, 0x4c, 0x54, 0x0e, 0xa8, 0x2d,
+	0xf2, 0xbd, 0xf1, 0xfe, 0x64, 0x30, 0x7b, 0x1d, 0x3e, 0x7a, 0x3d, 0x9c, 0x97, 0x6d, 0xf1, 0xc0,
+	0xb1, 0x77, 0xb4, 0xeb, 0xc0, 0x2a, 0xe4, 0xfb, 0x3e, 0x70, 0xd4, 0x0a, 0x7c, 0x05, 0xab, 0x44,
+	0x49, 0xb0, 0x0b, 0xda, 0xaf, 0xe7, 0x40, 0x7e, 0xe0, 0xf1, 0x93, 0x16, 0x5e, 0x0f, 0x23, 0x1a,
+	0x92, 0x2d, 0xe8, 0xcb, 0xea, 0xb5, 0x38, 0x97, 0x85, 0x5c, 0x21, 0xef, 0x8e, 0xc9, 0x64, 0x30,
+	0x7b, 0xf3, 0xfc, 0x6c, 0xd7, 0x9e, 0xb9, 0xda, 0xe3, 0x44, 0x8c, 0x92, 0xdd, 0x12, 0x9b, 0xd3,
+	0x91, 0x83, 0x72, 0x1d, 0xa5, 0xa7, 0xe7, 0x3d, 0x67, 0x4f, 0x47, 0xde, 0xae, 0xa5, 0xd1, 0x0c,
+	0xdd, 0x4e, 0x85, 0x5d, 0xd2, 0xa1, 0x95, 0x59, 0x76, 0x5b, 0x4b, 0x5e, 0x78, 0xc9, 0x69, 0x4b,
+	0x72, 0xb3, 0x45, 0x76, 0x1c, 0x03, 0xdb, 0x14, 0xd8, 0x7b, 0xda, 0xab, 0xc2, 0x87, 0x3e, 0xfc,
+	0xaa, 0xbd, 0x05, 0xdf, 0x14, 0x15, 0xc4, 0xce, 0xe9, 0x70, 0x09, 0x06, 0xad, 0xb6, 0x3f, 0xad,
+	0x06, 0xc3, 0xfb, 0x63, 0x32, 0xe9, 0x8b, 0x47, 0x35, 0xf6, 0x89, 0xf6, 0x13, 0x95, 0xa9, 0x54,
+	0x6e, 0x7f, 0x05, 0xf5, 0xbb, 0x7d, 0xdb, 0xb2, 0x2e, 0xc0, 0xa9, 0xc2, 0x48, 0xb3, 0x54, 0xf3,
+	0x8a, 0x14, 0x4d, 0x86, 0xdd, 0xd0, 0xe3, 0xfa, 0x23, 0x96, 0x88, 0x3a, 0x35, 0x2b, 0x65, 0x2c,
+	0xf2, 0xc1, 0xb3, 0xae, 0xda, 0x70, 0xf9, 0x40, 0x8a, 0xa3, 0xe4, 0x49, 0x0d, 0xaf, 0x2e, 0xfe,
+	0xae, 0x03, 0x72, 0xb7, 0x0e, 0xc8, 0xff, 0x75, 0x40, 0x7e, 0x6f, 0x82, 0xce, 0xdd, 0x26, 0xe8,
+	0xfc, 0xdb, 0x04, 0x9d, 0x6f, 0xd5, 0xcd, 0x63, 0xf2, 0x23, 0xd4, 0x10, 0xfd, 0xf2, 0xf7, 0x6c,
+	0x6f, 0x73, 0x85, 0x91, 0x9b, 0x7e, 0xef, 0xf9, 0x93, 0xfe, 0x78, 0x3f, 0x00, 0xbc, 0x9e, 0x27,
+	0x28, 0x19, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -206,6 +233,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegateAssignments) > 0 {
+		for iNdEx := len(m.DelegateAssignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateAssignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Delegates) > 0 {
+		for iNdEx := len(m.Delegates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -369,6 +424,18 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Delegates) > 0 {
+		for _, e := range m.Delegates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateAssignments) > 0 {
+		for _, e := range m.DelegateAssignments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -704,6 +771,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegates = append(m.Delegates, &GovernanceDelegate{})
+			if err := m.Delegates[len(m.Delegates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateAssignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateAssignments = append(m.DelegateAssignments, &DelegateAssignment{})
+			if err := m.DelegateAssignments[len(m.DelegateAssignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,6 +23,7 @@ func TestEmptyGenesis(t *testing.T) {
 func TestValidateGenesis(t *testing.T) {
 	codec := address.NewBech32Codec("cosmos")
 	params := v1.DefaultParams()
+	delegate, delegator := "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", "cosmos1w3jhxap3gempvr"
 
 	testCases := []struct {
 		name         string
@@ -95,6 +96,45 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "veto threshold too large",
 		},
+		{
+			name: "invalid timelock guardian",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TimelockGuardians = []string{"guardian"}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "invalid timelock guardian address",
+		},
+		{
+			name: "duplicate timelock guardians",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TimelockGuardians = []string{delegate, delegate}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "duplicate timelock guardian address",
+		},
+		{
+			name: "invalid timelock veto threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TimelockVetoThreshold = "2"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "timelock veto threshold too large",
+		},
+		{
+			name: "enabled timelock veto",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TimelockVetoThreshold = "0.5"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+		},
 		{
 			name: "duplicate proposals",
 			genesisState: func() *v1.GenesisState {
@@ -106,6 +146,16 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "duplicate proposal id: 1",
 		},
+		{
+			name: "queued proposal without execution time",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1, Status: v1.StatusQueued})
+
+				return state
+			},
+			expErrMsg: "queued proposal 1 has no execution time",
+		},
 		{
 			name: "duplicate votes",
 			genesisState: func() *v1.GenesisState {
@@ -172,6 +222,71 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid delegates",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Delegates = []*v1.GovernanceDelegate{{Address: delegate, Metadata: "metadata"}}
+				state.DelegateAssignments = []*v1.DelegateAssignment{{DelegatorAddress: delegator, DelegateAddress: delegate}}
+
+				return state
+			},
+		},
+		{
+			name: "duplicate delegates",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Delegates = []*v1.GovernanceDelegate{{Address: delegate}, {Address: delegate}}
+
+				return state
+			},
+			expErrMsg: "duplicate delegate",
+		},
+		{
+			name: "invalid delegate address",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Delegates = []*v1.GovernanceDelegate{{Address: "delegate"}}
+
+				return state
+			},
+			expErrMsg: "invalid delegate address",
+		},
+		{
+			name: "non-existent delegate in delegate assignments",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.DelegateAssignments = []*v1.DelegateAssignment{{DelegatorAddress: delegator, DelegateAddress: delegate}}
+
+				return state
+			},
+			expErrMsg: "has non-existent delegate",
+		},
+		{
+			name: "delegate assigning its voting power",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Delegates = []*v1.GovernanceDelegate{{Address: delegate}, {Address: delegator}}
+				state.DelegateAssignments = []*v1.DelegateAssignment{{DelegatorAddress: delegator, DelegateAddress: delegate}}
+
+				return state
+			},
+			expErrMsg: "cannot assign its voting power",
+		},
+		{
+			name: "duplicate delegate assignments",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Delegates = []*v1.GovernanceDelegate{{Address: delegate}}
+				state.DelegateAssignments = []*v1.DelegateAssignment{
+					{DelegatorAddress: delegator, DelegateAddress: delegate},
+					{DelegatorAddress: delegator, DelegateAddress: delegate},
+				}
+
+				return state
+			},
+			expErrMsg: "duplicate delegate assignment",
+		},
 	}
 
 	for _, tc := range testCases {
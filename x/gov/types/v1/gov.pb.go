@@ -154,6 +154,11 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_QUEUED defines a proposal status of a proposal that has
+	// passed and waits for its execution time to be executed.
+	//
+	// Since: x/gov v1.0.0
+	ProposalStatus_PROPOSAL_STATUS_QUEUED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -163,6 +168,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_QUEUED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -172,6 +178,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_QUEUED":         6,
 }
 
 func (x ProposalStatus) String() string {
@@ -353,6 +360,11 @@ type Proposal struct {
 	//
 	// Since: x/gov v1.0.0
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// execution_time is the earliest time at which a queued proposal is executed.
+	// It is only set for proposals which passed and whose messages have an execution delay.
+	//
+	// Since: x/gov v1.0.0
+	ExecutionTime *time.Time `protobuf:"bytes,17,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -501,6 +513,13 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetExecutionTime() *time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return nil
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 //
@@ -971,15 +990,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -998,7 +1017,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.50
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	//
@@ -1040,6 +1059,17 @@ type Params struct {
 	//
 	// Since: x/gov v1.0.0
 	YesQuorum string `protobuf:"bytes,20,opt,name=yes_quorum,json=yesQuorum,proto3" json:"yes_quorum,omitempty"`
+	// timelock_guardians defines the accounts allowed to cancel a queued proposal
+	// before its execution time.
+	//
+	// Since: x/gov v1.0.0
+	TimelockGuardians []string `protobuf:"bytes,21,rep,name=timelock_guardians,json=timelockGuardians,proto3" json:"timelock_guardians,omitempty"`
+	// timelock_veto_threshold defines the minimum percentage of the total bonded tokens
+	// voting NoWithVeto on a queued proposal for it to be canceled at its execution time.
+	// An empty value disables the emergency veto.
+	//
+	// Since: x/gov v1.0.0
+	TimelockVetoThreshold string `protobuf:"bytes,22,opt,name=timelock_veto_threshold,json=timelockVetoThreshold,proto3" json:"timelock_veto_threshold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1215,6 +1245,20 @@ func (m *Params) GetYesQuorum() string {
 	return ""
 }
 
+func (m *Params) GetTimelockGuardians() []string {
+	if m != nil {
+		return m.TimelockGuardians
+	}
+	return nil
+}
+
+func (m *Params) GetTimelockVetoThreshold() string {
+	if m != nil {
+		return m.TimelockVetoThreshold
+	}
+	return ""
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1232,6 +1276,11 @@ type MessageBasedParams struct {
 	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed.
 	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// execution_delay defines the delay between the end of the voting period of a
+	// passed proposal and the execution of its messages. No delay is applied when unset.
+	//
+	// Since: x/gov v1.0.0
+	ExecutionDelay *time.Duration `protobuf:"bytes,5,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay,omitempty"`
 }
 
 func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
@@ -1302,6 +1351,130 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *MessageBasedParams) GetExecutionDelay() *time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return nil
+}
+
+// GovernanceDelegate defines an account registered as a governance delegate.
+// Delegators can assign their governance voting power to a delegate, which then
+// votes on their behalf unless they vote themselves.
+//
+// Since: x/gov 1.0.0
+type GovernanceDelegate struct {
+	// address is the address of the delegate.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// metadata is any arbitrary metadata attached to the delegate.
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *GovernanceDelegate) Reset()         { *m = GovernanceDelegate{} }
+func (m *GovernanceDelegate) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegate) ProtoMessage()    {}
+func (*GovernanceDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *GovernanceDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegate.Merge(m, src)
+}
+func (m *GovernanceDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegate proto.InternalMessageInfo
+
+func (m *GovernanceDelegate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GovernanceDelegate) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// DelegateAssignment defines the assignment of the governance voting power of a
+// delegator to a governance delegate.
+//
+// Since: x/gov 1.0.0
+type DelegateAssignment struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// delegate_address is the address of the governance delegate.
+	DelegateAddress string `protobuf:"bytes,2,opt,name=delegate_address,json=delegateAddress,proto3" json:"delegate_address,omitempty"`
+}
+
+func (m *DelegateAssignment) Reset()         { *m = DelegateAssignment{} }
+func (m *DelegateAssignment) String() string { return proto.CompactTextString(m) }
+func (*DelegateAssignment) ProtoMessage()    {}
+func (*DelegateAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *DelegateAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateAssignment.Merge(m, src)
+}
+func (m *DelegateAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateAssignment proto.InternalMessageInfo
+
+func (m *DelegateAssignment) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegateAssignment) GetDelegateAddress() string {
+	if m != nil {
+		return m.DelegateAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1317,128 +1490,140 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*GovernanceDelegate)(nil), "cosmos.gov.v1.GovernanceDelegate")
+	proto.RegisterType((*DelegateAssignment)(nil), "cosmos.gov.v1.DelegateAssignment")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x73, 0xe3, 0x48,
-	0x15, 0x1f, 0xd9, 0x8e, 0x63, 0xbf, 0x38, 0x8e, 0xa6, 0x93, 0xd9, 0x28, 0xc9, 0xe6, 0x63, 0xcc,
-	0xd6, 0x56, 0x6a, 0xd8, 0xd8, 0x64, 0x61, 0x28, 0x6a, 0x59, 0x0a, 0xec, 0x58, 0x43, 0x34, 0x24,
-	0xb1, 0x91, 0x35, 0xc9, 0x0c, 0x17, 0xa1, 0x44, 0x3d, 0x89, 0xc0, 0x52, 0x1b, 0xa9, 0x9d, 0xc4,
-	0xfc, 0x15, 0x7b, 0xa2, 0x38, 0x51, 0xdc, 0xe0, 0xc8, 0x61, 0x8b, 0xe2, 0xca, 0x6d, 0x8b, 0x03,
-	0xb5, 0xb5, 0x27, 0x2e, 0x0c, 0xd4, 0xcc, 0x81, 0xaa, 0xfd, 0x13, 0x28, 0x0e, 0x54, 0x7f, 0xc8,
-	0x92, 0x1d, 0x67, 0x92, 0x6c, 0xed, 0x25, 0x91, 0xde, 0xfb, 0xfd, 0x5e, 0xbf, 0x7e, 0x5f, 0xdd,
-	0x16, 0x2c, 0x9e, 0x90, 0xc8, 0x27, 0x51, 0xed, 0x94, 0x9c, 0xd7, 0xce, 0xb7, 0xd9, 0xbf, 0x6a,
-	0x2f, 0x24, 0x94, 0xa0, 0x59, 0xa1, 0xa8, 0x32, 0xc9, 0xf9, 0xf6, 0xf2, 0x9a, 0xc4, 0x1d, 0x3b,
-	0x11, 0xae, 0x9d, 0x6f, 0x1f, 0x63, 0xea, 0x6c, 0xd7, 0x4e, 0x88, 0x17, 0x08, 0xf8, 0xf2, 0xc2,
-	0x29, 0x39, 0x25, 0xfc, 0xb1, 0xc6, 0x9e, 0xa4, 0x74, 0xfd, 0x94, 0x90, 0xd3, 0x2e, 0xae, 0xf1,
-	0xb7, 0xe3, 0xfe, 0xcb, 0x1a, 0xf5, 0x7c, 0x1c, 0x51, 0xc7, 0xef, 0x49, 0xc0, 0xd2, 0x38, 0xc0,
-	0x09, 0x06, 0x52, 0xb5, 0x36, 0xae, 0x72, 0xfb, 0xa1, 0x43, 0x3d, 0x12, 0xaf, 0xb8, 0x24, 0x3c,
-	0xb2, 0xc5, 0xa2, 0xd2, 0x5b, 0xa1, 0xba, 0xef, 0xf8, 0x5e, 0x40, 0x6a, 0xfc, 0xaf, 0x10, 0x55,
-	0x08, 0xa0, 0x23, 0xec, 0x9d, 0x9e, 0x51, 0xec, 0x1e, 0x12, 0x8a, 0x5b, 0x3d, 0x66, 0x09, 0x6d,
-	0x43, 0x9e, 0xf0, 0x27, 0x4d, 0xd9, 0x50, 0x36, 0xcb, 0x1f, 0x2e, 0x55, 0x47, 0x76, 0x5d, 0x4d,
-	0xa0, 0xa6, 0x04, 0xa2, 0xf7, 0x21, 0x7f, 0xc1, 0x0d, 0x69, 0x99, 0x0d, 0x65, 0xb3, 0xd8, 0x28,
-	0x7f, 0xf1, 0xe9, 0x16, 0x48, 0x56, 0x13, 0x9f, 0x98, 0x52, 0x5b, 0xf9, 0xbd, 0x02, 0xd3, 0x4d,
-	0xdc, 0x23, 0x91, 0x47, 0xd1, 0x3a, 0xcc, 0xf4, 0x42, 0xd2, 0x23, 0x91, 0xd3, 0xb5, 0x3d, 0x97,
-	0xaf, 0x95, 0x33, 0x21, 0x16, 0x19, 0x2e, 0xfa, 0x2e, 0x14, 0x5d, 0x81, 0x25, 0xa1, 0xb4, 0xab,
-	0x7d, 0xf1, 0xe9, 0xd6, 0x82, 0xb4, 0x5b, 0x77, 0xdd, 0x10, 0x47, 0x51, 0x87, 0x86, 0x5e, 0x70,
-	0x6a, 0x26, 0x50, 0xf4, 0x31, 0xe4, 0x1d, 0x9f, 0xf4, 0x03, 0xaa, 0x65, 0x37, 0xb2, 0x9b, 0x33,
-	0x89, 0xff, 0x2c, 0x4d, 0x55, 0x99, 0xa6, 0xea, 0x0e, 0xf1, 0x82, 0x46, 0xf1, 0xb3, 0x57, 0xeb,
-	0xf7, 0xfe, 0xf8, 0x9f, 0x3f, 0x3d, 0x52, 0x4c, 0xc9, 0xa9, 0xfc, 0x2d, 0x0f, 0x85, 0xb6, 0x74,
-	0x02, 0x95, 0x21, 0x33, 0x74, 0x2d, 0xe3, 0xb9, 0xe8, 0x5b, 0x50, 0xf0, 0x71, 0x14, 0x39, 0xa7,
-	0x38, 0xd2, 0x32, 0xdc, 0xf8, 0x42, 0x55, 0x64, 0xa4, 0x1a, 0x67, 0xa4, 0x5a, 0x0f, 0x06, 0xe6,
-	0x10, 0x85, 0x1e, 0x43, 0x3e, 0xa2, 0x0e, 0xed, 0x47, 0x5a, 0x96, 0x07, 0x73, 0x75, 0x2c, 0x98,
-	0xf1, 0x52, 0x1d, 0x0e, 0x32, 0x25, 0x18, 0xed, 0x02, 0x7a, 0xe9, 0x05, 0x4e, 0xd7, 0xa6, 0x4e,
-	0xb7, 0x3b, 0xb0, 0x43, 0x1c, 0xf5, 0xbb, 0x54, 0xcb, 0x6d, 0x28, 0x9b, 0x33, 0x1f, 0x2e, 0x8f,
-	0x99, 0xb0, 0x18, 0xc4, 0xe4, 0x08, 0x53, 0xe5, 0xac, 0x94, 0x04, 0xd5, 0x61, 0x26, 0xea, 0x1f,
-	0xfb, 0x1e, 0xb5, 0x59, 0x99, 0x69, 0x53, 0xd2, 0xc4, 0xb8, 0xd7, 0x56, 0x5c, 0x83, 0x8d, 0xdc,
-	0x27, 0xff, 0x5a, 0x57, 0x4c, 0x10, 0x24, 0x26, 0x46, 0x4f, 0x41, 0x95, 0xd1, 0xb5, 0x71, 0xe0,
-	0x0a, 0x3b, 0xf9, 0x5b, 0xda, 0x29, 0x4b, 0xa6, 0x1e, 0xb8, 0xdc, 0x96, 0x01, 0xb3, 0x94, 0x50,
-	0xa7, 0x6b, 0x4b, 0xb9, 0x36, 0x7d, 0x87, 0x1c, 0x95, 0x38, 0x35, 0x2e, 0xa0, 0x3d, 0xb8, 0x7f,
-	0x4e, 0xa8, 0x17, 0x9c, 0xda, 0x11, 0x75, 0x42, 0xb9, 0xbf, 0xc2, 0x2d, 0xfd, 0x9a, 0x13, 0xd4,
-	0x0e, 0x63, 0x72, 0xc7, 0x76, 0x41, 0x8a, 0x92, 0x3d, 0x16, 0x6f, 0x69, 0x6b, 0x56, 0x10, 0xe3,
-	0x2d, 0x2e, 0xb3, 0x22, 0xa1, 0x8e, 0xeb, 0x50, 0x47, 0x03, 0x56, 0xb6, 0xe6, 0xf0, 0x1d, 0x2d,
-	0xc0, 0x14, 0xf5, 0x68, 0x17, 0x6b, 0x33, 0x5c, 0x21, 0x5e, 0x90, 0x06, 0xd3, 0x51, 0xdf, 0xf7,
-	0x9d, 0x70, 0xa0, 0x95, 0xb8, 0x3c, 0x7e, 0x45, 0xdf, 0x81, 0x82, 0xe8, 0x08, 0x1c, 0x6a, 0xb3,
-	0x37, 0xb4, 0xc0, 0x10, 0x89, 0x36, 0xa0, 0x88, 0x2f, 0x7b, 0xd8, 0xf5, 0x28, 0x76, 0xb5, 0xf2,
-	0x86, 0xb2, 0x59, 0x68, 0x64, 0x34, 0xc5, 0x4c, 0x84, 0xe8, 0x1b, 0x30, 0xfb, 0xd2, 0xf1, 0xba,
-	0xd8, 0xb5, 0x43, 0xec, 0x44, 0x24, 0xd0, 0xe6, 0xf8, 0xba, 0x25, 0x21, 0x34, 0xb9, 0x0c, 0xfd,
-	0x08, 0x66, 0x87, 0x1d, 0x4a, 0x07, 0x3d, 0xac, 0xa9, 0xbc, 0x84, 0x57, 0xae, 0x29, 0x61, 0x6b,
-	0xd0, 0xc3, 0x66, 0xa9, 0x97, 0x7a, 0xab, 0xfc, 0x45, 0x81, 0xf9, 0x58, 0x9d, 0x8c, 0x8d, 0x08,
-	0xad, 0x02, 0x88, 0xc9, 0x61, 0x93, 0x00, 0xf3, 0xfe, 0x2a, 0x9a, 0x45, 0x21, 0x69, 0x05, 0x38,
-	0xa5, 0xa6, 0x17, 0x44, 0xb4, 0x7e, 0xac, 0xb6, 0x2e, 0x08, 0x7a, 0x08, 0xa5, 0x58, 0x7d, 0x16,
-	0x62, 0xcc, 0x3b, 0xab, 0x68, 0xce, 0x48, 0x00, 0x13, 0xb1, 0xe1, 0x22, 0x21, 0x2f, 0x49, 0x3f,
-	0xe4, 0x8d, 0x53, 0x34, 0xa5, 0xd1, 0x27, 0xa4, 0x1f, 0xa6, 0x00, 0x51, 0xcf, 0xf1, 0x79, 0x5b,
-	0x0c, 0x01, 0x9d, 0x9e, 0xe3, 0x57, 0xfe, 0x97, 0x85, 0x99, 0x74, 0x1f, 0x6d, 0x41, 0x71, 0x80,
-	0x23, 0xfb, 0x84, 0x0f, 0x16, 0xee, 0x71, 0x43, 0x4d, 0x4d, 0x39, 0x83, 0x49, 0xcd, 0xc2, 0x00,
-	0x47, 0x3b, 0x0c, 0x81, 0x1e, 0xc3, 0xac, 0x73, 0x1c, 0x51, 0xc7, 0x0b, 0x24, 0x25, 0x73, 0x0d,
-	0xa5, 0x24, 0x61, 0x82, 0xf6, 0x4d, 0x28, 0x04, 0x44, 0x32, 0xb2, 0xd7, 0x30, 0xa6, 0x03, 0x22,
-	0xc0, 0x3f, 0x00, 0x14, 0x10, 0xfb, 0xc2, 0xa3, 0x67, 0xf6, 0x39, 0xa6, 0x31, 0x2d, 0x77, 0x0d,
-	0x6d, 0x2e, 0x20, 0x47, 0x1e, 0x3d, 0x3b, 0xc4, 0x54, 0xd2, 0xbf, 0x07, 0x6a, 0x92, 0x04, 0x49,
-	0x9e, 0xba, 0x32, 0xbe, 0x8d, 0x80, 0x9a, 0xe5, 0x61, 0x6a, 0xc6, 0x99, 0xf4, 0x22, 0x5e, 0x36,
-	0xff, 0x36, 0xa6, 0x75, 0x21, 0xd7, 0xfc, 0x18, 0x50, 0x3a, 0x75, 0x92, 0x3b, 0x3d, 0x91, 0xab,
-	0xa6, 0x12, 0x2a, 0xd8, 0x1f, 0xc1, 0xfd, 0x54, 0x56, 0x25, 0xb9, 0x30, 0x91, 0x3c, 0x97, 0xe4,
-	0x5a, 0x70, 0xb7, 0x00, 0x58, 0xa6, 0x25, 0xa9, 0x38, 0x91, 0x54, 0x64, 0x08, 0x0e, 0xaf, 0xfc,
-	0x59, 0x81, 0x1c, 0xab, 0xd8, 0x9b, 0x8f, 0xa9, 0x2a, 0x4c, 0x9d, 0x13, 0x8a, 0x6f, 0x3e, 0xa2,
-	0x04, 0x0c, 0x7d, 0x1f, 0xa6, 0x85, 0x6f, 0x91, 0x96, 0xe3, 0xb3, 0xef, 0xe1, 0x58, 0x3f, 0x5d,
-	0x3d, 0x92, 0xcd, 0x98, 0x31, 0x32, 0x5b, 0xa6, 0x46, 0x67, 0xcb, 0xd3, 0x5c, 0x21, 0xab, 0xe6,
-	0x2a, 0xff, 0x54, 0x60, 0x56, 0x4e, 0xc8, 0xb6, 0x13, 0x3a, 0x7e, 0x84, 0x5e, 0xc0, 0x8c, 0xef,
-	0x05, 0xc3, 0x81, 0xab, 0xdc, 0x34, 0x70, 0x57, 0xd9, 0xc0, 0xfd, 0xf2, 0xd5, 0xfa, 0x83, 0x14,
-	0xeb, 0x03, 0xe2, 0x7b, 0x14, 0xfb, 0x3d, 0x3a, 0x30, 0xc1, 0xf7, 0x82, 0x78, 0x04, 0xfb, 0x80,
-	0x7c, 0xe7, 0x32, 0x06, 0xd9, 0x3d, 0x1c, 0x7a, 0xc4, 0xe5, 0x81, 0x60, 0x2b, 0x8c, 0xcf, 0xcd,
-	0xa6, 0xbc, 0xab, 0x34, 0xde, 0xfb, 0xf2, 0xd5, 0xfa, 0xbb, 0x57, 0x89, 0xc9, 0x22, 0xbf, 0x65,
-	0x63, 0x55, 0xf5, 0x9d, 0xcb, 0x78, 0x27, 0x5c, 0xff, 0x51, 0x46, 0x53, 0x2a, 0xcf, 0xa1, 0x74,
-	0xc8, 0xc7, 0xad, 0xdc, 0x5d, 0x13, 0xe4, 0xf8, 0x8d, 0x57, 0x57, 0x6e, 0x5a, 0x3d, 0xc7, 0xad,
-	0x97, 0x04, 0x2b, 0x65, 0xf9, 0x77, 0x8a, 0xec, 0x78, 0x69, 0xf9, 0x7d, 0xc8, 0xff, 0xaa, 0x4f,
-	0xc2, 0xbe, 0x2f, 0xdb, 0xfd, 0xca, 0xa5, 0x46, 0x68, 0xd1, 0x07, 0x50, 0x64, 0xc5, 0x1c, 0x9d,
-	0x91, 0xae, 0x7b, 0xcd, 0xfd, 0x27, 0x01, 0xa0, 0xc7, 0x50, 0xe6, 0xcd, 0x9a, 0x50, 0xb2, 0x13,
-	0x29, 0xb3, 0x0c, 0x65, 0xc5, 0x20, 0xee, 0xe0, 0x5f, 0x01, 0xf2, 0xd2, 0x37, 0xfd, 0x8e, 0x39,
-	0x4d, 0x1d, 0xa2, 0xe9, 0xfc, 0xed, 0x7f, 0xb5, 0xfc, 0xe5, 0x26, 0xe7, 0xe7, 0x6a, 0x2e, 0xb2,
-	0x5f, 0x21, 0x17, 0xa9, 0xb8, 0xe7, 0x6e, 0x1f, 0xf7, 0xa9, 0xbb, 0xc7, 0x3d, 0x7f, 0x8b, 0xb8,
-	0x23, 0x03, 0x96, 0x58, 0xa0, 0xbd, 0xc0, 0xa3, 0x5e, 0x72, 0x6b, 0xb1, 0xb9, 0xfb, 0x13, 0xe6,
-	0x16, 0xb3, 0xf0, 0x8e, 0xef, 0x05, 0x86, 0xc0, 0xcb, 0xf0, 0x98, 0x0c, 0x8d, 0x1a, 0xf0, 0x60,
-	0x38, 0x49, 0x4e, 0x9c, 0xe0, 0x04, 0x77, 0xa5, 0x99, 0xc2, 0x44, 0x33, 0xf3, 0x31, 0x78, 0x87,
-	0x63, 0x85, 0x8d, 0xa7, 0xb0, 0x30, 0x6e, 0xc3, 0xc5, 0x51, 0x3c, 0xcf, 0xae, 0x9f, 0x3d, 0x68,
-	0xd4, 0x58, 0x13, 0x47, 0x14, 0x1d, 0xc1, 0xe2, 0xf0, 0x42, 0x60, 0x8f, 0xe6, 0x0d, 0x6e, 0x97,
-	0xb7, 0x07, 0x43, 0xfe, 0x61, 0x3a, 0x81, 0x3f, 0x84, 0xf9, 0xc4, 0x70, 0x12, 0xef, 0x99, 0x89,
-	0xdb, 0x44, 0x43, 0x68, 0x12, 0xf4, 0xe7, 0x90, 0x58, 0xb6, 0xd3, 0x75, 0x5e, 0xba, 0x43, 0x9d,
-	0x27, 0x3e, 0xec, 0x27, 0x05, 0xbf, 0x09, 0xea, 0x71, 0x3f, 0x0c, 0xd8, 0x76, 0xb1, 0x2d, 0xab,
-	0x8c, 0xdd, 0xab, 0x0a, 0x66, 0x99, 0xc9, 0xd9, 0xc8, 0xfd, 0xa9, 0xa8, 0xae, 0x3a, 0xac, 0x72,
-	0xe4, 0x30, 0xdc, 0xc3, 0x26, 0x09, 0x31, 0x63, 0x8b, 0x7b, 0x95, 0xb9, 0xcc, 0x40, 0xf1, 0x15,
-	0x27, 0xee, 0x06, 0x81, 0x40, 0xef, 0x41, 0x39, 0x59, 0x8c, 0x95, 0x15, 0xbf, 0x65, 0x15, 0xcc,
-	0x52, 0xbc, 0x14, 0x3b, 0x8b, 0xd9, 0xa1, 0x96, 0xda, 0xa2, 0x2c, 0x09, 0x75, 0x62, 0xac, 0xe6,
-	0x92, 0xd6, 0x15, 0xe5, 0xf0, 0x13, 0x58, 0x1e, 0x2f, 0x07, 0xd6, 0xcf, 0x32, 0x8b, 0xf7, 0x27,
-	0x1a, 0x59, 0x1c, 0x2d, 0x85, 0x7d, 0xe7, 0x52, 0xa6, 0xed, 0xe7, 0xb0, 0xce, 0x8e, 0x19, 0xdf,
-	0x8b, 0xa8, 0x77, 0x62, 0x3b, 0x7d, 0x7a, 0x46, 0x42, 0xef, 0xd7, 0xd8, 0xb5, 0x1d, 0x51, 0x4a,
-	0x38, 0xd2, 0xd0, 0x46, 0xf6, 0xad, 0x65, 0xb6, 0x9a, 0x18, 0xa8, 0x0f, 0xf9, 0xf5, 0x98, 0x8e,
-	0x4c, 0x48, 0x01, 0xec, 0x10, 0xff, 0x02, 0x9f, 0x8c, 0x96, 0xc8, 0xfc, 0x44, 0x8f, 0x57, 0x12,
-	0x92, 0x29, 0x39, 0x49, 0xad, 0x6c, 0x01, 0xb0, 0x7b, 0x99, 0xcc, 0xe5, 0xc2, 0xe4, 0x31, 0x30,
-	0xc0, 0x91, 0x48, 0x6b, 0xe5, 0x37, 0x19, 0x40, 0xfb, 0xe2, 0xc7, 0x59, 0xc3, 0x89, 0xb0, 0xfb,
-	0x75, 0x9e, 0x22, 0xa9, 0xc9, 0x95, 0x79, 0xeb, 0xe4, 0xba, 0x9b, 0xcf, 0xa3, 0x83, 0x2e, 0x7b,
-	0xf7, 0x41, 0x97, 0xbb, 0xc5, 0xa0, 0x7b, 0xf4, 0x07, 0x05, 0x4a, 0xe9, 0x9b, 0x3c, 0x5a, 0x85,
-	0xa5, 0xb6, 0xd9, 0x6a, 0xb7, 0x3a, 0xf5, 0x3d, 0xdb, 0x7a, 0xd1, 0xd6, 0xed, 0x67, 0x07, 0x9d,
-	0xb6, 0xbe, 0x63, 0x3c, 0x31, 0xf4, 0xa6, 0x7a, 0x0f, 0x2d, 0xc3, 0x3b, 0xa3, 0xea, 0x8e, 0x55,
-	0x3f, 0x68, 0xd6, 0xcd, 0xa6, 0xaa, 0xa0, 0x87, 0xb0, 0x3a, 0xaa, 0xdb, 0x7f, 0xb6, 0x67, 0x19,
-	0xed, 0x3d, 0xdd, 0xde, 0xd9, 0x6d, 0x19, 0x3b, 0xba, 0x9a, 0x41, 0xef, 0x82, 0x36, 0x0a, 0x69,
-	0xb5, 0x2d, 0x63, 0xdf, 0xe8, 0x58, 0xc6, 0x8e, 0x9a, 0x45, 0x2b, 0xb0, 0x38, 0xaa, 0xd5, 0x9f,
-	0xb7, 0xf5, 0xa6, 0x61, 0xe9, 0x4d, 0x35, 0xf7, 0xe8, 0xbf, 0x0a, 0x40, 0xea, 0x73, 0xc5, 0x0a,
-	0x2c, 0x1e, 0xb6, 0x2c, 0x61, 0xa0, 0x75, 0x30, 0xe6, 0xe5, 0x3c, 0xcc, 0xa5, 0x95, 0xad, 0x03,
-	0x5d, 0x55, 0xc6, 0x85, 0x2f, 0xf4, 0xce, 0x55, 0xa1, 0x75, 0xd4, 0x52, 0x33, 0x68, 0x11, 0xe6,
-	0xd3, 0xc2, 0x7a, 0xa3, 0x63, 0xd5, 0x8d, 0x03, 0x35, 0x83, 0x1e, 0xc0, 0xfd, 0x11, 0xf4, 0xae,
-	0xa9, 0xeb, 0x6a, 0x16, 0x21, 0x28, 0xa7, 0xc5, 0x07, 0x2d, 0x35, 0x8b, 0x16, 0x40, 0x4d, 0xcb,
-	0x9e, 0xb4, 0x9e, 0x99, 0x6a, 0x8e, 0xed, 0x7f, 0x14, 0x69, 0x1f, 0x19, 0xd6, 0xae, 0x7d, 0xa8,
-	0x5b, 0x2d, 0x35, 0x37, 0xce, 0xe9, 0xb4, 0xeb, 0xfb, 0xea, 0xd4, 0x72, 0x46, 0x55, 0x1e, 0xfd,
-	0x5d, 0x81, 0xf2, 0xe8, 0x37, 0x03, 0xb4, 0x0e, 0x2b, 0xc3, 0x60, 0x75, 0xac, 0xba, 0xf5, 0xac,
-	0x33, 0x16, 0x84, 0x0a, 0xac, 0x8d, 0x03, 0x9a, 0x7a, 0xbb, 0xd5, 0x31, 0x2c, 0xbb, 0xad, 0x9b,
-	0x46, 0x6b, 0x3c, 0x65, 0x12, 0x73, 0xd8, 0xb2, 0x8c, 0x83, 0x1f, 0xc7, 0x90, 0xcc, 0x48, 0xc6,
-	0x25, 0xa4, 0x5d, 0xef, 0x74, 0xf4, 0xa6, 0x9a, 0x1d, 0x49, 0xa7, 0xd4, 0x99, 0xfa, 0x53, 0x7d,
-	0x87, 0x67, 0x6c, 0x12, 0xf3, 0x49, 0xdd, 0xd8, 0xd3, 0x9b, 0xea, 0x54, 0xe3, 0xf1, 0x67, 0xaf,
-	0xd7, 0x94, 0xcf, 0x5f, 0xaf, 0x29, 0xff, 0x7e, 0xbd, 0xa6, 0x7c, 0xf2, 0x66, 0xed, 0xde, 0xe7,
-	0x6f, 0xd6, 0xee, 0xfd, 0xe3, 0xcd, 0xda, 0xbd, 0x9f, 0xad, 0x88, 0x62, 0x8d, 0xdc, 0x5f, 0x56,
-	0x3d, 0x52, 0xbb, 0xe4, 0x5f, 0xe3, 0xd8, 0xcf, 0xd0, 0xa8, 0x76, 0xbe, 0x7d, 0x9c, 0xe7, 0x1d,
-	0xf9, 0xed, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x3d, 0xf3, 0x07, 0xab, 0x13, 0x00, 0x00,
+	// 2016 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0xf5, 0xcf, 0xd2, 0x93, 0x2c, 0xd3, 0x63, 0x7b, 0xcd, 0xd8, 0xeb, 0x3f, 0x51, 0x17,
+	0x0b, 0x23, 0xdd, 0xc8, 0x4d, 0xda, 0x14, 0xc5, 0x76, 0x8b, 0x56, 0xb2, 0x98, 0x98, 0x69, 0x6c,
+	0x69, 0x29, 0xda, 0x49, 0x7a, 0x61, 0x69, 0x71, 0x22, 0xb3, 0x2b, 0x72, 0x54, 0x72, 0x64, 0x5b,
+	0xfd, 0x14, 0x0b, 0xf4, 0xd2, 0x53, 0xd1, 0x5b, 0x7b, 0xec, 0x61, 0x51, 0xf4, 0x23, 0xec, 0x71,
+	0x91, 0x53, 0x2f, 0x4d, 0x17, 0xc9, 0xa1, 0x40, 0x3e, 0x42, 0xd1, 0x43, 0x31, 0xc3, 0xa1, 0x48,
+	0xc9, 0x72, 0x2c, 0x2f, 0x7a, 0xb1, 0xc5, 0xf7, 0x7e, 0xbf, 0x37, 0x6f, 0xde, 0xbf, 0x19, 0x12,
+	0x56, 0x3b, 0x24, 0x70, 0x49, 0xb0, 0xdb, 0x25, 0x67, 0xbb, 0x67, 0xf7, 0xd9, 0xbf, 0x6a, 0xdf,
+	0x27, 0x94, 0xa0, 0xf9, 0x50, 0x51, 0x65, 0x92, 0xb3, 0xfb, 0x6b, 0x9b, 0x02, 0x77, 0x62, 0x05,
+	0x78, 0xf7, 0xec, 0xfe, 0x09, 0xa6, 0xd6, 0xfd, 0xdd, 0x0e, 0x71, 0xbc, 0x10, 0xbe, 0xb6, 0xdc,
+	0x25, 0x5d, 0xc2, 0x7f, 0xee, 0xb2, 0x5f, 0x42, 0xba, 0xd5, 0x25, 0xa4, 0xdb, 0xc3, 0xbb, 0xfc,
+	0xe9, 0x64, 0xf0, 0x72, 0x97, 0x3a, 0x2e, 0x0e, 0xa8, 0xe5, 0xf6, 0x05, 0xe0, 0xf6, 0x24, 0xc0,
+	0xf2, 0x86, 0x42, 0xb5, 0x39, 0xa9, 0xb2, 0x07, 0xbe, 0x45, 0x1d, 0x12, 0xad, 0x78, 0x3b, 0xf4,
+	0xc8, 0x0c, 0x17, 0x15, 0xde, 0x86, 0xaa, 0x45, 0xcb, 0x75, 0x3c, 0xb2, 0xcb, 0xff, 0x86, 0xa2,
+	0x0a, 0x01, 0xf4, 0x0c, 0x3b, 0xdd, 0x53, 0x8a, 0xed, 0x63, 0x42, 0x71, 0xb3, 0xcf, 0x2c, 0xa1,
+	0xfb, 0x90, 0x23, 0xfc, 0x97, 0x22, 0x6d, 0x4b, 0x3b, 0xe5, 0x07, 0xb7, 0xab, 0x63, 0xbb, 0xae,
+	0xc6, 0x50, 0x5d, 0x00, 0xd1, 0xc7, 0x90, 0x3b, 0xe7, 0x86, 0x94, 0xd4, 0xb6, 0xb4, 0x53, 0xa8,
+	0x97, 0x5f, 0x7d, 0x75, 0x0f, 0x04, 0xab, 0x81, 0x3b, 0xba, 0xd0, 0x56, 0xfe, 0x24, 0xc1, 0x5c,
+	0x03, 0xf7, 0x49, 0xe0, 0x50, 0xb4, 0x05, 0xc5, 0xbe, 0x4f, 0xfa, 0x24, 0xb0, 0x7a, 0xa6, 0x63,
+	0xf3, 0xb5, 0x32, 0x3a, 0x44, 0x22, 0xcd, 0x46, 0x3f, 0x86, 0x82, 0x1d, 0x62, 0x89, 0x2f, 0xec,
+	0x2a, 0xaf, 0xbe, 0xba, 0xb7, 0x2c, 0xec, 0xd6, 0x6c, 0xdb, 0xc7, 0x41, 0xd0, 0xa6, 0xbe, 0xe3,
+	0x75, 0xf5, 0x18, 0x8a, 0x3e, 0x83, 0x9c, 0xe5, 0x92, 0x81, 0x47, 0x95, 0xf4, 0x76, 0x7a, 0xa7,
+	0x18, 0xfb, 0xcf, 0xd2, 0x54, 0x15, 0x69, 0xaa, 0xee, 0x11, 0xc7, 0xab, 0x17, 0xbe, 0x7e, 0xbd,
+	0x75, 0xeb, 0x2f, 0xff, 0xfe, 0xeb, 0x5d, 0x49, 0x17, 0x9c, 0xca, 0xef, 0xe7, 0x20, 0xdf, 0x12,
+	0x4e, 0xa0, 0x32, 0xa4, 0x46, 0xae, 0xa5, 0x1c, 0x1b, 0xfd, 0x00, 0xf2, 0x2e, 0x0e, 0x02, 0xab,
+	0x8b, 0x03, 0x25, 0xc5, 0x8d, 0x2f, 0x57, 0xc3, 0x8c, 0x54, 0xa3, 0x8c, 0x54, 0x6b, 0xde, 0x50,
+	0x1f, 0xa1, 0xd0, 0x43, 0xc8, 0x05, 0xd4, 0xa2, 0x83, 0x40, 0x49, 0xf3, 0x60, 0x6e, 0x4c, 0x04,
+	0x33, 0x5a, 0xaa, 0xcd, 0x41, 0xba, 0x00, 0xa3, 0x7d, 0x40, 0x2f, 0x1d, 0xcf, 0xea, 0x99, 0xd4,
+	0xea, 0xf5, 0x86, 0xa6, 0x8f, 0x83, 0x41, 0x8f, 0x2a, 0x99, 0x6d, 0x69, 0xa7, 0xf8, 0x60, 0x6d,
+	0xc2, 0x84, 0xc1, 0x20, 0x3a, 0x47, 0xe8, 0x32, 0x67, 0x25, 0x24, 0xa8, 0x06, 0xc5, 0x60, 0x70,
+	0xe2, 0x3a, 0xd4, 0x64, 0x65, 0xa6, 0x64, 0x85, 0x89, 0x49, 0xaf, 0x8d, 0xa8, 0x06, 0xeb, 0x99,
+	0x2f, 0xff, 0xb5, 0x25, 0xe9, 0x10, 0x92, 0x98, 0x18, 0x3d, 0x01, 0x59, 0x44, 0xd7, 0xc4, 0x9e,
+	0x1d, 0xda, 0xc9, 0xcd, 0x68, 0xa7, 0x2c, 0x98, 0xaa, 0x67, 0x73, 0x5b, 0x1a, 0xcc, 0x53, 0x42,
+	0xad, 0x9e, 0x29, 0xe4, 0xca, 0xdc, 0x0d, 0x72, 0x54, 0xe2, 0xd4, 0xa8, 0x80, 0x9e, 0xc2, 0xe2,
+	0x19, 0xa1, 0x8e, 0xd7, 0x35, 0x03, 0x6a, 0xf9, 0x62, 0x7f, 0xf9, 0x19, 0xfd, 0x5a, 0x08, 0xa9,
+	0x6d, 0xc6, 0xe4, 0x8e, 0xed, 0x83, 0x10, 0xc5, 0x7b, 0x2c, 0xcc, 0x68, 0x6b, 0x3e, 0x24, 0x46,
+	0x5b, 0x5c, 0x63, 0x45, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0xac, 0x6c, 0xf5, 0xd1, 0x33, 0x5a,
+	0x86, 0x2c, 0x75, 0x68, 0x0f, 0x2b, 0x45, 0xae, 0x08, 0x1f, 0x90, 0x02, 0x73, 0xc1, 0xc0, 0x75,
+	0x2d, 0x7f, 0xa8, 0x94, 0xb8, 0x3c, 0x7a, 0x44, 0x3f, 0x82, 0x7c, 0xd8, 0x11, 0xd8, 0x57, 0xe6,
+	0xaf, 0x69, 0x81, 0x11, 0x12, 0x6d, 0x43, 0x01, 0x5f, 0xf4, 0xb1, 0xed, 0x50, 0x6c, 0x2b, 0xe5,
+	0x6d, 0x69, 0x27, 0x5f, 0x4f, 0x29, 0x92, 0x1e, 0x0b, 0xd1, 0xf7, 0x60, 0xfe, 0xa5, 0xe5, 0xf4,
+	0xb0, 0x6d, 0xfa, 0xd8, 0x0a, 0x88, 0xa7, 0x2c, 0xf0, 0x75, 0x4b, 0xa1, 0x50, 0xe7, 0x32, 0xf4,
+	0x0b, 0x98, 0x1f, 0x75, 0x28, 0x1d, 0xf6, 0xb1, 0x22, 0xf3, 0x12, 0x5e, 0xbf, 0xa2, 0x84, 0x8d,
+	0x61, 0x1f, 0xeb, 0xa5, 0x7e, 0xe2, 0x09, 0x3d, 0x86, 0x32, 0xbe, 0xc0, 0x9d, 0x01, 0x1b, 0x12,
+	0x61, 0x4c, 0x17, 0x67, 0x8d, 0xe9, 0x88, 0xc7, 0x34, 0x95, 0xbf, 0x4b, 0xb0, 0x14, 0xad, 0x13,
+	0xcf, 0x9f, 0x00, 0x6d, 0x00, 0x84, 0x23, 0xc8, 0x24, 0x1e, 0xe6, 0x8d, 0x5a, 0xd0, 0x0b, 0xa1,
+	0xa4, 0xe9, 0xe1, 0x84, 0x9a, 0x9e, 0x13, 0x25, 0x95, 0x54, 0x1b, 0xe7, 0x04, 0xdd, 0x81, 0x52,
+	0xa4, 0x3e, 0xf5, 0x31, 0xe6, 0x2d, 0x5a, 0xd0, 0x8b, 0x02, 0xc0, 0x44, 0x6c, 0x4a, 0x09, 0xc8,
+	0x4b, 0x32, 0xf0, 0x79, 0x07, 0x16, 0x74, 0x61, 0xf4, 0x11, 0x19, 0xf8, 0x09, 0x40, 0xd0, 0xb7,
+	0x5c, 0x25, 0x9b, 0x04, 0xb4, 0xfb, 0x96, 0x5b, 0xf9, 0x6f, 0x1a, 0x8a, 0xc9, 0x86, 0xbc, 0x07,
+	0x85, 0x21, 0x0e, 0xcc, 0x0e, 0x9f, 0x50, 0xdc, 0xe3, 0xba, 0x9c, 0x18, 0x97, 0x1a, 0x93, 0xea,
+	0xf9, 0x21, 0x0e, 0xf6, 0x18, 0x02, 0x3d, 0x84, 0x79, 0xeb, 0x24, 0xa0, 0x96, 0xe3, 0x09, 0x4a,
+	0xea, 0x0a, 0x4a, 0x49, 0xc0, 0x42, 0xda, 0xf7, 0x21, 0xef, 0x11, 0xc1, 0x48, 0x5f, 0xc1, 0x98,
+	0xf3, 0x48, 0x08, 0xfe, 0x19, 0x20, 0x8f, 0x98, 0xe7, 0x0e, 0x3d, 0x35, 0xcf, 0x30, 0x8d, 0x68,
+	0x99, 0x2b, 0x68, 0x0b, 0x1e, 0x79, 0xe6, 0xd0, 0xd3, 0x63, 0x4c, 0x05, 0xfd, 0x27, 0x20, 0xc7,
+	0x49, 0x10, 0xe4, 0xec, 0xa5, 0x73, 0x40, 0xf3, 0xa8, 0x5e, 0x1e, 0xa5, 0x66, 0x92, 0x49, 0xcf,
+	0xa3, 0x65, 0x73, 0xef, 0x63, 0x1a, 0xe7, 0x62, 0xcd, 0xcf, 0x00, 0x25, 0x53, 0x27, 0xb8, 0x73,
+	0x53, 0xb9, 0x72, 0x22, 0xa1, 0x21, 0xfb, 0x53, 0x58, 0x4c, 0x64, 0x55, 0x90, 0xf3, 0x53, 0xc9,
+	0x0b, 0x71, 0xae, 0x43, 0xee, 0x3d, 0x00, 0x96, 0x69, 0x41, 0x2a, 0x4c, 0x25, 0x15, 0x18, 0x82,
+	0xc3, 0x2b, 0x7f, 0x93, 0x20, 0xc3, 0x2a, 0xf6, 0xfa, 0xf3, 0xae, 0x0a, 0xd9, 0x33, 0x42, 0xf1,
+	0xf5, 0x67, 0x5d, 0x08, 0x43, 0x3f, 0x85, 0xb9, 0xd0, 0xb7, 0x40, 0xc9, 0xf0, 0x21, 0x7a, 0x67,
+	0xa2, 0x31, 0x2f, 0x9f, 0xed, 0x7a, 0xc4, 0x18, 0x1b, 0x52, 0xd9, 0xf1, 0x21, 0xf5, 0x24, 0x93,
+	0x4f, 0xcb, 0x99, 0xca, 0x3f, 0x25, 0x98, 0x17, 0xa3, 0xb6, 0x65, 0xf9, 0x96, 0x1b, 0xa0, 0x17,
+	0x50, 0x74, 0x1d, 0x6f, 0x34, 0xb9, 0xa5, 0xeb, 0x26, 0xf7, 0x06, 0x9b, 0xdc, 0xef, 0x5e, 0x6f,
+	0xad, 0x24, 0x58, 0x9f, 0x10, 0xd7, 0xa1, 0xd8, 0xed, 0xd3, 0xa1, 0x0e, 0xae, 0xe3, 0x45, 0xb3,
+	0xdc, 0x05, 0xe4, 0x5a, 0x17, 0x11, 0xc8, 0xec, 0x63, 0xdf, 0x21, 0x36, 0x0f, 0x04, 0x5b, 0x61,
+	0x72, 0x58, 0x34, 0xc4, 0xa5, 0xa7, 0xfe, 0xd1, 0xbb, 0xd7, 0x5b, 0x1f, 0x5e, 0x26, 0xc6, 0x8b,
+	0xfc, 0x81, 0xcd, 0x12, 0xd9, 0xb5, 0x2e, 0xa2, 0x9d, 0x70, 0xfd, 0xa7, 0x29, 0x45, 0xaa, 0x3c,
+	0x87, 0xd2, 0x31, 0x9f, 0xdb, 0x62, 0x77, 0x0d, 0x10, 0x73, 0x3c, 0x5a, 0x5d, 0xba, 0x6e, 0xf5,
+	0x0c, 0xb7, 0x5e, 0x0a, 0x59, 0x09, 0xcb, 0x7f, 0x94, 0x44, 0xc7, 0x0b, 0xcb, 0x1f, 0x43, 0xee,
+	0xb7, 0x03, 0xe2, 0x0f, 0x5c, 0x45, 0xba, 0x54, 0x2d, 0xfc, 0x76, 0x14, 0x6a, 0xd1, 0x27, 0x50,
+	0x60, 0xc5, 0x1c, 0x9c, 0x92, 0x9e, 0x7d, 0xc5, 0x45, 0x2a, 0x06, 0xa0, 0x87, 0x50, 0xe6, 0xcd,
+	0x1a, 0x53, 0xd2, 0x53, 0x29, 0xf3, 0x0c, 0x65, 0x44, 0x20, 0xee, 0xe0, 0xab, 0x22, 0xe4, 0x84,
+	0x6f, 0xea, 0x0d, 0x73, 0x9a, 0x38, 0x8d, 0x93, 0xf9, 0x3b, 0xf8, 0x6e, 0xf9, 0xcb, 0x4c, 0xcf,
+	0xcf, 0xe5, 0x5c, 0xa4, 0xbf, 0x43, 0x2e, 0x12, 0x71, 0xcf, 0xcc, 0x1e, 0xf7, 0xec, 0xcd, 0xe3,
+	0x9e, 0x9b, 0x21, 0xee, 0x48, 0x83, 0xdb, 0x2c, 0xd0, 0x8e, 0xe7, 0x50, 0x27, 0xbe, 0xfe, 0x98,
+	0xdc, 0x7d, 0x65, 0x6e, 0xaa, 0x85, 0x0f, 0x5c, 0xc7, 0xd3, 0x42, 0xbc, 0x08, 0x8f, 0xce, 0xd0,
+	0xa8, 0x0e, 0x2b, 0xa3, 0x49, 0xd2, 0xb1, 0xbc, 0x0e, 0xee, 0x09, 0x33, 0xf9, 0xa9, 0x66, 0x96,
+	0x22, 0xf0, 0x1e, 0xc7, 0x86, 0x36, 0x9e, 0xc0, 0xf2, 0xa4, 0x0d, 0x1b, 0x07, 0xd1, 0x3c, 0xbb,
+	0x7a, 0xf6, 0xa0, 0x71, 0x63, 0x0d, 0x1c, 0x50, 0xf4, 0x0c, 0x56, 0x47, 0x37, 0x0b, 0x73, 0x3c,
+	0x6f, 0x30, 0x5b, 0xde, 0x56, 0x46, 0xfc, 0xe3, 0x64, 0x02, 0x7f, 0x0e, 0x4b, 0xb1, 0xe1, 0x38,
+	0xde, 0xc5, 0xa9, 0xdb, 0x44, 0x23, 0x68, 0x1c, 0xf4, 0xe7, 0x10, 0x5b, 0x36, 0x93, 0x75, 0x5e,
+	0xba, 0x41, 0x9d, 0xc7, 0x3e, 0x1c, 0xc4, 0x05, 0xbf, 0x03, 0xf2, 0xc9, 0xc0, 0xf7, 0xd8, 0x76,
+	0xb1, 0x29, 0xaa, 0x8c, 0x5d, 0xd0, 0xf2, 0x7a, 0x99, 0xc9, 0xd9, 0xc8, 0xfd, 0x3c, 0xac, 0xae,
+	0x1a, 0x6c, 0x70, 0xe4, 0x28, 0xdc, 0xa3, 0x26, 0xf1, 0x31, 0x63, 0x87, 0x17, 0x34, 0x7d, 0x8d,
+	0x81, 0xa2, 0x2b, 0x4e, 0xd4, 0x0d, 0x21, 0x02, 0x7d, 0x04, 0xe5, 0x78, 0x31, 0x56, 0x56, 0xfc,
+	0xba, 0x96, 0xd7, 0x4b, 0xd1, 0x52, 0xec, 0x2c, 0x66, 0x87, 0x5a, 0x62, 0x8b, 0xa2, 0x24, 0xe4,
+	0xa9, 0xb1, 0x5a, 0x88, 0x5b, 0x37, 0x2c, 0x87, 0x5f, 0xc2, 0xda, 0x64, 0x39, 0xb0, 0x7e, 0x16,
+	0x59, 0x5c, 0x9c, 0x6a, 0x64, 0x75, 0xbc, 0x14, 0x0e, 0xac, 0x0b, 0x91, 0xb6, 0x5f, 0xc3, 0x16,
+	0x3b, 0x66, 0x5c, 0x27, 0xa0, 0x4e, 0xc7, 0xb4, 0x06, 0xf4, 0x94, 0xf8, 0xce, 0xef, 0xb0, 0x6d,
+	0x5a, 0x61, 0x29, 0xe1, 0x40, 0x41, 0xdb, 0xe9, 0xf7, 0x96, 0xd9, 0x46, 0x6c, 0xa0, 0x36, 0xe2,
+	0xd7, 0x22, 0x3a, 0xd2, 0x21, 0x01, 0x30, 0x7d, 0xfc, 0x1b, 0xdc, 0x19, 0x2f, 0x91, 0xa5, 0xa9,
+	0x1e, 0xaf, 0xc7, 0x24, 0x5d, 0x70, 0xe2, 0x5a, 0xb9, 0x07, 0xc0, 0xee, 0x65, 0x22, 0x97, 0xcb,
+	0xd3, 0xc7, 0xc0, 0x10, 0x07, 0x22, 0xad, 0x8f, 0x01, 0xb1, 0x0b, 0x6d, 0x8f, 0x74, 0xbe, 0x30,
+	0xbb, 0x03, 0xcb, 0xb7, 0x1d, 0xcb, 0x0b, 0x94, 0x95, 0x6b, 0xf6, 0xb5, 0x18, 0x71, 0x1e, 0x47,
+	0x14, 0xf4, 0x08, 0x56, 0x47, 0x86, 0x26, 0x06, 0xcb, 0x07, 0x53, 0x9d, 0x58, 0x89, 0xe0, 0xc7,
+	0xc9, 0x01, 0x53, 0xf9, 0x36, 0x05, 0xe8, 0x20, 0x7c, 0xed, 0xac, 0x5b, 0x01, 0xb6, 0xff, 0x9f,
+	0xc7, 0x5a, 0x62, 0x94, 0xa6, 0xde, 0x3b, 0x4a, 0x6f, 0x18, 0xc4, 0xb1, 0xc9, 0x9b, 0xbe, 0xf9,
+	0xe4, 0xcd, 0xcc, 0x32, 0x79, 0xf7, 0x61, 0x21, 0x7e, 0x09, 0xb1, 0x71, 0xcf, 0x1a, 0x2a, 0xd9,
+	0xd9, 0x62, 0x10, 0xbf, 0xbc, 0x34, 0x18, 0xad, 0x62, 0x03, 0x7a, 0x4c, 0xce, 0xb0, 0xef, 0xb1,
+	0x82, 0x6f, 0xe0, 0x1e, 0xee, 0x5a, 0x14, 0xa3, 0x07, 0x30, 0x27, 0x0a, 0x5b, 0x9c, 0xef, 0x57,
+	0xa7, 0x3f, 0x02, 0x8e, 0x5d, 0xbf, 0x52, 0xe3, 0xd7, 0x2f, 0xf6, 0x91, 0x04, 0x45, 0xc6, 0x6b,
+	0x41, 0xe0, 0x74, 0x3d, 0x17, 0x7b, 0x14, 0xa9, 0xb0, 0x68, 0x87, 0x52, 0xe2, 0x9b, 0xb3, 0x2e,
+	0x28, 0x8f, 0x28, 0x42, 0x8e, 0xf6, 0x20, 0x92, 0xe1, 0x91, 0x95, 0xeb, 0x2e, 0x9c, 0x0b, 0x11,
+	0x43, 0x88, 0xef, 0xfe, 0x59, 0x82, 0x52, 0xf2, 0xb5, 0x0f, 0x6d, 0xc0, 0xed, 0x96, 0xde, 0x6c,
+	0x35, 0xdb, 0xb5, 0xa7, 0xa6, 0xf1, 0xa2, 0xa5, 0x9a, 0x47, 0x87, 0xed, 0x96, 0xba, 0xa7, 0x3d,
+	0xd2, 0xd4, 0x86, 0x7c, 0x0b, 0xad, 0xc1, 0x07, 0xe3, 0xea, 0xb6, 0x51, 0x3b, 0x6c, 0xd4, 0xf4,
+	0x86, 0x2c, 0xa1, 0x3b, 0xb0, 0x31, 0xae, 0x3b, 0x38, 0x7a, 0x6a, 0x68, 0xad, 0xa7, 0xaa, 0xb9,
+	0xb7, 0xdf, 0xd4, 0xf6, 0x54, 0x39, 0x85, 0x3e, 0x04, 0x65, 0x1c, 0xd2, 0x6c, 0x19, 0xda, 0x81,
+	0xd6, 0x36, 0xb4, 0x3d, 0x39, 0x8d, 0xd6, 0x61, 0x75, 0x5c, 0xab, 0x3e, 0x6f, 0xa9, 0x0d, 0xcd,
+	0x50, 0x1b, 0x72, 0xe6, 0xee, 0x7f, 0x24, 0x80, 0xc4, 0xb7, 0xad, 0x75, 0x58, 0x3d, 0x6e, 0x1a,
+	0xa1, 0x81, 0xe6, 0xe1, 0x84, 0x97, 0x4b, 0xb0, 0x90, 0x54, 0x36, 0x0f, 0x55, 0x59, 0x9a, 0x14,
+	0xbe, 0x50, 0xdb, 0x97, 0x85, 0xc6, 0xb3, 0xa6, 0x9c, 0x42, 0xab, 0xb0, 0x94, 0x14, 0xd6, 0xea,
+	0x6d, 0xa3, 0xa6, 0x1d, 0xca, 0x29, 0xb4, 0x02, 0x8b, 0x63, 0xe8, 0x7d, 0x5d, 0x55, 0xe5, 0x34,
+	0x42, 0x50, 0x4e, 0x8a, 0x0f, 0x9b, 0x72, 0x1a, 0x2d, 0x83, 0x9c, 0x94, 0x3d, 0x6a, 0x1e, 0xe9,
+	0x72, 0x86, 0xed, 0x7f, 0x1c, 0x69, 0x3e, 0xd3, 0x8c, 0x7d, 0xf3, 0x58, 0x35, 0x9a, 0x72, 0x66,
+	0x92, 0xd3, 0x6e, 0xd5, 0x0e, 0xe4, 0xec, 0x5a, 0x4a, 0x96, 0xee, 0xbe, 0x93, 0xa0, 0x3c, 0xfe,
+	0x81, 0x09, 0x6d, 0xc1, 0xfa, 0x28, 0x58, 0x6d, 0xa3, 0x66, 0x1c, 0xb5, 0x27, 0x82, 0x50, 0x81,
+	0xcd, 0x49, 0x40, 0x43, 0x6d, 0x35, 0xdb, 0x9a, 0x61, 0xb6, 0x54, 0x5d, 0x6b, 0x4e, 0xa6, 0x4c,
+	0x60, 0x8e, 0x9b, 0x86, 0x76, 0xf8, 0x38, 0x82, 0xa4, 0xc6, 0x32, 0x2e, 0x20, 0xad, 0x5a, 0xbb,
+	0xad, 0x36, 0xe4, 0xf4, 0x58, 0x3a, 0x85, 0x4e, 0x57, 0x9f, 0xa8, 0x7b, 0x3c, 0x63, 0xd3, 0x98,
+	0x8f, 0x6a, 0xda, 0x53, 0xb5, 0x21, 0x67, 0xa7, 0xe9, 0x3e, 0x3f, 0x52, 0x8f, 0xd4, 0x86, 0x9c,
+	0xab, 0x3f, 0xfc, 0xfa, 0xcd, 0xa6, 0xf4, 0xcd, 0x9b, 0x4d, 0xe9, 0xdb, 0x37, 0x9b, 0xd2, 0x97,
+	0x6f, 0x37, 0x6f, 0x7d, 0xf3, 0x76, 0xf3, 0xd6, 0x3f, 0xde, 0x6e, 0xde, 0xfa, 0xd5, 0x7a, 0x58,
+	0xd4, 0x81, 0xfd, 0x45, 0xd5, 0x21, 0xbb, 0x17, 0xfc, 0xb3, 0x2e, 0xfb, 0x9e, 0x11, 0xb0, 0x6f,
+	0xb6, 0x39, 0xde, 0xfc, 0x3f, 0xfc, 0xdf, 0x00, 0x78, 0x53, 0x93, 0x6e, 0xf4, 0x15, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1545,6 +1730,18 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -1598,22 +1795,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1632,22 +1829,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1911,12 +2108,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1958,12 +2155,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2034,6 +2231,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TimelockVetoThreshold) > 0 {
+		i -= len(m.TimelockVetoThreshold)
+		copy(dAtA[i:], m.TimelockVetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TimelockVetoThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.TimelockGuardians) > 0 {
+		for iNdEx := len(m.TimelockGuardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TimelockGuardians[iNdEx])
+			copy(dAtA[i:], m.TimelockGuardians[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.TimelockGuardians[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
 	if len(m.YesQuorum) > 0 {
 		i -= len(m.YesQuorum)
 		copy(dAtA[i:], m.YesQuorum)
@@ -2133,12 +2350,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2185,22 +2402,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2250,6 +2467,16 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.ExecutionDelay != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.VetoThreshold) > 0 {
 		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
@@ -2272,12 +2499,86 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegateAddress) > 0 {
+		i -= len(m.DelegateAddress)
+		copy(dAtA[i:], m.DelegateAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegateAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2403,6 +2704,10 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	if m.ExecutionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2648,6 +2953,16 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	if len(m.TimelockGuardians) > 0 {
+		for _, s := range m.TimelockGuardians {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.TimelockVetoThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2673,6 +2988,10 @@ func (m *MessageBasedParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.ExecutionDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	l = len(m.YesQuorum)
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
@@ -2680,6 +2999,40 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
+func (m *GovernanceDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.DelegateAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3436,6 +3789,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionTime == nil {
+				m.ExecutionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5173,6 +5562,70 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.YesQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockGuardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockGuardians = append(m.TimelockGuardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockVetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockVetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5355,6 +5808,42 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			}
 			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionDelay == nil {
+				m.ExecutionDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 20:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
@@ -5408,6 +5897,234 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GovernanceDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -133,6 +133,45 @@ func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal
 	}
 }
 
+// NewMsgRegisterDelegate creates a new MsgRegisterDelegate instance.
+func NewMsgRegisterDelegate(delegate, metadata string) *MsgRegisterDelegate {
+	return &MsgRegisterDelegate{
+		DelegateAddress: delegate,
+		Metadata:        metadata,
+	}
+}
+
+// NewMsgUnregisterDelegate creates a new MsgUnregisterDelegate instance.
+func NewMsgUnregisterDelegate(delegate string) *MsgUnregisterDelegate {
+	return &MsgUnregisterDelegate{
+		DelegateAddress: delegate,
+	}
+}
+
+// NewMsgAssignDelegate creates a new MsgAssignDelegate instance.
+func NewMsgAssignDelegate(delegator, delegate string) *MsgAssignDelegate {
+	return &MsgAssignDelegate{
+		DelegatorAddress: delegator,
+		DelegateAddress:  delegate,
+	}
+}
+
+// NewMsgUnassignDelegate creates a new MsgUnassignDelegate instance.
+func NewMsgUnassignDelegate(delegator string) *MsgUnassignDelegate {
+	return &MsgUnassignDelegate{
+		DelegatorAddress: delegator,
+	}
+}
+
+// NewMsgCancelQueuedProposal creates a new MsgCancelQueuedProposal instance.
+func NewMsgCancelQueuedProposal(proposalID uint64, guardian, reason string) *MsgCancelQueuedProposal {
+	return &MsgCancelQueuedProposal{
+		ProposalId: proposalID,
+		Guardian:   guardian,
+		Reason:     reason,
+	}
+}
+
 // GetSudoedMsg returns the cache values from the MsgSudoExec.Msg if present.
 func (msg *MsgSudoExec) GetSudoedMsg() (sdk.Msg, error) {
 	if msg.Msg == nil {
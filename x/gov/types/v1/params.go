@@ -36,6 +36,8 @@ var (
 	DefaultMinDepositRatio              = sdkmath.LegacyMustNewDecFromStr("0.01")
 	DefaultOptimisticRejectedThreshold  = sdkmath.LegacyMustNewDecFromStr("0.1")
 	DefaultOptimisticAuthorizedAddreses = []string(nil)
+	DefaultTimelockGuardians            = []string(nil)
+	DefaultTimelockVetoThreshold        = "" // the emergency veto of queued proposals is opt-in
 )
 
 // NewParams creates a new Params instance with given values.
@@ -70,7 +72,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
@@ -92,6 +94,10 @@ func DefaultParams() Params {
 		DefaultOptimisticRejectedThreshold.String(),
 		DefaultOptimisticAuthorizedAddreses,
 	)
+	params.TimelockGuardians = DefaultTimelockGuardians
+	params.TimelockVetoThreshold = DefaultTimelockVetoThreshold
+
+	return params
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -209,6 +215,30 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		}
 	}
 
+	guardians := make(map[string]struct{}, len(p.TimelockGuardians))
+	for _, addr := range p.TimelockGuardians {
+		if _, err := addressCodec.StringToBytes(addr); err != nil {
+			return fmt.Errorf("invalid timelock guardian address: %s", addr)
+		}
+		if _, ok := guardians[addr]; ok {
+			return fmt.Errorf("duplicate timelock guardian address: %s", addr)
+		}
+		guardians[addr] = struct{}{}
+	}
+
+	if len(p.TimelockVetoThreshold) != 0 {
+		timelockVetoThreshold, err := sdkmath.LegacyNewDecFromStr(p.TimelockVetoThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid timelock veto threshold string: %w", err)
+		}
+		if !timelockVetoThreshold.IsPositive() {
+			return fmt.Errorf("timelock veto threshold must be positive: %s", timelockVetoThreshold)
+		}
+		if timelockVetoThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("timelock veto threshold too large: %s", timelockVetoThreshold)
+		}
+	}
+
 	minInitialDepositRatio, err := sdkmath.LegacyNewDecFromStr(p.MinInitialDepositRatio)
 	if err != nil {
 		return fmt.Errorf("invalid minimum initial deposit ratio of proposal: %w", err)
@@ -305,5 +335,9 @@ func (p MessageBasedParams) ValidateBasic() error {
 		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
+	if p.ExecutionDelay != nil && p.ExecutionDelay.Seconds() < 0 {
+		return fmt.Errorf("execution delay cannot be negative: %s", p.ExecutionDelay)
+	}
+
 	return nil
 }
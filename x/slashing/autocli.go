@@ -34,6 +34,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "DowntimePenalty",
+					Use:       "downtime-penalty [validator-conspub/address]",
+					Short:     "Query the penalties of a validator's next downtime jailing",
+					Long:      "Query the slash fraction and jail duration of a validator's next downtime jailing, escalated by its repeat downtime jailings, with a pubkey ('<appd> comet show-validator') or a validator consensus address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -80,3 +80,39 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// DowntimePenalty returns the penalties of the next downtime jailing of a specific validator.
+func (k Keeper) DowntimePenalty(ctx context.Context, req *types.QueryDowntimePenaltyRequest) (*types.QueryDowntimePenaltyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	downtimeJailCount := NextDowntimeJailCount(params, signingInfo, blockTime)
+	slashFraction, jailDuration := params.DowntimePenalties(downtimeJailCount)
+
+	return &types.QueryDowntimePenaltyResponse{
+		DowntimeJailCount: downtimeJailCount,
+		SlashFraction:     slashFraction,
+		JailDuration:      jailDuration,
+	}, nil
+}
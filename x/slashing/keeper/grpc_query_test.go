@@ -94,3 +94,42 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCDowntimePenalty() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	infoResp, err := queryClient.DowntimePenalty(gocontext.Background(), &slashingtypes.QueryDowntimePenaltyRequest{ConsAddress: ""})
+	require.ErrorContains(err, "invalid request")
+	require.Nil(infoResp)
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+
+	params := testutil.TestParams()
+	params.DowntimeEscalationWindow = time.Hour
+	params.MaxDowntimeEscalations = 2
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(2, 0), false, int64(0))
+	signingInfo.DowntimeJailCount = 2
+	signingInfo.DowntimeEscalationResetTime = ctx.HeaderInfo().Time.Add(time.Minute)
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo))
+
+	// the third downtime jailing within the window quadruples the penalties
+	res, err := queryClient.DowntimePenalty(gocontext.Background(), &slashingtypes.QueryDowntimePenaltyRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal(int64(3), res.DowntimeJailCount)
+	require.Equal(params.SlashFractionDowntime.MulInt64(4), res.SlashFraction)
+	require.Equal(4*params.DowntimeJailDuration, res.JailDuration)
+
+	// the penalties reset once the escalation window has elapsed
+	signingInfo.DowntimeEscalationResetTime = ctx.HeaderInfo().Time
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo))
+
+	res, err = queryClient.DowntimePenalty(gocontext.Background(), &slashingtypes.QueryDowntimePenaltyRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal(int64(1), res.DowntimeJailCount)
+	require.Equal(params.SlashFractionDowntime, res.SlashFraction)
+	require.Equal(params.DowntimeJailDuration, res.JailDuration)
+}
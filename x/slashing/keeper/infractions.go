@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
@@ -152,10 +153,11 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
-			if err != nil {
-				return err
-			}
+			// Repeat downtime jailings within the escalation window are
+			// penalized more heavily.
+			blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+			downtimeJailCount := NextDowntimeJailCount(params, signInfo, blockTime)
+			slashFractionDowntime, downtimeJailDur := params.DowntimePenalties(downtimeJailCount)
 
 			coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
 			if err != nil {
@@ -177,11 +179,9 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			if err != nil {
 				return err
 			}
-			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
-			if err != nil {
-				return err
-			}
-			signInfo.JailedUntil = k.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(downtimeJailDur)
+			signInfo.JailedUntil = blockTime.Add(downtimeJailDur)
+			signInfo.DowntimeJailCount = downtimeJailCount
+			signInfo.DowntimeEscalationResetTime = signInfo.JailedUntil.Add(params.DowntimeEscalationWindow)
 
 			// We need to reset the counter & bitmap so that the validator won't be
 			// immediately slashed for downtime upon re-bonding.
@@ -201,6 +201,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				"threshold", minSignedPerWindow,
 				"slashed", slashFractionDowntime.String(),
 				"jailed_until", signInfo.JailedUntil,
+				"downtime_jail_count", downtimeJailCount,
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
@@ -217,3 +218,15 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 	}
 	return nil
 }
+
+// NextDowntimeJailCount returns the number of downtime jailings within the
+// escalation window a downtime jailing of the validator at the given time would
+// amount to. The count starts over once the escalation window has elapsed
+// without the validator being jailed for downtime.
+func NextDowntimeJailCount(params types.Params, signInfo types.ValidatorSigningInfo, blockTime time.Time) int64 {
+	if params.DowntimeEscalationWindow == 0 || !blockTime.Before(signInfo.DowntimeEscalationResetTime) {
+		return 1
+	}
+
+	return signInfo.DowntimeJailCount + 1
+}
@@ -162,7 +162,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 		func(i int64) {
 			s.ctx.KVStore(s.key).Set(validatorMissedBlockBitmapKey(consAddr, index), []byte{})
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"13fa25c95241a34b79665405987732a09c07a94772943bf79e1571ebb419dadb",
 	)
 	s.Require().NoError(err)
 
@@ -174,7 +174,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 			err := s.slashingKeeper.SetMissedBlockBitmapChunk(s.ctx, consAddr, index, []byte{})
 			s.Require().NoError(err)
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"13fa25c95241a34b79665405987732a09c07a94772943bf79e1571ebb419dadb",
 	)
 	s.Require().NoError(err)
 }
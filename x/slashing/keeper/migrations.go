@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v4 "cosmossdk.io/x/slashing/migrations/v4"
+	v5 "cosmossdk.io/x/slashing/migrations/v5"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -42,3 +43,9 @@ func (m Migrator) Migrate3to4(ctx context.Context) error {
 	}
 	return v4.Migrate(ctx, m.keeper.cdc, store, params)
 }
+
+// Migrate4to5 migrates the x/slashing module state from the consensus
+// version 4 to version 5. Specifically, it sets the downtime escalation params.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	return v5.Migrate(ctx, m.keeper.Params)
+}
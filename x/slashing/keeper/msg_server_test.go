@@ -118,16 +118,73 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid downtime escalation window",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(34800000000000),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					DowntimeEscalationWindow:     time.Duration(-1),
+					DowntimeEscalationMultiplier: sdkmath.LegacyNewDec(2),
+					MaxDowntimeEscalations:       uint32(3),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "downtime escalation window cannot be negative",
+		},
+		{
+			name: "set invalid downtime escalation multiplier",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(34800000000000),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					DowntimeEscalationWindow:     time.Duration(34800000000000),
+					DowntimeEscalationMultiplier: sdkmath.LegacyNewDecWithPrec(5, 1),
+					MaxDowntimeEscalations:       uint32(3),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "downtime escalation multiplier cannot be less than 1",
+		},
+		{
+			name: "set invalid max downtime escalations",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(34800000000000),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					DowntimeEscalationWindow:     time.Duration(34800000000000),
+					DowntimeEscalationMultiplier: sdkmath.LegacyNewDec(2),
+					MaxDowntimeEscalations:       uint32(17),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "max downtime escalations too large",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: slashingtypes.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(34800000000000),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					DowntimeEscalationWindow:     time.Duration(34800000000000),
+					DowntimeEscalationMultiplier: sdkmath.LegacyNewDec(2),
+					MaxDowntimeEscalations:       uint32(3),
 				},
 			},
 			expectErr: false,
@@ -50,6 +50,23 @@ func (k Keeper) Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error {
 	return k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo)
 }
 
+// Untombstone clears the tombstone of a validator, allowing it to be unjailed
+// right away.
+func (k Keeper) Untombstone(ctx context.Context, consAddr sdk.ConsAddress) error {
+	signInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		return types.ErrNoSigningInfoFound.Wrap(fmt.Sprintf("cannot untombstone validator with consensus address %s that does not have any signing information", consAddr.String()))
+	}
+
+	if !signInfo.Tombstoned {
+		return types.ErrValidatorNotTombstoned.Wrap("cannot untombstone validator that is not tombstoned")
+	}
+
+	signInfo.Tombstoned = false
+	signInfo.JailedUntil = k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	return k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo)
+}
+
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
 func (k Keeper) IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool {
 	signInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
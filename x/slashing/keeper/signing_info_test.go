@@ -57,6 +57,31 @@ func (s *KeeperTestSuite) TestValidatorSigningInfo() {
 	require.Equal(sInfo.JailedUntil, jailTime)
 }
 
+func (s *KeeperTestSuite) TestUntombstone() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	// no signing info
+	require.ErrorIs(keeper.Untombstone(ctx, consAddr), slashingtypes.ErrNoSigningInfoFound)
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consStr, ctx.BlockHeight(), time.Unix(2, 0), false, int64(0))
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo))
+
+	// not tombstoned
+	require.ErrorIs(keeper.Untombstone(ctx, consAddr), slashingtypes.ErrValidatorNotTombstoned)
+
+	require.NoError(keeper.Tombstone(ctx, consAddr))
+	require.NoError(keeper.JailUntil(ctx, consAddr, time.Unix(253402300799, 0)))
+
+	require.NoError(keeper.Untombstone(ctx, consAddr))
+	require.False(keeper.IsTombstoned(ctx, consAddr))
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(ctx.HeaderInfo().Time, info.JailedUntil)
+}
+
 func (s *KeeperTestSuite) TestValidatorMissedBlockBitmap_SmallWindow() {
 	ctx, keeper := s.ctx, s.slashingKeeper
 	require := s.Require()
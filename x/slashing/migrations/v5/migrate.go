@@ -0,0 +1,26 @@
+package v5
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/slashing/types"
+)
+
+// Migrate migrates state to consensus version 5. Specifically, the migration
+// sets the downtime escalation params to their default values, which leave the
+// escalation of downtime penalties disabled. The existing signing infos don't
+// need to be migrated as they start without any downtime jailing counted.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaultParams := types.DefaultParams()
+	p.DowntimeEscalationWindow = defaultParams.DowntimeEscalationWindow
+	p.DowntimeEscalationMultiplier = defaultParams.DowntimeEscalationMultiplier
+	p.MaxDowntimeEscalations = defaultParams.MaxDowntimeEscalations
+
+	return params.Set(ctx, p)
+}
@@ -0,0 +1,45 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/slashing"
+	v5 "cosmossdk.io/x/slashing/migrations/v5"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, slashing.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(slashingtypes.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	params := collections.NewItem(sb, slashingtypes.ParamsKey, "params", codec.CollValue[slashingtypes.Params](cdc))
+
+	// set params without the newly added fields
+	previousParams := slashingtypes.DefaultParams()
+	previousParams.SignedBlocksWindow = 1000
+	previousParams.DowntimeEscalationMultiplier = math.LegacyDec{}
+	previousParams.MaxDowntimeEscalations = 0
+	require.NoError(t, params.Set(ctx, previousParams))
+
+	require.NoError(t, v5.Migrate(ctx, params))
+
+	migratedParams, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, migratedParams.Validate())
+	require.Equal(t, int64(1000), migratedParams.SignedBlocksWindow)
+	require.Equal(t, slashingtypes.DefaultDowntimeEscalationWindow, migratedParams.DowntimeEscalationWindow)
+	require.Equal(t, slashingtypes.DefaultDowntimeEscalationMultiplier, migratedParams.DowntimeEscalationMultiplier)
+	require.Equal(t, slashingtypes.DefaultMaxDowntimeEscalations, migratedParams.MaxDowntimeEscalations)
+}
@@ -20,6 +20,10 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeEscalationWindow     = "downtime_escalation_window"
+	DowntimeEscalationMultiplier = "downtime_escalation_multiplier"
+	MaxDowntimeEscalations       = "max_downtime_escalations"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -47,6 +51,21 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeEscalationWindow randomized DowntimeEscalationWindow
+func GenDowntimeEscalationWindow(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*7)) * time.Second
+}
+
+// GenDowntimeEscalationMultiplier randomized DowntimeEscalationMultiplier
+func GenDowntimeEscalationMultiplier(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 10, 40)), 1)
+}
+
+// GenMaxDowntimeEscalations randomized MaxDowntimeEscalations
+func GenMaxDowntimeEscalations(r *rand.Rand) uint32 {
+	return uint32(r.Intn(6))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -64,9 +83,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var slashFractionDowntime math.LegacyDec
 	simState.AppParams.GetOrGenerate(SlashFractionDowntime, &slashFractionDowntime, simState.Rand, func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) })
 
+	var downtimeEscalationWindow time.Duration
+	simState.AppParams.GetOrGenerate(DowntimeEscalationWindow, &downtimeEscalationWindow, simState.Rand, func(r *rand.Rand) { downtimeEscalationWindow = GenDowntimeEscalationWindow(r) })
+
+	var downtimeEscalationMultiplier math.LegacyDec
+	simState.AppParams.GetOrGenerate(DowntimeEscalationMultiplier, &downtimeEscalationMultiplier, simState.Rand, func(r *rand.Rand) { downtimeEscalationMultiplier = GenDowntimeEscalationMultiplier(r) })
+
+	var maxDowntimeEscalations uint32
+	simState.AppParams.GetOrGenerate(MaxDowntimeEscalations, &maxDowntimeEscalations, simState.Rand, func(r *rand.Rand) { maxDowntimeEscalations = GenMaxDowntimeEscalations(r) })
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		downtimeEscalationWindow, downtimeEscalationMultiplier, maxDowntimeEscalations,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	stdmath "math"
 	"time"
 
 	"cosmossdk.io/math"
@@ -11,25 +12,42 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	// DefaultDowntimeEscalationWindow is set to zero, disabling the escalation
+	// of downtime penalties
+	DefaultDowntimeEscalationWindow = time.Duration(0)
+	DefaultMaxDowntimeEscalations   = uint32(3)
+
+	// MaxDowntimeEscalationMultiplier and MaxDowntimeEscalationsLimit bound the
+	// escalated penalties so that they can't overflow
+	MaxDowntimeEscalationMultiplier = 10
+	MaxDowntimeEscalationsLimit     = uint32(16)
 )
 
 var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+
+	DefaultDowntimeEscalationMultiplier = math.LegacyNewDec(2)
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
+	downtimeEscalationWindow time.Duration, downtimeEscalationMultiplier math.LegacyDec,
+	maxDowntimeEscalations uint32,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:           signedBlocksWindow,
+		MinSignedPerWindow:           minSignedPerWindow,
+		DowntimeJailDuration:         downtimeJailDuration,
+		SlashFractionDoubleSign:      slashFractionDoubleSign,
+		SlashFractionDowntime:        slashFractionDowntime,
+		DowntimeEscalationWindow:     downtimeEscalationWindow,
+		DowntimeEscalationMultiplier: downtimeEscalationMultiplier,
+		MaxDowntimeEscalations:       maxDowntimeEscalations,
 	}
 }
 
@@ -41,6 +59,9 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultDowntimeEscalationWindow,
+		DefaultDowntimeEscalationMultiplier,
+		DefaultMaxDowntimeEscalations,
 	)
 }
 
@@ -61,6 +82,15 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeEscalationWindow(p.DowntimeEscalationWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeEscalationMultiplier(p.DowntimeEscalationMultiplier); err != nil {
+		return err
+	}
+	if err := validateMaxDowntimeEscalations(p.MaxDowntimeEscalations); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +177,77 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateDowntimeEscalationWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime escalation window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeEscalationMultiplier(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("downtime escalation multiplier cannot be nil: %s", v)
+	}
+	if v.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("downtime escalation multiplier cannot be less than 1: %s", v)
+	}
+	if v.GT(math.LegacyNewDec(MaxDowntimeEscalationMultiplier)) {
+		return fmt.Errorf("downtime escalation multiplier too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeEscalations(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxDowntimeEscalationsLimit {
+		return fmt.Errorf("max downtime escalations too large: %d", v)
+	}
+
+	return nil
+}
+
+// DowntimePenalties returns the slash fraction and the jail duration of the
+// given downtime jailing of a validator within the escalation window, starting
+// at 1. Every repeat jailing multiplies the penalties by the escalation
+// multiplier, up to MaxDowntimeEscalations times. The slash fraction is capped
+// at 100%.
+func (p Params) DowntimePenalties(downtimeJailCount int64) (math.LegacyDec, time.Duration) {
+	if p.DowntimeEscalationWindow == 0 || downtimeJailCount <= 1 {
+		return p.SlashFractionDowntime, p.DowntimeJailDuration
+	}
+
+	escalations := downtimeJailCount - 1
+	if escalations > int64(p.MaxDowntimeEscalations) {
+		escalations = int64(p.MaxDowntimeEscalations)
+	}
+	factor := p.DowntimeEscalationMultiplier.Power(uint64(escalations))
+
+	slashFraction := math.LegacyMinDec(p.SlashFractionDowntime.Mul(factor), math.LegacyOneDec())
+
+	jailDuration := factor.MulInt64(int64(p.DowntimeJailDuration)).TruncateInt()
+	if !jailDuration.IsInt64() {
+		return slashFraction, time.Duration(stdmath.MaxInt64)
+	}
+
+	return slashFraction, time.Duration(jailDuration.Int64())
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
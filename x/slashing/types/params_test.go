@@ -0,0 +1,90 @@
+package types
+
+import (
+	stdmath "math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestDowntimePenalties(t *testing.T) {
+	params := DefaultParams()
+	params.DowntimeEscalationWindow = time.Hour
+	params.DowntimeEscalationMultiplier = math.LegacyNewDec(2)
+	params.MaxDowntimeEscalations = 3
+	params.SlashFractionDowntime = math.LegacyNewDecWithPrec(1, 1)
+
+	testCases := []struct {
+		name              string
+		params            func() Params
+		downtimeJailCount int64
+		expSlashFraction  math.LegacyDec
+		expJailDuration   time.Duration
+	}{
+		{
+			name:              "first jailing",
+			params:            func() Params { return params },
+			downtimeJailCount: 1,
+			expSlashFraction:  math.LegacyNewDecWithPrec(1, 1),
+			expJailDuration:   DefaultDowntimeJailDuration,
+		},
+		{
+			name:              "repeat jailing",
+			params:            func() Params { return params },
+			downtimeJailCount: 3,
+			expSlashFraction:  math.LegacyNewDecWithPrec(4, 1),
+			expJailDuration:   4 * DefaultDowntimeJailDuration,
+		},
+		{
+			name:              "escalations are capped",
+			params:            func() Params { return params },
+			downtimeJailCount: 10,
+			expSlashFraction:  math.LegacyNewDecWithPrec(8, 1),
+			expJailDuration:   8 * DefaultDowntimeJailDuration,
+		},
+		{
+			name: "slash fraction is capped",
+			params: func() Params {
+				p := params
+				p.SlashFractionDowntime = math.LegacyNewDecWithPrec(5, 1)
+				return p
+			},
+			downtimeJailCount: 3,
+			expSlashFraction:  math.LegacyOneDec(),
+			expJailDuration:   4 * DefaultDowntimeJailDuration,
+		},
+		{
+			name: "jail duration is capped",
+			params: func() Params {
+				p := params
+				p.DowntimeJailDuration = time.Duration(stdmath.MaxInt64 / 2)
+				return p
+			},
+			downtimeJailCount: 3,
+			expSlashFraction:  math.LegacyNewDecWithPrec(4, 1),
+			expJailDuration:   time.Duration(stdmath.MaxInt64),
+		},
+		{
+			name: "escalation disabled",
+			params: func() Params {
+				p := params
+				p.DowntimeEscalationWindow = 0
+				return p
+			},
+			downtimeJailCount: 3,
+			expSlashFraction:  math.LegacyNewDecWithPrec(1, 1),
+			expJailDuration:   DefaultDowntimeJailDuration,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slashFraction, jailDuration := tc.params().DowntimePenalties(tc.downtimeJailCount)
+			require.True(t, tc.expSlashFraction.Equal(slashFraction), slashFraction.String())
+			require.Equal(t, tc.expJailDuration, jailDuration)
+		})
+	}
+}
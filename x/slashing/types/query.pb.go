@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -12,19 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -309,6 +314,112 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDowntimePenaltyRequest is the request type for the Query/DowntimePenalty
+// RPC method
+type QueryDowntimePenaltyRequest struct {
+	// cons_address is the address to query the downtime penalty of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryDowntimePenaltyRequest) Reset()         { *m = QueryDowntimePenaltyRequest{} }
+func (m *QueryDowntimePenaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimePenaltyRequest) ProtoMessage()    {}
+func (*QueryDowntimePenaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryDowntimePenaltyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimePenaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimePenaltyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimePenaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimePenaltyRequest.Merge(m, src)
+}
+func (m *QueryDowntimePenaltyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimePenaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimePenaltyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimePenaltyRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimePenaltyRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryDowntimePenaltyResponse is the response type for the
+// Query/DowntimePenalty RPC method
+type QueryDowntimePenaltyResponse struct {
+	// downtime_jail_count is the number of downtime jailings within the
+	// escalation window the next downtime jailing would amount to.
+	DowntimeJailCount int64 `protobuf:"varint,1,opt,name=downtime_jail_count,json=downtimeJailCount,proto3" json:"downtime_jail_count,omitempty"`
+	// slash_fraction is the fraction slashed by the next downtime jailing.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// jail_duration is the duration of the next downtime jailing.
+	JailDuration time.Duration `protobuf:"bytes,3,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *QueryDowntimePenaltyResponse) Reset()         { *m = QueryDowntimePenaltyResponse{} }
+func (m *QueryDowntimePenaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimePenaltyResponse) ProtoMessage()    {}
+func (*QueryDowntimePenaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryDowntimePenaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimePenaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimePenaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimePenaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimePenaltyResponse.Merge(m, src)
+}
+func (m *QueryDowntimePenaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimePenaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimePenaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimePenaltyResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimePenaltyResponse) GetDowntimeJailCount() int64 {
+	if m != nil {
+		return m.DowntimeJailCount
+	}
+	return 0
+}
+
+func (m *QueryDowntimePenaltyResponse) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +427,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryDowntimePenaltyRequest)(nil), "cosmos.slashing.v1beta1.QueryDowntimePenaltyRequest")
+	proto.RegisterType((*QueryDowntimePenaltyResponse)(nil), "cosmos.slashing.v1beta1.QueryDowntimePenaltyResponse")
 }
 
 func init() {
@@ -323,43 +436,55 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x53, 0x10, 0xf4, 0x12, 0x66,
-	0x9b, 0xe9, 0x38, 0xb8, 0x99, 0xd9, 0xee, 0x6c, 0x82, 0x45, 0xf4, 0xe0, 0xd9, 0x83, 0xe0, 0x67,
-	0x10, 0x3c, 0xaa, 0xf8, 0x21, 0x7a, 0x2c, 0x7a, 0xf1, 0x24, 0x92, 0x08, 0xde, 0xfd, 0x04, 0xb2,
-	0x33, 0x93, 0x64, 0x4a, 0x5c, 0x4d, 0xe9, 0x25, 0x0c, 0x6f, 0xde, 0xff, 0xfd, 0x7f, 0xef, 0xe5,
-	0xcd, 0xc2, 0x8b, 0xdb, 0x52, 0x75, 0xa4, 0xc2, 0x2a, 0x22, 0xea, 0x09, 0x17, 0x0c, 0xf7, 0x6a,
-	0x21, 0x4d, 0x49, 0x0d, 0xef, 0x76, 0x69, 0xb2, 0x17, 0xc4, 0x89, 0x4c, 0x25, 0x5a, 0x34, 0x49,
-	0xc1, 0x30, 0x29, 0xb0, 0x49, 0xe5, 0x75, 0xab, 0x0e, 0x89, 0xa2, 0x46, 0x31, 0xd2, 0xc7, 0x84,
-	0x71, 0x41, 0x52, 0x2e, 0x85, 0x29, 0x52, 0x5e, 0x60, 0x92, 0x49, 0x7d, 0xc4, 0xd9, 0xc9, 0x46,
-	0xcf, 0x31, 0x29, 0x59, 0x44, 0x31, 0x89, 0x39, 0x26, 0x42, 0xc8, 0x54, 0x4b, 0x94, 0xbd, 0xad,
-	0xe6, 0xd1, 0x8d, 0x48, 0x4c, 0xde, 0xb2, 0xc9, 0x6b, 0x99, 0xf2, 0x96, 0xd6, 0x5c, 0x9d, 0x21,
-	0x1d, 0x2e, 0x24, 0xd6, 0xbf, 0x26, 0xe4, 0x2f, 0x40, 0xf4, 0x20, 0x63, 0xbd, 0x4f, 0x12, 0xd2,
-	0x51, 0x4d, 0xba, 0xdb, 0xa5, 0x2a, 0xf5, 0x1f, 0xc1, 0xb3, 0x87, 0xa2, 0x2a, 0x96, 0x42, 0x51,
-	0xd4, 0x80, 0xc5, 0x58, 0x47, 0x96, 0xc0, 0x05, 0xb0, 0x56, 0xaa, 0x57, 0x82, 0x9c, 0x61, 0x04,
-	0x46, 0xd8, 0x98, 0xdb, 0xff, 0x5e, 0x29, 0xbc, 0xff, 0xf5, 0x61, 0x1d, 0x34, 0xad, 0xd2, 0x6f,
-	0xc1, 0x45, 0x5d, 0x7a, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa4, 0x75, 0x45, 0xb7, 0xe0,
-	0xfc, 0xb6, 0x14, 0xaa, 0x45, 0xda, 0xed, 0x84, 0x2a, 0x63, 0x32, 0xd7, 0x58, 0xf9, 0xf2, 0x79,
-	0xe3, 0xbc, 0xf5, 0xb9, 0x99, 0x61, 0x08, 0xd5, 0x55, 0x37, 0x4c, 0xca, 0x56, 0x9a, 0x70, 0xc1,
-	0x9a, 0xa5, 0x4c, 0x66, 0x43, 0xfe, 0x4b, 0xb8, 0x34, 0x69, 0x60, 0x1b, 0x08, 0xe1, 0xe9, 0x1e,
-	0x89, 0x5a, 0xca, 0x5c, 0xb5, 0xb8, 0xd8, 0x91, 0xb6, 0x95, 0x8d, 0xdc, 0x56, 0x1e, 0x92, 0x88,
-	0xb7, 0x49, 0x2a, 0x13, 0xa7, 0xa0, 0xdb, 0xd8, 0xa9, 0x1e, 0x89, 0x9c, 0x2b, 0x3f, 0x9c, 0xf4,
-	0x1f, 0xce, 0x15, 0xdd, 0x86, 0x70, 0xbc, 0x0b, 0xd6, 0xb9, 0x3a, 0x74, 0xce, 0x16, 0x27, 0x30,
-	0xab, 0x36, 0x1e, 0x23, 0xa3, 0x56, 0xdb, 0x74, 0x94, 0xfe, 0x27, 0x00, 0x97, 0xff, 0x62, 0x62,
-	0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0x66, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee, 0x1c, 0x62, 0x9e,
-	0xd1, 0xcc, 0xab, 0xff, 0x65, 0x36, 0x28, 0x2e, 0x74, 0xfd, 0xf7, 0x2c, 0x3c, 0xa9, 0xa1, 0xd1,
-	0x6b, 0x00, 0x8b, 0x66, 0x43, 0xd0, 0xa5, 0x5c, 0xba, 0xc9, 0xb5, 0x2c, 0x5f, 0x9e, 0x2e, 0xd9,
-	0x78, 0xfb, 0xab, 0xaf, 0xbe, 0xfe, 0x7c, 0x3b, 0xb3, 0x82, 0x2a, 0x38, 0xef, 0xe5, 0x98, 0x95,
-	0x44, 0x1f, 0x01, 0x2c, 0x39, 0x23, 0x40, 0x57, 0xfe, 0x6d, 0x33, 0xb9, 0xb9, 0xe5, 0xda, 0x11,
-	0x14, 0x96, 0xee, 0xba, 0xa6, 0xdb, 0x44, 0xd7, 0x72, 0xe9, 0xdc, 0x2d, 0x55, 0xf8, 0xb9, 0xfb,
-	0x34, 0x5e, 0xa0, 0x77, 0x00, 0xce, 0xbb, 0x7f, 0x3e, 0x9a, 0x1e, 0x61, 0x34, 0xce, 0xfa, 0x51,
-	0x24, 0x16, 0x3b, 0xd0, 0xd8, 0x6b, 0xa8, 0x3a, 0x1d, 0x76, 0x63, 0x73, 0xbf, 0xef, 0x81, 0x83,
-	0xbe, 0x07, 0x7e, 0xf4, 0x3d, 0xf0, 0x66, 0xe0, 0x15, 0x0e, 0x06, 0x5e, 0xe1, 0xdb, 0xc0, 0x2b,
-	0x3c, 0xb6, 0x6f, 0x5a, 0xb5, 0x9f, 0x06, 0x5c, 0xe2, 0x67, 0xe3, 0x42, 0xe9, 0x5e, 0x4c, 0x55,
-	0x58, 0xd4, 0xdf, 0xa7, 0xab, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x73, 0x05, 0x05, 0x95,
-	0x05, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x4f, 0x13, 0x4d,
+	0x18, 0xc7, 0xbb, 0xf0, 0x42, 0xc2, 0x50, 0x78, 0x5f, 0x06, 0x12, 0xa0, 0x40, 0xfb, 0xd2, 0x37,
+	0x01, 0xc2, 0x2b, 0xbb, 0x82, 0x22, 0x89, 0xd1, 0x83, 0x4b, 0x83, 0xd1, 0x60, 0x82, 0x25, 0x31,
+	0xd1, 0xc4, 0x6c, 0xa6, 0xdb, 0xe9, 0x32, 0xba, 0x9d, 0x59, 0x76, 0xb6, 0x68, 0x63, 0xf4, 0xe0,
+	0xd9, 0x83, 0x89, 0x17, 0xbf, 0x80, 0x89, 0x47, 0x35, 0x24, 0xde, 0x3d, 0x71, 0x24, 0x78, 0x31,
+	0x1e, 0xd0, 0x80, 0x89, 0x5f, 0xc2, 0x83, 0xd9, 0x99, 0xd9, 0xb2, 0xa5, 0x2c, 0x42, 0xf4, 0x42,
+	0x96, 0x79, 0x9e, 0xff, 0xf3, 0xff, 0x3d, 0x4f, 0xe7, 0x19, 0xf0, 0x9f, 0xcd, 0x78, 0x95, 0x71,
+	0x83, 0xbb, 0x88, 0xaf, 0x11, 0xea, 0x18, 0x1b, 0xb3, 0x25, 0x1c, 0xa0, 0x59, 0x63, 0xbd, 0x86,
+	0xfd, 0xba, 0xee, 0xf9, 0x2c, 0x60, 0x70, 0x50, 0x26, 0xe9, 0x51, 0x92, 0xae, 0x92, 0x32, 0xd3,
+	0x4a, 0x5d, 0x42, 0x1c, 0x4b, 0x45, 0x43, 0xef, 0x21, 0x87, 0x50, 0x14, 0x10, 0x46, 0x65, 0x91,
+	0xcc, 0x80, 0xc3, 0x1c, 0x26, 0x3e, 0x8d, 0xf0, 0x4b, 0x9d, 0x8e, 0x3a, 0x8c, 0x39, 0x2e, 0x36,
+	0x90, 0x47, 0x0c, 0x44, 0x29, 0x0b, 0x84, 0x84, 0xab, 0xe8, 0x44, 0x12, 0x5d, 0x83, 0x44, 0xe6,
+	0x0d, 0xcb, 0x3c, 0x4b, 0x96, 0x57, 0xb4, 0x32, 0xd4, 0x87, 0xaa, 0x84, 0x32, 0x43, 0xfc, 0x55,
+	0x47, 0x59, 0xe5, 0x29, 0xfe, 0x2b, 0xd5, 0x2a, 0x46, 0xb9, 0xe6, 0xc7, 0x48, 0xf3, 0x03, 0x00,
+	0xde, 0x0c, 0x7b, 0x59, 0x41, 0x3e, 0xaa, 0xf2, 0x22, 0x5e, 0xaf, 0x61, 0x1e, 0xe4, 0x6f, 0x83,
+	0xfe, 0xa6, 0x53, 0xee, 0x31, 0xca, 0x31, 0x34, 0x41, 0xa7, 0x27, 0x4e, 0x86, 0xb4, 0x7f, 0xb5,
+	0xa9, 0xee, 0xb9, 0x9c, 0x9e, 0x30, 0x2c, 0x5d, 0x0a, 0xcd, 0xae, 0xad, 0xdd, 0x5c, 0xea, 0xf5,
+	0xf7, 0x37, 0xd3, 0x5a, 0x51, 0x29, 0xf3, 0x16, 0x18, 0x14, 0xa5, 0x57, 0x89, 0x43, 0x09, 0x75,
+	0xae, 0xd1, 0x0a, 0x53, 0xae, 0xb0, 0x00, 0xd2, 0x36, 0xa3, 0xdc, 0x42, 0xe5, 0xb2, 0x8f, 0xb9,
+	0x34, 0xe9, 0x32, 0xc7, 0x77, 0x36, 0x67, 0xc6, 0x94, 0xcf, 0x62, 0x88, 0x41, 0x79, 0x8d, 0x5f,
+	0x91, 0x29, 0xab, 0x81, 0x4f, 0xa8, 0x53, 0xec, 0x0e, 0x65, 0xea, 0x28, 0xff, 0x04, 0x0c, 0xb5,
+	0x1a, 0xa8, 0x06, 0x4a, 0xe0, 0x9f, 0x0d, 0xe4, 0x5a, 0x5c, 0x86, 0x2c, 0x42, 0x2b, 0x4c, 0xb5,
+	0x32, 0x93, 0xd8, 0xca, 0x2d, 0xe4, 0x92, 0x32, 0x0a, 0x98, 0x1f, 0x2b, 0x18, 0x6f, 0xac, 0x77,
+	0x03, 0xb9, 0xb1, 0x50, 0xbe, 0xd4, 0xea, 0x1f, 0xcd, 0x15, 0x2e, 0x01, 0x70, 0x70, 0x57, 0x94,
+	0xf3, 0x44, 0xe4, 0x1c, 0x5e, 0x2c, 0x5d, 0x5e, 0xc5, 0x83, 0x31, 0x3a, 0x58, 0x69, 0x8b, 0x31,
+	0x65, 0xfe, 0x9d, 0x06, 0x86, 0x8f, 0x30, 0x51, 0x5d, 0x2e, 0x83, 0xbf, 0x54, 0x67, 0xed, 0xbf,
+	0xd5, 0x99, 0xa8, 0x02, 0xaf, 0x36, 0x31, 0xb7, 0x09, 0xe6, 0xc9, 0x5f, 0x32, 0x4b, 0x94, 0x26,
+	0x68, 0x1b, 0x8c, 0x08, 0xe6, 0x02, 0x7b, 0x40, 0x03, 0x52, 0xc5, 0x2b, 0x98, 0x22, 0x37, 0xa8,
+	0xff, 0xd9, 0x5f, 0xff, 0x87, 0x06, 0x46, 0x8f, 0x76, 0x51, 0xc3, 0xd1, 0x41, 0x7f, 0x59, 0x85,
+	0xac, 0x7b, 0x88, 0xb8, 0x96, 0xcd, 0x6a, 0x34, 0x10, 0x6e, 0xed, 0xc5, 0xbe, 0x28, 0x74, 0x1d,
+	0x11, 0x77, 0x31, 0x0c, 0xc0, 0xbb, 0xa0, 0x57, 0x0c, 0xce, 0xaa, 0xf8, 0xc8, 0x6e, 0x8c, 0x20,
+	0x6d, 0x5e, 0x08, 0xe7, 0xf4, 0x79, 0x37, 0x37, 0x22, 0xe1, 0x78, 0xf9, 0xbe, 0x4e, 0x98, 0x51,
+	0x45, 0xc1, 0x9a, 0xbe, 0x8c, 0x1d, 0x64, 0xd7, 0x0b, 0xd8, 0xde, 0xd9, 0x9c, 0x01, 0x8a, 0xbd,
+	0x80, 0x6d, 0x39, 0xd4, 0x1e, 0x51, 0x6d, 0x49, 0x15, 0x83, 0x37, 0x40, 0x8f, 0xa0, 0x88, 0xd6,
+	0x72, 0xa8, 0x5d, 0x0c, 0x78, 0x58, 0x97, 0x7b, 0xab, 0x47, 0x7b, 0xab, 0x17, 0x54, 0x82, 0xd9,
+	0x13, 0x1a, 0xbf, 0xfc, 0x92, 0xd3, 0x64, 0xbd, 0x74, 0x28, 0x8f, 0x82, 0x73, 0xef, 0x3b, 0x40,
+	0x87, 0x68, 0x1f, 0x3e, 0xd3, 0x40, 0xa7, 0xdc, 0x42, 0xf8, 0x7f, 0xe2, 0x0d, 0x68, 0x5d, 0xfd,
+	0xcc, 0x99, 0x93, 0x25, 0xcb, 0x69, 0xe6, 0x27, 0x9f, 0x7e, 0xfc, 0xf6, 0xa2, 0x6d, 0x1c, 0xe6,
+	0x8c, 0xa4, 0xd7, 0x4b, 0xae, 0x3d, 0x7c, 0xab, 0x81, 0xee, 0xd8, 0x35, 0x83, 0x67, 0x8f, 0xb7,
+	0x69, 0x7d, 0x1d, 0x32, 0xb3, 0xa7, 0x50, 0x28, 0xba, 0xcb, 0x82, 0x6e, 0x01, 0xce, 0x27, 0xd2,
+	0xc5, 0x5f, 0x02, 0x6e, 0x3c, 0x8a, 0x5f, 0xc0, 0xc7, 0xf0, 0x95, 0x06, 0xd2, 0xf1, 0x05, 0x83,
+	0x27, 0x47, 0x68, 0x8c, 0x73, 0xee, 0x34, 0x12, 0x85, 0xad, 0x0b, 0xec, 0x29, 0x38, 0x71, 0x32,
+	0x6c, 0xf8, 0x41, 0x03, 0x7f, 0x1f, 0xba, 0xee, 0xf0, 0xfc, 0xf1, 0xbe, 0x47, 0xef, 0x60, 0x66,
+	0xfe, 0x94, 0x2a, 0x05, 0x6c, 0x0a, 0xe0, 0x4b, 0xf0, 0x62, 0x22, 0x70, 0x63, 0xe5, 0x3c, 0x21,
+	0x25, 0xf8, 0xf0, 0xb0, 0xcd, 0x85, 0xad, 0xbd, 0xac, 0xb6, 0xbd, 0x97, 0xd5, 0xbe, 0xee, 0x65,
+	0xb5, 0xe7, 0xfb, 0xd9, 0xd4, 0xf6, 0x7e, 0x36, 0xf5, 0x69, 0x3f, 0x9b, 0xba, 0x33, 0xd6, 0xb4,
+	0x61, 0x0f, 0x0f, 0x8a, 0x07, 0x75, 0x0f, 0xf3, 0x52, 0xa7, 0x58, 0x91, 0x73, 0x3f, 0x07, 0x00,
+	0x53, 0xb6, 0x09, 0xec, 0xde, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +505,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// DowntimePenalty queries the penalties of the next downtime jailing of the
+	// given cons address.
+	DowntimePenalty(ctx context.Context, in *QueryDowntimePenaltyRequest, opts ...grpc.CallOption) (*QueryDowntimePenaltyResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +545,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimePenalty(ctx context.Context, in *QueryDowntimePenaltyRequest, opts ...grpc.CallOption) (*QueryDowntimePenaltyResponse, error) {
+	out := new(QueryDowntimePenaltyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/DowntimePenalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +562,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// DowntimePenalty queries the penalties of the next downtime jailing of the
+	// given cons address.
+	DowntimePenalty(context.Context, *QueryDowntimePenaltyRequest) (*QueryDowntimePenaltyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +580,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) DowntimePenalty(ctx context.Context, req *QueryDowntimePenaltyRequest) (*QueryDowntimePenaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimePenalty not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +642,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimePenalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimePenaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimePenalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/DowntimePenalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimePenalty(ctx, req.(*QueryDowntimePenaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +676,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "DowntimePenalty",
+			Handler:    _Query_DowntimePenalty_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,6 +888,82 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDowntimePenaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimePenaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimePenaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimePenaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimePenaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimePenaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DowntimeJailCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DowntimeJailCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -810,6 +1051,35 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDowntimePenaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimePenaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DowntimeJailCount != 0 {
+		n += 1 + sovQuery(uint64(m.DowntimeJailCount))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1320,6 +1590,223 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDowntimePenaltyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimePenaltyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimePenaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimePenaltyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimePenaltyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimePenaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailCount", wireType)
+			}
+			m.DowntimeJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
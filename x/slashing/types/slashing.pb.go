@@ -50,6 +50,12 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Number of downtime jailings within the current escalation window. It
+	// determines the penalties of the next downtime jailing.
+	DowntimeJailCount int64 `protobuf:"varint,7,opt,name=downtime_jail_count,json=downtimeJailCount,proto3" json:"downtime_jail_count,omitempty"`
+	// Timestamp at which the downtime jail count resets if the validator has not
+	// been jailed for downtime again.
+	DowntimeEscalationResetTime time.Time `protobuf:"bytes,8,opt,name=downtime_escalation_reset_time,json=downtimeEscalationResetTime,proto3,stdtime" json:"downtime_escalation_reset_time"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -128,6 +134,20 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeJailCount() int64 {
+	if m != nil {
+		return m.DowntimeJailCount
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetDowntimeEscalationResetTime() time.Time {
+	if m != nil {
+		return m.DowntimeEscalationResetTime
+	}
+	return time.Time{}
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -135,6 +155,17 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// downtime_escalation_window is the period following the end of a downtime
+	// jailing during which another downtime jailing escalates the penalties. A
+	// zero window disables the escalation.
+	DowntimeEscalationWindow time.Duration `protobuf:"bytes,6,opt,name=downtime_escalation_window,json=downtimeEscalationWindow,proto3,stdduration" json:"downtime_escalation_window"`
+	// downtime_escalation_multiplier is the factor applied to the downtime jail
+	// duration and slash fraction for every repeat downtime jailing within the
+	// escalation window.
+	DowntimeEscalationMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=downtime_escalation_multiplier,json=downtimeEscalationMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"downtime_escalation_multiplier"`
+	// max_downtime_escalations is the maximum number of times the escalation
+	// multiplier is applied.
+	MaxDowntimeEscalations uint32 `protobuf:"varint,8,opt,name=max_downtime_escalations,json=maxDowntimeEscalations,proto3" json:"max_downtime_escalations,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,6 +215,20 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeEscalationWindow() time.Duration {
+	if m != nil {
+		return m.DowntimeEscalationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDowntimeEscalations() uint32 {
+	if m != nil {
+		return m.MaxDowntimeEscalations
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
@@ -194,47 +239,54 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x09, 0x04, 0x76, 0x12, 0x0e, 0x0c, 0x01, 0x4c, 0x00, 0x27, 0x20, 0xed, 0x2a, 0x42,
+	0xc2, 0x5e, 0x58, 0x69, 0xb5, 0x82, 0xd3, 0x86, 0xec, 0xaa, 0xad, 0xa8, 0x8a, 0x4c, 0x7f, 0x48,
+	0x3d, 0xd4, 0x9a, 0xd8, 0x13, 0x67, 0x8a, 0x3d, 0x13, 0x79, 0x26, 0x25, 0x48, 0xfd, 0x0b, 0x7a,
+	0xe2, 0xd8, 0x63, 0x8f, 0x5c, 0x2a, 0x71, 0xe0, 0x8f, 0xe0, 0x88, 0x38, 0x55, 0x3d, 0xd0, 0x2a,
+	0xa8, 0xa2, 0x7f, 0x46, 0xe5, 0x19, 0x3b, 0xfc, 0x08, 0x17, 0xc4, 0x25, 0x4a, 0xde, 0xfb, 0xbe,
+	0xef, 0xbd, 0xf7, 0xbd, 0x37, 0x01, 0x7f, 0xb8, 0x8c, 0x87, 0x8c, 0x5b, 0x3c, 0x40, 0xbc, 0x45,
+	0xa8, 0x6f, 0xbd, 0x5b, 0x6d, 0x60, 0x81, 0x56, 0xfb, 0x01, 0xb3, 0x1d, 0x31, 0xc1, 0xe0, 0x8c,
+	0xc2, 0x99, 0xfd, 0x70, 0x82, 0x2b, 0x15, 0x7d, 0xe6, 0x33, 0x89, 0xb1, 0xe2, 0x6f, 0x0a, 0x5e,
+	0x32, 0x7c, 0xc6, 0xfc, 0x00, 0x5b, 0xf2, 0x57, 0xa3, 0xd3, 0xb4, 0xbc, 0x4e, 0x84, 0x04, 0x61,
+	0x34, 0xc9, 0x97, 0x6f, 0xe7, 0x05, 0x09, 0x31, 0x17, 0x28, 0x6c, 0x27, 0x80, 0x59, 0x55, 0xcf,
+	0x51, 0xca, 0x49, 0x71, 0x95, 0x9a, 0x40, 0x21, 0xa1, 0xcc, 0x92, 0x9f, 0x2a, 0xb4, 0xf4, 0x23,
+	0x0b, 0x8a, 0x2f, 0x51, 0x40, 0x3c, 0x24, 0x58, 0xb4, 0x43, 0x7c, 0x4a, 0xa8, 0xff, 0x98, 0x36,
+	0x19, 0xdc, 0x00, 0xa3, 0xc8, 0xf3, 0x22, 0xcc, 0xb9, 0xae, 0x55, 0xb4, 0xea, 0x6f, 0xb5, 0xc5,
+	0xb3, 0xe3, 0x95, 0x85, 0x44, 0x6e, 0x93, 0x51, 0x8e, 0x29, 0xef, 0xf0, 0x7f, 0x15, 0x64, 0x47,
+	0x44, 0x84, 0xfa, 0x76, 0xca, 0x80, 0x8b, 0xa0, 0xc0, 0x05, 0x8a, 0x84, 0xd3, 0xc2, 0xc4, 0x6f,
+	0x09, 0x7d, 0xa8, 0xa2, 0x55, 0xb3, 0x76, 0x5e, 0xc6, 0x1e, 0xc9, 0x10, 0xfc, 0x1d, 0x14, 0x08,
+	0xf5, 0x70, 0xd7, 0x61, 0xcd, 0x26, 0xc7, 0x42, 0xcf, 0xc6, 0x90, 0xda, 0x90, 0xae, 0xd9, 0x79,
+	0x19, 0x7f, 0x26, 0xc3, 0x70, 0x0b, 0x14, 0xde, 0x22, 0x12, 0x60, 0xcf, 0xe9, 0x50, 0x41, 0x02,
+	0x7d, 0xb8, 0xa2, 0x55, 0xf3, 0x6b, 0x25, 0x53, 0xb9, 0x60, 0xa6, 0x2e, 0x98, 0xcf, 0x53, 0x17,
+	0x6a, 0xe3, 0x27, 0xe7, 0xe5, 0xcc, 0xc1, 0xb7, 0xb2, 0x76, 0x78, 0x79, 0xb4, 0xac, 0xd9, 0x79,
+	0x45, 0x7f, 0x11, 0xb3, 0xa1, 0x01, 0x80, 0x60, 0x61, 0x83, 0x0b, 0x46, 0xb1, 0xa7, 0x8f, 0x54,
+	0xb4, 0xea, 0x98, 0x7d, 0x2d, 0x02, 0xd7, 0xc0, 0x54, 0x48, 0x38, 0xc7, 0x9e, 0xd3, 0x08, 0x98,
+	0xbb, 0xcb, 0x1d, 0x97, 0x75, 0xa8, 0xc0, 0x91, 0x9e, 0x93, 0x03, 0x4c, 0xaa, 0x64, 0x4d, 0xe6,
+	0x36, 0x55, 0x0a, 0x9a, 0x60, 0xd2, 0x63, 0x7b, 0x34, 0x5e, 0x83, 0x13, 0xd7, 0x52, 0x1c, 0x7d,
+	0x54, 0x32, 0x26, 0xd2, 0xd4, 0x13, 0x44, 0x02, 0xc9, 0x80, 0x14, 0x18, 0x7d, 0x3c, 0xe6, 0x2e,
+	0x0a, 0xe4, 0x76, 0x9d, 0x08, 0x73, 0x2c, 0x9c, 0x38, 0xaa, 0x8f, 0xdd, 0x77, 0xc6, 0xb9, 0x54,
+	0xf0, 0xbf, 0xbe, 0x9e, 0x1d, 0xcb, 0xc5, 0x84, 0xf5, 0xe1, 0x9f, 0x9f, 0xca, 0xda, 0xd2, 0xe7,
+	0x1c, 0xc8, 0x6d, 0xa3, 0x08, 0x85, 0x1c, 0xfe, 0x09, 0x8a, 0x9c, 0xf8, 0xf4, 0x6a, 0xc8, 0x3d,
+	0x42, 0x3d, 0xb6, 0x27, 0xd7, 0x9c, 0xb5, 0xa1, 0xca, 0xa9, 0x19, 0x5f, 0xc9, 0x0c, 0x24, 0xb1,
+	0x2d, 0xd4, 0x49, 0x58, 0x6d, 0x1c, 0xa5, 0x94, 0x78, 0xaf, 0x85, 0xda, 0xdf, 0x71, 0x37, 0x5f,
+	0xcf, 0xcb, 0x73, 0xea, 0x3a, 0xb8, 0xb7, 0x6b, 0x12, 0x66, 0x85, 0x48, 0xb4, 0xcc, 0x2d, 0xec,
+	0x23, 0x77, 0xbf, 0x8e, 0xdd, 0xb3, 0xe3, 0x15, 0x90, 0x1c, 0x4f, 0x1d, 0xbb, 0xaa, 0x6d, 0x18,
+	0x12, 0xba, 0x23, 0x35, 0xb7, 0x71, 0x94, 0x94, 0x7a, 0x03, 0xa6, 0x6f, 0xba, 0x99, 0x9e, 0xbf,
+	0x3c, 0x90, 0xfc, 0xda, 0xec, 0x80, 0x2b, 0xf5, 0x04, 0xa0, 0x4c, 0xf9, 0xd8, 0x37, 0xa5, 0x78,
+	0xdd, 0xfa, 0x14, 0x04, 0x39, 0x28, 0xc9, 0x87, 0xe8, 0x34, 0x23, 0xe4, 0x4a, 0xe3, 0x3d, 0xd6,
+	0x69, 0x04, 0x58, 0x0e, 0xa7, 0x0f, 0x3f, 0x68, 0x9e, 0x19, 0xa9, 0xfc, 0x7f, 0x22, 0x5c, 0x97,
+	0xba, 0xf1, 0x7c, 0x90, 0x82, 0x99, 0x81, 0xa2, 0xaa, 0x37, 0x7d, 0xe4, 0x41, 0x15, 0xa7, 0x6e,
+	0x55, 0x54, 0xa2, 0xb0, 0x09, 0x4a, 0x77, 0x9d, 0x58, 0xb2, 0xb4, 0xdc, 0x3d, 0x8d, 0xd4, 0x07,
+	0xaf, 0x2b, 0x59, 0xd6, 0xfb, 0xbb, 0x4f, 0x39, 0xec, 0x04, 0x82, 0xb4, 0x03, 0x82, 0x23, 0x7d,
+	0xf4, 0x41, 0xe3, 0xcd, 0x0f, 0x56, 0x7e, 0xda, 0xd7, 0x86, 0xff, 0x00, 0x3d, 0x44, 0x5d, 0xe7,
+	0x8e, 0x0e, 0xb8, 0x7c, 0x42, 0xe3, 0xf6, 0x74, 0x88, 0xba, 0xf5, 0x01, 0x09, 0xbe, 0xbe, 0xf8,
+	0xe1, 0xf2, 0x68, 0x79, 0x5e, 0x55, 0x5b, 0xe1, 0xde, 0xae, 0xd5, 0xbd, 0xfa, 0x17, 0x57, 0x8f,
+	0xa4, 0xb6, 0x71, 0xd8, 0x33, 0xb4, 0x93, 0x9e, 0xa1, 0x9d, 0xf6, 0x0c, 0xed, 0x7b, 0xcf, 0xd0,
+	0x0e, 0x2e, 0x8c, 0xcc, 0xe9, 0x85, 0x91, 0xf9, 0x72, 0x61, 0x64, 0x5e, 0x2f, 0xdc, 0x18, 0xe4,
+	0x1a, 0x5b, 0xec, 0xb7, 0x31, 0x6f, 0xe4, 0xa4, 0xa7, 0x7f, 0xfd, 0x1a, 0x00, 0x31, 0xdf, 0xb7,
+	0x41, 0x23, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -274,6 +326,12 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeJailCount != that1.DowntimeJailCount {
+		return false
+	}
+	if !this.DowntimeEscalationResetTime.Equal(that1.DowntimeEscalationResetTime) {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -310,6 +368,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeEscalationWindow != that1.DowntimeEscalationWindow {
+		return false
+	}
+	if !this.DowntimeEscalationMultiplier.Equal(that1.DowntimeEscalationMultiplier) {
+		return false
+	}
+	if this.MaxDowntimeEscalations != that1.MaxDowntimeEscalations {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -332,6 +399,19 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DowntimeEscalationResetTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DowntimeEscalationResetTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.DowntimeJailCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeJailCount))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -347,12 +427,12 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if m.IndexOffset != 0 {
@@ -395,6 +475,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDowntimeEscalations != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxDowntimeEscalations))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.DowntimeEscalationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeEscalationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeEscalationWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeEscalationWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -415,12 +518,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -476,6 +579,11 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.DowntimeJailCount != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeJailCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DowntimeEscalationResetTime)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -496,6 +604,13 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeEscalationWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeEscalationMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.MaxDowntimeEscalations != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxDowntimeEscalations))
+	}
 	return n
 }
 
@@ -676,6 +791,58 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailCount", wireType)
+			}
+			m.DowntimeJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationResetTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DowntimeEscalationResetTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -877,6 +1044,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeEscalationWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeEscalationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeEscalations", wireType)
+			}
+			m.MaxDowntimeEscalations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDowntimeEscalations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
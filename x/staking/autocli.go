@@ -137,6 +137,47 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "TokenizeShareRecordById",
+					Use:       "tokenize-share-record-by-id [id]",
+					Short:     "Query the tokenize share record with the given id",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record-by-id 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeShareRecordByDenom",
+					Use:       "tokenize-share-record-by-denom [denom]",
+					Short:     "Query the tokenize share record of the given share token denom",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record-by-denom cosmosvaloper.../1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeShareRecordsOwned",
+					Use:       "tokenize-share-records-owned [owner]",
+					Short:     "Query the tokenize share records owned by an address",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-records-owned cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the total amount of liquid staked tokens",
+				},
+				{
+					RpcMethod: "LiquidValidator",
+					Use:       "liquid-validator [validator-addr]",
+					Short:     "Query the liquid shares and the validator bond shares of a validator",
+					Example:   fmt.Sprintf("$ %s query staking liquid-validator cosmosvaloper...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -180,6 +221,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pubkey myvalidator {"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share [validator-addr] [amount] [rewards-owner] --from [delegator_address]",
+					Short:          "Tokenize delegation shares",
+					Long:           "Convert an amount of the delegation to a validator into share tokens, whose rewards are withdrawable by the rewards owner.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount] --from [delegator_address]",
+					Short:          "Redeem share tokens for delegation shares",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "TransferTokenizeShareRecord",
+					Use:            "transfer-tokenize-share-record [record-id] [new-owner] --from [sender]",
+					Short:          "Transfer the ownership of a tokenize share record",
+					Example:        fmt.Sprintf(`%s tx staking transfer-tokenize-share-record 1 cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenize_share_record_id"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "ValidatorBond",
+					Use:            "validator-bond [validator-addr] --from [delegator_address]",
+					Short:          "Flag a delegation as a validator bond",
+					Example:        fmt.Sprintf(`%s tx staking validator-bond cosmosvaloper... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -121,6 +121,11 @@ func (k Keeper) RemoveDelegation(ctx context.Context, delegation types.Delegatio
 		return err
 	}
 
+	err = k.ValidatorBonds.Remove(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delegatorAddress)))
+	if err != nil {
+		return err
+	}
+
 	return k.DelegationsByValidator.Remove(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delegatorAddress)))
 }
 
@@ -687,6 +692,10 @@ func (k Keeper) Delegate(
 		return math.LegacyZeroDec(), types.ErrDelegatorShareExRateInvalid
 	}
 
+	if err := k.checkValidatorPowerCap(ctx, validator, bondAmt, tokenSrc); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
 	valbz, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
 	if err != nil {
 		return math.LegacyZeroDec(), err
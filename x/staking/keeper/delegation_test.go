@@ -123,6 +123,13 @@ func (s *KeeperTestSuite) TestDelegation() {
 		resDels, err := keeper.GetValidatorDelegations(ctx, valAddrs[i])
 		require.NoError(err)
 		require.Len(resDels, 2)
+
+		var iterDels []sdk.DelegationI
+		require.NoError(keeper.IterateValidatorDelegations(ctx, valAddrs[i], func(_ int64, del sdk.DelegationI) bool {
+			iterDels = append(iterDels, del)
+			return false
+		}))
+		require.Equal([]sdk.DelegationI{resDels[0], resDels[1]}, iterDels)
 	}
 
 	// test total bonded for single delegator
@@ -113,6 +113,52 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+			panic(err)
+		}
+
+		// the tokenized shares of the validators are the shares of the record delegations
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		delegation, err := k.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), sdk.ValAddress(valAddr)))
+		if err != nil {
+			panic(fmt.Sprintf("delegation of tokenize share record %d not found", record.Id))
+		}
+
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.setValidatorLiquidShares(ctx, valAddr, liquidShares.Add(delegation.Shares)); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.LastTokenizeShareRecordID.Set(ctx, data.LastTokenizeShareRecordId); err != nil {
+		panic(err)
+	}
+
+	for _, bond := range data.ValidatorBonds {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(bond.DelegatorAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid delegator address: %s", err))
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(bond.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.ValidatorBonds.Set(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delegatorAddress))); err != nil {
+			panic(err)
+		}
+	}
+
 	for _, ubd := range data.UnbondingDelegations {
 		if err := k.SetUnbondingDelegation(ctx, ubd); err != nil {
 			panic(err)
@@ -271,14 +317,45 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	lastTokenizeShareRecordID, err := k.LastTokenizeShareRecordID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var validatorBonds []types.DVPair
+
+	err = k.ValidatorBonds.Walk(ctx, nil, func(key collections.Pair[sdk.ValAddress, sdk.AccAddress]) (stop bool, err error) {
+		delAddr, err := k.authKeeper.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		valAddr, err := k.validatorAddressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		validatorBonds = append(validatorBonds, types.DVPair{DelegatorAddress: delAddr, ValidatorAddress: valAddr})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    params,
+		LastTotalPower:            totalPower,
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                allValidators,
+		Delegations:               allDelegations,
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
+		ValidatorBonds:            validatorBonds,
 	}
 }
@@ -66,6 +66,31 @@ func NewRotationHistoryIndexes(sb *collections.SchemaBuilder) rotationHistoryInd
 	}
 }
 
+type tokenizeShareRecordIndexes struct {
+	Owner *indexes.Multi[sdk.AccAddress, uint64, types.TokenizeShareRecord]
+}
+
+func (a tokenizeShareRecordIndexes) IndexesList() []collections.Index[uint64, types.TokenizeShareRecord] {
+	return []collections.Index[uint64, types.TokenizeShareRecord]{
+		a.Owner,
+	}
+}
+
+func NewTokenizeShareRecordIndexes(sb *collections.SchemaBuilder, addressCodec addresscodec.Codec) tokenizeShareRecordIndexes {
+	return tokenizeShareRecordIndexes{
+		Owner: indexes.NewMulti(
+			sb,
+			types.TokenizeShareRecordByOwnerKey,
+			"tokenize_share_records_by_owner",
+			sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to range over the records of an owner
+			collections.Uint64Key,
+			func(_ uint64, v types.TokenizeShareRecord) (sdk.AccAddress, error) {
+				return addressCodec.StringToBytes(v.Owner)
+			},
+		),
+	}
+}
+
 // Keeper of the x/staking store
 type Keeper struct {
 	environment           appmodule.Environment
@@ -129,6 +154,15 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	// A index is being added with key `TokenizeShareRecordByOwner`: tokenize share records by owner
+	TokenizeShareRecords *collections.IndexedMap[uint64, types.TokenizeShareRecord, tokenizeShareRecordIndexes]
+	// LastTokenizeShareRecordID value: id of the last tokenize share record
+	LastTokenizeShareRecordID collections.Sequence
+	// ValidatorLiquidShares key: valAddr | value: tokenized shares of the validator
+	ValidatorLiquidShares collections.Map[sdk.ValAddress, math.LegacyDec]
+	// ValidatorBonds key: valAddr+delAddr | value: none used (delegations flagged as validator bonds)
+	ValidatorBonds collections.KeySet[collections.Pair[sdk.ValAddress, sdk.AccAddress]]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -142,7 +176,7 @@ func NewKeeper(
 	consensusAddressCodec addresscodec.Codec,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
-	// ensure bonded and not bonded module accounts are set
+	// ensure bonded, not bonded and liquid staking module accounts are set
 	if addr := ak.GetModuleAddress(types.BondedPoolName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.BondedPoolName))
 	}
@@ -151,6 +185,10 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.NotBondedPoolName))
 	}
 
+	if addr := ak.GetModuleAddress(types.LiquidStakingPoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.LiquidStakingPoolName))
+	}
+
 	// ensure that authority is a valid AccAddress
 	if _, err := ak.AddressCodec().StringToBytes(authority); err != nil {
 		panic("authority is not a valid acc address")
@@ -304,6 +342,31 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 129 | recordID
+		// index is: 130 | lengthPrefixedBytes(owner) | recordID
+		TokenizeShareRecords: collections.NewIndexedMap(
+			sb,
+			types.TokenizeShareRecordKey,
+			"tokenize_share_records",
+			collections.Uint64Key,
+			codec.CollValue[types.TokenizeShareRecord](cdc),
+			NewTokenizeShareRecordIndexes(sb, ak.AddressCodec()),
+		),
+		LastTokenizeShareRecordID: collections.NewSequence(sb, types.LastTokenizeShareRecordIDKey, "last_tokenize_share_record_id"),
+		// key format is: 132 | valAddr
+		ValidatorLiquidShares: collections.NewMap(
+			sb, types.ValidatorLiquidSharesKey,
+			"validator_liquid_shares",
+			sdk.ValAddressKey,
+			sdk.LegacyDecValue,
+		),
+		// key format is: 133 | lengthPrefixedBytes(valAddr) | delAddr
+		ValidatorBonds: collections.NewKeySet(
+			sb, types.ValidatorBondKey,
+			"validator_bonds",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), sdk.AccAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to range over the bonds of a validator
+		),
 	}
 
 	schema, err := sb.Build()
@@ -32,9 +32,10 @@ import (
 )
 
 var (
-	bondedAcc    = authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName)
-	notBondedAcc = authtypes.NewEmptyModuleAccount(stakingtypes.NotBondedPoolName)
-	PKs          = simtestutil.CreateTestPubKeys(500)
+	bondedAcc        = authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName)
+	notBondedAcc     = authtypes.NewEmptyModuleAccount(stakingtypes.NotBondedPoolName)
+	liquidStakingAcc = authtypes.NewEmptyModuleAccount(stakingtypes.LiquidStakingPoolName)
+	PKs              = simtestutil.CreateTestPubKeys(500)
 )
 
 type KeeperTestSuite struct {
@@ -66,6 +67,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	accountKeeper := stakingtestutil.NewMockAccountKeeper(ctrl)
 	accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolName).Return(bondedAcc.GetAddress())
 	accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolName).Return(notBondedAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAddress(stakingtypes.LiquidStakingPoolName).Return(liquidStakingAcc.GetAddress())
 	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
 	bankKeeper := stakingtestutil.NewMockBankKeeper(ctrl)
@@ -254,7 +256,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"e41511c68d0c892994dd7ebd57c3847056ea470e1583e3f6a46800af3a109ef9",
 	)
 	s.Require().NoError(err)
 
@@ -269,7 +271,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"e41511c68d0c892994dd7ebd57c3847056ea470e1583e3f6a46800af3a109ef9",
 	)
 	s.Require().NoError(err)
 }
@@ -287,7 +289,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"d07dd866c4d501d1c38a07319ae2de5cc13b42e9c84e1dc4b429916fe274a918",
 	)
 	s.Require().NoError(err)
 
@@ -300,7 +302,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"d07dd866c4d501d1c38a07319ae2de5cc13b42e9c84e1dc4b429916fe274a918",
 	)
 
 	s.Require().NoError(err)
@@ -319,7 +321,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81", // this hash obtained when ran this test in main branch
+		"6bfde65b833a698d0c17c6aaa40c7271ede647458bef0eea8b0ffb67b4d5e989", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -332,7 +334,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81",
+		"6bfde65b833a698d0c17c6aaa40c7271ede647458bef0eea8b0ffb67b4d5e989",
 	)
 
 	s.Require().NoError(err)
@@ -363,7 +365,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"139439885d206415fadd01fbc0563364030a2b13fed426894fa04acfc0215599",
 	)
 	s.Require().NoError(err)
 
@@ -387,7 +389,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"139439885d206415fadd01fbc0563364030a2b13fed426894fa04acfc0215599",
 	)
 	s.Require().NoError(err)
 }
@@ -404,7 +406,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"915eb5888297ef7cf6eef0a9317793c3438e00ff9681b67696511734532021cd",
 	)
 	s.Require().NoError(err)
 
@@ -417,7 +419,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"915eb5888297ef7cf6eef0a9317793c3438e00ff9681b67696511734532021cd",
 	)
 	s.Require().NoError(err)
 }
@@ -451,7 +453,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"a63e602b348efe47d8150763b6cf9a71034076362b2abe62703ed6b642628e23",
 	)
 	s.Require().NoError(err)
 
@@ -477,7 +479,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"a63e602b348efe47d8150763b6cf9a71034076362b2abe62703ed6b642628e23",
 	)
 	s.Require().NoError(err)
 }
@@ -500,7 +502,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"08f11eb5c91ed895c72052252be643e6fc32a89afe75ceba4571cbb049478bb5",
 	)
 	s.Require().NoError(err)
 
@@ -515,7 +517,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"08f11eb5c91ed895c72052252be643e6fc32a89afe75ceba4571cbb049478bb5",
 	)
 	s.Require().NoError(err)
 }
@@ -543,7 +545,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"828799747f75b6fe03e118e72b6fc187800ec5a94cef341b14043c7a21b106ca",
 	)
 	s.Require().NoError(err)
 
@@ -565,7 +567,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"828799747f75b6fe03e118e72b6fc187800ec5a94cef341b14043c7a21b106ca",
 	)
 	s.Require().NoError(err)
 }
@@ -0,0 +1,503 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (types.TokenizeShareRecord, error) {
+	record, err := k.TokenizeShareRecords.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "id %d", id)
+	}
+	return record, err
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of a share
+// token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	_, id, err := types.ParseShareTokenDenom(denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	if record.GetShareTokenDenom() != denom {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrInvalidShareTokenDenom, "denom %s is not the share token denom of record %d", denom, id)
+	}
+	return record, nil
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by
+// the given address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	iterator, err := k.TokenizeShareRecords.Indexes.Owner.MatchExact(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return indexes.CollectValues(ctx, k.TokenizeShareRecords, iterator)
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) ([]types.TokenizeShareRecord, error) {
+	records := make([]types.TokenizeShareRecord, 0)
+	err := k.TokenizeShareRecords.Walk(ctx, nil, func(_ uint64, record types.TokenizeShareRecord) (stop bool, err error) {
+		records = append(records, record)
+		return false, nil
+	})
+	return records, err
+}
+
+// GetValidatorLiquidShares returns the amount of tokenized shares of a validator.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+	return shares, err
+}
+
+// setValidatorLiquidShares sets the amount of tokenized shares of a validator,
+// removing the entry once there are no tokenized shares left.
+func (k Keeper) setValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	if !shares.IsPositive() {
+		return k.ValidatorLiquidShares.Remove(ctx, valAddr)
+	}
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, shares)
+}
+
+// GetValidatorBondShares returns the amount of shares of the delegations
+// flagged as validator bonds of a validator.
+func (k Keeper) GetValidatorBondShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares := math.LegacyZeroDec()
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, sdk.AccAddress](valAddr)
+	err := k.ValidatorBonds.Walk(ctx, rng, func(key collections.Pair[sdk.ValAddress, sdk.AccAddress]) (stop bool, err error) {
+		delegation, err := k.Delegations.Get(ctx, collections.Join(key.K2(), key.K1()))
+		if err != nil {
+			return true, err
+		}
+		shares = shares.Add(delegation.Shares)
+		return false, nil
+	})
+	return shares, err
+}
+
+// IsValidatorBond returns whether the delegation of the delegator to the
+// validator is flagged as a validator bond.
+func (k Keeper) IsValidatorBond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error) {
+	return k.ValidatorBonds.Has(ctx, collections.Join(valAddr, delAddr))
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens backing the tokenized
+// shares of all the validators. It is computed from the current exchange rate
+// of the validators, so that slashes are accounted for.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	return k.sumLiquidStakedTokens(ctx, false)
+}
+
+// sumLiquidStakedTokens returns the amount of tokens backing the tokenized
+// shares of all the validators, or of the bonded validators only.
+func (k Keeper) sumLiquidStakedTokens(ctx context.Context, bondedOnly bool) (math.Int, error) {
+	total := math.LegacyZeroDec()
+	err := k.ValidatorLiquidShares.Walk(ctx, nil, func(valAddr sdk.ValAddress, shares math.LegacyDec) (stop bool, err error) {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+		if bondedOnly && !validator.IsBonded() {
+			return false, nil
+		}
+		total = total.Add(validator.TokensFromShares(shares))
+		return false, nil
+	})
+	return total.TruncateInt(), err
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the given shares and
+// tokens of the validator would exceed the global or the validator liquid
+// staking caps, or the validator bond requirement of the validator.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, valAddr sdk.ValAddress, shares math.LegacyDec, tokens math.Int) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	liquidShares = liquidShares.Add(shares)
+
+	// the global cap is a ratio of the total bonded tokens, so only the liquid
+	// staked tokens of the bonded validators are counted against it
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) && validator.IsBonded() {
+		bondedLiquidStaked, err := k.sumLiquidStakedTokens(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		totalBonded, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		liquidStakedRatio := math.LegacyOneDec()
+		if totalBonded.IsPositive() {
+			liquidStakedRatio = math.LegacyNewDecFromInt(bondedLiquidStaked.Add(tokens)).QuoInt(totalBonded)
+		}
+		if liquidStakedRatio.GT(params.GlobalLiquidStakingCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) {
+		if liquidShares.GT(params.ValidatorLiquidStakingCap.Mul(validator.DelegatorShares)) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	if params.ValidatorBondEnabled() {
+		bondShares, err := k.GetValidatorBondShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if liquidShares.GT(bondShares.Mul(params.ValidatorBondFactor)) {
+			return types.ErrInsufficientValidatorBondShares
+		}
+	}
+
+	return nil
+}
+
+// checkValidatorBondRemoval returns an error if removing the given shares from
+// the validator bond delegations of the validator would break its validator
+// bond requirement.
+func (k Keeper) checkValidatorBondRemoval(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.ValidatorBondEnabled() {
+		return nil
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	bondShares, err := k.GetValidatorBondShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if liquidShares.GT(bondShares.Sub(shares).Mul(params.ValidatorBondFactor)) {
+		return errorsmod.Wrap(types.ErrInsufficientValidatorBondShares, "the validator bond cannot be reduced below the tokenized shares of the validator")
+	}
+	return nil
+}
+
+// TokenizeShares converts the given amount of the delegation of the delegator
+// to the validator into share tokens minted to the delegator. The delegation is
+// moved to the module account of a new tokenize share record owned by owner,
+// who can withdraw its rewards.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if isBond, err := k.IsValidatorBond(ctx, delAddr, valAddr); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	} else if isBond {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrValidatorBondNotAllowedForTokenizeShare
+	}
+
+	// the delegation must stay with the delegator while it can be slashed for
+	// the source validator of a redelegation
+	if hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	} else if hasRedelegation {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, valAddr, shares, validator.TokensFromShares(shares).TruncateInt()); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	lastID, err := k.LastTokenizeShareRecordID.Peek(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	id := lastID + 1
+	if err := k.LastTokenizeShareRecordID.Set(ctx, id); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(id, ownerStr, validator.GetOperator())
+	recordAddr := record.GetModuleAddress()
+	if k.authKeeper.GetAccount(ctx, recordAddr) == nil {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccount(ctx, authtypes.NewEmptyModuleAccount(record.ModuleAccount)))
+	}
+
+	// undelegate the shares to the delegator, so that vesting accounts track the
+	// undelegation, and delegate the tokens again from the record module account
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// reload the validator, its shares and tokens changed with the unbonding
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.setValidatorLiquidShares(ctx, valAddr, liquidShares.Add(newShares)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// share tokens map 1:1 to the shares of the record delegation
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareToken.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize (truncates to zero share tokens)")
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.LiquidStakingPoolName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.LiquidStakingPoolName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens of the delegator and moves the
+// corresponding shares of the tokenized delegation back to the delegator. The
+// tokenize share record is removed once its delegation is fully redeemed, and
+// its remaining rewards are sent to the owner of the record.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareToken.Denom)
+	if balance.Amount.LT(shareToken.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "balance %s is smaller than %s", balance, shareToken)
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{}, types.ErrNoDelegatorForAddress
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// share tokens are truncated shares, redeeming all of them redeems the whole
+	// delegation
+	shares := math.LegacyNewDecFromInt(shareToken.Amount)
+	if shareToken.Amount.Equal(delegation.Shares.TruncateInt()) {
+		shares = delegation.Shares
+	} else if shares.GT(delegation.Shares) {
+		return sdk.Coin{}, types.ErrNotEnoughDelegationShares
+	}
+
+	if validator.TokensFromShares(shares).TruncateInt().IsZero() {
+		return sdk.Coin{}, types.ErrTinyRedemptionAmount
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.setValidatorLiquidShares(ctx, valAddr, liquidShares.Sub(shares)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	shareTokens := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.LiquidStakingPoolName, shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.LiquidStakingPoolName), shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr))); errors.Is(err, collections.ErrNotFound) {
+		if err := k.removeTokenizeShareRecord(ctx, record); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnCoin := sdk.NewCoin(bondDenom, returnAmount)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// reload the validator, its shares and tokens changed with the unbonding
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return returnCoin, nil
+}
+
+// removeTokenizeShareRecord removes a fully redeemed tokenize share record and
+// sends the rewards withdrawn to its module account to the owner of the record.
+func (k Keeper) removeTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if rewards := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress()); !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), owner, rewards); err != nil {
+			return err
+		}
+	}
+
+	return k.TokenizeShareRecords.Remove(ctx, record.Id)
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record, and thus of its rewards. The sender must be the owner of the record.
+func (k Keeper) TransferTokenizeShareRecord(ctx context.Context, id uint64, sender sdk.AccAddress, newOwner string) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	senderStr, err := k.authKeeper.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return err
+	}
+	if record.Owner != senderStr {
+		return errorsmod.Wrapf(types.ErrNotTokenizeShareRecordOwner, "%s is not the owner of record %d", senderStr, id)
+	}
+
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(newOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	record.Owner = newOwner
+	return k.TokenizeShareRecords.Set(ctx, record.Id, record)
+}
+
+// ValidatorBond flags the delegation of the delegator to the validator as a
+// validator bond. The shares of the validator bond delegations of a validator
+// determine how many of its shares can be tokenized.
+func (k Keeper) ValidatorBond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if _, err := k.GetValidator(ctx, valAddr); err != nil {
+		return err
+	}
+
+	if has, err := k.Delegations.Has(ctx, collections.Join(delAddr, valAddr)); err != nil {
+		return err
+	} else if !has {
+		return types.ErrNoDelegation
+	}
+
+	if isBond, err := k.IsValidatorBond(ctx, delAddr, valAddr); err != nil {
+		return err
+	} else if isBond {
+		return types.ErrValidatorBondAlreadySet
+	}
+
+	return k.ValidatorBonds.Set(ctx, collections.Join(valAddr, delAddr))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v5 "cosmossdk.io/x/staking/migrations/v5"
+	v6 "cosmossdk.io/x/staking/migrations/v6"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -40,3 +41,9 @@ func (m Migrator) Migrate4to5(ctx context.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.environment.KVStoreService.OpenKVStore(ctx))
 	return v5.MigrateStore(ctx, store, m.keeper.cdc, m.keeper.Logger())
 }
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx context.Context) error {
+	blockTime := m.keeper.environment.HeaderService.GetHeaderInfo(ctx).Time
+	return v6.MigrateStore(ctx, blockTime, m.keeper.Params, m.keeper.Validators)
+}
@@ -208,6 +208,60 @@ func (k Keeper) UpdateValidatorCommission(ctx context.Context,
 	return commission, nil
 }
 
+// checkValidatorPowerCap returns an error if delegating the given tokens to the
+// validator would bring its tokens above the max validator power ratio of the
+// total bonded tokens. tokenSrc is the bond status of the delegated tokens, which
+// are already part of the bonded tokens when they come from a bonded validator.
+// The cap is only enforced once there are enough bonded validators for all of
+// them to stay below it.
+func (k Keeper) checkValidatorPowerCap(ctx context.Context, validator types.Validator, amount math.Int, tokenSrc types.BondStatus) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxRatio := params.MaxValidatorPowerRatio
+	if maxRatio.GTE(math.LegacyOneDec()) {
+		return nil
+	}
+
+	bondedCount := int64(0)
+	err = k.LastValidatorPower.Walk(ctx, nil, func(_ []byte, _ gogotypes.Int64Value) (bool, error) {
+		bondedCount++
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if maxRatio.MulInt64(bondedCount).LTE(math.LegacyOneDec()) {
+		return nil
+	}
+
+	totalBonded, err := k.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	tokens := validator.Tokens.Add(amount)
+	total := totalBonded
+	if tokenSrc != types.Bonded {
+		total = total.Add(amount)
+	}
+	if !validator.IsBonded() {
+		total = total.Add(validator.Tokens)
+	}
+
+	if math.LegacyNewDecFromInt(tokens).GT(maxRatio.MulInt(total)) {
+		return errorsmod.Wrapf(
+			types.ErrValidatorPowerCapExceeded,
+			"validator would hold %s of %s bonded tokens, max ratio is %s", tokens, total, maxRatio,
+		)
+	}
+
+	return nil
+}
+
 // RemoveValidator removes the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) error {
@@ -240,6 +294,10 @@ func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) err
 		return err
 	}
 
+	if err = k.ValidatorLiquidShares.Remove(ctx, address); err != nil {
+		return err
+	}
+
 	if err = store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx), k.validatorAddressCodec)); err != nil {
 		return err
 	}
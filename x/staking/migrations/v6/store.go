@@ -0,0 +1,51 @@
+package v6
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The migration
+// includes:
+//
+// Addition of the liquid staking params (validator bond factor, global and
+// validator liquid staking caps) and of the max validator power ratio param.
+// Raise of the commission rate of the validators to the minimum commission rate.
+func MigrateStore(
+	ctx context.Context, blockTime time.Time,
+	paramsCollection collections.Item[types.Params], validatorsCollection collections.Map[[]byte, types.Validator],
+) error {
+	params, err := paramsCollection.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get staking params: %w", err)
+	}
+
+	defaultParams := types.DefaultParams()
+	params.ValidatorBondFactor = defaultParams.ValidatorBondFactor
+	params.GlobalLiquidStakingCap = defaultParams.GlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = defaultParams.ValidatorLiquidStakingCap
+	params.MaxValidatorPowerRatio = defaultParams.MaxValidatorPowerRatio
+
+	if err := paramsCollection.Set(ctx, params); err != nil {
+		return err
+	}
+
+	minRate := params.MinCommissionRate
+	return validatorsCollection.Walk(ctx, nil, func(key []byte, validator types.Validator) (bool, error) {
+		if !validator.Commission.Rate.LT(minRate) {
+			return false, nil
+		}
+
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		validator.Commission.UpdateTime = blockTime
+
+		return false, validatorsCollection.Set(ctx, key, validator)
+	})
+}
@@ -0,0 +1,88 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/staking"
+	v6 "cosmossdk.io/x/staking/migrations/v6"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, staking.AppModule{}).Codec
+	stakingKey := storetypes.NewKVStoreKey("staking")
+	ctx := testutil.DefaultContext(stakingKey, storetypes.NewTransientStoreKey("transient_test"))
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(stakingKey))
+	paramsCollection := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	validatorsCollection := collections.NewMap(sb, types.ValidatorsKey, "validators", sdk.LengthPrefixedBytesKey, codec.CollValue[types.Validator](cdc))
+
+	// set defaults without newly added fields
+	previousParams := types.DefaultParams()
+	previousParams.MinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+	previousParams.ValidatorBondFactor = math.LegacyDec{}
+	previousParams.GlobalLiquidStakingCap = math.LegacyDec{}
+	previousParams.ValidatorLiquidStakingCap = math.LegacyDec{}
+	previousParams.MaxValidatorPowerRatio = math.LegacyDec{}
+	require.NoError(t, paramsCollection.Set(ctx, previousParams))
+
+	pks := simtestutil.CreateTestPubKeys(3)
+	rates := []types.CommissionRates{
+		types.NewCommissionRates(math.LegacyZeroDec(), math.LegacyNewDecWithPrec(1, 2), math.LegacyZeroDec()),
+		types.NewCommissionRates(math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDecWithPrec(10, 2), math.LegacyZeroDec()),
+		types.NewCommissionRates(math.LegacyNewDecWithPrec(10, 2), math.LegacyNewDecWithPrec(20, 2), math.LegacyZeroDec()),
+	}
+	valAddrs := make([]sdk.ValAddress, len(pks))
+	for i, pk := range pks {
+		valAddrs[i] = sdk.ValAddress(pk.Address())
+		validator, err := types.NewValidator(valAddrs[i].String(), pk, types.Description{})
+		require.NoError(t, err)
+		validator.Commission = types.NewCommission(rates[i].Rate, rates[i].MaxRate, rates[i].MaxChangeRate)
+		require.NoError(t, validatorsCollection.Set(ctx, valAddrs[i], validator))
+	}
+
+	// Run migrations.
+	blockTime := time.Unix(1700000000, 0).UTC()
+	require.NoError(t, v6.MigrateStore(ctx, blockTime, paramsCollection, validatorsCollection))
+
+	// Check params
+	newParams, err := paramsCollection.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, newParams.Validate())
+	require.Equal(t, types.DefaultParams().ValidatorBondFactor, newParams.ValidatorBondFactor)
+	require.Equal(t, types.DefaultParams().GlobalLiquidStakingCap, newParams.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultParams().ValidatorLiquidStakingCap, newParams.ValidatorLiquidStakingCap)
+	require.Equal(t, types.DefaultParams().MaxValidatorPowerRatio, newParams.MaxValidatorPowerRatio)
+	require.Equal(t, previousParams.MinCommissionRate, newParams.MinCommissionRate)
+
+	// Check the commission rates are raised to the minimum
+	expRates := []types.CommissionRates{
+		types.NewCommissionRates(newParams.MinCommissionRate, newParams.MinCommissionRate, math.LegacyZeroDec()),
+		types.NewCommissionRates(newParams.MinCommissionRate, rates[1].MaxRate, math.LegacyZeroDec()),
+		rates[2],
+	}
+	for i, valAddr := range valAddrs {
+		validator, err := validatorsCollection.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.True(t, expRates[i].Rate.Equal(validator.Commission.Rate), "validator %d", i)
+		require.True(t, expRates[i].MaxRate.Equal(validator.Commission.MaxRate), "validator %d", i)
+		if i < 2 {
+			require.Equal(t, blockTime, validator.Commission.UpdateTime)
+		} else {
+			require.NotEqual(t, blockTime, validator.Commission.UpdateTime)
+		}
+	}
+}
@@ -52,4 +52,18 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already used for a validator")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 47, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid              = errors.Register(ModuleName, 48, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotExists            = errors.Register(ModuleName, 49, "tokenize share record not exists")
+	ErrNotTokenizeShareRecordOwner             = errors.Register(ModuleName, 50, "not tokenize share record owner")
+	ErrInvalidShareTokenDenom                  = errors.Register(ModuleName, 51, "invalid share token denom")
+	ErrRedelegationInProgress                  = errors.Register(ModuleName, 52, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
+	ErrValidatorBondNotAllowedForTokenizeShare = errors.Register(ModuleName, 53, "validator bond delegation is not allowed to tokenize shares")
+	ErrValidatorBondAlreadySet                 = errors.Register(ModuleName, 54, "delegation is already a validator bond")
+	ErrInsufficientValidatorBondShares         = errors.Register(ModuleName, 55, "insufficient validator bond shares")
+	ErrGlobalLiquidStakingCapExceeded          = errors.Register(ModuleName, 56, "delegation or tokenization exceeds the global cap")
+	ErrValidatorLiquidStakingCapExceeded       = errors.Register(ModuleName, 57, "delegation or tokenization exceeds the validator cap")
+	ErrTinyRedemptionAmount                    = errors.Register(ModuleName, 58, "too few tokens to redeem (truncates to zero tokens)")
+
+	ErrValidatorPowerCapExceeded = errors.Register(ModuleName, 59, "delegation exceeds the max validator power ratio")
 )
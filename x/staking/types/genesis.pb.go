@@ -46,6 +46,12 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record created.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// validator_bonds defines the delegations flagged as validator bonds at genesis.
+	ValidatorBonds []DVPair `protobuf:"bytes,11,rep,name=validator_bonds,json=validatorBonds,proto3" json:"validator_bonds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +136,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetValidatorBonds() []DVPair {
+	if m != nil {
+		return m.ValidatorBonds
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -181,39 +208,44 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x11, 0x72, 0x42, 0xd4, 0x21,
-	0x2a, 0xaa, 0x4d, 0x83, 0xc4, 0xc0, 0xd6, 0x08, 0x09, 0x55, 0xaa, 0x44, 0xe5, 0x52, 0x86, 0x2e,
-	0xd1, 0x05, 0x9f, 0xdc, 0x53, 0x9c, 0x3b, 0xcb, 0xf7, 0xb5, 0x94, 0x7f, 0xc0, 0xc8, 0xc6, 0xda,
-	0x91, 0x91, 0xa1, 0x3f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x28, 0x19, 0xe0, 0x67, 0x20, 0xdf,
-	0x39, 0xe6, 0xaa, 0xd4, 0x4b, 0x94, 0xcb, 0xf7, 0xbc, 0xcf, 0xfb, 0x49, 0xb9, 0x43, 0xeb, 0x1f,
-	0x85, 0x9c, 0x0a, 0xe9, 0x4b, 0x20, 0x13, 0xc6, 0x23, 0xff, 0x64, 0x6b, 0x4c, 0x81, 0x6c, 0xf9,
-	0x11, 0xe5, 0x54, 0x32, 0xe9, 0x25, 0xa9, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xcb, 0x29, 0x2f, 0xa7,
-	0xda, 0xcd, 0x48, 0x44, 0x42, 0x21, 0x7e, 0xf6, 0x4d, 0xd3, 0xed, 0x32, 0xe7, 0x22, 0xad, 0xa9,
-	0x27, 0x9a, 0x1a, 0xe9, 0x78, 0x5e, 0xa0, 0x47, 0x0f, 0xc9, 0x94, 0x71, 0xe1, 0xab, 0x4f, 0xfd,
-	0x53, 0xef, 0x5b, 0x15, 0xad, 0xbd, 0xd5, 0x3b, 0xed, 0x03, 0x01, 0x8a, 0xb7, 0x51, 0x2d, 0x21,
-	0x29, 0x99, 0x4a, 0xc7, 0xee, 0xda, 0xfd, 0xc6, 0xc0, 0xf5, 0x6e, 0xdf, 0xd1, 0xdb, 0x53, 0xd4,
-	0x70, 0xf5, 0xe2, 0xba, 0x63, 0x7d, 0xff, 0xf3, 0x63, 0xc3, 0x0e, 0xf2, 0x20, 0x3e, 0x44, 0x0f,
-	0x62, 0x22, 0x61, 0x04, 0x02, 0x48, 0x3c, 0x4a, 0xc4, 0x27, 0x9a, 0x3a, 0x77, 0xba, 0x76, 0x7f,
-	0x6d, 0xf8, 0x22, 0x83, 0x7f, 0x5d, 0x77, 0x5a, 0xda, 0x29, 0xc3, 0x89, 0xc7, 0x84, 0x3f, 0x25,
-	0x70, 0xe4, 0xed, 0x70, 0xb8, 0x3a, 0xdf, 0x44, 0x79, 0xd9, 0x0e, 0x07, 0xed, 0xbc, 0x9f, 0x99,
-	0xde, 0x67, 0xa2, 0xbd, 0xcc, 0x83, 0x19, 0x6a, 0x29, 0xf7, 0x09, 0x89, 0x59, 0x48, 0x40, 0xa4,
-	0xda, 0x2f, 0x9d, 0x4a, 0xb7, 0xd2, 0x6f, 0x0c, 0x36, 0xca, 0xb6, 0xdd, 0x25, 0x12, 0x3e, 0x2c,
-	0x32, 0x4a, 0x65, 0x6e, 0xfe, 0x28, 0x5e, 0x1a, 0x4b, 0xbc, 0x8b, 0x50, 0xd1, 0x22, 0x9d, 0xbb,
-	0xca, 0xff, 0xac, 0xcc, 0x5f, 0x84, 0x4d, 0xad, 0x91, 0xc7, 0xef, 0x50, 0x23, 0xa4, 0x31, 0x8d,
-	0x08, 0x30, 0xc1, 0xa5, 0x53, 0x55, 0xba, 0x5e, 0x99, 0xee, 0x4d, 0x81, 0x9a, 0x3e, 0xd3, 0x80,
-	0x27, 0xa8, 0x75, 0xcc, 0xc7, 0x82, 0x87, 0x8c, 0x47, 0x23, 0x53, 0x5d, 0x53, 0xea, 0xe7, 0x65,
-	0xea, 0x83, 0x45, 0xe8, 0xf6, 0x8e, 0xe6, 0xf1, 0xf2, 0x5c, 0xe2, 0x03, 0x74, 0x2f, 0xa5, 0x66,
-	0xc9, 0x8a, 0x2a, 0x59, 0x2f, 0x2b, 0x09, 0x0c, 0xd8, 0xb4, 0xdf, 0xb4, 0xe0, 0x36, 0xaa, 0xd3,
-	0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb5, 0xfb, 0xf5, 0xa0, 0x38, 0xf7, 0x8e, 0x10, 0x5e,
-	0xfe, 0xd3, 0xf0, 0x00, 0xad, 0x90, 0x30, 0x4c, 0xa9, 0xd4, 0xf7, 0x73, 0x75, 0xe8, 0x5c, 0x9d,
-	0x6f, 0x36, 0xf3, 0x2d, 0xb6, 0xf5, 0x64, 0x1f, 0x52, 0xc6, 0xa3, 0x60, 0x01, 0xe2, 0x26, 0xaa,
-	0xfe, 0xbf, 0x84, 0x95, 0x40, 0x1f, 0x5e, 0xd7, 0xbf, 0x9c, 0x75, 0xac, 0xbf, 0x67, 0x1d, 0x6b,
-	0xf8, 0xea, 0x62, 0xe6, 0xda, 0x97, 0x33, 0xd7, 0xfe, 0x3d, 0x73, 0xed, 0xaf, 0x73, 0xd7, 0xba,
-	0x9c, 0xbb, 0xd6, 0xcf, 0xb9, 0x6b, 0x1d, 0x3e, 0xbd, 0x71, 0x4f, 0x4f, 0x8b, 0x97, 0x07, 0x9f,
-	0x13, 0x2a, 0xc7, 0x35, 0xf5, 0x84, 0x5e, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x8a, 0xed,
-	0xf3, 0xec, 0x03, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0xed, 0x5f, 0xda, 0xfc, 0xb9, 0xf4, 0x57, 0xe0, 0x48, 0x2a, 0x37, 0x02, 0xc7, 0x44,
+	0x1d, 0xa2, 0xa2, 0xda, 0x34, 0x48, 0x0c, 0x4c, 0x34, 0xaa, 0x84, 0x22, 0x55, 0x22, 0x72, 0xda,
+	0x0e, 0x5d, 0xac, 0x4b, 0x7d, 0x72, 0x4e, 0x71, 0x7c, 0x91, 0xef, 0x5a, 0x0a, 0xaf, 0x80, 0x91,
+	0x9d, 0xa5, 0x23, 0x23, 0x43, 0x5f, 0x44, 0xc7, 0xaa, 0x13, 0x62, 0xa8, 0x50, 0x32, 0xc0, 0xcb,
+	0x40, 0xbe, 0x73, 0x5c, 0x47, 0x89, 0xbb, 0x44, 0xb9, 0x3c, 0xdf, 0xef, 0xe7, 0xfb, 0x5c, 0xf4,
+	0x3c, 0x07, 0xb6, 0x4e, 0x29, 0x1b, 0x51, 0x66, 0x31, 0x8e, 0x86, 0x24, 0xf0, 0xac, 0xf3, 0xdd,
+	0x3e, 0xe6, 0x68, 0xd7, 0xf2, 0x70, 0x80, 0x19, 0x61, 0xe6, 0x38, 0xa4, 0x9c, 0xc2, 0x0d, 0xa9,
+	0x32, 0x63, 0x95, 0x19, 0xab, 0x6a, 0x15, 0x8f, 0x7a, 0x54, 0x48, 0xac, 0xe8, 0x9b, 0x54, 0xd7,
+	0xb2, 0x98, 0x33, 0xb7, 0x54, 0x6d, 0x4a, 0x95, 0x23, 0xed, 0x71, 0x80, 0x2c, 0x3d, 0x41, 0x23,
+	0x12, 0x50, 0x4b, 0x7c, 0xca, 0x9f, 0x1a, 0xdf, 0x0a, 0x60, 0xed, 0xbd, 0xec, 0xa9, 0xc7, 0x11,
+	0xc7, 0x70, 0x0f, 0xe4, 0xc7, 0x28, 0x44, 0x23, 0xa6, 0xa9, 0x86, 0xda, 0x2c, 0xb7, 0x74, 0x73,
+	0x79, 0x8f, 0x66, 0x57, 0xa8, 0xda, 0xa5, 0xeb, 0xbb, 0xba, 0xf2, 0xfd, 0xcf, 0x8f, 0x6d, 0xd5,
+	0x8e, 0x8d, 0xf0, 0x04, 0x3c, 0xf6, 0x11, 0xe3, 0x0e, 0xa7, 0x1c, 0xf9, 0xce, 0x98, 0x7e, 0xc4,
+	0xa1, 0xf6, 0x9f, 0xa1, 0x36, 0xd7, 0xda, 0xaf, 0x22, 0xf1, 0xaf, 0xbb, 0x7a, 0x55, 0x32, 0x99,
+	0x3b, 0x34, 0x09, 0xb5, 0x46, 0x88, 0x0f, 0xcc, 0x4e, 0xc0, 0x6f, 0xaf, 0x76, 0x40, 0x1c, 0xd6,
+	0x09, 0xb8, 0x64, 0xae, 0x47, 0xa4, 0xc3, 0x08, 0xd4, 0x8d, 0x38, 0x90, 0x80, 0xaa, 0x60, 0x9f,
+	0x23, 0x9f, 0xb8, 0x88, 0xd3, 0x50, 0xf2, 0x99, 0x96, 0x33, 0x72, 0xcd, 0x72, 0x6b, 0x3b, 0xab,
+	0xdb, 0x03, 0xc4, 0xf8, 0xf1, 0xcc, 0x23, 0x50, 0xe9, 0xce, 0x9f, 0xfa, 0x0b, 0x65, 0x06, 0x0f,
+	0x00, 0x48, 0x52, 0x98, 0xb6, 0x22, 0xf8, 0x2f, 0xb2, 0xf8, 0x89, 0x39, 0x8d, 0x4d, 0xf9, 0xe1,
+	0x07, 0x50, 0x76, 0xb1, 0x8f, 0x3d, 0xc4, 0x09, 0x0d, 0x98, 0xb6, 0x2a, 0x70, 0x8d, 0x2c, 0xdc,
+	0x7e, 0x22, 0x4d, 0xf3, 0xd2, 0x04, 0x38, 0x04, 0xd5, 0xb3, 0xa0, 0x4f, 0x03, 0x97, 0x04, 0x9e,
+	0x93, 0x46, 0xe7, 0x05, 0xfa, 0x65, 0x16, 0xfa, 0x68, 0x66, 0x5a, 0x9e, 0x51, 0x39, 0x5b, 0xac,
+	0x33, 0x78, 0x04, 0xfe, 0x0f, 0x71, 0x3a, 0xa4, 0x20, 0x42, 0xb6, 0xb2, 0x42, 0x6c, 0xec, 0x2e,
+	0xa5, 0xcf, 0x53, 0x60, 0x0d, 0x14, 0xf1, 0xc5, 0x98, 0x86, 0x1c, 0xbb, 0x5a, 0xd1, 0x50, 0x9b,
+	0x45, 0x3b, 0x39, 0x43, 0x1f, 0x6c, 0x70, 0x3a, 0xc4, 0x01, 0xf9, 0x8c, 0x1d, 0x36, 0x40, 0x21,
+	0x76, 0x42, 0x7c, 0x4a, 0x43, 0x97, 0x69, 0xa5, 0x87, 0x2f, 0x78, 0x18, 0xbb, 0x7a, 0x91, 0xc9,
+	0x16, 0x9e, 0xb9, 0x0b, 0xf2, 0xc5, 0x3a, 0x83, 0xef, 0xc0, 0xf3, 0x78, 0x66, 0x97, 0x44, 0x3a,
+	0xc4, 0xd5, 0x80, 0xa1, 0x36, 0x57, 0xec, 0x4d, 0x39, 0x8e, 0x0b, 0x80, 0x8e, 0x0b, 0x6d, 0xf0,
+	0xe8, 0x7e, 0x28, 0xa3, 0xbf, 0x90, 0x69, 0x65, 0x23, 0xf7, 0xd0, 0x06, 0xed, 0x1f, 0x77, 0x11,
+	0x99, 0x1b, 0x98, 0xf5, 0x84, 0xd0, 0x8e, 0x00, 0x8d, 0x01, 0x80, 0x8b, 0x83, 0x0b, 0x5b, 0xa0,
+	0x80, 0x5c, 0x37, 0xc4, 0x4c, 0xee, 0x68, 0xa9, 0xad, 0xdd, 0x5e, 0xed, 0x54, 0xe2, 0x90, 0x3d,
+	0x59, 0xe9, 0xf1, 0x90, 0x04, 0x9e, 0x3d, 0x13, 0xc2, 0x0a, 0x58, 0xbd, 0x5f, 0xc4, 0x9c, 0x2d,
+	0x0f, 0x6f, 0x8b, 0x5f, 0x2e, 0xeb, 0xca, 0xdf, 0xcb, 0xba, 0xd2, 0x7e, 0x73, 0x3d, 0xd1, 0xd5,
+	0x9b, 0x89, 0xae, 0xfe, 0x9e, 0xe8, 0xea, 0xd7, 0xa9, 0xae, 0xdc, 0x4c, 0x75, 0xe5, 0xe7, 0x54,
+	0x57, 0x4e, 0x9e, 0xcd, 0xed, 0xea, 0x45, 0xf2, 0xfa, 0xf0, 0x4f, 0x63, 0xcc, 0xfa, 0x79, 0xf1,
+	0x8c, 0xbc, 0xfe, 0x37, 0x00, 0xbe, 0x27, 0xd6, 0x90, 0xf0, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -236,6 +268,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorBonds) > 0 {
+		for iNdEx := len(m.ValidatorBonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -428,6 +493,21 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.ValidatorBonds) > 0 {
+		for _, e := range m.ValidatorBonds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -738,6 +818,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBonds = append(m.ValidatorBonds, DVPair{})
+			if err := m.ValidatorBonds[len(m.ValidatorBonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
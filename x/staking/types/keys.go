@@ -70,6 +70,12 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	NewToOldConsKeyMap                          = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsKeyMap                          = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	TokenizeShareRecordKey        = collections.NewPrefix(129) // prefix for the tokenize share records
+	TokenizeShareRecordByOwnerKey = collections.NewPrefix(130) // prefix for the tokenize share records, by owner
+	LastTokenizeShareRecordIDKey  = collections.NewPrefix(131) // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey      = collections.NewPrefix(132) // prefix for the tokenized shares of each validator
+	ValidatorBondKey              = collections.NewPrefix(133) // prefix for the delegations flagged as validator bonds, by validator
 )
 
 // UnbondingType defines the type of unbonding operation
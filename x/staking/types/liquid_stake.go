@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding the tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, owner, validator string) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record, in
+// the form {validator}/{id}.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
+
+// ParseShareTokenDenom returns the validator and the record id of a share
+// token denom.
+func ParseShareTokenDenom(denom string) (validator string, id uint64, err error) {
+	idx := strings.LastIndex(denom, "/")
+	if idx <= 0 {
+		return "", 0, errorsmod.Wrapf(ErrInvalidShareTokenDenom, "denom %s is not of the form {validator}/{id}", denom)
+	}
+
+	id, err = strconv.ParseUint(denom[idx+1:], 10, 64)
+	if err != nil {
+		return "", 0, errorsmod.Wrapf(ErrInvalidShareTokenDenom, "invalid record id in denom %s", denom)
+	}
+	return denom[:idx], id, nil
+}
+
+// Validate performs a basic validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+	if r.ModuleAccount != fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id) {
+		return fmt.Errorf("invalid module account %s of tokenize share record %d", r.ModuleAccount, r.Id)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid owner of tokenize share record %d: %w", r.Id, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid validator of tokenize share record %d: %w", r.Id, err)
+	}
+	return nil
+}
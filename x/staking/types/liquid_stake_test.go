@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestShareTokenDenom(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator"))
+	record := types.NewTokenizeShareRecord(12, sdk.AccAddress([]byte("owner")).String(), valAddr.String())
+	require.NoError(t, record.Validate())
+
+	denom := record.GetShareTokenDenom()
+	require.Equal(t, valAddr.String()+"/12", denom)
+
+	validator, id, err := types.ParseShareTokenDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, valAddr.String(), validator)
+	require.Equal(t, uint64(12), id)
+
+	for _, denom := range []string{"stake", "/12", valAddr.String() + "/", valAddr.String() + "/abc"} {
+		_, _, err := types.ParseShareTokenDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidShareTokenDenom, denom)
+	}
+}
+
+func TestValidateTokenizeShareRecord(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner")).String()
+	validator := sdk.ValAddress([]byte("validator")).String()
+
+	require.NoError(t, types.NewTokenizeShareRecord(1, owner, validator).Validate())
+	require.Error(t, types.NewTokenizeShareRecord(0, owner, validator).Validate())
+	require.Error(t, types.NewTokenizeShareRecord(1, "invalid", validator).Validate())
+	require.Error(t, types.NewTokenizeShareRecord(1, owner, owner).Validate())
+
+	record := types.NewTokenizeShareRecord(1, owner, validator)
+	record.ModuleAccount = "tokenizeshare_2"
+	require.Error(t, record.Validate())
+}
@@ -37,13 +37,26 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultValidatorBondFactor is set to -1, disabling the validator bond requirement
+	DefaultValidatorBondFactor = math.LegacyNewDec(-1)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, disabling the global liquid staking cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, disabling the validator liquid staking cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultMaxValidatorPowerRatio is set to 100%, disabling the validator voting power cap
+	DefaultMaxValidatorPowerRatio = math.LegacyOneDec()
 )
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration,
 	maxValidators, maxEntries, historicalEntries uint32,
 	bondDenom string, minCommissionRate math.LegacyDec,
-	keyRotationFee sdk.Coin,
+	keyRotationFee sdk.Coin, validatorBondFactor, globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
+	maxValidatorPowerRatio math.LegacyDec,
 ) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
@@ -53,6 +66,11 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		ValidatorBondFactor:       validatorBondFactor,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		MaxValidatorPowerRatio:    maxValidatorPowerRatio,
 	}
 }
 
@@ -66,6 +84,10 @@ func DefaultParams() Params {
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
 		DefaultKeyRotationFee,
+		DefaultValidatorBondFactor,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultMaxValidatorPowerRatio,
 	)
 }
 
@@ -119,6 +141,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateMaxValidatorPowerRatio(p.MaxValidatorPowerRatio); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -234,3 +272,62 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil: %s", v)
+	}
+	if v.IsNegative() && !v.Equal(math.LegacyNewDec(-1)) {
+		return fmt.Errorf("invalid validator bond factor: %s, only -1 is allowed as a negative factor", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+// ValidatorBondEnabled returns whether the validator bond requirement is enabled.
+func (p Params) ValidatorBondEnabled() bool {
+	return !p.ValidatorBondFactor.Equal(math.LegacyNewDec(-1))
+}
+
+func validateMaxValidatorPowerRatio(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max validator power ratio cannot be nil: %s", v)
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("max validator power ratio must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max validator power ratio cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
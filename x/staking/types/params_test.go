@@ -46,4 +46,33 @@ func TestValidateParams(t *testing.T) {
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
+
+	// reset params to default
+	params = types.DefaultParams()
+
+	// validate validator bond factor, -1 disables it
+	params.ValidatorBondFactor = math.LegacyNewDec(-2)
+	require.Error(t, params.Validate())
+
+	params.ValidatorBondFactor = math.LegacyNewDec(250)
+	require.NoError(t, params.Validate())
+
+	// validate liquid staking caps
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	// validate max validator power ratio
+	params = types.DefaultParams()
+	params.MaxValidatorPowerRatio = math.LegacyZeroDec()
+	require.Error(t, params.Validate())
+
+	params.MaxValidatorPowerRatio = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.MaxValidatorPowerRatio = math.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, params.Validate())
 }
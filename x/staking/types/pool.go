@@ -9,9 +9,13 @@ import (
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - LiquidStakingPool -> "liquid_staking_tokens_pool", mints and burns the
+// liquid staking share tokens
 const (
-	NotBondedPoolName = "not_bonded_tokens_pool"
-	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName     = "not_bonded_tokens_pool"
+	BondedPoolName        = "bonded_tokens_pool"
+	LiquidStakingPoolName = "liquid_staking_tokens_pool"
 )
 
 // NewPool creates a new Pool instance used for queries
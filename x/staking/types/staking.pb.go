@@ -1001,6 +1001,21 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types2.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// validator_bond_factor is the number of shares that can be tokenized for each
+	// validator bond share of a validator. A negative value disables the validator
+	// bond requirement.
+	ValidatorBondFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_factor"`
+	// global_liquid_staking_cap is the maximum ratio of the total bonded tokens
+	// that can be liquid staked with the bonded validators.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum ratio of the delegator shares of
+	// a validator that can be liquid staked.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// max_validator_power_ratio is the maximum ratio of the total bonded tokens
+	// that a validator can reach through delegations. It is only enforced when
+	// there are enough bonded validators for it to be reachable, a value of 1
+	// disables it.
+	MaxValidatorPowerRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=max_validator_power_ratio,json=maxValidatorPowerRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_validator_power_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1078,6 +1093,82 @@ func (m *Params) GetKeyRotationFee() types2.Coin {
 	return types2.Coin{}
 }
 
+// TokenizeShareRecord represents a tokenized delegation. The delegation is owned
+// by the module account of the record, and the share tokens of the record can be
+// redeemed for the shares of the delegation by their holders. The rewards of the
+// delegation can be withdrawn by the owner of the record.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account entitled to the rewards of the record.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1089,7 +1180,7 @@ func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
 func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegationResponse) ProtoMessage()    {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1144,7 +1235,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1283,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,7 +1337,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1285,7 +1376,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{21}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1430,7 @@ func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHis
 func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
 func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
 func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{22}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1378,7 +1469,7 @@ func (m *ValAddrsOfRotatedConsKeys) Reset()         { *m = ValAddrsOfRotatedCons
 func (m *ValAddrsOfRotatedConsKeys) String() string { return proto.CompactTextString(m) }
 func (*ValAddrsOfRotatedConsKeys) ProtoMessage()    {}
 func (*ValAddrsOfRotatedConsKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{23}
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
 }
 func (m *ValAddrsOfRotatedConsKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1434,6 +1525,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1448,138 +1540,149 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x5b, 0x49,
-	0x1d, 0xcf, 0xb3, 0x5d, 0x27, 0xf9, 0xdb, 0x89, 0x9d, 0xe9, 0x97, 0xe3, 0xee, 0xc6, 0xae, 0xb7,
-	0xb0, 0x6d, 0xa1, 0x0e, 0x2d, 0xa8, 0x87, 0x80, 0x40, 0x75, 0xec, 0x6e, 0xbd, 0x1f, 0x49, 0x78,
-	0x4e, 0x02, 0xcb, 0xd7, 0xd3, 0xf8, 0xbd, 0xb1, 0xfd, 0x88, 0x3d, 0xcf, 0xbc, 0x19, 0xb7, 0xf5,
-	0x9d, 0xc3, 0x2a, 0x2b, 0xa4, 0x3d, 0x01, 0x12, 0xaa, 0xa8, 0xc4, 0x65, 0xb9, 0xed, 0xa1, 0xe2,
-	0xce, 0x6d, 0x41, 0x42, 0xaa, 0x7a, 0x42, 0x48, 0x04, 0xd4, 0x1e, 0xba, 0x82, 0x0b, 0xe2, 0xc4,
-	0x11, 0xcd, 0xbc, 0x79, 0x1f, 0x8e, 0xe3, 0xe6, 0xa3, 0x2b, 0xb4, 0x62, 0x2f, 0x91, 0x67, 0xe6,
-	0xff, 0xff, 0xbd, 0xff, 0xf7, 0xcc, 0xff, 0x1f, 0xb8, 0x64, 0x3a, 0xac, 0xe7, 0xb0, 0x65, 0xc6,
-	0xf1, 0x8e, 0x4d, 0xdb, 0xcb, 0x77, 0xaf, 0x37, 0x09, 0xc7, 0xd7, 0xfd, 0x75, 0xb9, 0xef, 0x3a,
-	0xdc, 0x41, 0xe7, 0x3c, 0xaa, 0xb2, 0xbf, 0xab, 0xa8, 0xf2, 0x67, 0xda, 0x4e, 0xdb, 0x91, 0x24,
-	0xcb, 0xe2, 0x97, 0x47, 0x9d, 0x5f, 0x6c, 0x3b, 0x4e, 0xbb, 0x4b, 0x96, 0xe5, 0xaa, 0x39, 0x68,
-	0x2d, 0x63, 0x3a, 0x54, 0x47, 0x4b, 0xfb, 0x8f, 0xac, 0x81, 0x8b, 0xb9, 0xed, 0x50, 0x75, 0x5e,
-	0xd8, 0x7f, 0xce, 0xed, 0x1e, 0x61, 0x1c, 0xf7, 0xfa, 0x3e, 0xb6, 0x27, 0x89, 0xe1, 0x7d, 0x54,
-	0x89, 0xa5, 0xb0, 0x95, 0x2a, 0x4d, 0xcc, 0x48, 0xa0, 0x87, 0xe9, 0xd8, 0x3e, 0xf6, 0x02, 0xee,
-	0xd9, 0xd4, 0x59, 0x96, 0x7f, 0xd5, 0xd6, 0x2b, 0x9c, 0x50, 0x8b, 0xb8, 0x3d, 0x9b, 0xf2, 0x65,
-	0x3e, 0xec, 0x13, 0xe6, 0xfd, 0x55, 0xa7, 0x17, 0x22, 0xa7, 0xb8, 0x69, 0xda, 0xd1, 0xc3, 0xd2,
-	0x2f, 0x34, 0x98, 0xbf, 0x63, 0x33, 0xee, 0xb8, 0xb6, 0x89, 0xbb, 0x75, 0xda, 0x72, 0xd0, 0xd7,
-	0x21, 0xd9, 0x21, 0xd8, 0x22, 0x6e, 0x4e, 0x2b, 0x6a, 0x97, 0x53, 0x37, 0x72, 0xe5, 0x10, 0xa0,
-	0xec, 0xf1, 0xde, 0x91, 0xe7, 0x95, 0xd9, 0x8f, 0xf7, 0x0a, 0x53, 0x1f, 0x3e, 0xff, 0xe8, 0xaa,
-	0xa6, 0x2b, 0x16, 0x54, 0x85, 0xe4, 0x5d, 0xdc, 0x65, 0x84, 0xe7, 0x62, 0xc5, 0xf8, 0xe5, 0xd4,
-	0x8d, 0x8b, 0xe5, 0x83, 0x6d, 0x5e, 0xde, 0xc6, 0x5d, 0xdb, 0xc2, 0xdc, 0x19, 0x45, 0xf1, 0x78,
-	0x57, 0x62, 0x39, 0xad, 0xf4, 0xbe, 0x06, 0xd9, 0x50, 0x32, 0x9d, 0x98, 0x8e, 0x6b, 0xa1, 0x1c,
-	0x4c, 0xe3, 0x7e, 0xbf, 0x83, 0x59, 0x47, 0x0a, 0x97, 0xd6, 0xfd, 0x25, 0xfa, 0x1a, 0x24, 0x84,
-	0x91, 0x73, 0x31, 0x29, 0x73, 0xbe, 0xec, 0x79, 0xa0, 0xec, 0x7b, 0xa0, 0xbc, 0xe9, 0x7b, 0xa0,
-	0x92, 0xf8, 0xe0, 0x6f, 0x05, 0x4d, 0x97, 0xd4, 0xe8, 0x75, 0xc8, 0xdc, 0xf5, 0x05, 0x61, 0x86,
-	0xc4, 0x8d, 0x4b, 0xdc, 0xf9, 0x70, 0xfb, 0x0e, 0x66, 0x9d, 0xd2, 0xcf, 0x63, 0x90, 0x59, 0x75,
-	0x7a, 0x3d, 0x9b, 0x31, 0xdb, 0xa1, 0x3a, 0xe6, 0x84, 0xa1, 0x37, 0x21, 0xe1, 0x62, 0x4e, 0xa4,
-	0x24, 0xb3, 0x95, 0x9b, 0x42, 0x8d, 0xbf, 0xec, 0x15, 0x2e, 0x78, 0x0a, 0x33, 0x6b, 0xa7, 0x6c,
-	0x3b, 0xcb, 0x3d, 0xcc, 0x3b, 0xe5, 0xb7, 0x49, 0x1b, 0x9b, 0xc3, 0x2a, 0x31, 0x9f, 0x3c, 0xba,
-	0x06, 0xca, 0x1e, 0x55, 0x62, 0x7a, 0x3a, 0x4b, 0x0c, 0xf4, 0x6d, 0x98, 0xe9, 0xe1, 0xfb, 0x86,
-	0xc4, 0x8b, 0xbd, 0x14, 0xde, 0x74, 0x0f, 0xdf, 0x17, 0xf2, 0xa1, 0x1f, 0x41, 0x46, 0x40, 0x9a,
-	0x1d, 0x4c, 0xdb, 0xc4, 0x43, 0x8e, 0xbf, 0x14, 0xf2, 0x5c, 0x0f, 0xdf, 0x5f, 0x95, 0x68, 0x02,
-	0x7f, 0x25, 0xf1, 0xc9, 0xc3, 0x82, 0x56, 0xfa, 0xbd, 0x06, 0x10, 0x1a, 0x06, 0x61, 0xc8, 0x9a,
-	0xc1, 0x4a, 0x7e, 0x94, 0xa9, 0x30, 0x7a, 0x7d, 0x52, 0x24, 0xec, 0x33, 0x6b, 0x65, 0x4e, 0x88,
-	0xf7, 0x78, 0xaf, 0xa0, 0x79, 0x5f, 0xcd, 0x98, 0x63, 0x66, 0x4f, 0x0d, 0xfa, 0x16, 0xe6, 0xc4,
-	0x38, 0xa2, 0xc3, 0x25, 0xa0, 0x70, 0xba, 0x07, 0x08, 0x1e, 0xb7, 0x38, 0x57, 0x3a, 0x7c, 0xa8,
-	0x41, 0xaa, 0x4a, 0x98, 0xe9, 0xda, 0x7d, 0x91, 0xc4, 0x22, 0xca, 0x7a, 0x0e, 0xb5, 0x77, 0x54,
-	0x0a, 0xcc, 0xea, 0xfe, 0x12, 0xe5, 0x61, 0xc6, 0xb6, 0x08, 0xe5, 0x36, 0x1f, 0x7a, 0x6e, 0xd2,
-	0x83, 0xb5, 0xe0, 0xba, 0x47, 0x9a, 0xcc, 0xf6, 0xed, 0xac, 0xfb, 0x4b, 0x74, 0x05, 0xb2, 0x8c,
-	0x98, 0x03, 0xd7, 0xe6, 0x43, 0xc3, 0x74, 0x28, 0xc7, 0x26, 0xcf, 0x25, 0x24, 0x49, 0xc6, 0xdf,
-	0x5f, 0xf5, 0xb6, 0x05, 0x88, 0x45, 0x38, 0xb6, 0xbb, 0x2c, 0x77, 0xca, 0x03, 0x51, 0x4b, 0x25,
-	0xea, 0xee, 0x34, 0xcc, 0x06, 0xa9, 0x83, 0x56, 0x21, 0xeb, 0xf4, 0x89, 0x2b, 0x7e, 0x1b, 0xd8,
-	0xb2, 0x5c, 0xc2, 0x98, 0x8a, 0xc6, 0xdc, 0x93, 0x47, 0xd7, 0xce, 0x28, 0x83, 0xdf, 0xf2, 0x4e,
-	0x1a, 0xdc, 0xb5, 0x69, 0x5b, 0xcf, 0xf8, 0x1c, 0x6a, 0x1b, 0xbd, 0x2b, 0x5c, 0x46, 0x19, 0xa1,
-	0x6c, 0xc0, 0x8c, 0xfe, 0xa0, 0xb9, 0x43, 0x86, 0xca, 0xa8, 0x67, 0xc6, 0x8c, 0x7a, 0x8b, 0x0e,
-	0x2b, 0xb9, 0x3f, 0x86, 0xd0, 0xa6, 0x3b, 0xec, 0x73, 0xa7, 0xbc, 0x31, 0x68, 0xbe, 0x45, 0x86,
-	0xc2, 0x55, 0x0a, 0x67, 0x43, 0xc2, 0xa0, 0x73, 0x90, 0xfc, 0x31, 0xb6, 0xbb, 0xc4, 0x92, 0x16,
-	0x99, 0xd1, 0xd5, 0x0a, 0xad, 0x40, 0x92, 0x71, 0xcc, 0x07, 0x4c, 0x9a, 0x61, 0xfe, 0x46, 0x69,
-	0x52, 0x6c, 0x54, 0x1c, 0x6a, 0x35, 0x24, 0xa5, 0xae, 0x38, 0xd0, 0x2a, 0x24, 0xb9, 0xb3, 0x43,
-	0xa8, 0x32, 0x50, 0xe5, 0x4b, 0x2a, 0x9a, 0xcf, 0x8e, 0x47, 0x73, 0x9d, 0xf2, 0x48, 0x1c, 0xd7,
-	0x29, 0xd7, 0x15, 0x2b, 0xfa, 0x01, 0x64, 0x2d, 0xd2, 0x25, 0x6d, 0x69, 0x39, 0xd6, 0xc1, 0x2e,
-	0x61, 0xb9, 0xa4, 0x84, 0xbb, 0x7e, 0xec, 0xe4, 0xd0, 0x33, 0x01, 0x54, 0x43, 0x22, 0xa1, 0x0d,
-	0x48, 0x59, 0x61, 0x38, 0xe5, 0xa6, 0xa5, 0x31, 0x5f, 0x9b, 0xa4, 0x63, 0x24, 0xf2, 0xa2, 0xb5,
-	0x30, 0x0a, 0x21, 0x22, 0x68, 0x40, 0x9b, 0x0e, 0xb5, 0x6c, 0xda, 0x36, 0x3a, 0xc4, 0x6e, 0x77,
-	0x78, 0x6e, 0xa6, 0xa8, 0x5d, 0x8e, 0xeb, 0x99, 0x60, 0xff, 0x8e, 0xdc, 0x46, 0x1b, 0x30, 0x1f,
-	0x92, 0xca, 0x0c, 0x99, 0x3d, 0x6e, 0x86, 0xcc, 0x05, 0x00, 0x82, 0x04, 0xbd, 0x03, 0x10, 0xe6,
-	0x60, 0x0e, 0x24, 0x5a, 0xe9, 0xf0, 0x6c, 0x8e, 0x2a, 0x13, 0x01, 0x40, 0xdf, 0x87, 0xd3, 0x3d,
-	0x9b, 0x1a, 0x8c, 0x74, 0x5b, 0x86, 0xb2, 0x9c, 0xc0, 0x4d, 0x1d, 0xdf, 0x9b, 0x0b, 0x3d, 0x9b,
-	0x36, 0x48, 0xb7, 0x55, 0x0d, 0x50, 0xd0, 0x37, 0xe0, 0x42, 0xa8, 0xbd, 0x43, 0x8d, 0x8e, 0xd3,
-	0xb5, 0x0c, 0x97, 0xb4, 0x0c, 0xd3, 0x19, 0x50, 0x9e, 0x4b, 0x4b, 0x9b, 0x9d, 0x0f, 0x48, 0xd6,
-	0xe9, 0x1d, 0xa7, 0x6b, 0xe9, 0xa4, 0xb5, 0x2a, 0x8e, 0xd1, 0x6b, 0x10, 0xaa, 0x6e, 0xd8, 0x16,
-	0xcb, 0xcd, 0x15, 0xe3, 0x97, 0x13, 0x7a, 0x3a, 0xd8, 0xac, 0x5b, 0x6c, 0x65, 0xe6, 0xbd, 0x87,
-	0x85, 0xa9, 0x4f, 0x1e, 0x16, 0xa6, 0x4a, 0xb7, 0x21, 0xbd, 0x8d, 0xbb, 0x2a, 0x8f, 0x08, 0x43,
-	0x37, 0x61, 0x16, 0xfb, 0x8b, 0x9c, 0x56, 0x8c, 0xbf, 0x30, 0x0f, 0x43, 0xd2, 0xd2, 0x6f, 0x35,
-	0x48, 0x56, 0xb7, 0x37, 0xb0, 0xed, 0xa2, 0x1a, 0x2c, 0x84, 0x81, 0x79, 0xd4, 0x94, 0x0e, 0x63,
-	0xd9, 0xcf, 0xe9, 0x35, 0x58, 0x08, 0x2e, 0xb0, 0x00, 0xc6, 0xbb, 0x57, 0x2e, 0x3e, 0x79, 0x74,
-	0xed, 0x55, 0x05, 0x13, 0x54, 0x92, 0x7d, 0x78, 0x77, 0xf7, 0xed, 0x47, 0x74, 0x7e, 0x13, 0xa6,
-	0x3d, 0x51, 0x19, 0xfa, 0x16, 0x9c, 0xea, 0x8b, 0x1f, 0x52, 0xd5, 0xd4, 0x8d, 0xa5, 0x89, 0x01,
-	0x2e, 0xe9, 0xa3, 0xe1, 0xe0, 0xf1, 0x95, 0xde, 0x8f, 0x01, 0x54, 0xb7, 0xb7, 0x37, 0x5d, 0xbb,
-	0xdf, 0x25, 0xfc, 0xd3, 0xd2, 0x7d, 0x0b, 0xce, 0x86, 0xba, 0x33, 0xd7, 0x3c, 0xbe, 0xfe, 0xa7,
-	0x03, 0xfe, 0x86, 0x6b, 0x1e, 0x08, 0x6b, 0x31, 0x1e, 0xc0, 0xc6, 0x8f, 0x0f, 0x5b, 0x65, 0x7c,
-	0xdc, 0xb2, 0xdf, 0x85, 0x54, 0x68, 0x0c, 0x86, 0xea, 0x30, 0xc3, 0xd5, 0x6f, 0x65, 0xe0, 0xd2,
-	0x64, 0x03, 0xfb, 0x6c, 0x51, 0x23, 0x07, 0xec, 0xa5, 0xff, 0x68, 0x00, 0x91, 0x1c, 0xf9, 0x6c,
-	0xc6, 0x18, 0xaa, 0x43, 0x52, 0x55, 0xe2, 0xf8, 0x49, 0x2b, 0xb1, 0x02, 0x88, 0x18, 0xf5, 0x67,
-	0x31, 0x38, 0xbd, 0xe5, 0x67, 0xef, 0x67, 0xdf, 0x06, 0x5b, 0x30, 0x4d, 0x28, 0x77, 0x6d, 0x69,
-	0x04, 0xe1, 0xf3, 0xaf, 0x4c, 0xf2, 0xf9, 0x01, 0x4a, 0xd5, 0x28, 0x77, 0x87, 0xd1, 0x08, 0xf0,
-	0xb1, 0x22, 0xf6, 0xf8, 0x55, 0x1c, 0x72, 0x93, 0x58, 0xc5, 0x6b, 0xd8, 0x74, 0x89, 0xdc, 0xf0,
-	0x2f, 0x19, 0x4d, 0x16, 0xcc, 0x79, 0x7f, 0x5b, 0xdd, 0x31, 0x3a, 0x88, 0x57, 0x99, 0x08, 0x2e,
-	0x41, 0x7a, 0xb2, 0x67, 0xd8, 0x7c, 0x88, 0x20, 0x6f, 0x99, 0x4d, 0xc8, 0xd8, 0xd4, 0xe6, 0x36,
-	0xee, 0x1a, 0x4d, 0xdc, 0xc5, 0xd4, 0xf4, 0x9f, 0xab, 0xc7, 0xba, 0x12, 0xe6, 0x15, 0x46, 0xc5,
-	0x83, 0x40, 0x35, 0x98, 0xf6, 0xd1, 0x12, 0xc7, 0x47, 0xf3, 0x79, 0xd1, 0x45, 0x48, 0x47, 0x2f,
-	0x06, 0xf9, 0xf4, 0x48, 0xe8, 0xa9, 0xc8, 0xbd, 0x70, 0xd8, 0xcd, 0x93, 0x7c, 0xe1, 0xcd, 0xa3,
-	0x5e, 0x77, 0xbf, 0x8e, 0xc3, 0x82, 0x4e, 0xac, 0xff, 0x7f, 0xb7, 0x6c, 0x00, 0x78, 0xa9, 0x2a,
-	0x2a, 0xa9, 0xf2, 0xcc, 0x09, 0xf2, 0x7d, 0xd6, 0x03, 0xa9, 0x32, 0xfe, 0xbf, 0xf2, 0xd0, 0x5f,
-	0x63, 0x90, 0x8e, 0x7a, 0xe8, 0x73, 0x79, 0x69, 0xa1, 0xb5, 0xb0, 0x4c, 0x25, 0x64, 0x99, 0xba,
-	0x32, 0xa9, 0x4c, 0x8d, 0x45, 0xf3, 0x21, 0xf5, 0xe9, 0x79, 0x1c, 0x92, 0x1b, 0xd8, 0xc5, 0x3d,
-	0x86, 0xd6, 0xc7, 0x1e, 0xb2, 0x5e, 0x23, 0xb9, 0x38, 0x16, 0xcc, 0x55, 0x35, 0x7d, 0xf1, 0x62,
-	0xf9, 0x97, 0x93, 0xde, 0xb1, 0x5f, 0x80, 0x79, 0xd1, 0x10, 0x87, 0x9d, 0xbd, 0x34, 0xee, 0x9c,
-	0xec, 0x6b, 0x03, 0xed, 0x19, 0x2a, 0x40, 0x4a, 0x90, 0x85, 0x75, 0x58, 0xd0, 0x40, 0x0f, 0xdf,
-	0xaf, 0x79, 0x3b, 0xe8, 0x1a, 0xa0, 0x4e, 0x30, 0x98, 0x30, 0x42, 0x43, 0x08, 0xba, 0x85, 0xf0,
-	0xc4, 0x27, 0x7f, 0x15, 0x40, 0x48, 0x61, 0x58, 0x84, 0x3a, 0x3d, 0xd5, 0xd5, 0xcd, 0x8a, 0x9d,
-	0xaa, 0xd8, 0x40, 0x3f, 0xd5, 0xbc, 0xf7, 0xf0, 0xbe, 0xb6, 0x59, 0xb5, 0x23, 0x9b, 0x47, 0x48,
-	0x8a, 0x7f, 0xef, 0x15, 0xf2, 0x43, 0xdc, 0xeb, 0xae, 0x94, 0x0e, 0xc0, 0x29, 0x1d, 0xd4, 0xc9,
-	0x8b, 0x87, 0xf3, 0x68, 0xdb, 0x8d, 0xea, 0x90, 0xdd, 0x21, 0x43, 0xc3, 0x75, 0xb8, 0x57, 0x68,
-	0x5a, 0x84, 0xa8, 0xc6, 0x65, 0xd1, 0xf7, 0x6d, 0x13, 0x33, 0x12, 0x79, 0xe7, 0xdb, 0xb4, 0x92,
-	0x10, 0xd2, 0xe9, 0xf3, 0x3b, 0x64, 0xa8, 0x2b, 0xbe, 0xdb, 0x84, 0xac, 0x5c, 0x12, 0x99, 0xb2,
-	0xfb, 0xfc, 0xa3, 0xab, 0x4a, 0xe8, 0x6b, 0xcc, 0xda, 0x59, 0xbe, 0x1f, 0xcc, 0xe6, 0x3c, 0xf7,
-	0x8a, 0x47, 0x2f, 0x0a, 0x2f, 0x20, 0x9d, 0xb0, 0xbe, 0x68, 0x1e, 0x45, 0xb3, 0x11, 0x69, 0x0a,
-	0xb4, 0x17, 0x37, 0x1b, 0x21, 0xff, 0x48, 0xb3, 0x11, 0x49, 0xcf, 0x6f, 0x86, 0xf5, 0x3f, 0x76,
-	0x98, 0x36, 0xd1, 0xc8, 0x54, 0x4c, 0x32, 0xeb, 0xa7, 0x4a, 0x7f, 0xd2, 0x60, 0x71, 0x2c, 0x92,
-	0x03, 0x91, 0x4d, 0x40, 0x6e, 0xe4, 0x50, 0x46, 0xc4, 0x50, 0x89, 0x7e, 0xb2, 0xc4, 0x58, 0x70,
-	0xc7, 0x2e, 0x81, 0x4f, 0xe7, 0x22, 0x53, 0x55, 0xec, 0x0f, 0x1a, 0x9c, 0x89, 0x0a, 0x10, 0xa8,
-	0xd2, 0x80, 0x74, 0xf4, 0xd3, 0x4a, 0x89, 0x4b, 0x47, 0x51, 0x22, 0x2a, 0xff, 0x08, 0x08, 0xda,
-	0x0e, 0xab, 0x85, 0x37, 0x14, 0xbc, 0x7e, 0x64, 0xa3, 0xf8, 0x82, 0x1d, 0x58, 0x35, 0x3c, 0xdf,
-	0xfc, 0x53, 0x83, 0xc4, 0x86, 0xe3, 0x74, 0xd1, 0x4f, 0x60, 0x81, 0x3a, 0xdc, 0x10, 0x99, 0x45,
-	0x2c, 0x43, 0xcd, 0x08, 0xbc, 0x4a, 0x5c, 0x7b, 0xa1, 0xad, 0xfe, 0xb1, 0x57, 0x18, 0xe7, 0x1c,
-	0x35, 0xa0, 0x1a, 0x45, 0x51, 0x87, 0x57, 0x24, 0xd1, 0xa6, 0x37, 0x46, 0x68, 0xc1, 0xdc, 0xe8,
-	0xe7, 0xbc, 0x6a, 0x7d, 0xeb, 0xb0, 0xcf, 0xcd, 0x1d, 0xfa, 0xa9, 0x74, 0x33, 0xf2, 0x9d, 0x95,
-	0x19, 0xe1, 0xb5, 0x7f, 0x09, 0xcf, 0xbd, 0x0b, 0xd9, 0xa0, 0x54, 0x6d, 0xc9, 0x39, 0x16, 0x13,
-	0xa1, 0xe1, 0x8d, 0xb4, 0xfc, 0x46, 0xa1, 0x18, 0x9d, 0xd8, 0xe2, 0xa6, 0x69, 0x97, 0xf7, 0xf1,
-	0x8c, 0x98, 0x53, 0xf1, 0x96, 0x1e, 0xc7, 0x60, 0x71, 0xd5, 0xa1, 0x4c, 0x0d, 0x73, 0x54, 0x42,
-	0x7b, 0x23, 0xd8, 0x21, 0xba, 0x32, 0x61, 0xd4, 0x94, 0x1e, 0x1f, 0x28, 0x6d, 0x43, 0x46, 0xdc,
-	0xac, 0xa6, 0x43, 0x5f, 0x72, 0x9e, 0x34, 0xe7, 0x74, 0x2d, 0x25, 0xd1, 0x0e, 0x19, 0x0a, 0x5c,
-	0x4a, 0xee, 0x8d, 0xe0, 0xc6, 0x4f, 0x86, 0x4b, 0xc9, 0xbd, 0x08, 0xee, 0x39, 0x48, 0xaa, 0x67,
-	0x55, 0x42, 0x3e, 0x1a, 0xd4, 0x0a, 0xdd, 0x84, 0xb8, 0xa8, 0x82, 0xa7, 0x8e, 0x51, 0x37, 0x04,
-	0x43, 0xe4, 0x36, 0x6b, 0xc0, 0xa2, 0x1a, 0x10, 0xb0, 0xf5, 0x96, 0xb4, 0x28, 0x91, 0x0a, 0xbd,
-	0x45, 0x86, 0x07, 0x4c, 0x0b, 0xd2, 0x47, 0x9a, 0x16, 0x5c, 0xfd, 0x9d, 0x06, 0x10, 0xce, 0xc5,
-	0xd0, 0x97, 0xe1, 0x7c, 0x65, 0x7d, 0xad, 0x6a, 0x34, 0x36, 0x6f, 0x6d, 0x6e, 0x35, 0x8c, 0xad,
-	0xb5, 0xc6, 0x46, 0x6d, 0xb5, 0x7e, 0xbb, 0x5e, 0xab, 0x66, 0xa7, 0xf2, 0x99, 0xdd, 0x07, 0xc5,
-	0xd4, 0x16, 0x65, 0x7d, 0x62, 0xda, 0x2d, 0x9b, 0x58, 0xe8, 0x8b, 0x70, 0x66, 0x94, 0x5a, 0xac,
-	0x6a, 0xd5, 0xac, 0x96, 0x4f, 0xef, 0x3e, 0x28, 0xce, 0x78, 0xad, 0x01, 0xb1, 0xd0, 0x65, 0x38,
-	0x3b, 0x4e, 0x57, 0x5f, 0x7b, 0x23, 0x1b, 0xcb, 0xcf, 0xed, 0x3e, 0x28, 0xce, 0x06, 0x3d, 0x04,
-	0x2a, 0x01, 0x8a, 0x52, 0x2a, 0xbc, 0x78, 0x1e, 0x76, 0x1f, 0x14, 0x93, 0x5e, 0xb6, 0xe4, 0x13,
-	0xef, 0xfd, 0x66, 0x69, 0xea, 0xea, 0x0f, 0x01, 0xea, 0xb4, 0xe5, 0x62, 0x53, 0x56, 0x85, 0x3c,
-	0x9c, 0xab, 0xaf, 0xdd, 0xd6, 0x6f, 0xad, 0x6e, 0xd6, 0xd7, 0xd7, 0x46, 0xc5, 0xde, 0x77, 0x56,
-	0x5d, 0xdf, 0xaa, 0xbc, 0x5d, 0x33, 0x1a, 0xf5, 0x37, 0xd6, 0xb2, 0x1a, 0x3a, 0x0f, 0xa7, 0x47,
-	0xce, 0xbe, 0xb3, 0xb6, 0x59, 0x7f, 0xa7, 0x96, 0x8d, 0x55, 0x6e, 0x7e, 0xfc, 0x74, 0x49, 0x7b,
-	0xfc, 0x74, 0x49, 0xfb, 0xfb, 0xd3, 0x25, 0xed, 0x83, 0x67, 0x4b, 0x53, 0x8f, 0x9f, 0x2d, 0x4d,
-	0xfd, 0xf9, 0xd9, 0xd2, 0xd4, 0xf7, 0x5e, 0x19, 0xc9, 0xc3, 0xf0, 0x26, 0x92, 0xff, 0xcc, 0x68,
-	0x26, 0x65, 0xd4, 0x7c, 0xf5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x51, 0xe0, 0x99, 0x08, 0x44,
-	0x1a, 0x00, 0x00,
+	// 2261 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x34, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0x76, 0xe4, 0x95, 0xec, 0x48, 0x32, 0xe3,
+	0xff, 0x3f, 0x8e, 0x5b, 0x53, 0xb5, 0x5b, 0xf8, 0xa0, 0x16, 0x0d, 0x44, 0x51, 0x8e, 0x99, 0x38,
+	0x92, 0xba, 0x94, 0xd4, 0xa6, 0x5f, 0x8b, 0xe1, 0xee, 0x90, 0xdc, 0x68, 0xb9, 0x43, 0xef, 0x2c,
+	0x2d, 0xb3, 0xe7, 0x1e, 0x02, 0x05, 0x05, 0x7c, 0x6a, 0x0b, 0x14, 0x46, 0x0d, 0xf4, 0x92, 0xdc,
+	0x82, 0xc2, 0xe8, 0xbd, 0xe8, 0x25, 0x2d, 0x50, 0xc0, 0xf0, 0xa9, 0x28, 0x50, 0xa7, 0xb0, 0x0f,
+	0x09, 0xda, 0x4b, 0xd1, 0x53, 0x8f, 0xc5, 0x7c, 0xec, 0x07, 0x45, 0xc9, 0x92, 0xac, 0xa0, 0x08,
+	0xda, 0x0b, 0xc1, 0x99, 0x79, 0xef, 0x37, 0xef, 0xbd, 0x79, 0xef, 0xcd, 0xbc, 0xb7, 0x70, 0xd1,
+	0xa2, 0xac, 0x4d, 0xd9, 0x02, 0x0b, 0xf0, 0xb6, 0xe3, 0x35, 0x17, 0xee, 0x5c, 0xad, 0x93, 0x00,
+	0x5f, 0x0d, 0xc7, 0xa5, 0x8e, 0x4f, 0x03, 0x8a, 0xa6, 0x24, 0x55, 0x29, 0x9c, 0x55, 0x54, 0x33,
+	0x67, 0x9a, 0xb4, 0x49, 0x05, 0xc9, 0x02, 0xff, 0x27, 0xa9, 0x67, 0xa6, 0x9b, 0x94, 0x36, 0x5d,
+	0xb2, 0x20, 0x46, 0xf5, 0x6e, 0x63, 0x01, 0x7b, 0x3d, 0xb5, 0x34, 0xbb, 0x77, 0xc9, 0xee, 0xfa,
+	0x38, 0x70, 0xa8, 0xa7, 0xd6, 0xe7, 0xf6, 0xae, 0x07, 0x4e, 0x9b, 0xb0, 0x00, 0xb7, 0x3b, 0x21,
+	0xb6, 0x94, 0xc4, 0x94, 0x9b, 0x2a, 0xb1, 0x14, 0xb6, 0x52, 0xa5, 0x8e, 0x19, 0x89, 0xf4, 0xb0,
+	0xa8, 0x13, 0x62, 0x4f, 0xe2, 0xb6, 0xe3, 0xd1, 0x05, 0xf1, 0xab, 0xa6, 0xce, 0x07, 0xc4, 0xb3,
+	0x89, 0xdf, 0x76, 0xbc, 0x60, 0x21, 0xe8, 0x75, 0x08, 0x93, 0xbf, 0x6a, 0xf5, 0x5c, 0x62, 0x15,
+	0xd7, 0x2d, 0x27, 0xb9, 0x58, 0xfc, 0x99, 0x06, 0x13, 0x37, 0x1d, 0x16, 0x50, 0xdf, 0xb1, 0xb0,
+	0x5b, 0xf5, 0x1a, 0x14, 0x7d, 0x1d, 0xb2, 0x2d, 0x82, 0x6d, 0xe2, 0xeb, 0xda, 0xbc, 0x76, 0x29,
+	0x77, 0x4d, 0x2f, 0xc5, 0x00, 0x25, 0xc9, 0x7b, 0x53, 0xac, 0x97, 0x47, 0x3f, 0x7e, 0x32, 0x37,
+	0xf4, 0xc1, 0xa7, 0x1f, 0x5d, 0xd6, 0x0c, 0xc5, 0x82, 0x2a, 0x90, 0xbd, 0x83, 0x5d, 0x46, 0x02,
+	0x3d, 0x35, 0x9f, 0xbe, 0x94, 0xbb, 0x76, 0xa1, 0xb4, 0xbf, 0xcd, 0x4b, 0x5b, 0xd8, 0x75, 0x6c,
+	0x1c, 0xd0, 0x7e, 0x14, 0xc9, 0xbb, 0x98, 0xd2, 0xb5, 0xe2, 0xfb, 0x1a, 0x14, 0x62, 0xc9, 0x0c,
+	0x62, 0x51, 0xdf, 0x46, 0x3a, 0x0c, 0xe3, 0x4e, 0xa7, 0x85, 0x59, 0x4b, 0x08, 0x37, 0x66, 0x84,
+	0x43, 0xf4, 0x35, 0xc8, 0x70, 0x23, 0xeb, 0x29, 0x21, 0xf3, 0x4c, 0x49, 0x9e, 0x40, 0x29, 0x3c,
+	0x81, 0xd2, 0x46, 0x78, 0x02, 0xe5, 0xcc, 0xbd, 0x4f, 0xe6, 0x34, 0x43, 0x50, 0xa3, 0x57, 0x21,
+	0x7f, 0x27, 0x14, 0x84, 0x99, 0x02, 0x37, 0x2d, 0x70, 0x27, 0xe2, 0xe9, 0x9b, 0x98, 0xb5, 0x8a,
+	0x3f, 0x4d, 0x41, 0x7e, 0x99, 0xb6, 0xdb, 0x0e, 0x63, 0x0e, 0xf5, 0x0c, 0x1c, 0x10, 0x86, 0xde,
+	0x84, 0x8c, 0x8f, 0x03, 0x22, 0x24, 0x19, 0x2d, 0x5f, 0xe7, 0x6a, 0xfc, 0xf9, 0xc9, 0xdc, 0x39,
+	0xa9, 0x30, 0xb3, 0xb7, 0x4b, 0x0e, 0x5d, 0x68, 0xe3, 0xa0, 0x55, 0xba, 0x45, 0x9a, 0xd8, 0xea,
+	0x55, 0x88, 0xf5, 0xf8, 0xe1, 0x15, 0x50, 0xf6, 0xa8, 0x10, 0x4b, 0xea, 0x2c, 0x30, 0xd0, 0xb7,
+	0x60, 0xa4, 0x8d, 0xef, 0x9a, 0x02, 0x2f, 0x75, 0x22, 0xbc, 0xe1, 0x36, 0xbe, 0xcb, 0xe5, 0x43,
+	0x3f, 0x84, 0x3c, 0x87, 0xb4, 0x5a, 0xd8, 0x6b, 0x12, 0x89, 0x9c, 0x3e, 0x11, 0xf2, 0x78, 0x1b,
+	0xdf, 0x5d, 0x16, 0x68, 0x1c, 0x7f, 0x31, 0xf3, 0xd9, 0x83, 0x39, 0xad, 0xf8, 0x5b, 0x0d, 0x20,
+	0x36, 0x0c, 0xc2, 0x50, 0xb0, 0xa2, 0x91, 0xd8, 0x94, 0x29, 0x37, 0x7a, 0xf5, 0x20, 0x4f, 0xd8,
+	0x63, 0xd6, 0xf2, 0x38, 0x17, 0xef, 0xd1, 0x93, 0x39, 0x4d, 0xee, 0x9a, 0xb7, 0x06, 0xcc, 0x9e,
+	0xeb, 0x76, 0x6c, 0x1c, 0x10, 0xf3, 0x88, 0x07, 0x2e, 0x00, 0xef, 0x7d, 0x12, 0x02, 0x82, 0xe4,
+	0xe6, 0xeb, 0x4a, 0x87, 0x0f, 0x34, 0xc8, 0x55, 0x08, 0xb3, 0x7c, 0xa7, 0xc3, 0x83, 0x98, 0x7b,
+	0x59, 0x9b, 0x7a, 0xce, 0xb6, 0x0a, 0x81, 0x51, 0x23, 0x1c, 0xa2, 0x19, 0x18, 0x71, 0x6c, 0xe2,
+	0x05, 0x4e, 0xd0, 0x93, 0xc7, 0x64, 0x44, 0x63, 0xce, 0xb5, 0x43, 0xea, 0xcc, 0x09, 0xed, 0x6c,
+	0x84, 0x43, 0xf4, 0x1a, 0x14, 0x18, 0xb1, 0xba, 0xbe, 0x13, 0xf4, 0x4c, 0x8b, 0x7a, 0x01, 0xb6,
+	0x02, 0x3d, 0x23, 0x48, 0xf2, 0xe1, 0xfc, 0xb2, 0x9c, 0xe6, 0x20, 0x36, 0x09, 0xb0, 0xe3, 0x32,
+	0xfd, 0x94, 0x04, 0x51, 0x43, 0x25, 0xea, 0xee, 0x30, 0x8c, 0x46, 0xa1, 0x83, 0x96, 0xa1, 0x40,
+	0x3b, 0xc4, 0xe7, 0xff, 0x4d, 0x6c, 0xdb, 0x3e, 0x61, 0x4c, 0x79, 0xa3, 0xfe, 0xf8, 0xe1, 0x95,
+	0x33, 0xca, 0xe0, 0x4b, 0x72, 0xa5, 0x16, 0xf8, 0x8e, 0xd7, 0x34, 0xf2, 0x21, 0x87, 0x9a, 0x46,
+	0xef, 0xf0, 0x23, 0xf3, 0x18, 0xf1, 0x58, 0x97, 0x99, 0x9d, 0x6e, 0x7d, 0x9b, 0xf4, 0x94, 0x51,
+	0xcf, 0x0c, 0x18, 0x75, 0xc9, 0xeb, 0x95, 0xf5, 0x3f, 0xc4, 0xd0, 0x96, 0xdf, 0xeb, 0x04, 0xb4,
+	0xb4, 0xde, 0xad, 0xbf, 0x45, 0x7a, 0x46, 0x3e, 0xc2, 0x59, 0x17, 0x30, 0x68, 0x0a, 0xb2, 0xef,
+	0x62, 0xc7, 0x25, 0xb6, 0xb0, 0xc8, 0x88, 0xa1, 0x46, 0x68, 0x11, 0xb2, 0x2c, 0xc0, 0x41, 0x97,
+	0x09, 0x33, 0x4c, 0x5c, 0x2b, 0x1e, 0xe4, 0x1b, 0x65, 0xea, 0xd9, 0x35, 0x41, 0x69, 0x28, 0x0e,
+	0xb4, 0x0c, 0xd9, 0x80, 0x6e, 0x13, 0x4f, 0x19, 0xa8, 0xfc, 0x25, 0xe5, 0xcd, 0x2f, 0x0d, 0x7a,
+	0x73, 0xd5, 0x0b, 0x12, 0x7e, 0x5c, 0xf5, 0x02, 0x43, 0xb1, 0xa2, 0xef, 0x43, 0xc1, 0x26, 0x2e,
+	0x69, 0x0a, 0xcb, 0xb1, 0x16, 0xf6, 0x09, 0xd3, 0xb3, 0x02, 0xee, 0xea, 0xb1, 0x83, 0xc3, 0xc8,
+	0x47, 0x50, 0x35, 0x81, 0x84, 0xd6, 0x21, 0x67, 0xc7, 0xee, 0xa4, 0x0f, 0x0b, 0x63, 0xbe, 0x72,
+	0x90, 0x8e, 0x09, 0xcf, 0x4b, 0xe6, 0xc2, 0x24, 0x04, 0xf7, 0xa0, 0xae, 0x57, 0xa7, 0x9e, 0xed,
+	0x78, 0x4d, 0xb3, 0x45, 0x9c, 0x66, 0x2b, 0xd0, 0x47, 0xe6, 0xb5, 0x4b, 0x69, 0x23, 0x1f, 0xcd,
+	0xdf, 0x14, 0xd3, 0x68, 0x1d, 0x26, 0x62, 0x52, 0x11, 0x21, 0xa3, 0xc7, 0x8d, 0x90, 0xf1, 0x08,
+	0x80, 0x93, 0xa0, 0xb7, 0x01, 0xe2, 0x18, 0xd4, 0x41, 0xa0, 0x15, 0x0f, 0x8f, 0xe6, 0xa4, 0x32,
+	0x09, 0x00, 0xf4, 0x3d, 0x38, 0xdd, 0x76, 0x3c, 0x93, 0x11, 0xb7, 0x61, 0x2a, 0xcb, 0x71, 0xdc,
+	0xdc, 0xf1, 0x4f, 0x73, 0xb2, 0xed, 0x78, 0x35, 0xe2, 0x36, 0x2a, 0x11, 0x0a, 0xfa, 0x06, 0x9c,
+	0x8b, 0xb5, 0xa7, 0x9e, 0xd9, 0xa2, 0xae, 0x6d, 0xfa, 0xa4, 0x61, 0x5a, 0xb4, 0xeb, 0x05, 0xfa,
+	0x98, 0xb0, 0xd9, 0xd9, 0x88, 0x64, 0xcd, 0xbb, 0x49, 0x5d, 0xdb, 0x20, 0x8d, 0x65, 0xbe, 0x8c,
+	0x5e, 0x81, 0x58, 0x75, 0xd3, 0xb1, 0x99, 0x3e, 0x3e, 0x9f, 0xbe, 0x94, 0x31, 0xc6, 0xa2, 0xc9,
+	0xaa, 0xcd, 0x16, 0x47, 0xde, 0x7b, 0x30, 0x37, 0xf4, 0xd9, 0x83, 0xb9, 0xa1, 0xe2, 0x0d, 0x18,
+	0xdb, 0xc2, 0xae, 0x8a, 0x23, 0xc2, 0xd0, 0x75, 0x18, 0xc5, 0xe1, 0x40, 0xd7, 0xe6, 0xd3, 0xcf,
+	0x8d, 0xc3, 0x98, 0xb4, 0xf8, 0xa1, 0x06, 0xd9, 0xca, 0xd6, 0x3a, 0x76, 0x7c, 0xb4, 0x02, 0x93,
+	0xb1, 0x63, 0x1e, 0x35, 0xa4, 0x63, 0x5f, 0x0e, 0x63, 0x7a, 0x15, 0x26, 0xa3, 0x0b, 0x2c, 0x82,
+	0x91, 0xf7, 0xca, 0x85, 0xc7, 0x0f, 0xaf, 0xbc, 0xac, 0x60, 0xa2, 0x4c, 0xb2, 0x07, 0xef, 0xce,
+	0x9e, 0xf9, 0x84, 0xce, 0x6f, 0xc2, 0xb0, 0x14, 0x95, 0xa1, 0xd7, 0xe1, 0x54, 0x87, 0xff, 0x11,
+	0xaa, 0xe6, 0xae, 0xcd, 0x1e, 0xe8, 0xe0, 0x82, 0x3e, 0xe9, 0x0e, 0x92, 0xaf, 0xf8, 0x7e, 0x0a,
+	0xa0, 0xb2, 0xb5, 0xb5, 0xe1, 0x3b, 0x1d, 0x97, 0x04, 0x9f, 0x97, 0xee, 0x9b, 0xf0, 0x52, 0xac,
+	0x3b, 0xf3, 0xad, 0xe3, 0xeb, 0x7f, 0x3a, 0xe2, 0xaf, 0xf9, 0xd6, 0xbe, 0xb0, 0x36, 0x0b, 0x22,
+	0xd8, 0xf4, 0xf1, 0x61, 0x2b, 0x2c, 0x18, 0xb4, 0xec, 0x77, 0x20, 0x17, 0x1b, 0x83, 0xa1, 0x2a,
+	0x8c, 0x04, 0xea, 0xbf, 0x32, 0x70, 0xf1, 0x60, 0x03, 0x87, 0x6c, 0x49, 0x23, 0x47, 0xec, 0xc5,
+	0x7f, 0x69, 0x00, 0x89, 0x18, 0xf9, 0x62, 0xfa, 0x18, 0xaa, 0x42, 0x56, 0x65, 0xe2, 0xf4, 0x8b,
+	0x66, 0x62, 0x05, 0x90, 0x30, 0xea, 0x4f, 0x52, 0x70, 0x7a, 0x33, 0x8c, 0xde, 0x2f, 0xbe, 0x0d,
+	0x36, 0x61, 0x98, 0x78, 0x81, 0xef, 0x08, 0x23, 0xf0, 0x33, 0xff, 0xca, 0x41, 0x67, 0xbe, 0x8f,
+	0x52, 0x2b, 0x5e, 0xe0, 0xf7, 0x92, 0x1e, 0x10, 0x62, 0x25, 0xec, 0xf1, 0x8b, 0x34, 0xe8, 0x07,
+	0xb1, 0xf2, 0xd7, 0xb0, 0xe5, 0x13, 0x31, 0x11, 0x5e, 0x32, 0x9a, 0x48, 0x98, 0x13, 0xe1, 0xb4,
+	0xba, 0x63, 0x0c, 0xe0, 0xaf, 0x32, 0xee, 0x5c, 0x9c, 0xf4, 0xc5, 0x9e, 0x61, 0x13, 0x31, 0x82,
+	0xb8, 0x65, 0x36, 0x20, 0xef, 0x78, 0x4e, 0xe0, 0x60, 0xd7, 0xac, 0x63, 0x17, 0x7b, 0x56, 0xf8,
+	0x5c, 0x3d, 0xd6, 0x95, 0x30, 0xa1, 0x30, 0xca, 0x12, 0x02, 0xad, 0xc0, 0x70, 0x88, 0x96, 0x39,
+	0x3e, 0x5a, 0xc8, 0x8b, 0x2e, 0xc0, 0x58, 0xf2, 0x62, 0x10, 0x4f, 0x8f, 0x8c, 0x91, 0x4b, 0xdc,
+	0x0b, 0x87, 0xdd, 0x3c, 0xd9, 0xe7, 0xde, 0x3c, 0xea, 0x75, 0xf7, 0xcb, 0x34, 0x4c, 0x1a, 0xc4,
+	0xfe, 0xef, 0x3f, 0x96, 0x75, 0x00, 0x19, 0xaa, 0x3c, 0x93, 0xea, 0x99, 0x17, 0x8d, 0xf7, 0x51,
+	0x09, 0x52, 0x61, 0xc1, 0x7f, 0xea, 0x84, 0xfe, 0x92, 0x82, 0xb1, 0xe4, 0x09, 0xfd, 0x4f, 0x5e,
+	0x5a, 0x68, 0x35, 0x4e, 0x53, 0x19, 0x91, 0xa6, 0x5e, 0x3b, 0x28, 0x4d, 0x0d, 0x78, 0xf3, 0x21,
+	0xf9, 0xe9, 0xd7, 0xc3, 0x90, 0x5d, 0xc7, 0x3e, 0x6e, 0x33, 0xb4, 0x36, 0xf0, 0x90, 0x95, 0x85,
+	0xe4, 0xf4, 0x80, 0x33, 0x57, 0x54, 0xf7, 0x45, 0xfa, 0xf2, 0xcf, 0x0f, 0x7a, 0xc7, 0xfe, 0x1f,
+	0x4c, 0xf0, 0x82, 0x38, 0x52, 0x48, 0x1a, 0x77, 0x5c, 0xd4, 0xb5, 0x91, 0xf6, 0x0c, 0xcd, 0x41,
+	0x8e, 0x93, 0xc5, 0x79, 0x98, 0xd3, 0x40, 0x1b, 0xdf, 0x5d, 0x91, 0x33, 0xe8, 0x0a, 0xa0, 0x56,
+	0xd4, 0x98, 0x30, 0x63, 0x43, 0x70, 0xba, 0xc9, 0x78, 0x25, 0x24, 0x7f, 0x19, 0x80, 0x4b, 0x61,
+	0xda, 0xc4, 0xa3, 0x6d, 0x55, 0xd5, 0x8d, 0xf2, 0x99, 0x0a, 0x9f, 0x40, 0x3f, 0xd6, 0xe4, 0x7b,
+	0x78, 0x4f, 0xd9, 0xac, 0xca, 0x91, 0x8d, 0x23, 0x04, 0xc5, 0x3f, 0x9f, 0xcc, 0xcd, 0xf4, 0x70,
+	0xdb, 0x5d, 0x2c, 0xee, 0x83, 0x53, 0xdc, 0xaf, 0x92, 0xe7, 0x0f, 0xe7, 0xfe, 0xb2, 0x1b, 0x55,
+	0xa1, 0xb0, 0x4d, 0x7a, 0xa6, 0x4f, 0x03, 0x99, 0x68, 0x1a, 0x84, 0xa8, 0xc2, 0x65, 0x3a, 0x3c,
+	0xdb, 0x3a, 0x66, 0x24, 0xf1, 0xce, 0x77, 0xbc, 0x72, 0x86, 0x4b, 0x67, 0x4c, 0x6c, 0x93, 0x9e,
+	0xa1, 0xf8, 0x6e, 0x10, 0x82, 0xde, 0x4d, 0x3a, 0x9d, 0x50, 0xbd, 0x81, 0xad, 0x80, 0xfa, 0xfa,
+	0xc8, 0x89, 0xda, 0x0f, 0xb1, 0x27, 0xf2, 0xaa, 0xf0, 0x86, 0x80, 0x44, 0xb7, 0x61, 0xba, 0xe9,
+	0xd2, 0x3a, 0x76, 0x4d, 0xd7, 0xb9, 0xdd, 0x75, 0x6c, 0x53, 0x39, 0xa0, 0x69, 0xe1, 0x8e, 0x3e,
+	0x7a, 0xa2, 0xfd, 0xa6, 0x24, 0xf0, 0x2d, 0x81, 0x5b, 0x93, 0xb0, 0xcb, 0xb8, 0x83, 0x76, 0xe0,
+	0x7c, 0xac, 0xde, 0x3e, 0xbb, 0xc2, 0x89, 0x76, 0x9d, 0x8e, 0xb0, 0x07, 0x36, 0xbe, 0x0d, 0xd3,
+	0x7d, 0xfe, 0x6b, 0x76, 0xe8, 0x0e, 0xf1, 0x4d, 0xe1, 0xfb, 0x7a, 0xee, 0x44, 0xbb, 0x4e, 0x25,
+	0x43, 0x60, 0x9d, 0xc3, 0x1a, 0x1c, 0x75, 0xf1, 0x22, 0x4f, 0x7a, 0xbb, 0x9f, 0x7e, 0x74, 0x59,
+	0x01, 0x5e, 0x61, 0xf6, 0xf6, 0xc2, 0xdd, 0xa8, 0xcd, 0x2a, 0x23, 0xb5, 0xf8, 0x3b, 0x0d, 0x4e,
+	0x6f, 0xf0, 0xc2, 0xda, 0xf9, 0x11, 0x11, 0x25, 0xb0, 0xea, 0xd6, 0x4d, 0x40, 0xca, 0xb1, 0x45,
+	0xd4, 0x66, 0x8c, 0x94, 0x63, 0xa3, 0x12, 0x9c, 0xa2, 0x3b, 0x1e, 0xf1, 0xf5, 0xd4, 0x21, 0xf9,
+	0x51, 0x92, 0x89, 0x80, 0xa5, 0x76, 0xd7, 0x25, 0x26, 0xb6, 0x64, 0x8e, 0x96, 0x8d, 0x95, 0x71,
+	0x39, 0xbb, 0x24, 0x27, 0xd1, 0xeb, 0x30, 0x1a, 0xd9, 0x44, 0xcf, 0x1c, 0x35, 0xb1, 0xc5, 0x3c,
+	0x2a, 0xb5, 0x7f, 0xa8, 0x01, 0x8a, 0x5f, 0x44, 0x06, 0x61, 0x1d, 0xea, 0x31, 0x51, 0xfd, 0x26,
+	0xaa, 0x54, 0xed, 0xf9, 0xd5, 0x6f, 0xcc, 0xdf, 0x57, 0xfd, 0x26, 0xee, 0x8b, 0x6f, 0xc6, 0x0f,
+	0x92, 0xd4, 0x61, 0xe1, 0x95, 0x4c, 0x95, 0x8a, 0x49, 0xc8, 0x3a, 0x54, 0xfc, 0xa3, 0x06, 0xd3,
+	0x03, 0xa9, 0x35, 0x12, 0xd9, 0x02, 0xe4, 0x27, 0x16, 0x45, 0x8a, 0xea, 0x29, 0xd1, 0x5f, 0x2c,
+	0x53, 0x4f, 0xfa, 0x7b, 0x57, 0x3f, 0xa7, 0x97, 0x95, 0xb2, 0xfd, 0xef, 0x35, 0x38, 0x93, 0x14,
+	0x20, 0x52, 0xa5, 0x06, 0x63, 0xc9, 0xad, 0x95, 0x12, 0x17, 0x8f, 0xa2, 0x44, 0x52, 0xfe, 0x3e,
+	0x10, 0xb4, 0x15, 0x5f, 0x5f, 0xb2, 0x4b, 0x7d, 0xf5, 0xc8, 0x46, 0x09, 0x05, 0xdb, 0xf7, 0x1a,
+	0x93, 0x67, 0xf3, 0x77, 0x0d, 0x32, 0xeb, 0x94, 0xba, 0xe8, 0x36, 0x4c, 0x7a, 0x34, 0x10, 0x19,
+	0x90, 0xd8, 0xa6, 0x6a, 0x5a, 0xc9, 0xa7, 0xc1, 0xca, 0x73, 0x6d, 0xf5, 0xb7, 0x27, 0x73, 0x83,
+	0x9c, 0xfd, 0x06, 0x54, 0xbd, 0x51, 0x8f, 0x06, 0x65, 0x41, 0x24, 0xc2, 0x8f, 0xa1, 0x06, 0x8c,
+	0xf7, 0x6f, 0x27, 0x23, 0x6d, 0xe9, 0xb0, 0xed, 0xc6, 0x0f, 0xdd, 0x6a, 0xac, 0x9e, 0xd8, 0x67,
+	0x71, 0x84, 0x9f, 0xda, 0x3f, 0xf8, 0xc9, 0xbd, 0x03, 0x85, 0x28, 0xc0, 0x36, 0x45, 0x63, 0x95,
+	0x71, 0xd7, 0x90, 0x3d, 0xd6, 0xb0, 0x72, 0x9d, 0x4f, 0x7e, 0x42, 0xe0, 0xdf, 0x20, 0x4a, 0x7b,
+	0x78, 0xfa, 0xcc, 0xa9, 0x78, 0x8b, 0x8f, 0x52, 0x30, 0xbd, 0x4c, 0x3d, 0xa6, 0xba, 0x8b, 0xea,
+	0x86, 0x91, 0xdf, 0x04, 0x7a, 0xbc, 0x25, 0xb6, 0x6f, 0xef, 0x73, 0x6c, 0xb0, 0xc3, 0xb9, 0x05,
+	0x79, 0xfe, 0xd4, 0xb3, 0xa8, 0x77, 0xc2, 0x06, 0xe7, 0x38, 0x75, 0x6d, 0x25, 0x11, 0x6f, 0x6f,
+	0x6e, 0x41, 0xde, 0x23, 0x3b, 0x7d, 0xb8, 0xe9, 0x17, 0xc3, 0xf5, 0xc8, 0x4e, 0x02, 0x77, 0x8a,
+	0x7f, 0x81, 0x11, 0xef, 0xfc, 0x8c, 0xc8, 0x9d, 0x6a, 0x84, 0xae, 0x43, 0x9a, 0x5f, 0xcb, 0xa7,
+	0x8e, 0x91, 0x37, 0x38, 0x43, 0xe2, 0x79, 0x55, 0x83, 0x69, 0xd5, 0xb1, 0x62, 0x6b, 0x0d, 0x61,
+	0x51, 0x22, 0x14, 0x7a, 0x8b, 0xf4, 0xf6, 0x69, 0x5f, 0x8d, 0x1d, 0xa9, 0x7d, 0x75, 0xf9, 0x37,
+	0x1a, 0x40, 0xdc, 0xa8, 0x45, 0x5f, 0x86, 0xb3, 0xe5, 0xb5, 0xd5, 0x8a, 0x59, 0xdb, 0x58, 0xda,
+	0xd8, 0xac, 0x99, 0x9b, 0xab, 0xb5, 0xf5, 0x95, 0xe5, 0xea, 0x8d, 0xea, 0x4a, 0xa5, 0x30, 0x34,
+	0x93, 0xdf, 0xbd, 0x3f, 0x9f, 0xdb, 0xf4, 0x58, 0x87, 0x58, 0x4e, 0xc3, 0x21, 0x36, 0xfa, 0x7f,
+	0x38, 0xd3, 0x4f, 0xcd, 0x47, 0x2b, 0x95, 0x82, 0x36, 0x33, 0xb6, 0x7b, 0x7f, 0x7e, 0x44, 0xd6,
+	0xaa, 0xc4, 0x46, 0x97, 0xe0, 0xa5, 0x41, 0xba, 0xea, 0xea, 0x1b, 0x85, 0xd4, 0xcc, 0xf8, 0xee,
+	0xfd, 0xf9, 0xd1, 0xa8, 0xa8, 0x45, 0x45, 0x40, 0x49, 0x4a, 0x85, 0x97, 0x9e, 0x81, 0xdd, 0xfb,
+	0xf3, 0x59, 0x19, 0x2d, 0x33, 0x99, 0xf7, 0x7e, 0x35, 0x3b, 0x74, 0xf9, 0x07, 0x00, 0x55, 0xaf,
+	0xe1, 0x63, 0x4b, 0x64, 0x85, 0x19, 0x98, 0xaa, 0xae, 0xde, 0x30, 0x96, 0x96, 0x37, 0xaa, 0x6b,
+	0xab, 0xfd, 0x62, 0xef, 0x59, 0xab, 0xac, 0x6d, 0x96, 0x6f, 0xad, 0x98, 0xb5, 0xea, 0x1b, 0xab,
+	0x05, 0x0d, 0x9d, 0x85, 0xd3, 0x7d, 0x6b, 0xdf, 0x5e, 0xdd, 0xa8, 0xbe, 0xbd, 0x52, 0x48, 0x95,
+	0xaf, 0x7f, 0xfc, 0x74, 0x56, 0x7b, 0xf4, 0x74, 0x56, 0xfb, 0xeb, 0xd3, 0x59, 0xed, 0xde, 0xb3,
+	0xd9, 0xa1, 0x47, 0xcf, 0x66, 0x87, 0xfe, 0xf4, 0x6c, 0x76, 0xe8, 0xbb, 0xe7, 0xfb, 0xe2, 0x30,
+	0xbe, 0x4f, 0xc5, 0xd7, 0xb5, 0x7a, 0x56, 0x78, 0xcd, 0x57, 0xff, 0x3d, 0x00, 0x2b, 0x41, 0xc2,
+	0x85, 0xd5, 0x1c, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1588,716 +1691,723 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11335 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x1c, 0xd7,
-		0x75, 0x18, 0xbc, 0xf3, 0x9e, 0x39, 0xf3, 0xea, 0xbd, 0xbb, 0x00, 0x16, 0x03, 0x12, 0xbb, 0x6c,
-		0x8a, 0x24, 0x08, 0x92, 0x0b, 0x12, 0x24, 0x40, 0x71, 0x21, 0x89, 0xdf, 0xcc, 0xec, 0x00, 0x18,
-		0x70, 0x5f, 0xec, 0x99, 0x85, 0x48, 0xda, 0x56, 0xbb, 0xb7, 0xe7, 0xee, 0x6e, 0x13, 0x33, 0xdd,
-		0xad, 0xee, 0x1e, 0x60, 0x97, 0xf5, 0x55, 0x4a, 0x8e, 0xec, 0x44, 0x86, 0x1f, 0x91, 0x63, 0x97,
-		0x2d, 0x5b, 0x82, 0x2d, 0xd9, 0xb1, 0x25, 0x3b, 0x2f, 0x3f, 0x14, 0x3f, 0xe2, 0x8a, 0x63, 0xa7,
-		0x9c, 0xc4, 0x76, 0x25, 0x29, 0xc5, 0x3f, 0x12, 0x27, 0x55, 0x66, 0x6c, 0xc9, 0x15, 0x29, 0xb2,
-		0x9c, 0xf8, 0x21, 0x27, 0x4e, 0xa9, 0x92, 0x4a, 0xdd, 0x57, 0x3f, 0xe6, 0xb1, 0x33, 0x0b, 0x91,
-		0xb2, 0x63, 0xe7, 0x0f, 0x30, 0x7d, 0xee, 0x39, 0xe7, 0xde, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x73,
-		0xee, 0x63, 0xe1, 0x77, 0x6b, 0xb0, 0xb4, 0x67, 0x59, 0x7b, 0x5d, 0x7c, 0xc1, 0x76, 0x2c, 0xcf,
-		0xda, 0xe9, 0xef, 0x5e, 0xe8, 0x60, 0x57, 0x77, 0x0c, 0xdb, 0xb3, 0x9c, 0x65, 0x0a, 0x43, 0x65,
-		0x86, 0xb1, 0x2c, 0x30, 0xe4, 0x75, 0x98, 0xbd, 0x6a, 0x74, 0xf1, 0xaa, 0x8f, 0xd8, 0xc2, 0x1e,
-		0x7a, 0x27, 0x24, 0x77, 0x8d, 0x2e, 0x5e, 0x88, 0x2d, 0x25, 0xce, 0xe5, 0x2f, 0xbe, 0x63, 0x79,
-		0x80, 0x68, 0x39, 0x4a, 0xb1, 0x45, 0xc0, 0x0a, 0xa5, 0x90, 0xff, 0x77, 0x12, 0xe6, 0x46, 0x94,
-		0x22, 0x04, 0x49, 0x53, 0xeb, 0x11, 0x8e, 0xb1, 0x73, 0x39, 0x85, 0xfe, 0x46, 0x0b, 0x90, 0xb1,
-		0x35, 0xfd, 0x96, 0xb6, 0x87, 0x17, 0xe2, 0x14, 0x2c, 0x3e, 0xd1, 0x59, 0x80, 0x0e, 0xb6, 0xb1,
-		0xd9, 0xc1, 0xa6, 0x7e, 0xb8, 0x90, 0x58, 0x4a, 0x9c, 0xcb, 0x29, 0x21, 0x08, 0x7a, 0x02, 0x66,
-		0xed, 0xfe, 0x4e, 0xd7, 0xd0, 0xd5, 0x10, 0x1a, 0x2c, 0x25, 0xce, 0xa5, 0x14, 0x89, 0x15, 0xac,
-		0x06, 0xc8, 0x8f, 0x41, 0xf9, 0x0e, 0xd6, 0x6e, 0x85, 0x51, 0xf3, 0x14, 0xb5, 0x44, 0xc0, 0x21,
-		0xc4, 0x3a, 0x14, 0x7a, 0xd8, 0x75, 0xb5, 0x3d, 0xac, 0x7a, 0x87, 0x36, 0x5e, 0x48, 0xd2, 0xde,
-		0x2f, 0x0d, 0xf5, 0x7e, 0xb0, 0xe7, 0x79, 0x4e, 0xd5, 0x3e, 0xb4, 0x31, 0xaa, 0x42, 0x0e, 0x9b,
-		0xfd, 0x1e, 0xe3, 0x90, 0x1a, 0x23, 0xbf, 0x86, 0xd9, 0xef, 0x0d, 0x72, 0xc9, 0x12, 0x32, 0xce,
-		0x22, 0xe3, 0x62, 0xe7, 0xb6, 0xa1, 0xe3, 0x85, 0x34, 0x65, 0xf0, 0xd8, 0x10, 0x83, 0x16, 0x2b,
-		0x1f, 0xe4, 0x21, 0xe8, 0x50, 0x1d, 0x72, 0xf8, 0xc0, 0xc3, 0xa6, 0x6b, 0x58, 0xe6, 0x42, 0x86,
-		0x32, 0x79, 0x64, 0xc4, 0x28, 0xe2, 0x6e, 0x67, 0x90, 0x45, 0x40, 0x87, 0x2e, 0x43, 0xc6, 0xb2,
-		0x3d, 0xc3, 0x32, 0xdd, 0x85, 0xec, 0x52, 0xec, 0x5c, 0xfe, 0xe2, 0x03, 0x23, 0x15, 0x61, 0x93,
-		0xe1, 0x28, 0x02, 0x19, 0x35, 0x41, 0x72, 0xad, 0xbe, 0xa3, 0x63, 0x55, 0xb7, 0x3a, 0x58, 0x35,
-		0xcc, 0x5d, 0x6b, 0x21, 0x47, 0x19, 0x2c, 0x0e, 0x77, 0x84, 0x22, 0xd6, 0xad, 0x0e, 0x6e, 0x9a,
-		0xbb, 0x96, 0x52, 0x72, 0x23, 0xdf, 0xe8, 0x24, 0xa4, 0xdd, 0x43, 0xd3, 0xd3, 0x0e, 0x16, 0x0a,
-		0x54, 0x43, 0xf8, 0x17, 0x51, 0x1d, 0xdc, 0x31, 0x48, 0x75, 0x0b, 0x45, 0xa6, 0x3a, 0xfc, 0x53,
-		0xfe, 0x85, 0x34, 0x94, 0xa7, 0x51, 0xbe, 0x2b, 0x90, 0xda, 0x25, 0xfd, 0x5f, 0x88, 0x1f, 0x47,
-		0x3a, 0x8c, 0x26, 0x2a, 0xde, 0xf4, 0x7d, 0x8a, 0xb7, 0x0a, 0x79, 0x13, 0xbb, 0x1e, 0xee, 0x30,
-		0x5d, 0x49, 0x4c, 0xa9, 0x6d, 0xc0, 0x88, 0x86, 0x95, 0x2d, 0x79, 0x5f, 0xca, 0xf6, 0x0a, 0x94,
-		0xfd, 0x26, 0xa9, 0x8e, 0x66, 0xee, 0x09, 0xad, 0xbd, 0x30, 0xa9, 0x25, 0xcb, 0x0d, 0x41, 0xa7,
-		0x10, 0x32, 0xa5, 0x84, 0x23, 0xdf, 0x68, 0x15, 0xc0, 0x32, 0xb1, 0xb5, 0xab, 0x76, 0xb0, 0xde,
-		0x5d, 0xc8, 0x8e, 0x91, 0xd2, 0x26, 0x41, 0x19, 0x92, 0x92, 0xc5, 0xa0, 0x7a, 0x17, 0xbd, 0x10,
-		0x28, 0x61, 0x66, 0x8c, 0x0e, 0xad, 0xb3, 0xe9, 0x37, 0xa4, 0x87, 0xdb, 0x50, 0x72, 0x30, 0x99,
-		0x11, 0xb8, 0xc3, 0x7b, 0x96, 0xa3, 0x8d, 0x58, 0x9e, 0xd8, 0x33, 0x85, 0x93, 0xb1, 0x8e, 0x15,
-		0x9d, 0xf0, 0x27, 0x7a, 0x18, 0x7c, 0x80, 0x4a, 0xd5, 0x0a, 0xa8, 0x7d, 0x2a, 0x08, 0xe0, 0x86,
-		0xd6, 0xc3, 0x95, 0x37, 0xa0, 0x14, 0x15, 0x0f, 0x9a, 0x87, 0x94, 0xeb, 0x69, 0x8e, 0x47, 0xb5,
-		0x30, 0xa5, 0xb0, 0x0f, 0x24, 0x41, 0x02, 0x9b, 0x1d, 0x6a, 0xff, 0x52, 0x0a, 0xf9, 0x89, 0xfe,
-		0xbf, 0xa0, 0xc3, 0x09, 0xda, 0xe1, 0x47, 0x87, 0x47, 0x34, 0xc2, 0x79, 0xb0, 0xdf, 0x95, 0xe7,
-		0xa1, 0x18, 0xe9, 0xc0, 0xb4, 0x55, 0xcb, 0xff, 0x3f, 0x9c, 0x18, 0xc9, 0x1a, 0xbd, 0x02, 0xf3,
-		0x7d, 0xd3, 0x30, 0x3d, 0xec, 0xd8, 0x0e, 0x26, 0x1a, 0xcb, 0xaa, 0x5a, 0xf8, 0x7c, 0x66, 0x8c,
-		0xce, 0x6d, 0x87, 0xb1, 0x19, 0x17, 0x65, 0xae, 0x3f, 0x0c, 0x3c, 0x9f, 0xcb, 0x7e, 0x21, 0x23,
-		0x7d, 0xe0, 0x03, 0x1f, 0xf8, 0x40, 0x5c, 0xfe, 0x95, 0x34, 0xcc, 0x8f, 0x9a, 0x33, 0x23, 0xa7,
-		0xef, 0x49, 0x48, 0x9b, 0xfd, 0xde, 0x0e, 0x76, 0xa8, 0x90, 0x52, 0x0a, 0xff, 0x42, 0x55, 0x48,
-		0x75, 0xb5, 0x1d, 0xdc, 0x5d, 0x48, 0x2e, 0xc5, 0xce, 0x95, 0x2e, 0x3e, 0x31, 0xd5, 0xac, 0x5c,
-		0x5e, 0x23, 0x24, 0x0a, 0xa3, 0x44, 0xef, 0x81, 0x24, 0x37, 0xde, 0x84, 0xc3, 0xf9, 0xe9, 0x38,
-		0x90, 0xb9, 0xa4, 0x50, 0x3a, 0x74, 0x06, 0x72, 0xe4, 0x7f, 0xa6, 0x1b, 0x69, 0xda, 0xe6, 0x2c,
-		0x01, 0x10, 0xbd, 0x40, 0x15, 0xc8, 0xd2, 0x69, 0xd2, 0xc1, 0x62, 0xd1, 0xf3, 0xbf, 0x89, 0x62,
-		0x75, 0xf0, 0xae, 0xd6, 0xef, 0x7a, 0xea, 0x6d, 0xad, 0xdb, 0xc7, 0x54, 0xe1, 0x73, 0x4a, 0x81,
-		0x03, 0x6f, 0x12, 0x18, 0x5a, 0x84, 0x3c, 0x9b, 0x55, 0x86, 0xd9, 0xc1, 0x07, 0xd4, 0xae, 0xa6,
-		0x14, 0x36, 0xd1, 0x9a, 0x04, 0x42, 0xaa, 0x7f, 0xdd, 0xb5, 0x4c, 0xa1, 0x9a, 0xb4, 0x0a, 0x02,
-		0xa0, 0xd5, 0x3f, 0x3f, 0x68, 0xd2, 0x1f, 0x1c, 0xdd, 0xbd, 0xa1, 0xb9, 0xf4, 0x18, 0x94, 0x29,
-		0xc6, 0xb3, 0x7c, 0xe8, 0xb5, 0xee, 0xc2, 0xec, 0x52, 0xec, 0x5c, 0x56, 0x29, 0x31, 0xf0, 0x26,
-		0x87, 0xca, 0x3f, 0x1b, 0x87, 0x24, 0x35, 0x2c, 0x65, 0xc8, 0xb7, 0x5f, 0xdd, 0x6a, 0xa8, 0xab,
-		0x9b, 0xdb, 0xb5, 0xb5, 0x86, 0x14, 0x43, 0x25, 0x00, 0x0a, 0xb8, 0xba, 0xb6, 0x59, 0x6d, 0x4b,
-		0x71, 0xff, 0xbb, 0xb9, 0xd1, 0xbe, 0xfc, 0x9c, 0x94, 0xf0, 0x09, 0xb6, 0x19, 0x20, 0x19, 0x46,
-		0x78, 0xf6, 0xa2, 0x94, 0x42, 0x12, 0x14, 0x18, 0x83, 0xe6, 0x2b, 0x8d, 0xd5, 0xcb, 0xcf, 0x49,
-		0xe9, 0x28, 0xe4, 0xd9, 0x8b, 0x52, 0x06, 0x15, 0x21, 0x47, 0x21, 0xb5, 0xcd, 0xcd, 0x35, 0x29,
-		0xeb, 0xf3, 0x6c, 0xb5, 0x95, 0xe6, 0xc6, 0x35, 0x29, 0xe7, 0xf3, 0xbc, 0xa6, 0x6c, 0x6e, 0x6f,
-		0x49, 0xe0, 0x73, 0x58, 0x6f, 0xb4, 0x5a, 0xd5, 0x6b, 0x0d, 0x29, 0xef, 0x63, 0xd4, 0x5e, 0x6d,
-		0x37, 0x5a, 0x52, 0x21, 0xd2, 0xac, 0x67, 0x2f, 0x4a, 0x45, 0xbf, 0x8a, 0xc6, 0xc6, 0xf6, 0xba,
-		0x54, 0x42, 0xb3, 0x50, 0x64, 0x55, 0x88, 0x46, 0x94, 0x07, 0x40, 0x97, 0x9f, 0x93, 0xa4, 0xa0,
-		0x21, 0x8c, 0xcb, 0x6c, 0x04, 0x70, 0xf9, 0x39, 0x09, 0xc9, 0x75, 0x48, 0x51, 0x35, 0x44, 0x08,
-		0x4a, 0x6b, 0xd5, 0x5a, 0x63, 0x4d, 0xdd, 0xdc, 0x6a, 0x37, 0x37, 0x37, 0xaa, 0x6b, 0x52, 0x2c,
-		0x80, 0x29, 0x8d, 0x97, 0xb7, 0x9b, 0x4a, 0x63, 0x55, 0x8a, 0x87, 0x61, 0x5b, 0x8d, 0x6a, 0xbb,
-		0xb1, 0x2a, 0x25, 0x64, 0x1d, 0xe6, 0x47, 0x19, 0xd4, 0x91, 0x53, 0x28, 0xa4, 0x0b, 0xf1, 0x31,
-		0xba, 0x40, 0x79, 0x0d, 0xea, 0x82, 0xfc, 0xb9, 0x38, 0xcc, 0x8d, 0x58, 0x54, 0x46, 0x56, 0xf2,
-		0x22, 0xa4, 0x98, 0x2e, 0xb3, 0x65, 0xf6, 0xf1, 0x91, 0xab, 0x13, 0xd5, 0xec, 0xa1, 0xa5, 0x96,
-		0xd2, 0x85, 0x9d, 0x90, 0xc4, 0x18, 0x27, 0x84, 0xb0, 0x18, 0x52, 0xd8, 0x6f, 0x18, 0x32, 0xfe,
-		0x6c, 0x7d, 0xbc, 0x3c, 0xcd, 0xfa, 0x48, 0x61, 0xc7, 0x5b, 0x04, 0x52, 0x23, 0x16, 0x81, 0x2b,
-		0x30, 0x3b, 0xc4, 0x68, 0x6a, 0x63, 0xfc, 0xc1, 0x18, 0x2c, 0x8c, 0x13, 0xce, 0x04, 0x93, 0x18,
-		0x8f, 0x98, 0xc4, 0x2b, 0x83, 0x12, 0x7c, 0x68, 0xfc, 0x20, 0x0c, 0x8d, 0xf5, 0x27, 0x63, 0x70,
-		0x72, 0xb4, 0xb3, 0x39, 0xb2, 0x0d, 0xef, 0x81, 0x74, 0x0f, 0x7b, 0xfb, 0x96, 0x70, 0xab, 0x1e,
-		0x1d, 0xb1, 0x58, 0x93, 0xe2, 0xc1, 0xc1, 0xe6, 0x54, 0xe1, 0xd5, 0x3e, 0x31, 0xce, 0x63, 0x64,
-		0xad, 0x19, 0x6a, 0xe9, 0xb7, 0xc6, 0xe1, 0xc4, 0x48, 0xe6, 0x23, 0x1b, 0xfa, 0x20, 0x80, 0x61,
-		0xda, 0x7d, 0x8f, 0xb9, 0x4e, 0xcc, 0x12, 0xe7, 0x28, 0x84, 0x1a, 0x2f, 0x62, 0x65, 0xfb, 0x9e,
-		0x5f, 0x9e, 0xa0, 0xe5, 0xc0, 0x40, 0x14, 0xe1, 0x9d, 0x41, 0x43, 0x93, 0xb4, 0xa1, 0x67, 0xc7,
-		0xf4, 0x74, 0x48, 0x31, 0x9f, 0x06, 0x49, 0xef, 0x1a, 0xd8, 0xf4, 0x54, 0xd7, 0x73, 0xb0, 0xd6,
-		0x33, 0xcc, 0x3d, 0xba, 0xd4, 0x64, 0x57, 0x52, 0xbb, 0x5a, 0xd7, 0xc5, 0x4a, 0x99, 0x15, 0xb7,
-		0x44, 0x29, 0xa1, 0xa0, 0x0a, 0xe4, 0x84, 0x28, 0xd2, 0x11, 0x0a, 0x56, 0xec, 0x53, 0xc8, 0xdf,
-		0x95, 0x83, 0x7c, 0xc8, 0x35, 0x47, 0x0f, 0x41, 0xe1, 0x75, 0xed, 0xb6, 0xa6, 0x8a, 0x70, 0x8b,
-		0x49, 0x22, 0x4f, 0x60, 0x5b, 0x3c, 0xe4, 0x7a, 0x1a, 0xe6, 0x29, 0x8a, 0xd5, 0xf7, 0xb0, 0xa3,
-		0xea, 0x5d, 0xcd, 0x75, 0xa9, 0xd0, 0xb2, 0x14, 0x15, 0x91, 0xb2, 0x4d, 0x52, 0x54, 0x17, 0x25,
-		0xe8, 0x12, 0xcc, 0x51, 0x8a, 0x5e, 0xbf, 0xeb, 0x19, 0x76, 0x17, 0xab, 0x24, 0x00, 0x74, 0xe9,
-		0x92, 0xe3, 0xb7, 0x6c, 0x96, 0x60, 0xac, 0x73, 0x04, 0xd2, 0x22, 0x17, 0xad, 0xc2, 0x83, 0x94,
-		0x6c, 0x0f, 0x9b, 0xd8, 0xd1, 0x3c, 0xac, 0xe2, 0xf7, 0xf7, 0xb5, 0xae, 0xab, 0x6a, 0x66, 0x47,
-		0xdd, 0xd7, 0xdc, 0xfd, 0x85, 0x79, 0xc2, 0xa0, 0x16, 0x5f, 0x88, 0x29, 0xa7, 0x09, 0xe2, 0x35,
-		0x8e, 0xd7, 0xa0, 0x68, 0x55, 0xb3, 0x73, 0x5d, 0x73, 0xf7, 0xd1, 0x0a, 0x9c, 0xa4, 0x5c, 0x5c,
-		0xcf, 0x31, 0xcc, 0x3d, 0x55, 0xdf, 0xc7, 0xfa, 0x2d, 0xb5, 0xef, 0xed, 0xbe, 0x73, 0xe1, 0x4c,
-		0xb8, 0x7e, 0xda, 0xc2, 0x16, 0xc5, 0xa9, 0x13, 0x94, 0x6d, 0x6f, 0xf7, 0x9d, 0xa8, 0x05, 0x05,
-		0x32, 0x18, 0x3d, 0xe3, 0x0d, 0xac, 0xee, 0x5a, 0x0e, 0x5d, 0x43, 0x4b, 0x23, 0x4c, 0x53, 0x48,
-		0x82, 0xcb, 0x9b, 0x9c, 0x60, 0xdd, 0xea, 0xe0, 0x95, 0x54, 0x6b, 0xab, 0xd1, 0x58, 0x55, 0xf2,
-		0x82, 0xcb, 0x55, 0xcb, 0x21, 0x0a, 0xb5, 0x67, 0xf9, 0x02, 0xce, 0x33, 0x85, 0xda, 0xb3, 0x84,
-		0x78, 0x2f, 0xc1, 0x9c, 0xae, 0xb3, 0x3e, 0x1b, 0xba, 0xca, 0xc3, 0x34, 0x77, 0x41, 0x8a, 0x08,
-		0x4b, 0xd7, 0xaf, 0x31, 0x04, 0xae, 0xe3, 0x2e, 0x7a, 0x01, 0x4e, 0x04, 0xc2, 0x0a, 0x13, 0xce,
-		0x0e, 0xf5, 0x72, 0x90, 0xf4, 0x12, 0xcc, 0xd9, 0x87, 0xc3, 0x84, 0x28, 0x52, 0xa3, 0x7d, 0x38,
-		0x48, 0xf6, 0x3c, 0xcc, 0xdb, 0xfb, 0xf6, 0x30, 0xdd, 0xf9, 0x30, 0x1d, 0xb2, 0xf7, 0xed, 0x41,
-		0xc2, 0x47, 0x68, 0xcc, 0xee, 0x60, 0x5d, 0xf3, 0x70, 0x67, 0xe1, 0x54, 0x18, 0x3d, 0x54, 0x80,
-		0x96, 0x41, 0xd2, 0x75, 0x15, 0x9b, 0xda, 0x4e, 0x17, 0xab, 0x9a, 0x83, 0x4d, 0xcd, 0x5d, 0x58,
-		0xa4, 0xc8, 0x49, 0xcf, 0xe9, 0x63, 0xa5, 0xa4, 0xeb, 0x0d, 0x5a, 0x58, 0xa5, 0x65, 0xe8, 0x3c,
-		0xcc, 0x5a, 0x3b, 0xaf, 0xeb, 0x4c, 0x23, 0x55, 0xdb, 0xc1, 0xbb, 0xc6, 0xc1, 0xc2, 0x3b, 0xa8,
-		0x78, 0xcb, 0xa4, 0x80, 0xea, 0xe3, 0x16, 0x05, 0xa3, 0xc7, 0x41, 0xd2, 0xdd, 0x7d, 0xcd, 0xb1,
-		0xa9, 0x49, 0x76, 0x6d, 0x4d, 0xc7, 0x0b, 0x8f, 0x30, 0x54, 0x06, 0xdf, 0x10, 0x60, 0x32, 0x23,
-		0xdc, 0x3b, 0xc6, 0xae, 0x27, 0x38, 0x3e, 0xc6, 0x66, 0x04, 0x85, 0x71, 0x6e, 0xe7, 0x40, 0x22,
-		0x92, 0x88, 0x54, 0x7c, 0x8e, 0xa2, 0x95, 0xec, 0x7d, 0x3b, 0x5c, 0xef, 0xc3, 0x50, 0x24, 0x98,
-		0x41, 0xa5, 0x8f, 0x33, 0xc7, 0xcd, 0xde, 0x0f, 0xd5, 0xf8, 0x1c, 0x9c, 0x24, 0x48, 0x3d, 0xec,
-		0x69, 0x1d, 0xcd, 0xd3, 0x42, 0xd8, 0x4f, 0x52, 0x6c, 0x22, 0xf6, 0x75, 0x5e, 0x18, 0x69, 0xa7,
-		0xd3, 0xdf, 0x39, 0xf4, 0x15, 0xeb, 0x29, 0xd6, 0x4e, 0x02, 0x13, 0xaa, 0xf5, 0xb6, 0x39, 0xe7,
-		0xf2, 0x0a, 0x14, 0xc2, 0x7a, 0x8f, 0x72, 0xc0, 0x34, 0x5f, 0x8a, 0x11, 0x27, 0xa8, 0xbe, 0xb9,
-		0x4a, 0xdc, 0x97, 0xd7, 0x1a, 0x52, 0x9c, 0xb8, 0x51, 0x6b, 0xcd, 0x76, 0x43, 0x55, 0xb6, 0x37,
-		0xda, 0xcd, 0xf5, 0x86, 0x94, 0x08, 0x39, 0xf6, 0x37, 0x92, 0xd9, 0x47, 0xa5, 0xc7, 0xe4, 0x5f,
-		0x4c, 0x40, 0x29, 0x1a, 0xa9, 0xa1, 0x77, 0xc1, 0x29, 0x91, 0x70, 0x71, 0xb1, 0xa7, 0xde, 0x31,
-		0x1c, 0x3a, 0x21, 0x7b, 0x1a, 0x5b, 0x1c, 0x7d, 0xfd, 0x99, 0xe7, 0x58, 0x2d, 0xec, 0xbd, 0xd7,
-		0x70, 0xc8, 0x74, 0xeb, 0x69, 0x1e, 0x5a, 0x83, 0x45, 0xd3, 0x52, 0x5d, 0x4f, 0x33, 0x3b, 0x9a,
-		0xd3, 0x51, 0x83, 0x54, 0x97, 0xaa, 0xe9, 0x3a, 0x76, 0x5d, 0x8b, 0x2d, 0x84, 0x3e, 0x97, 0x07,
-		0x4c, 0xab, 0xc5, 0x91, 0x83, 0x15, 0xa2, 0xca, 0x51, 0x07, 0xd4, 0x37, 0x31, 0x4e, 0x7d, 0xcf,
-		0x40, 0xae, 0xa7, 0xd9, 0x2a, 0x36, 0x3d, 0xe7, 0x90, 0xfa, 0xe7, 0x59, 0x25, 0xdb, 0xd3, 0xec,
-		0x06, 0xf9, 0x46, 0x37, 0xe1, 0xd1, 0x00, 0x55, 0xed, 0xe2, 0x3d, 0x4d, 0x3f, 0x54, 0xa9, 0x33,
-		0x4e, 0xd3, 0x06, 0xaa, 0x6e, 0x99, 0xbb, 0x5d, 0x43, 0xf7, 0x5c, 0x6a, 0x1f, 0x98, 0x8d, 0x93,
-		0x03, 0x8a, 0x35, 0x4a, 0x70, 0xc3, 0xb5, 0x4c, 0xea, 0x83, 0xd7, 0x05, 0xf6, 0xd7, 0x24, 0xfc,
-		0xba, 0x91, 0xcc, 0x26, 0xa5, 0xd4, 0x8d, 0x64, 0x36, 0x25, 0xa5, 0x6f, 0x24, 0xb3, 0x69, 0x29,
-		0x73, 0x23, 0x99, 0xcd, 0x4a, 0xb9, 0x1b, 0xc9, 0x6c, 0x4e, 0x02, 0xf9, 0xe7, 0xb2, 0x50, 0x08,
-		0x47, 0x06, 0x24, 0xd0, 0xd2, 0xe9, 0xda, 0x18, 0xa3, 0xd6, 0xf3, 0xe1, 0x23, 0xe3, 0x88, 0xe5,
-		0x3a, 0x59, 0x34, 0x57, 0xd2, 0xcc, 0x0d, 0x57, 0x18, 0x25, 0x71, 0x58, 0x88, 0x5a, 0x63, 0xe6,
-		0xf6, 0x64, 0x15, 0xfe, 0x85, 0xae, 0x41, 0xfa, 0x75, 0x97, 0xf2, 0x4e, 0x53, 0xde, 0xef, 0x38,
-		0x9a, 0xf7, 0x8d, 0x16, 0x65, 0x9e, 0xbb, 0xd1, 0x52, 0x37, 0x36, 0x95, 0xf5, 0xea, 0x9a, 0xc2,
-		0xc9, 0xd1, 0x69, 0x48, 0x76, 0xb5, 0x37, 0x0e, 0xa3, 0xcb, 0x2b, 0x05, 0xa1, 0x65, 0x28, 0xf7,
-		0xcd, 0xdb, 0xd8, 0x31, 0x76, 0x0d, 0x32, 0x54, 0x04, 0xab, 0x1c, 0xc6, 0x2a, 0x05, 0xa5, 0x6b,
-		0x04, 0x7f, 0x4a, 0xf5, 0x38, 0x0d, 0xc9, 0x3b, 0x58, 0xbb, 0x15, 0x5d, 0x04, 0x29, 0x08, 0x9d,
-		0x83, 0x42, 0x07, 0xef, 0xf4, 0xf7, 0x54, 0x07, 0x77, 0x34, 0xdd, 0x8b, 0x9a, 0xfe, 0x3c, 0x2d,
-		0x52, 0x68, 0x09, 0x7a, 0x09, 0x72, 0x64, 0x8c, 0x4c, 0x3a, 0xc6, 0xb3, 0x54, 0x04, 0x4f, 0x1d,
-		0x2d, 0x02, 0x3e, 0xc4, 0x82, 0x48, 0x09, 0xe8, 0xd1, 0x55, 0x48, 0x7b, 0x9a, 0xb3, 0x87, 0x3d,
-		0x6a, 0xf9, 0x4b, 0x23, 0x92, 0x1f, 0x23, 0x38, 0xb5, 0x29, 0x05, 0x8d, 0x69, 0x39, 0xf5, 0xdb,
-		0x68, 0x65, 0x2e, 0x40, 0x8a, 0xaa, 0x07, 0x02, 0xe0, 0x0a, 0x22, 0xcd, 0xa0, 0x2c, 0x24, 0xeb,
-		0x9b, 0x0a, 0xb1, 0x34, 0x12, 0x14, 0x18, 0x54, 0xdd, 0x6a, 0x36, 0xea, 0x0d, 0x29, 0x2e, 0x5f,
-		0x82, 0x34, 0x1b, 0x73, 0x62, 0x85, 0xfc, 0x51, 0x97, 0x66, 0xf8, 0x27, 0xe7, 0x11, 0x13, 0xa5,
-		0xdb, 0xeb, 0xb5, 0x86, 0x22, 0xc5, 0xe5, 0x6d, 0x28, 0x0f, 0xc8, 0x09, 0x9d, 0x80, 0x59, 0xa5,
-		0xd1, 0x6e, 0x6c, 0x90, 0x38, 0x4b, 0xdd, 0xde, 0x78, 0x69, 0x63, 0xf3, 0xbd, 0x1b, 0xd2, 0x4c,
-		0x14, 0x2c, 0x4c, 0x5a, 0x0c, 0xcd, 0x83, 0x14, 0x80, 0x5b, 0x9b, 0xdb, 0x0a, 0x6d, 0xcd, 0xb7,
-		0xc7, 0x41, 0x1a, 0x94, 0x1a, 0x3a, 0x05, 0x73, 0xed, 0xaa, 0x72, 0xad, 0xd1, 0x56, 0x59, 0xec,
-		0xe8, 0xb3, 0x9e, 0x07, 0x29, 0x5c, 0x70, 0xb5, 0x49, 0x43, 0xe3, 0x45, 0x38, 0x13, 0x86, 0x36,
-		0x5e, 0x69, 0x37, 0x36, 0x5a, 0xb4, 0xf2, 0xea, 0xc6, 0x35, 0x62, 0x5f, 0x07, 0xf8, 0x89, 0x68,
-		0x35, 0x41, 0x9a, 0x1a, 0xe5, 0xd7, 0x58, 0x5b, 0x95, 0x92, 0x83, 0xe0, 0xcd, 0x8d, 0xc6, 0xe6,
-		0x55, 0x29, 0x35, 0x58, 0x3b, 0x8d, 0x60, 0xd3, 0xa8, 0x02, 0x27, 0x07, 0xa1, 0x6a, 0x63, 0xa3,
-		0xad, 0xbc, 0x2a, 0x65, 0x06, 0x2b, 0x6e, 0x35, 0x94, 0x9b, 0xcd, 0x7a, 0x43, 0xca, 0xa2, 0x93,
-		0x80, 0xa2, 0x2d, 0x6a, 0x5f, 0xdf, 0x5c, 0x95, 0x72, 0x43, 0x16, 0x45, 0x76, 0xa1, 0x10, 0x0e,
-		0x23, 0xbf, 0x36, 0xb9, 0xa4, 0x8f, 0xc4, 0x21, 0x1f, 0x0a, 0x0b, 0x89, 0x3f, 0xaf, 0x75, 0xbb,
-		0xd6, 0x1d, 0x55, 0xeb, 0x1a, 0x9a, 0xcb, 0xed, 0x0d, 0x50, 0x50, 0x95, 0x40, 0xa6, 0x9d, 0xdf,
-		0xd3, 0x5b, 0xf8, 0xf4, 0x5f, 0x44, 0x0b, 0x9f, 0x92, 0xd2, 0xf2, 0x0f, 0xc6, 0x40, 0x1a, 0x8c,
-		0xf7, 0x06, 0xba, 0x1f, 0x1b, 0xd7, 0xfd, 0xaf, 0xc9, 0xd8, 0x7d, 0x2c, 0x06, 0xa5, 0x68, 0x90,
-		0x37, 0xd0, 0xbc, 0x87, 0xfe, 0x5c, 0x9b, 0xf7, 0x3b, 0x71, 0x28, 0x46, 0x42, 0xbb, 0x69, 0x5b,
-		0xf7, 0x7e, 0x98, 0x35, 0x3a, 0xb8, 0x67, 0x5b, 0x1e, 0x36, 0xf5, 0x43, 0xb5, 0x8b, 0x6f, 0xe3,
-		0xee, 0x82, 0x4c, 0x8d, 0xf2, 0x85, 0xa3, 0x83, 0xc7, 0xe5, 0x66, 0x40, 0xb7, 0x46, 0xc8, 0x56,
-		0xe6, 0x9a, 0xab, 0x8d, 0xf5, 0xad, 0xcd, 0x76, 0x63, 0xa3, 0xfe, 0xaa, 0xb0, 0x2e, 0x8a, 0x64,
-		0x0c, 0xa0, 0xbd, 0x8d, 0x46, 0x7b, 0x0b, 0xa4, 0xc1, 0x46, 0x11, 0x5b, 0x31, 0xa2, 0x59, 0xd2,
-		0x0c, 0x9a, 0x83, 0xf2, 0xc6, 0xa6, 0xda, 0x6a, 0xae, 0x36, 0xd4, 0xc6, 0xd5, 0xab, 0x8d, 0x7a,
-		0xbb, 0xc5, 0xd2, 0x81, 0x3e, 0x76, 0x5b, 0x8a, 0x87, 0x45, 0xfc, 0x03, 0x09, 0x98, 0x1b, 0xd1,
-		0x12, 0x54, 0xe5, 0x81, 0x3c, 0xcb, 0x2d, 0x3c, 0x35, 0x4d, 0xeb, 0x97, 0x89, 0x2b, 0xbd, 0xa5,
-		0x39, 0x1e, 0x8f, 0xfb, 0x1f, 0x07, 0x22, 0x25, 0xd3, 0x23, 0x2b, 0xbb, 0xc3, 0xd3, 0xac, 0x2c,
-		0xba, 0x2f, 0x07, 0x70, 0x96, 0x69, 0x7d, 0x12, 0x90, 0x6d, 0xb9, 0x86, 0x67, 0xdc, 0xc6, 0xaa,
-		0x61, 0x8a, 0x9c, 0x2c, 0x89, 0xf6, 0x93, 0x8a, 0x24, 0x4a, 0x9a, 0xa6, 0xe7, 0x63, 0x9b, 0x78,
-		0x4f, 0x1b, 0xc0, 0x26, 0x9e, 0x47, 0x42, 0x91, 0x44, 0x89, 0x8f, 0xfd, 0x10, 0x14, 0x3a, 0x56,
-		0x9f, 0x84, 0x40, 0x0c, 0x8f, 0x58, 0x8b, 0x98, 0x92, 0x67, 0x30, 0x1f, 0x85, 0x07, 0xb7, 0x41,
-		0x32, 0xb8, 0xa0, 0xe4, 0x19, 0x8c, 0xa1, 0x3c, 0x06, 0x65, 0x6d, 0x6f, 0xcf, 0x21, 0xcc, 0x05,
-		0x23, 0x16, 0xae, 0x97, 0x7c, 0x30, 0x45, 0xac, 0xdc, 0x80, 0xac, 0x90, 0x03, 0xf1, 0x60, 0x89,
-		0x24, 0x54, 0x9b, 0xe5, 0xa0, 0xe2, 0xe7, 0x72, 0x4a, 0xd6, 0x14, 0x85, 0x0f, 0x41, 0xc1, 0x70,
-		0xd5, 0x60, 0x6f, 0x2b, 0xbe, 0x14, 0x3f, 0x97, 0x55, 0xf2, 0x86, 0xeb, 0xef, 0x0b, 0xc8, 0x9f,
-		0x8c, 0x43, 0x29, 0xba, 0x6b, 0x87, 0x56, 0x21, 0xdb, 0xb5, 0x74, 0x8d, 0xaa, 0x16, 0xdb, 0x32,
-		0x3e, 0x37, 0x61, 0xa3, 0x6f, 0x79, 0x8d, 0xe3, 0x2b, 0x3e, 0x65, 0xe5, 0xdf, 0xc4, 0x20, 0x2b,
-		0xc0, 0xe8, 0x24, 0x24, 0x6d, 0xcd, 0xdb, 0xa7, 0xec, 0x52, 0xb5, 0xb8, 0x14, 0x53, 0xe8, 0x37,
-		0x81, 0xbb, 0xb6, 0x66, 0x52, 0x15, 0xe0, 0x70, 0xf2, 0x4d, 0xc6, 0xb5, 0x8b, 0xb5, 0x0e, 0xcd,
-		0x05, 0x58, 0xbd, 0x1e, 0x36, 0x3d, 0x57, 0x8c, 0x2b, 0x87, 0xd7, 0x39, 0x18, 0x3d, 0x01, 0xb3,
-		0x9e, 0xa3, 0x19, 0xdd, 0x08, 0x6e, 0x92, 0xe2, 0x4a, 0xa2, 0xc0, 0x47, 0x5e, 0x81, 0xd3, 0x82,
-		0x6f, 0x07, 0x7b, 0x9a, 0xbe, 0x8f, 0x3b, 0x01, 0x51, 0x9a, 0xe6, 0xfc, 0x4e, 0x71, 0x84, 0x55,
-		0x5e, 0x2e, 0x68, 0xe5, 0xcf, 0xc4, 0x61, 0x56, 0x64, 0x2f, 0x3a, 0xbe, 0xb0, 0xd6, 0x01, 0x34,
-		0xd3, 0xb4, 0xbc, 0xb0, 0xb8, 0x86, 0x55, 0x79, 0x88, 0x6e, 0xb9, 0xea, 0x13, 0x29, 0x21, 0x06,
-		0x95, 0xdf, 0x8f, 0x01, 0x04, 0x45, 0x63, 0xe5, 0xb6, 0x08, 0x79, 0xbe, 0x27, 0x4b, 0x37, 0xf6,
-		0x59, 0xc2, 0x0b, 0x18, 0xe8, 0xaa, 0xd1, 0xa5, 0x69, 0xc9, 0x1d, 0xbc, 0x67, 0x98, 0x7c, 0x3f,
-		0x85, 0x7d, 0x88, 0xb4, 0x64, 0x32, 0xd8, 0x9e, 0x52, 0x20, 0xeb, 0xe2, 0x9e, 0x66, 0x7a, 0x86,
-		0xce, 0x77, 0x48, 0x2e, 0x1f, 0xab, 0xf1, 0xcb, 0x2d, 0x4e, 0xad, 0xf8, 0x7c, 0xe4, 0x73, 0x90,
-		0x15, 0x50, 0xe2, 0xf8, 0x6d, 0x6c, 0x6e, 0x34, 0xa4, 0x19, 0x94, 0x81, 0x44, 0xab, 0xd1, 0x96,
-		0x62, 0x24, 0xec, 0xac, 0xae, 0x35, 0xab, 0x2d, 0x29, 0x5e, 0xfb, 0x6b, 0x30, 0xa7, 0x5b, 0xbd,
-		0xc1, 0x0a, 0x6b, 0xd2, 0x40, 0xca, 0xcf, 0xbd, 0x1e, 0x7b, 0xed, 0x29, 0x8e, 0xb4, 0x67, 0x75,
-		0x35, 0x73, 0x6f, 0xd9, 0x72, 0xf6, 0x82, 0x63, 0x11, 0x24, 0x3a, 0x70, 0x43, 0x87, 0x23, 0xec,
-		0x9d, 0x3f, 0x8b, 0xc5, 0x7e, 0x38, 0x9e, 0xb8, 0xb6, 0x55, 0xfb, 0x89, 0x78, 0xe5, 0x1a, 0x23,
-		0xdc, 0x12, 0xdd, 0x51, 0xf0, 0x6e, 0x17, 0xeb, 0xa4, 0xf1, 0xf0, 0x07, 0x4f, 0xc0, 0xfc, 0x9e,
-		0xb5, 0x67, 0x51, 0x4e, 0x17, 0xc8, 0x2f, 0x7e, 0xae, 0x22, 0xe7, 0x43, 0x2b, 0x13, 0x0f, 0x61,
-		0xac, 0x6c, 0xc0, 0x1c, 0x47, 0x56, 0xe9, 0xf6, 0x2d, 0x4b, 0x2e, 0xa0, 0x23, 0x33, 0xdb, 0x0b,
-		0x3f, 0xf5, 0x7b, 0xd4, 0x2b, 0x51, 0x66, 0x39, 0x29, 0x29, 0x63, 0xf9, 0x87, 0x15, 0x05, 0x4e,
-		0x44, 0xf8, 0x31, 0x1b, 0x81, 0x9d, 0x09, 0x1c, 0xff, 0x39, 0xe7, 0x38, 0x17, 0xe2, 0xd8, 0xe2,
-		0xa4, 0x2b, 0x75, 0x28, 0x1e, 0x87, 0xd7, 0xbf, 0xe0, 0xbc, 0x0a, 0x38, 0xcc, 0xe4, 0x1a, 0x94,
-		0x29, 0x13, 0xbd, 0xef, 0x7a, 0x56, 0x8f, 0x1a, 0xe0, 0xa3, 0xd9, 0xfc, 0xcb, 0xdf, 0x63, 0x93,
-		0xb6, 0x44, 0xc8, 0xea, 0x3e, 0xd5, 0xca, 0x0a, 0xd0, 0x1d, 0xeb, 0x0e, 0xd6, 0xbb, 0x13, 0x38,
-		0xfc, 0x1a, 0x6f, 0x88, 0x8f, 0xbf, 0x72, 0x13, 0xe6, 0xc9, 0x6f, 0x6a, 0x1f, 0xc3, 0x2d, 0x99,
-		0x9c, 0x06, 0x5f, 0xf8, 0xb7, 0x1f, 0x64, 0x76, 0x61, 0xce, 0x67, 0x10, 0x6a, 0x53, 0x68, 0x14,
-		0xf7, 0xb0, 0xe7, 0x61, 0xc7, 0x55, 0xb5, 0xee, 0xa8, 0xe6, 0x85, 0xf2, 0x88, 0x0b, 0xdf, 0xff,
-		0xa5, 0xe8, 0x28, 0x5e, 0x63, 0x94, 0xd5, 0x6e, 0x77, 0x65, 0x1b, 0x4e, 0x8d, 0xd0, 0x8a, 0x29,
-		0x78, 0xfe, 0x00, 0xe7, 0x39, 0x3f, 0xa4, 0x19, 0x84, 0xed, 0x16, 0x08, 0xb8, 0x3f, 0x96, 0x53,
-		0xf0, 0xfc, 0x28, 0xe7, 0x89, 0x38, 0xad, 0x18, 0x52, 0xc2, 0xf1, 0x06, 0xcc, 0xde, 0xc6, 0xce,
-		0x8e, 0xe5, 0xf2, 0xdc, 0xed, 0x14, 0xec, 0x3e, 0xc6, 0xd9, 0x95, 0x39, 0x21, 0x4d, 0xe6, 0x12,
-		0x5e, 0x2f, 0x40, 0x76, 0x57, 0xd3, 0xf1, 0x14, 0x2c, 0xee, 0x71, 0x16, 0x19, 0x82, 0x4f, 0x48,
-		0xab, 0x50, 0xd8, 0xb3, 0xf8, 0x12, 0x39, 0x99, 0xfc, 0x07, 0x39, 0x79, 0x5e, 0xd0, 0x70, 0x16,
-		0xb6, 0x65, 0xf7, 0xbb, 0x64, 0xfd, 0x9c, 0xcc, 0xe2, 0x87, 0x04, 0x0b, 0x41, 0xc3, 0x59, 0x1c,
-		0x43, 0xac, 0x1f, 0x17, 0x2c, 0xdc, 0x90, 0x3c, 0x5f, 0x84, 0xbc, 0x65, 0x76, 0x0f, 0x2d, 0x73,
-		0x9a, 0x46, 0x7c, 0x82, 0x73, 0x00, 0x4e, 0x42, 0x18, 0x5c, 0x81, 0xdc, 0xb4, 0x03, 0xf1, 0xa3,
-		0x5f, 0x12, 0xd3, 0x43, 0x8c, 0xc0, 0x35, 0x28, 0x0b, 0x03, 0x65, 0x58, 0xe6, 0x14, 0x2c, 0x7e,
-		0x8c, 0xb3, 0x28, 0x85, 0xc8, 0x78, 0x37, 0x3c, 0xec, 0x7a, 0x7b, 0x78, 0x1a, 0x26, 0x9f, 0x14,
-		0xdd, 0xe0, 0x24, 0x5c, 0x94, 0x3b, 0xd8, 0xd4, 0xf7, 0xa7, 0xe3, 0xf0, 0x29, 0x21, 0x4a, 0x41,
-		0x43, 0x58, 0xd4, 0xa1, 0xd8, 0xd3, 0x1c, 0x77, 0x5f, 0xeb, 0x4e, 0x35, 0x1c, 0x3f, 0xce, 0x79,
-		0x14, 0x7c, 0x22, 0x2e, 0x91, 0xbe, 0x79, 0x1c, 0x36, 0x3f, 0x21, 0x24, 0x12, 0x22, 0xe3, 0x53,
-		0xcf, 0xf5, 0x68, 0xa2, 0xfb, 0x38, 0xdc, 0xfe, 0xae, 0x98, 0x7a, 0x8c, 0x76, 0x3d, 0xcc, 0xf1,
-		0x0a, 0xe4, 0x5c, 0xe3, 0x8d, 0xa9, 0xd8, 0xfc, 0x3d, 0x31, 0xd2, 0x94, 0x80, 0x10, 0xbf, 0x0a,
-		0xa7, 0x47, 0x2e, 0x13, 0x53, 0x30, 0xfb, 0xfb, 0x9c, 0xd9, 0xc9, 0x11, 0x4b, 0x05, 0x37, 0x09,
-		0xc7, 0x65, 0xf9, 0x0f, 0x84, 0x49, 0xc0, 0x03, 0xbc, 0xb6, 0x48, 0xd0, 0xe2, 0x6a, 0xbb, 0xc7,
-		0x93, 0xda, 0x3f, 0x14, 0x52, 0x63, 0xb4, 0x11, 0xa9, 0xb5, 0xe1, 0x24, 0xe7, 0x78, 0xbc, 0x71,
-		0xfd, 0x49, 0x61, 0x58, 0x19, 0xf5, 0x76, 0x74, 0x74, 0xbf, 0x0e, 0x2a, 0xbe, 0x38, 0x85, 0x77,
-		0xec, 0xaa, 0x3d, 0xcd, 0x9e, 0x82, 0xf3, 0x4f, 0x71, 0xce, 0xc2, 0xe2, 0xfb, 0xee, 0xb5, 0xbb,
-		0xae, 0xd9, 0x84, 0xf9, 0x2b, 0xb0, 0x20, 0x98, 0xf7, 0x4d, 0x07, 0xeb, 0xd6, 0x9e, 0x69, 0xbc,
-		0x81, 0x3b, 0x53, 0xb0, 0xfe, 0xe9, 0x81, 0xa1, 0xda, 0x0e, 0x91, 0x13, 0xce, 0x4d, 0x90, 0x7c,
-		0x5f, 0x45, 0x35, 0x7a, 0xb6, 0xe5, 0x78, 0x13, 0x38, 0xfe, 0x8c, 0x18, 0x29, 0x9f, 0xae, 0x49,
-		0xc9, 0x56, 0x1a, 0xc0, 0x4e, 0x7f, 0x4c, 0xab, 0x92, 0x9f, 0xe6, 0x8c, 0x8a, 0x01, 0x15, 0x37,
-		0x1c, 0xba, 0xd5, 0xb3, 0x35, 0x67, 0x1a, 0xfb, 0xf7, 0x8f, 0x84, 0xe1, 0xe0, 0x24, 0xdc, 0x70,
-		0x10, 0x8f, 0x8e, 0xac, 0xf6, 0x53, 0x70, 0xf8, 0x59, 0x61, 0x38, 0x04, 0x0d, 0x67, 0x21, 0x1c,
-		0x86, 0x29, 0x58, 0xfc, 0x9c, 0x60, 0x21, 0x68, 0x08, 0x8b, 0x97, 0x83, 0x85, 0xd6, 0xc1, 0x7b,
-		0x86, 0xeb, 0x39, 0xcc, 0x25, 0x3f, 0x9a, 0xd5, 0xcf, 0x7f, 0x29, 0xea, 0x84, 0x29, 0x21, 0x52,
-		0x62, 0x89, 0xf8, 0xd6, 0x07, 0x0d, 0xd9, 0x26, 0x37, 0xec, 0x17, 0x84, 0x25, 0x0a, 0x91, 0x91,
-		0xb6, 0x85, 0x3c, 0x44, 0x22, 0x76, 0x9d, 0x04, 0x2a, 0x53, 0xb0, 0xfb, 0xc7, 0x03, 0x8d, 0x6b,
-		0x09, 0x5a, 0xc2, 0x33, 0xe4, 0xff, 0xf4, 0xcd, 0x5b, 0xf8, 0x70, 0x2a, 0xed, 0xfc, 0xc5, 0x01,
-		0xff, 0x67, 0x9b, 0x51, 0x32, 0x1b, 0x52, 0x1e, 0xf0, 0xa7, 0xd0, 0xa4, 0xb3, 0x7e, 0x0b, 0xdf,
-		0xf4, 0x65, 0xde, 0xdf, 0xa8, 0x3b, 0xb5, 0xb2, 0x46, 0x94, 0x3c, 0xea, 0xf4, 0x4c, 0x66, 0xf6,
-		0xc1, 0x2f, 0xfb, 0x7a, 0x1e, 0xf1, 0x79, 0x56, 0xae, 0x42, 0x31, 0xe2, 0xf0, 0x4c, 0x66, 0xf5,
-		0xcd, 0x9c, 0x55, 0x21, 0xec, 0xef, 0xac, 0x5c, 0x82, 0x24, 0x71, 0x5e, 0x26, 0x93, 0x7f, 0x0b,
-		0x27, 0xa7, 0xe8, 0x2b, 0xef, 0x86, 0xac, 0x70, 0x5a, 0x26, 0x93, 0xfe, 0x0d, 0x4e, 0xea, 0x93,
-		0x10, 0x72, 0xe1, 0xb0, 0x4c, 0x26, 0xff, 0x9b, 0x82, 0x5c, 0x90, 0x10, 0xf2, 0xe9, 0x45, 0xf8,
-		0xcb, 0xdf, 0x96, 0xe4, 0x8b, 0x8e, 0x90, 0xdd, 0x15, 0xc8, 0x70, 0x4f, 0x65, 0x32, 0xf5, 0xb7,
-		0xf2, 0xca, 0x05, 0xc5, 0xca, 0xf3, 0x90, 0x9a, 0x52, 0xe0, 0xdf, 0xc1, 0x49, 0x19, 0xfe, 0x4a,
-		0x1d, 0xf2, 0x21, 0xef, 0x64, 0x32, 0xf9, 0x77, 0x72, 0xf2, 0x30, 0x15, 0x69, 0x3a, 0xf7, 0x4e,
-		0x26, 0x33, 0xf8, 0x5b, 0xa2, 0xe9, 0x9c, 0x82, 0x88, 0x4d, 0x38, 0x26, 0x93, 0xa9, 0x3f, 0x2c,
-		0xa4, 0x2e, 0x48, 0x56, 0x5e, 0x84, 0x9c, 0xbf, 0xd8, 0x4c, 0xa6, 0xff, 0x2e, 0x4e, 0x1f, 0xd0,
-		0x10, 0x09, 0x84, 0x16, 0xbb, 0xc9, 0x2c, 0xfe, 0xb6, 0x90, 0x40, 0x88, 0x8a, 0x4c, 0xa3, 0x41,
-		0x07, 0x66, 0x32, 0xa7, 0xef, 0x16, 0xd3, 0x68, 0xc0, 0x7f, 0x21, 0xa3, 0x49, 0x6d, 0xfe, 0x64,
-		0x16, 0xdf, 0x23, 0x46, 0x93, 0xe2, 0x93, 0x66, 0x0c, 0x7a, 0x04, 0x93, 0x79, 0x7c, 0x9f, 0x68,
-		0xc6, 0x80, 0x43, 0xb0, 0xb2, 0x05, 0x68, 0xd8, 0x1b, 0x98, 0xcc, 0xef, 0x23, 0x9c, 0xdf, 0xec,
-		0x90, 0x33, 0xb0, 0xf2, 0x5e, 0x38, 0x39, 0xda, 0x13, 0x98, 0xcc, 0xf5, 0xfb, 0xbf, 0x3c, 0x10,
-		0xbb, 0x85, 0x1d, 0x81, 0x95, 0x76, 0xb0, 0xa4, 0x84, 0xbd, 0x80, 0xc9, 0x6c, 0x7f, 0xe0, 0xcb,
-		0x51, 0xc3, 0x1d, 0x76, 0x02, 0x56, 0xaa, 0x00, 0xc1, 0x02, 0x3c, 0x99, 0xd7, 0xc7, 0x38, 0xaf,
-		0x10, 0x11, 0x99, 0x1a, 0x7c, 0xfd, 0x9d, 0x4c, 0x7f, 0x4f, 0x4c, 0x0d, 0x4e, 0x41, 0xa6, 0x86,
-		0x58, 0x7a, 0x27, 0x53, 0xff, 0xa0, 0x98, 0x1a, 0x82, 0x84, 0x68, 0x76, 0x68, 0x75, 0x9b, 0xcc,
-		0xe1, 0x13, 0x42, 0xb3, 0x43, 0x54, 0x2b, 0x1b, 0x30, 0x3b, 0xb4, 0x20, 0x4e, 0x66, 0xf5, 0xc3,
-		0x9c, 0x95, 0x34, 0xb8, 0x1e, 0x86, 0x17, 0x2f, 0xbe, 0x18, 0x4e, 0xe6, 0xf6, 0x23, 0x03, 0x8b,
-		0x17, 0x5f, 0x0b, 0x57, 0xae, 0x40, 0xd6, 0xec, 0x77, 0xbb, 0x64, 0xf2, 0xa0, 0xa3, 0xcf, 0xe7,
-		0x2e, 0xfc, 0x97, 0xaf, 0x70, 0xe9, 0x08, 0x82, 0x95, 0x4b, 0x90, 0xc2, 0xbd, 0x1d, 0xdc, 0x99,
-		0x44, 0xf9, 0xc5, 0xaf, 0x08, 0x83, 0x49, 0xb0, 0x57, 0x5e, 0x04, 0x60, 0xa9, 0x11, 0xba, 0x71,
-		0x3e, 0x81, 0xf6, 0xf7, 0xbf, 0xc2, 0x0f, 0xc4, 0x05, 0x24, 0x01, 0x03, 0x76, 0xbc, 0xee, 0x68,
-		0x06, 0x5f, 0x8a, 0x32, 0xa0, 0x23, 0xf2, 0x02, 0x64, 0x5e, 0x77, 0x2d, 0xd3, 0xd3, 0xf6, 0x26,
-		0x51, 0xff, 0x01, 0xa7, 0x16, 0xf8, 0x44, 0x60, 0x3d, 0xcb, 0xc1, 0x9e, 0xb6, 0xe7, 0x4e, 0xa2,
-		0xfd, 0xaf, 0x9c, 0xd6, 0x27, 0x20, 0xc4, 0xba, 0xe6, 0x7a, 0xd3, 0xf4, 0xfb, 0xbf, 0x09, 0x62,
-		0x41, 0x40, 0x1a, 0x4d, 0x7e, 0xdf, 0xc2, 0x87, 0x93, 0x68, 0xff, 0x50, 0x34, 0x9a, 0xe3, 0xaf,
-		0xbc, 0x1b, 0x72, 0xe4, 0x27, 0x3b, 0xe5, 0x3a, 0x81, 0xf8, 0x8f, 0x38, 0x71, 0x40, 0x41, 0x6a,
-		0x76, 0xbd, 0x8e, 0x67, 0x4c, 0x16, 0xf6, 0x1f, 0xf3, 0x91, 0x16, 0xf8, 0x2b, 0x55, 0xc8, 0xbb,
-		0x5e, 0xa7, 0xd3, 0xe7, 0xfe, 0xe9, 0x04, 0xf2, 0x3f, 0xf9, 0x8a, 0x9f, 0xb2, 0xf0, 0x69, 0xc8,
-		0x68, 0xdf, 0xb9, 0xe5, 0xd9, 0x16, 0xdd, 0x6f, 0x99, 0xc4, 0xe1, 0xcb, 0x9c, 0x43, 0x88, 0x64,
-		0xa5, 0x0e, 0x05, 0xd2, 0x17, 0x07, 0xdb, 0x98, 0x6e, 0x8e, 0x4d, 0x60, 0xf1, 0xa7, 0x5c, 0x00,
-		0x11, 0xa2, 0xda, 0x37, 0xfe, 0xda, 0x67, 0xcf, 0xc6, 0x3e, 0xf3, 0xd9, 0xb3, 0xb1, 0xdf, 0xf9,
-		0xec, 0xd9, 0xd8, 0x87, 0x3f, 0x77, 0x76, 0xe6, 0x33, 0x9f, 0x3b, 0x3b, 0xf3, 0x5b, 0x9f, 0x3b,
-		0x3b, 0x33, 0x3a, 0x4b, 0x0c, 0xd7, 0xac, 0x6b, 0x16, 0xcb, 0x0f, 0xbf, 0xf6, 0xc8, 0x9e, 0xe1,
-		0xed, 0xf7, 0x77, 0x96, 0x75, 0xab, 0x77, 0x41, 0xb7, 0xdc, 0x9e, 0xe5, 0x5e, 0x88, 0xe6, 0x75,
-		0xe9, 0x2f, 0xf8, 0x5f, 0x31, 0x12, 0x33, 0x47, 0xd3, 0xb9, 0x9a, 0x79, 0x38, 0xee, 0x32, 0xdd,
-		0x65, 0x48, 0x54, 0xcd, 0x43, 0x74, 0x9a, 0x19, 0x38, 0xb5, 0xef, 0x74, 0xf9, 0x51, 0xcb, 0x0c,
-		0xf9, 0xde, 0x76, 0xba, 0x68, 0x3e, 0x38, 0x0f, 0x1d, 0x3b, 0x57, 0xe0, 0x87, 0x9c, 0x6b, 0xdf,
-		0x19, 0x3b, 0x5e, 0x4f, 0xb2, 0x55, 0xf3, 0x90, 0x76, 0x64, 0x2b, 0xf6, 0xda, 0x93, 0x13, 0xf3,
-		0xdc, 0xb7, 0x4c, 0xeb, 0x8e, 0x49, 0x9a, 0x6d, 0xef, 0x88, 0x1c, 0xf7, 0xd9, 0xc1, 0x1c, 0xf7,
-		0x7b, 0x71, 0xb7, 0xfb, 0x12, 0xc1, 0x6b, 0x13, 0x92, 0x9d, 0x34, 0x3b, 0xd5, 0x0f, 0xdf, 0x1d,
-		0x87, 0xb3, 0x43, 0xe9, 0x6c, 0xae, 0x04, 0xe3, 0x84, 0xb0, 0x02, 0xd9, 0x55, 0xa1, 0x5b, 0x0b,
-		0x90, 0x71, 0xb1, 0x6e, 0x99, 0x1d, 0x97, 0x0a, 0x22, 0xa1, 0x88, 0x4f, 0x22, 0x08, 0x53, 0x33,
-		0x2d, 0x97, 0x1f, 0x56, 0x66, 0x1f, 0xb5, 0x8f, 0x1e, 0x53, 0x10, 0x45, 0x51, 0x93, 0x90, 0xc6,
-		0x33, 0x53, 0x4a, 0x43, 0x74, 0x22, 0x92, 0xf9, 0x9f, 0x56, 0x2a, 0xdf, 0x17, 0x87, 0xc5, 0x41,
-		0xa9, 0x90, 0x99, 0xe5, 0x7a, 0x5a, 0xcf, 0x1e, 0x27, 0x96, 0x2b, 0x90, 0x6b, 0x0b, 0x9c, 0x63,
-		0xcb, 0xe5, 0xde, 0x31, 0xe5, 0x52, 0xf2, 0xab, 0x12, 0x82, 0xb9, 0x38, 0xa5, 0x60, 0xfc, 0x7e,
-		0xdc, 0x97, 0x64, 0xfe, 0x67, 0x1a, 0x4e, 0xb3, 0xe9, 0xa4, 0xb2, 0xa9, 0xc4, 0x3e, 0xb8, 0x4c,
-		0x0a, 0xe1, 0xa2, 0xc9, 0xfb, 0x24, 0xf2, 0x4b, 0x30, 0xd7, 0x24, 0xd6, 0x82, 0x44, 0x41, 0xc1,
-		0x0e, 0xcf, 0xc8, 0xf3, 0xdc, 0x4b, 0x11, 0x87, 0x9f, 0xef, 0x6f, 0x85, 0x41, 0xf2, 0x37, 0xc5,
-		0x40, 0x6a, 0xe9, 0x5a, 0x57, 0x73, 0xbe, 0x5a, 0x56, 0xe8, 0x79, 0x00, 0x76, 0xdc, 0xc3, 0xbf,
-		0xb8, 0x57, 0xba, 0xb8, 0xb0, 0x1c, 0xee, 0xdc, 0x32, 0xab, 0x89, 0x9e, 0xa0, 0xca, 0x51, 0x5c,
-		0xf2, 0xf3, 0xfc, 0x2b, 0x00, 0x41, 0x01, 0x3a, 0x03, 0xa7, 0x5a, 0xf5, 0xea, 0x5a, 0x55, 0x11,
-		0x87, 0x84, 0x5a, 0x5b, 0x8d, 0x7a, 0xf3, 0x6a, 0xb3, 0xb1, 0x2a, 0xcd, 0xa0, 0x93, 0x80, 0xc2,
-		0x85, 0xfe, 0xa1, 0xa6, 0x13, 0x30, 0x1b, 0x86, 0xb3, 0x5b, 0x2a, 0x71, 0xe2, 0x29, 0x1a, 0x3d,
-		0xbb, 0x8b, 0xe9, 0xce, 0xa3, 0x6a, 0x08, 0xa9, 0x4d, 0x76, 0x42, 0x7e, 0xfd, 0xdf, 0xb1, 0x9b,
-		0x0b, 0x73, 0x01, 0xb9, 0x2f, 0xf3, 0x95, 0x35, 0x98, 0xd5, 0x74, 0x1d, 0xdb, 0x11, 0x96, 0x13,
-		0x4c, 0x35, 0x61, 0x48, 0xf7, 0x52, 0x39, 0x65, 0xc0, 0xed, 0x79, 0x48, 0xbb, 0xb4, 0xf7, 0x93,
-		0x58, 0xfc, 0x06, 0x67, 0xc1, 0xd1, 0x57, 0x4c, 0x98, 0x25, 0x9e, 0x9f, 0xe6, 0xe0, 0x50, 0x33,
-		0x8e, 0xce, 0x33, 0xfc, 0x93, 0x9f, 0x79, 0x9a, 0xee, 0xac, 0x3e, 0x14, 0x1d, 0x96, 0x11, 0xea,
-		0xa4, 0x48, 0x9c, 0x77, 0xd0, 0x50, 0x0c, 0x25, 0x51, 0x1f, 0x6f, 0xf0, 0xd1, 0x95, 0xfd, 0x12,
-		0xaf, 0xec, 0xec, 0x28, 0x1d, 0x08, 0xd5, 0x54, 0xe4, 0x5c, 0x59, 0x41, 0xad, 0x31, 0x6e, 0x4e,
-		0xbf, 0xf6, 0xc4, 0xf0, 0xea, 0xc4, 0xfe, 0x7b, 0x8a, 0x72, 0xbe, 0x12, 0xae, 0xc6, 0x9f, 0x7b,
-		0x1f, 0x4d, 0xc2, 0xac, 0xd6, 0x33, 0x4c, 0xeb, 0x02, 0xfd, 0x97, 0xcf, 0xb9, 0x14, 0xfd, 0x98,
-		0x62, 0x53, 0xf2, 0x32, 0x9b, 0x0a, 0x93, 0x35, 0xe6, 0x8f, 0xbe, 0xfd, 0xc7, 0x52, 0xc1, 0x74,
-		0x59, 0x59, 0x07, 0x49, 0x1c, 0xe2, 0xc5, 0xa6, 0x6e, 0x75, 0xa6, 0xca, 0x52, 0xfc, 0xb1, 0xe0,
-		0x21, 0xf2, 0x5b, 0x0d, 0x4e, 0xba, 0xf2, 0x2e, 0xc8, 0xfa, 0x6c, 0x26, 0x79, 0x26, 0x82, 0x89,
-		0x4f, 0x41, 0xfc, 0x12, 0x36, 0x33, 0xa7, 0xf1, 0x42, 0xbf, 0x2c, 0xe8, 0xd9, 0x0c, 0xdd, 0x20,
-		0xbd, 0xb9, 0x06, 0xa5, 0x8e, 0x65, 0x7a, 0xaa, 0xd5, 0x33, 0x3c, 0xdc, 0xb3, 0xbd, 0x89, 0x7e,
-		0xdd, 0x9f, 0x32, 0x26, 0x59, 0xa5, 0x48, 0xe8, 0x36, 0x05, 0x19, 0x69, 0x09, 0xbb, 0xa7, 0x37,
-		0x4d, 0x4b, 0xfe, 0xbb, 0xdf, 0x12, 0x4a, 0x43, 0x5a, 0x72, 0x5f, 0xda, 0xe1, 0x76, 0x6e, 0x71,
-		0x63, 0xee, 0x1d, 0x30, 0x2d, 0xf0, 0xb5, 0xe3, 0x93, 0x09, 0x38, 0xcb, 0x91, 0x77, 0x34, 0x17,
-		0x5f, 0xb8, 0xfd, 0xcc, 0x0e, 0xf6, 0xb4, 0x67, 0x2e, 0xe8, 0x96, 0x21, 0x56, 0xf2, 0x39, 0x6e,
-		0xac, 0x49, 0xf9, 0x32, 0x2f, 0xaf, 0x8c, 0xdc, 0xee, 0xae, 0x8c, 0x37, 0xf2, 0x95, 0x61, 0x1d,
-		0x94, 0xbb, 0x90, 0xac, 0x5b, 0x86, 0x49, 0xd6, 0xb6, 0x0e, 0x36, 0xad, 0x1e, 0x37, 0xb7, 0xec,
-		0x03, 0x5d, 0x87, 0xb4, 0xd6, 0xb3, 0xfa, 0xa6, 0xc7, 0x4c, 0x6d, 0xed, 0xe9, 0x5f, 0x7b, 0x73,
-		0x71, 0xe6, 0x3f, 0xbe, 0xb9, 0x78, 0x82, 0xb1, 0x75, 0x3b, 0xb7, 0x96, 0x0d, 0xeb, 0x42, 0x4f,
-		0xf3, 0xf6, 0xc9, 0xf4, 0xfd, 0xcd, 0x4f, 0x3f, 0x05, 0xbc, 0xbe, 0xa6, 0xe9, 0x7d, 0xea, 0xf3,
-		0x3f, 0x79, 0x3e, 0xa6, 0x70, 0xfa, 0x95, 0xe4, 0x17, 0x3e, 0xbe, 0x18, 0x93, 0x6d, 0xc8, 0xac,
-		0x62, 0xfd, 0x88, 0x0a, 0x9b, 0x03, 0x15, 0x3e, 0xc3, 0x2b, 0x3c, 0x33, 0x5c, 0x21, 0x3b, 0xb0,
-		0xb7, 0x8a, 0xf5, 0x50, 0xb5, 0xab, 0x58, 0x8f, 0xd6, 0x58, 0x5b, 0xfd, 0xad, 0xdf, 0x3d, 0x3b,
-		0xf3, 0x81, 0xcf, 0x9e, 0x9d, 0x19, 0x3b, 0x64, 0xf2, 0xe4, 0x21, 0xf3, 0x47, 0xea, 0xc7, 0x92,
-		0xf0, 0x20, 0xbd, 0x11, 0xea, 0xf4, 0x0c, 0xd3, 0xbb, 0xa0, 0x3b, 0x87, 0xb6, 0x67, 0x91, 0x89,
-		0x6b, 0xed, 0xf2, 0x81, 0x9a, 0x0d, 0x8a, 0x97, 0x59, 0xf1, 0xe8, 0x61, 0x92, 0x77, 0x21, 0xb5,
-		0x45, 0xe8, 0x88, 0x20, 0x3c, 0xcb, 0xd3, 0xba, 0xdc, 0xdb, 0x60, 0x1f, 0x04, 0xca, 0x6e, 0x91,
-		0xc6, 0x19, 0xd4, 0x10, 0x17, 0x48, 0xbb, 0x58, 0xdb, 0x65, 0x97, 0x71, 0x12, 0xd4, 0x4d, 0xcd,
-		0x12, 0x00, 0xbd, 0x77, 0x33, 0x0f, 0x29, 0xad, 0xcf, 0x0e, 0xcc, 0x24, 0x88, 0xff, 0x4a, 0x3f,
-		0xe4, 0x97, 0x20, 0xc3, 0xf7, 0xcd, 0x91, 0x04, 0x89, 0x5b, 0xf8, 0x90, 0xd6, 0x53, 0x50, 0xc8,
-		0x4f, 0xb4, 0x0c, 0x29, 0xda, 0x78, 0x7e, 0xcb, 0x70, 0x61, 0x79, 0xa8, 0xf5, 0xcb, 0xb4, 0x91,
-		0x0a, 0x43, 0x93, 0x6f, 0x40, 0x76, 0xd5, 0x22, 0xda, 0x13, 0xe5, 0x96, 0x63, 0xdc, 0x68, 0x9b,
-		0xed, 0x3e, 0x1f, 0x3b, 0x85, 0x7d, 0xa0, 0x93, 0x90, 0x66, 0x97, 0xb3, 0xf8, 0xa1, 0x1f, 0xfe,
-		0x25, 0xd7, 0x21, 0x43, 0x79, 0x6f, 0xda, 0x64, 0xa9, 0xf7, 0xcf, 0xab, 0xe7, 0xf8, 0x55, 0x5d,
-		0xce, 0x3e, 0x1e, 0x34, 0x16, 0x41, 0xb2, 0xa3, 0x79, 0x1a, 0xef, 0x37, 0xfd, 0x2d, 0xbf, 0x07,
-		0xb2, 0x9c, 0x89, 0x8b, 0x2e, 0x42, 0xc2, 0xb2, 0x5d, 0x7e, 0x6c, 0xa7, 0x32, 0xae, 0x2b, 0x9b,
-		0x76, 0x2d, 0x49, 0x94, 0x4a, 0x21, 0xc8, 0xb5, 0x8d, 0xb1, 0x6a, 0xf1, 0x5c, 0x44, 0x2d, 0x7a,
-		0xd8, 0xdb, 0xd9, 0xf5, 0x82, 0x1f, 0x6c, 0x38, 0x87, 0x54, 0xc1, 0x57, 0x94, 0x7b, 0x71, 0x38,
-		0x1b, 0x2a, 0xbd, 0x8d, 0x1d, 0xd7, 0xb0, 0x4c, 0xa6, 0x4d, 0x5c, 0x53, 0x50, 0xa8, 0x81, 0xbc,
-		0x7c, 0x8c, 0xaa, 0xbc, 0x1b, 0x12, 0x55, 0xdb, 0x46, 0x15, 0xc8, 0xd2, 0x6f, 0xdd, 0x62, 0xba,
-		0x92, 0x54, 0xfc, 0x6f, 0x52, 0xe6, 0x5a, 0xbb, 0xde, 0x1d, 0xcd, 0xf1, 0xef, 0x2e, 0x8b, 0x6f,
-		0xf9, 0x05, 0xc8, 0xd5, 0x2d, 0xd3, 0xc5, 0xa6, 0xdb, 0xa7, 0x3e, 0xec, 0x4e, 0xd7, 0xd2, 0x6f,
-		0x71, 0x0e, 0xec, 0x83, 0x08, 0x5b, 0xb3, 0x6d, 0x4a, 0x99, 0x54, 0xc8, 0x4f, 0x3e, 0x7b, 0x36,
-		0xc7, 0x8a, 0xe7, 0xd2, 0xf1, 0xc4, 0xc3, 0x3b, 0x18, 0x38, 0xa3, 0x31, 0x78, 0x60, 0x78, 0x22,
-		0xdd, 0xc2, 0x87, 0xee, 0x71, 0xe7, 0xd1, 0x2b, 0x90, 0xdb, 0xa2, 0xcf, 0x8a, 0xbc, 0x84, 0x0f,
-		0x51, 0x05, 0x32, 0xb8, 0x73, 0xf1, 0xd2, 0xa5, 0x67, 0x5e, 0x60, 0x5a, 0x7e, 0x7d, 0x46, 0x11,
-		0x00, 0x74, 0x16, 0x72, 0x2e, 0xd6, 0xed, 0x8b, 0x97, 0x2e, 0xdf, 0x7a, 0x86, 0xa9, 0xd5, 0xf5,
-		0x19, 0x25, 0x00, 0xad, 0x64, 0x49, 0x8f, 0xbf, 0xf0, 0x89, 0xc5, 0x58, 0x2d, 0x05, 0x09, 0xb7,
-		0xdf, 0x7b, 0xdb, 0x74, 0xe3, 0x0f, 0xd3, 0xb0, 0x14, 0x2a, 0x65, 0x8b, 0xc2, 0x6d, 0xad, 0x6b,
-		0x74, 0xb4, 0xe0, 0x31, 0x18, 0x29, 0xd4, 0x7f, 0x8a, 0x31, 0xc6, 0xda, 0x1f, 0x29, 0x45, 0xf9,
-		0xa7, 0x63, 0x50, 0xb8, 0x29, 0x38, 0xb7, 0xb0, 0x87, 0xae, 0x00, 0xf8, 0x35, 0x89, 0xa9, 0x72,
-		0x66, 0x79, 0xb0, 0xae, 0x65, 0x9f, 0x46, 0x09, 0xa1, 0xa3, 0xe7, 0xa9, 0x02, 0xda, 0x96, 0xcb,
-		0xef, 0xb1, 0x4e, 0x20, 0xf5, 0x91, 0xd1, 0x93, 0x80, 0xa8, 0x55, 0x53, 0x6f, 0x5b, 0x9e, 0x61,
-		0xee, 0xa9, 0xb6, 0x75, 0x87, 0xbf, 0x0e, 0x90, 0x50, 0x24, 0x5a, 0x72, 0x93, 0x16, 0x6c, 0x11,
-		0x38, 0x69, 0x74, 0xce, 0xe7, 0x42, 0xc2, 0x31, 0xad, 0xd3, 0x71, 0xb0, 0xeb, 0x72, 0xc3, 0x25,
-		0x3e, 0xd1, 0x15, 0xc8, 0xd8, 0xfd, 0x1d, 0x55, 0x58, 0x89, 0xfc, 0xc5, 0x07, 0x46, 0xcd, 0x79,
-		0xa1, 0x1b, 0x7c, 0xd6, 0xa7, 0xed, 0xfe, 0x0e, 0xd1, 0x94, 0x87, 0xa0, 0x30, 0xa2, 0x31, 0xf9,
-		0xdb, 0x41, 0x3b, 0xe8, 0x4b, 0x36, 0xbc, 0x07, 0xaa, 0xed, 0x18, 0x96, 0x63, 0x78, 0x87, 0xf4,
-		0xb8, 0x5d, 0x42, 0x91, 0x44, 0xc1, 0x16, 0x87, 0xcb, 0xb7, 0xa0, 0xdc, 0xa2, 0x6e, 0x7a, 0xd0,
-		0xf2, 0x4b, 0x41, 0xfb, 0x62, 0x93, 0xdb, 0x37, 0xb6, 0x65, 0xf1, 0xa1, 0x96, 0x9d, 0xff, 0xf7,
-		0x31, 0xc8, 0xd7, 0xc8, 0xc4, 0x6d, 0xae, 0x5e, 0xed, 0x6a, 0x7b, 0xe8, 0x19, 0x38, 0x51, 0x5b,
-		0xdb, 0xac, 0xbf, 0xa4, 0x36, 0x57, 0xd5, 0xab, 0x6b, 0xd5, 0x6b, 0xc1, 0xf9, 0xde, 0xca, 0xc9,
-		0xbb, 0xf7, 0x96, 0x50, 0x08, 0x77, 0xdb, 0xa4, 0xb1, 0x24, 0xba, 0x00, 0xf3, 0x51, 0x92, 0x6a,
-		0xad, 0xd5, 0xd8, 0x68, 0x4b, 0xb1, 0xca, 0x89, 0xbb, 0xf7, 0x96, 0x66, 0x43, 0x14, 0xd5, 0x1d,
-		0x17, 0x9b, 0xde, 0x30, 0x41, 0x7d, 0x73, 0x7d, 0xbd, 0xd9, 0x96, 0xe2, 0x43, 0x04, 0x75, 0xab,
-		0xd7, 0x33, 0x3c, 0xf4, 0x38, 0xcc, 0x46, 0x09, 0x36, 0x9a, 0x6b, 0x52, 0xa2, 0x82, 0xee, 0xde,
-		0x5b, 0x2a, 0x85, 0xb0, 0x37, 0x8c, 0x6e, 0x25, 0xfb, 0xa1, 0x1f, 0x39, 0x3b, 0xf3, 0xa9, 0xbf,
-		0x73, 0x36, 0x56, 0x5b, 0x1f, 0x3b, 0xe7, 0x9e, 0x3d, 0xde, 0x9c, 0x8b, 0xae, 0xdb, 0x5f, 0x79,
-		0x20, 0x62, 0x6e, 0xb8, 0x1f, 0x16, 0x32, 0xc6, 0xd3, 0x4e, 0xb7, 0x49, 0xb9, 0x85, 0xca, 0xd1,
-		0xee, 0x41, 0x65, 0xc2, 0xa2, 0x50, 0x99, 0x68, 0x18, 0xe4, 0x17, 0xa0, 0xb8, 0xa5, 0x39, 0x5e,
-		0x0b, 0x7b, 0xd7, 0xb1, 0xd6, 0xc1, 0x4e, 0xd4, 0x7f, 0x28, 0x0a, 0xff, 0x01, 0x41, 0x92, 0x3a,
-		0x09, 0x6c, 0xfd, 0xa4, 0xbf, 0xe5, 0x7d, 0x48, 0xd2, 0x93, 0xc4, 0xbe, 0x6f, 0xc1, 0x29, 0x98,
-		0x6f, 0x41, 0x56, 0x86, 0x43, 0x0f, 0xbb, 0x22, 0xfd, 0x45, 0x3f, 0xd0, 0x73, 0xc2, 0x43, 0x48,
-		0x1c, 0xed, 0x21, 0xf0, 0xe9, 0xc5, 0xfd, 0x84, 0x2e, 0x64, 0xf8, 0x10, 0xfb, 0x0d, 0x89, 0x05,
-		0x0d, 0x41, 0xeb, 0x50, 0xb6, 0x35, 0xc7, 0xa3, 0x37, 0x0b, 0xf7, 0x69, 0x2f, 0xf8, 0x0c, 0x5e,
-		0x1c, 0xb6, 0x27, 0x91, 0xce, 0xf2, 0x5a, 0x8a, 0x76, 0x18, 0x28, 0xff, 0xe7, 0x24, 0xa4, 0xb9,
-		0x30, 0xde, 0x0d, 0x19, 0x2e, 0x56, 0x3e, 0xe7, 0x1e, 0x5c, 0x1e, 0x5e, 0x66, 0x97, 0xfd, 0xe5,
-		0x90, 0xf3, 0x13, 0x34, 0xe8, 0x51, 0xc8, 0xea, 0xfb, 0x9a, 0x61, 0xaa, 0x46, 0x87, 0x3b, 0xa0,
-		0xf9, 0xcf, 0xbe, 0xb9, 0x98, 0xa9, 0x13, 0x58, 0x73, 0x55, 0xc9, 0xd0, 0xc2, 0x66, 0x87, 0xf8,
-		0x34, 0xfb, 0xd8, 0xd8, 0xdb, 0xf7, 0xb8, 0xdd, 0xe0, 0x5f, 0xe8, 0x9d, 0x90, 0x24, 0x0a, 0xc1,
-		0xef, 0x9d, 0x57, 0x86, 0x22, 0x0b, 0x3f, 0xf5, 0x53, 0xcb, 0x92, 0x8a, 0x3f, 0xfc, 0x9f, 0x16,
-		0x63, 0x0a, 0xa5, 0x40, 0x75, 0x28, 0x76, 0x35, 0xd7, 0x53, 0xe9, 0x7a, 0x4c, 0xaa, 0x4f, 0x51,
-		0x16, 0xa7, 0x87, 0x05, 0xc2, 0x05, 0xcb, 0x9b, 0x9e, 0x27, 0x54, 0x0c, 0xd4, 0x41, 0xe7, 0x40,
-		0xa2, 0x4c, 0x74, 0x3a, 0x03, 0x99, 0x97, 0x98, 0xa6, 0x72, 0x2f, 0x11, 0x38, 0x9b, 0x98, 0xd4,
-		0x57, 0x3c, 0x03, 0x39, 0x7a, 0xd3, 0x95, 0xa2, 0xb0, 0xe3, 0xeb, 0x59, 0x02, 0xa0, 0x85, 0x8f,
-		0x41, 0x39, 0xb0, 0xfa, 0x0c, 0x25, 0xcb, 0xb8, 0x04, 0x60, 0x8a, 0xf8, 0x34, 0xcc, 0x9b, 0xf8,
-		0x80, 0x1e, 0xa8, 0x8f, 0x60, 0xe7, 0x28, 0x36, 0x22, 0x65, 0x37, 0xa3, 0x14, 0x8f, 0x40, 0x49,
-		0x17, 0xc2, 0x67, 0xb8, 0x40, 0x71, 0x8b, 0x3e, 0x94, 0xa2, 0x9d, 0x86, 0xac, 0x66, 0xdb, 0x0c,
-		0x21, 0xcf, 0xad, 0xbe, 0x6d, 0xd3, 0xa2, 0xf3, 0x30, 0x4b, 0xfb, 0xe8, 0x60, 0xb7, 0xdf, 0xf5,
-		0x38, 0x93, 0x02, 0xc5, 0x29, 0x93, 0x02, 0x85, 0xc1, 0x29, 0xee, 0xc3, 0x50, 0xc4, 0xb7, 0x8d,
-		0x0e, 0x36, 0x75, 0xcc, 0xf0, 0x8a, 0x14, 0xaf, 0x20, 0x80, 0x14, 0xe9, 0x71, 0xf0, 0xad, 0xb9,
-		0x2a, 0x56, 0x9a, 0x12, 0xe3, 0x27, 0xe0, 0x55, 0x06, 0x96, 0x17, 0x20, 0xb9, 0xaa, 0x79, 0x1a,
-		0x71, 0x97, 0xbc, 0x03, 0xb6, 0x7c, 0x16, 0x14, 0xf2, 0x53, 0xfe, 0xb9, 0x04, 0x24, 0x6f, 0x5a,
-		0x1e, 0x46, 0xcf, 0x86, 0x5c, 0xd9, 0xd2, 0x28, 0x7d, 0x6e, 0x19, 0x7b, 0x26, 0xee, 0xac, 0xbb,
-		0x7b, 0xa1, 0x67, 0x69, 0x02, 0x75, 0x8a, 0x47, 0xd4, 0x69, 0x1e, 0x52, 0x8e, 0xd5, 0x37, 0x3b,
-		0xe2, 0xe0, 0x37, 0xfd, 0x40, 0x0d, 0xc8, 0xfa, 0x5a, 0x92, 0x9c, 0xa4, 0x25, 0x65, 0xa2, 0x25,
-		0x44, 0x87, 0x39, 0x40, 0xc9, 0xec, 0x70, 0x65, 0xa9, 0x41, 0xce, 0x37, 0x5e, 0x5c, 0xdb, 0xa6,
-		0x53, 0xd8, 0x80, 0x8c, 0x2c, 0x91, 0xfe, 0xd8, 0xfb, 0xc2, 0x63, 0x1a, 0x27, 0xf9, 0x05, 0x5c,
-		0x7a, 0x11, 0xb5, 0xe2, 0x4f, 0xe4, 0x64, 0x68, 0xbf, 0x02, 0xb5, 0x62, 0xcf, 0xe4, 0x3c, 0x00,
-		0x39, 0xd7, 0xd8, 0x33, 0x35, 0xaf, 0xef, 0x60, 0xae, 0x79, 0x01, 0x80, 0x94, 0x06, 0x97, 0x20,
-		0x98, 0xa6, 0x85, 0x5e, 0xee, 0xba, 0x00, 0x73, 0xc1, 0x9b, 0x59, 0x01, 0x17, 0xa6, 0x65, 0xc8,
-		0x2f, 0x6a, 0x89, 0x12, 0xf9, 0x97, 0x63, 0x90, 0xe6, 0x2b, 0x56, 0x30, 0x0c, 0xb1, 0xd1, 0xc3,
-		0x10, 0x1f, 0x37, 0x0c, 0x89, 0xfb, 0x1f, 0x86, 0x2a, 0x80, 0xdf, 0x4c, 0x97, 0x3f, 0x84, 0x32,
-		0xc2, 0xad, 0x62, 0x4d, 0x6c, 0x19, 0x7b, 0x7c, 0xde, 0x87, 0x88, 0xe4, 0xdf, 0x8e, 0x11, 0x0f,
-		0x9f, 0x97, 0xa3, 0x2a, 0x14, 0x45, 0xbb, 0xd4, 0xdd, 0xae, 0xb6, 0xc7, 0x55, 0xf1, 0xc1, 0xb1,
-		0x8d, 0x23, 0xab, 0xb0, 0x92, 0xe7, 0xed, 0xa1, 0xfe, 0xc4, 0xc8, 0x61, 0x8d, 0x8f, 0x19, 0xd6,
-		0x88, 0x1e, 0x25, 0xee, 0x4f, 0x8f, 0x22, 0x23, 0x9e, 0x1c, 0x18, 0x71, 0xf9, 0x77, 0x63, 0xfc,
-		0xc5, 0xae, 0x0e, 0xbb, 0xc1, 0xf1, 0xe7, 0x35, 0x54, 0xaf, 0x71, 0xdd, 0xea, 0xe0, 0x8e, 0x3a,
-		0x34, 0x66, 0x0f, 0x0f, 0x73, 0x8c, 0xb6, 0x39, 0x18, 0x3b, 0x24, 0xb8, 0xb4, 0x82, 0x31, 0xfc,
-		0x74, 0x1c, 0x66, 0x87, 0xf0, 0xff, 0xf2, 0x8d, 0x65, 0x74, 0xf6, 0xa6, 0xa6, 0x9c, 0xbd, 0xe9,
-		0xb1, 0xb3, 0xf7, 0x67, 0xe2, 0x34, 0x01, 0x60, 0x5b, 0xae, 0xd6, 0xfd, 0x5a, 0xd8, 0xde, 0x33,
-		0x90, 0xb3, 0xad, 0xae, 0xca, 0x4a, 0xd8, 0xd5, 0x9b, 0xac, 0x6d, 0x75, 0x95, 0x21, 0x35, 0x4b,
-		0xbd, 0x45, 0x86, 0x39, 0xfd, 0x16, 0x0c, 0x42, 0x66, 0x70, 0x42, 0x39, 0x50, 0x60, 0xa2, 0xe0,
-		0x5e, 0xd3, 0xd3, 0x44, 0x06, 0xd4, 0x0d, 0x8b, 0x0d, 0x7b, 0x79, 0xac, 0xd9, 0x0c, 0x53, 0xe1,
-		0x78, 0x84, 0x82, 0x39, 0x19, 0xa3, 0x32, 0x47, 0x61, 0x8b, 0xa5, 0x70, 0x3c, 0xf9, 0x7b, 0x63,
-		0x00, 0x6b, 0x44, 0xb2, 0xb4, 0xbf, 0xc4, 0xdf, 0x71, 0x69, 0x13, 0xd4, 0x48, 0xcd, 0x67, 0xc7,
-		0x0d, 0x1a, 0xaf, 0xbf, 0xe0, 0x86, 0xdb, 0x5d, 0x87, 0x62, 0xa0, 0xdb, 0x2e, 0x16, 0x8d, 0x39,
-		0x7b, 0x44, 0x54, 0xda, 0xc2, 0x9e, 0x52, 0xb8, 0x1d, 0xfa, 0x92, 0xff, 0x59, 0x0c, 0x72, 0xb4,
-		0x4d, 0xeb, 0xd8, 0xd3, 0x22, 0x63, 0x18, 0xbb, 0xff, 0x31, 0x7c, 0x10, 0x80, 0xb1, 0x71, 0x8d,
-		0x37, 0x30, 0xd7, 0xac, 0x1c, 0x85, 0xb4, 0x8c, 0x37, 0x30, 0xba, 0xec, 0x0b, 0x3c, 0x71, 0xb4,
-		0xc0, 0x45, 0xd4, 0xca, 0xc5, 0x7e, 0x0a, 0x32, 0xf4, 0x4d, 0xc9, 0x03, 0x97, 0x07, 0xa2, 0x69,
-		0xb3, 0xdf, 0x6b, 0x1f, 0xb8, 0xf2, 0xeb, 0x90, 0x69, 0x1f, 0xb0, 0x7c, 0xe2, 0x19, 0xc8, 0x39,
-		0x96, 0xc5, 0xbd, 0x3f, 0xe6, 0x75, 0x67, 0x09, 0x80, 0x3a, 0x3b, 0x22, 0x87, 0x16, 0x0f, 0x72,
-		0x68, 0x41, 0x12, 0x30, 0x31, 0x55, 0x12, 0x90, 0x44, 0x9f, 0xc5, 0xc8, 0x4c, 0x42, 0x4f, 0xc2,
-		0xa9, 0x56, 0xf3, 0xda, 0x46, 0x63, 0x55, 0x5d, 0x6f, 0x5d, 0x1b, 0xb8, 0x56, 0x5f, 0x29, 0xdf,
-		0xbd, 0xb7, 0x94, 0xe7, 0x61, 0xe7, 0x38, 0xec, 0x2d, 0xa5, 0x71, 0x73, 0xb3, 0xdd, 0x90, 0x62,
-		0x0c, 0x7b, 0xcb, 0xc1, 0xb7, 0x2d, 0x8f, 0x3d, 0xda, 0xfa, 0x34, 0x9c, 0x1e, 0x81, 0xed, 0x07,
-		0x9f, 0xb3, 0x77, 0xef, 0x2d, 0x15, 0xb7, 0x1c, 0xcc, 0xb4, 0x8c, 0x52, 0x2c, 0xc3, 0xc2, 0x30,
-		0xc5, 0xe6, 0xd6, 0x66, 0xab, 0xba, 0x26, 0x2d, 0x55, 0xa4, 0xbb, 0xf7, 0x96, 0x0a, 0xc2, 0x64,
-		0x10, 0xfc, 0xb7, 0x3f, 0xfa, 0xfc, 0xd6, 0x6c, 0x24, 0x6b, 0xcc, 0xe2, 0x3a, 0x5b, 0x73, 0xb4,
-		0xde, 0x71, 0xc3, 0xcf, 0x09, 0x1b, 0xfe, 0xf2, 0x4f, 0xc7, 0xa1, 0xec, 0x07, 0x37, 0x5b, 0xb4,
-		0x06, 0xf4, 0x6c, 0x38, 0xe3, 0x97, 0x1f, 0xbb, 0x76, 0x30, 0x6c, 0x91, 0x10, 0x7c, 0x17, 0x64,
-		0x85, 0x93, 0xcc, 0x27, 0xd5, 0xd2, 0x88, 0xf5, 0x8d, 0x63, 0x70, 0x52, 0x9f, 0x02, 0xbd, 0x08,
-		0x39, 0x7f, 0x8a, 0xf9, 0x0f, 0x9b, 0x8d, 0x9f, 0x93, 0x9c, 0x3e, 0xa0, 0x41, 0x2f, 0x04, 0x61,
-		0x5c, 0x72, 0x5c, 0x60, 0x78, 0x93, 0x21, 0x70, 0x62, 0x3f, 0x84, 0x7b, 0x1a, 0x92, 0xda, 0x8e,
-		0x6e, 0x70, 0x03, 0xfc, 0xc0, 0x30, 0x5d, 0xb5, 0x56, 0x6f, 0x72, 0x22, 0x8a, 0x29, 0x37, 0x79,
-		0x32, 0x85, 0xcb, 0x8b, 0x3e, 0x23, 0x73, 0xa0, 0xb2, 0x58, 0x98, 0x79, 0x17, 0xd9, 0x9e, 0x76,
-		0x50, 0xa3, 0xe1, 0xf0, 0x29, 0xc8, 0x90, 0xc2, 0x3d, 0xfe, 0x50, 0x41, 0x42, 0x49, 0xf7, 0xb4,
-		0x83, 0x6b, 0x9a, 0x7b, 0x23, 0x99, 0x4d, 0x48, 0x49, 0xf9, 0xc7, 0x89, 0xa7, 0x12, 0x91, 0x0a,
-		0x7a, 0x02, 0x10, 0xa1, 0xd0, 0xf6, 0xb0, 0x4a, 0xa6, 0x2e, 0x15, 0xaf, 0xe0, 0x5b, 0xee, 0x69,
-		0x07, 0xd5, 0x3d, 0xbc, 0xd1, 0xef, 0xd1, 0x06, 0xb8, 0x68, 0x1d, 0x24, 0x81, 0x2c, 0x46, 0x96,
-		0x8b, 0xff, 0xf4, 0xf0, 0xc3, 0xa8, 0x1c, 0x81, 0x2d, 0x00, 0x1f, 0x21, 0x0b, 0x40, 0x89, 0xf1,
-		0xf3, 0x8f, 0x78, 0x44, 0xba, 0x92, 0x88, 0x76, 0x45, 0x7e, 0x11, 0xca, 0x03, 0x23, 0x80, 0x64,
-		0x28, 0xf2, 0x8c, 0x15, 0xdd, 0x3e, 0x67, 0x11, 0x4e, 0x4e, 0xc9, 0xb3, 0xcc, 0x14, 0x3d, 0x4e,
-		0xb0, 0x92, 0xfd, 0xf9, 0x8f, 0x2f, 0xc6, 0xe8, 0x66, 0xce, 0x13, 0x50, 0x8c, 0x8c, 0x81, 0xc8,
-		0x22, 0xc7, 0x82, 0x2c, 0x72, 0x80, 0xfc, 0x1a, 0x14, 0x88, 0x01, 0xc2, 0x1d, 0x8e, 0xfb, 0x28,
-		0x94, 0x99, 0x81, 0x1c, 0x94, 0x35, 0x73, 0x78, 0xd6, 0x85, 0xc0, 0x65, 0xe1, 0x01, 0x45, 0xc5,
-		0x9e, 0x17, 0x58, 0xd7, 0x34, 0x57, 0xde, 0x04, 0x08, 0x06, 0x15, 0x55, 0xe1, 0x41, 0x62, 0x3c,
-		0xc2, 0xc7, 0x3d, 0xf9, 0x93, 0x56, 0x11, 0x8f, 0xb1, 0x42, 0x90, 0x82, 0x23, 0x9d, 0xec, 0x61,
-		0xab, 0xeb, 0x14, 0xa3, 0xf6, 0xf2, 0xa7, 0x3e, 0x7b, 0x36, 0xf6, 0xf6, 0xd8, 0x82, 0xff, 0xf0,
-		0x2a, 0x9c, 0x09, 0x15, 0x12, 0x05, 0x8c, 0x24, 0xa2, 0xca, 0x21, 0x7d, 0x25, 0x85, 0x93, 0x12,
-		0x4a, 0x47, 0xe6, 0x7f, 0x2b, 0x47, 0x9b, 0x9d, 0xc9, 0xd9, 0xa6, 0xc9, 0xf9, 0xae, 0xd1, 0x49,
-		0xf9, 0x7f, 0x9a, 0x83, 0x8c, 0x82, 0xdf, 0xdf, 0xc7, 0xae, 0x87, 0x2e, 0x42, 0x12, 0xeb, 0xfb,
-		0xd6, 0xa8, 0x1c, 0x28, 0xe9, 0xe0, 0x32, 0xc7, 0x6b, 0xe8, 0xfb, 0xd6, 0xf5, 0x19, 0x85, 0xe2,
-		0xa2, 0x4b, 0x90, 0xda, 0xed, 0xf6, 0x79, 0xfa, 0x6a, 0xc0, 0x66, 0x85, 0x89, 0xae, 0x12, 0xa4,
-		0xeb, 0x33, 0x0a, 0xc3, 0x26, 0x55, 0xd1, 0x17, 0xad, 0x13, 0x47, 0x57, 0xd5, 0x34, 0x77, 0x69,
-		0x55, 0x04, 0x17, 0xd5, 0x00, 0x0c, 0xd3, 0xf0, 0x54, 0x9a, 0xda, 0xe1, 0x56, 0xe3, 0xa1, 0xf1,
-		0x94, 0x86, 0x47, 0x93, 0x41, 0xd7, 0x67, 0x94, 0x9c, 0x21, 0x3e, 0x48, 0x73, 0xdf, 0xdf, 0xc7,
-		0xce, 0x21, 0xf7, 0xd6, 0xc6, 0x36, 0xf7, 0x65, 0x82, 0x44, 0x9a, 0x4b, 0xb1, 0x89, 0x91, 0x65,
-		0x8f, 0xe3, 0x79, 0x07, 0xfc, 0xc9, 0xd7, 0xc5, 0x71, 0x94, 0xf4, 0x85, 0xbc, 0xf6, 0xc1, 0xf5,
-		0x19, 0x25, 0xa3, 0xb3, 0x9f, 0xe8, 0x9d, 0xbe, 0x0b, 0x96, 0x1f, 0xf6, 0x7a, 0x22, 0xb4, 0x2c,
-		0xed, 0x33, 0x23, 0x5c, 0x31, 0xb4, 0x01, 0xa5, 0xae, 0xe1, 0x7a, 0xaa, 0x6b, 0x6a, 0xb6, 0xbb,
-		0x6f, 0x79, 0x2e, 0xcd, 0x9f, 0xe4, 0x2f, 0x3e, 0x32, 0x8e, 0xc3, 0x9a, 0xe1, 0x7a, 0x2d, 0x81,
-		0x7c, 0x7d, 0x46, 0x29, 0x76, 0xc3, 0x00, 0xc2, 0xcf, 0xda, 0xdd, 0xc5, 0x8e, 0xcf, 0x90, 0xe6,
-		0x59, 0x8e, 0xe0, 0xb7, 0x49, 0xb0, 0x05, 0x3d, 0xe1, 0x67, 0x85, 0x01, 0xe8, 0xeb, 0x60, 0xae,
-		0x6b, 0x69, 0x1d, 0x9f, 0x9d, 0xaa, 0xef, 0xf7, 0xcd, 0x5b, 0x34, 0x29, 0x93, 0xbf, 0xf8, 0xf8,
-		0xd8, 0x46, 0x5a, 0x5a, 0x47, 0xb0, 0xa8, 0x13, 0x82, 0xeb, 0x33, 0xca, 0x6c, 0x77, 0x10, 0x88,
-		0xde, 0x07, 0xf3, 0x9a, 0x6d, 0x77, 0x0f, 0x07, 0xb9, 0x97, 0x29, 0xf7, 0xf3, 0xe3, 0xb8, 0x57,
-		0x09, 0xcd, 0x20, 0x7b, 0xa4, 0x0d, 0x41, 0x51, 0x1b, 0x24, 0xdb, 0xc1, 0xf4, 0xc6, 0x94, 0xcd,
-		0xbd, 0x0c, 0xfa, 0x9e, 0x54, 0xfe, 0xe2, 0x63, 0xe3, 0x78, 0x6f, 0x31, 0x7c, 0xe1, 0x94, 0x5c,
-		0x9f, 0x51, 0xca, 0x76, 0x14, 0xc4, 0xb8, 0x5a, 0x3a, 0xa6, 0xcf, 0xdd, 0x71, 0xae, 0xb3, 0x93,
-		0xb8, 0x52, 0xfc, 0x28, 0xd7, 0x08, 0x08, 0x35, 0x20, 0xcf, 0x42, 0x51, 0x95, 0x18, 0x43, 0xfa,
-		0x0a, 0x55, 0xfe, 0xa2, 0x3c, 0x76, 0x86, 0x52, 0xd4, 0x9b, 0x96, 0x87, 0xaf, 0xcf, 0x28, 0x80,
-		0xfd, 0x2f, 0xa4, 0xc1, 0x09, 0xfa, 0x20, 0xd7, 0xa1, 0x1a, 0x35, 0xbc, 0x0b, 0x73, 0x94, 0xe1,
-		0x13, 0xe3, 0x18, 0xde, 0xa4, 0x44, 0x37, 0xc3, 0x76, 0xf8, 0xfa, 0x8c, 0x32, 0x77, 0x7b, 0x18,
-		0x4c, 0x54, 0x6c, 0xd7, 0x30, 0xb5, 0xae, 0xf1, 0x06, 0x66, 0x4b, 0x28, 0x7d, 0x8a, 0xf2, 0x08,
-		0x15, 0xbb, 0xca, 0xb1, 0xe9, 0xc2, 0x4a, 0x54, 0x6c, 0x37, 0x0c, 0xa8, 0x65, 0xf8, 0x59, 0x4f,
-		0xff, 0x69, 0xb5, 0x8c, 0x94, 0x65, 0xcf, 0xa9, 0xdd, 0x48, 0x66, 0x41, 0xca, 0xcb, 0x8f, 0x41,
-		0x3e, 0x64, 0x98, 0xd0, 0x02, 0x64, 0xf8, 0x69, 0x18, 0x71, 0x6a, 0x94, 0x7f, 0xca, 0x25, 0x28,
-		0x84, 0x8d, 0x91, 0xfc, 0xe1, 0x98, 0x4f, 0x49, 0xdf, 0x94, 0x58, 0x88, 0x66, 0xa4, 0x73, 0x81,
-		0xa7, 0xf2, 0xb0, 0x58, 0xda, 0x44, 0x39, 0xdb, 0x7e, 0x2d, 0x50, 0x20, 0x5f, 0x59, 0xd1, 0x22,
-		0xe4, 0xed, 0x8b, 0xb6, 0x8f, 0x92, 0xa0, 0x28, 0x60, 0x5f, 0xb4, 0x05, 0xc2, 0x43, 0x50, 0x20,
-		0xfd, 0x56, 0xc3, 0xfe, 0x52, 0x4e, 0xc9, 0x13, 0x18, 0x47, 0x91, 0xff, 0x55, 0x1c, 0xa4, 0x41,
-		0x03, 0xe6, 0xa7, 0xaa, 0x63, 0xc7, 0x4e, 0x55, 0x9f, 0x1e, 0x4c, 0x92, 0x07, 0x79, 0xf1, 0x35,
-		0x90, 0x82, 0xf4, 0x2e, 0x5b, 0x6a, 0xc6, 0xfb, 0x7f, 0x03, 0x8e, 0xaa, 0x52, 0xd6, 0x07, 0x3c,
-		0xd7, 0xab, 0x91, 0xcd, 0x4a, 0xf1, 0x27, 0x1f, 0x06, 0x07, 0xdc, 0x77, 0x62, 0xb6, 0xed, 0x8e,
-		0xe6, 0x61, 0x91, 0x1f, 0x0b, 0xed, 0x5b, 0x3e, 0x0a, 0x65, 0xcd, 0xb6, 0x55, 0xd7, 0xd3, 0x3c,
-		0xcc, 0x7d, 0x0d, 0x96, 0x79, 0x28, 0x6a, 0xb6, 0xdd, 0x22, 0x50, 0xe6, 0x6b, 0x3c, 0x02, 0x25,
-		0x62, 0xd3, 0x0d, 0xad, 0x2b, 0x5c, 0x85, 0x34, 0x73, 0x49, 0x38, 0x94, 0x79, 0x07, 0x72, 0xc7,
-		0x1f, 0x71, 0x6a, 0xcf, 0xfd, 0x98, 0x2a, 0x16, 0x8a, 0xa9, 0x10, 0x7f, 0xeb, 0x83, 0xc9, 0x47,
-		0xbc, 0x8f, 0x32, 0x7a, 0xd3, 0x60, 0x9e, 0xc6, 0x5f, 0xb7, 0x59, 0xb2, 0x24, 0xab, 0xb0, 0x0f,
-		0x59, 0x81, 0x52, 0xd4, 0xf6, 0xa3, 0x12, 0xc4, 0xbd, 0x03, 0x5e, 0x4b, 0xdc, 0x3b, 0x20, 0x9e,
-		0xae, 0xff, 0x42, 0x6e, 0x69, 0xc4, 0x6a, 0xc7, 0xe9, 0x82, 0x5c, 0x87, 0x5c, 0x86, 0x62, 0x64,
-		0x4d, 0x90, 0x4f, 0xc2, 0xfc, 0x28, 0x13, 0x2f, 0xef, 0xfb, 0xf0, 0x88, 0xa9, 0x46, 0x97, 0x20,
-		0xeb, 0xdb, 0xf8, 0x11, 0xd1, 0x31, 0xad, 0x56, 0x20, 0x2b, 0x3e, 0x6a, 0x24, 0x9d, 0x1f, 0x8f,
-		0xa4, 0xf3, 0xe5, 0x6f, 0x84, 0x85, 0x71, 0xf6, 0x7b, 0x20, 0xc9, 0x97, 0xf4, 0x05, 0x76, 0x12,
-		0xd2, 0xfc, 0x29, 0xca, 0x38, 0xdd, 0xc0, 0xe2, 0x5f, 0x44, 0x90, 0xcc, 0x96, 0x27, 0xd8, 0xbe,
-		0x16, 0xfd, 0x90, 0x55, 0x38, 0x3d, 0xd6, 0x86, 0x8f, 0xdf, 0x0a, 0x63, 0x8c, 0xf8, 0x56, 0x98,
-		0x2e, 0x9a, 0xe3, 0xd2, 0xbe, 0x8a, 0x83, 0x2c, 0xec, 0x4b, 0xfe, 0x48, 0x02, 0x4e, 0x8e, 0xb6,
-		0xe4, 0x68, 0x09, 0x0a, 0xc4, 0x6f, 0xf5, 0xa2, 0x2e, 0x2e, 0xf4, 0xb4, 0x83, 0x36, 0xf7, 0x6f,
-		0xf9, 0x56, 0x42, 0xdc, 0xdf, 0x4a, 0x40, 0xdb, 0x30, 0xdb, 0xb5, 0x74, 0xad, 0xab, 0x86, 0xb6,
-		0x72, 0xf8, 0x24, 0x7a, 0x78, 0x48, 0xd8, 0xd1, 0x94, 0x21, 0x31, 0x38, 0x5c, 0xff, 0xcb, 0x94,
-		0xc7, 0x9a, 0xbf, 0xeb, 0x83, 0x56, 0x21, 0xdf, 0x33, 0xdc, 0x1d, 0xbc, 0xaf, 0xdd, 0x36, 0x2c,
-		0x87, 0xcf, 0xa6, 0x61, 0xa5, 0x59, 0x0f, 0x70, 0xc4, 0x0e, 0x53, 0x88, 0x2c, 0x34, 0x24, 0xa9,
-		0x91, 0x1b, 0x5f, 0xe9, 0x63, 0x5b, 0x93, 0x71, 0x7b, 0x48, 0x99, 0xb1, 0x7b, 0x48, 0xa3, 0x36,
-		0x6c, 0xb2, 0xa3, 0x37, 0x6c, 0x3e, 0x14, 0x1e, 0x9a, 0xe8, 0xda, 0x37, 0xb4, 0x87, 0x83, 0x5a,
-		0x30, 0xcf, 0xe9, 0x3b, 0x11, 0xd9, 0x8f, 0x38, 0xea, 0xc0, 0xe6, 0xd7, 0xa0, 0xcc, 0x91, 0x20,
-		0x1f, 0x2f, 0xf6, 0xc4, 0xfd, 0x89, 0x5d, 0x6c, 0xa2, 0x26, 0x43, 0x9b, 0xa8, 0xff, 0x97, 0x0d,
-		0xc5, 0x07, 0x13, 0x30, 0x3b, 0xe4, 0x48, 0x8c, 0xdc, 0x1d, 0x1e, 0x97, 0x91, 0x15, 0x1d, 0x4b,
-		0x1c, 0xbb, 0x63, 0x7c, 0xac, 0x93, 0x93, 0xc7, 0x3a, 0xf5, 0x16, 0x8e, 0x75, 0xfa, 0xfe, 0xc6,
-		0xfa, 0x6d, 0x1d, 0x85, 0x8f, 0xc5, 0xa0, 0x32, 0xde, 0xfb, 0x1a, 0x39, 0x1c, 0xc7, 0xda, 0x40,
-		0x18, 0xb7, 0xc6, 0x3d, 0x02, 0xa5, 0x01, 0xdf, 0x90, 0xa9, 0x72, 0x31, 0x12, 0x85, 0xcb, 0xdf,
-		0x9c, 0xf0, 0x17, 0x9e, 0x88, 0x03, 0x37, 0x62, 0xb6, 0xbe, 0x0c, 0x73, 0x1d, 0xac, 0x1b, 0x9d,
-		0xfb, 0x9d, 0xac, 0xb3, 0x9c, 0xfa, 0xff, 0xcd, 0xd5, 0x61, 0x2d, 0xf9, 0x1e, 0x80, 0xac, 0x82,
-		0x5d, 0x9b, 0xf8, 0x63, 0xa8, 0x06, 0x39, 0x7c, 0xa0, 0x63, 0xdb, 0x0b, 0x0e, 0x55, 0x8c, 0x0a,
-		0x11, 0x18, 0x76, 0x43, 0x60, 0x92, 0x00, 0xd9, 0x27, 0x43, 0xcf, 0xf2, 0x1c, 0xc0, 0xf8, 0x70,
-		0x9e, 0x93, 0x87, 0x93, 0x00, 0x97, 0x45, 0x12, 0x20, 0x31, 0x36, 0xbe, 0x65, 0x54, 0x03, 0x59,
-		0x80, 0x67, 0x79, 0x16, 0x20, 0x39, 0xa1, 0xb2, 0x48, 0x1a, 0xa0, 0x1e, 0x49, 0x03, 0xa4, 0x27,
-		0x74, 0x73, 0x4c, 0x1e, 0xe0, 0xb2, 0xc8, 0x03, 0x64, 0x26, 0xb4, 0x78, 0x20, 0x11, 0xf0, 0xee,
-		0x50, 0x22, 0x20, 0x37, 0x9c, 0x6d, 0x8d, 0x90, 0x8e, 0xc8, 0x04, 0xbc, 0xe0, 0x67, 0x02, 0x0a,
-		0x63, 0xb3, 0x08, 0x9c, 0x78, 0x30, 0x15, 0xb0, 0x39, 0x94, 0x0a, 0x28, 0xf2, 0x3f, 0x6c, 0x34,
-		0x8e, 0xc5, 0x84, 0x5c, 0xc0, 0xe6, 0x50, 0x2e, 0xa0, 0x34, 0x81, 0xe1, 0x84, 0x64, 0xc0, 0xd7,
-		0x8f, 0x4e, 0x06, 0x8c, 0x0f, 0xd7, 0x79, 0x33, 0xa7, 0xcb, 0x06, 0xa8, 0x63, 0xb2, 0x01, 0xd2,
-		0xd8, 0xc8, 0x95, 0xb1, 0x9f, 0x3a, 0x1d, 0xb0, 0x3d, 0x22, 0x1d, 0xc0, 0x02, 0xf7, 0x73, 0x63,
-		0x99, 0x4f, 0x91, 0x0f, 0xd8, 0x1e, 0x91, 0x0f, 0x40, 0x13, 0xd9, 0x4e, 0x4c, 0x08, 0x5c, 0x8d,
-		0x26, 0x04, 0xe6, 0xc6, 0x78, 0x9d, 0xc1, 0x6c, 0x1f, 0x93, 0x11, 0xd8, 0x19, 0x97, 0x11, 0x60,
-		0x51, 0xfb, 0x93, 0x63, 0x39, 0x1e, 0x23, 0x25, 0xb0, 0x39, 0x94, 0x12, 0x38, 0x31, 0x41, 0xd3,
-		0xa6, 0xcf, 0x09, 0xb0, 0x87, 0xd6, 0xd9, 0x13, 0xeb, 0x20, 0xe5, 0x6f, 0x24, 0xb3, 0x79, 0xa9,
-		0x20, 0x3f, 0x4e, 0x3c, 0x98, 0x01, 0x3b, 0x47, 0x62, 0x05, 0xec, 0x38, 0x96, 0x23, 0xee, 0x31,
-		0xd0, 0x0f, 0xf9, 0x1c, 0x89, 0x11, 0x03, 0x9b, 0x76, 0x44, 0xfe, 0x80, 0xc6, 0x64, 0x21, 0x3b,
-		0x26, 0xff, 0x7c, 0x2c, 0xa0, 0xa5, 0x19, 0x84, 0x70, 0x7c, 0x99, 0xe3, 0xf1, 0x65, 0x28, 0xab,
-		0x10, 0x8f, 0x66, 0x15, 0x16, 0x21, 0x4f, 0x62, 0xad, 0x81, 0x84, 0x81, 0x66, 0xfb, 0x09, 0x03,
-		0x71, 0x80, 0x8a, 0xe5, 0x1e, 0xf8, 0xb2, 0xc4, 0x76, 0x13, 0xcb, 0xfe, 0x61, 0x32, 0x16, 0xea,
-		0xa2, 0xa7, 0x60, 0x2e, 0x84, 0xeb, 0xc7, 0x70, 0x2c, 0x7a, 0x96, 0x7c, 0xec, 0x2a, 0x0f, 0xe6,
-		0x7e, 0x35, 0x16, 0x48, 0x28, 0xc8, 0x34, 0x8c, 0x4a, 0x0a, 0xc4, 0xde, 0xa2, 0xa4, 0x40, 0xfc,
-		0xbe, 0x93, 0x02, 0xe1, 0x98, 0x34, 0x11, 0x8d, 0x49, 0xff, 0x47, 0x2c, 0x18, 0x13, 0x3f, 0xc4,
-		0xd7, 0xad, 0x0e, 0xe6, 0x51, 0x22, 0xfd, 0x4d, 0x5c, 0x92, 0xae, 0xb5, 0xc7, 0x63, 0x41, 0xf2,
-		0x93, 0x60, 0xf9, 0x0b, 0x4f, 0x8e, 0xaf, 0x2b, 0x7e, 0x80, 0x99, 0x0a, 0xdf, 0xe3, 0xe0, 0x97,
-		0x1b, 0xd2, 0xc1, 0xe5, 0x06, 0xff, 0xf2, 0x71, 0x26, 0x74, 0xf9, 0x18, 0xbd, 0x13, 0x72, 0x34,
-		0xd9, 0xaf, 0x5a, 0xb6, 0xf8, 0xab, 0x60, 0x67, 0xc6, 0x5f, 0x6c, 0x70, 0xe9, 0x91, 0x6b, 0x76,
-		0x19, 0x22, 0xf0, 0x38, 0x72, 0x11, 0x8f, 0xe3, 0x01, 0xc8, 0x91, 0xd6, 0xb3, 0xbf, 0x6e, 0x01,
-		0xfc, 0xe6, 0xba, 0x00, 0xc8, 0x3f, 0x11, 0x87, 0xf2, 0xc0, 0x42, 0x33, 0xb2, 0xef, 0xa3, 0xb6,
-		0x91, 0xa7, 0x93, 0xc7, 0x59, 0x80, 0x3d, 0xcd, 0x55, 0xef, 0x68, 0xa6, 0x87, 0x3b, 0x5c, 0x28,
-		0x21, 0x08, 0xaa, 0x40, 0x96, 0x7c, 0xf5, 0x5d, 0xdc, 0xe1, 0xd9, 0x17, 0xff, 0x1b, 0x5d, 0x87,
-		0x34, 0xbe, 0x4d, 0x5f, 0x77, 0x65, 0x6f, 0x24, 0x9f, 0x1c, 0x0e, 0x87, 0x49, 0x71, 0x6d, 0x81,
-		0x0c, 0xf6, 0x17, 0xdf, 0x5c, 0x94, 0x18, 0xf6, 0x93, 0xfe, 0x85, 0x31, 0x85, 0xd3, 0x47, 0xa5,
-		0x90, 0x1d, 0x90, 0x02, 0xcd, 0x03, 0x16, 0x44, 0x78, 0x4f, 0x64, 0xca, 0xce, 0x78, 0x2b, 0xc5,
-		0x1e, 0xee, 0xd9, 0x96, 0xd5, 0x55, 0xd9, 0x1c, 0xaf, 0x42, 0x29, 0xba, 0xae, 0xb2, 0xbf, 0x3c,
-		0xe5, 0x69, 0x86, 0xa9, 0x46, 0x9c, 0xe0, 0x02, 0x03, 0xb2, 0x39, 0x75, 0x23, 0x99, 0x8d, 0x49,
-		0xf1, 0x1b, 0xc9, 0x6c, 0x5c, 0x4a, 0xc8, 0x5b, 0x70, 0x62, 0xe4, 0xba, 0x8a, 0x9e, 0x87, 0x5c,
-		0xb0, 0x24, 0xb3, 0x63, 0xfa, 0x47, 0x64, 0x5a, 0x02, 0x5c, 0xf9, 0x97, 0x62, 0x01, 0xcb, 0x68,
-		0xee, 0xa6, 0x01, 0x69, 0x76, 0x66, 0x92, 0x9f, 0x8f, 0x79, 0x6a, 0xba, 0x15, 0x79, 0x99, 0x1d,
-		0xa8, 0x54, 0x38, 0xb1, 0xfc, 0x3e, 0x48, 0x33, 0x08, 0xca, 0x43, 0x26, 0x78, 0x47, 0x1a, 0x20,
-		0x5d, 0xad, 0xd7, 0x1b, 0x5b, 0xe2, 0x51, 0xd8, 0xda, 0xa6, 0xd2, 0x96, 0xe2, 0x04, 0xac, 0x34,
-		0x6e, 0x34, 0xea, 0x6d, 0x29, 0x81, 0x66, 0xa1, 0xc8, 0x7e, 0xab, 0x57, 0x37, 0x95, 0xf5, 0x6a,
-		0x5b, 0x4a, 0x86, 0x40, 0xad, 0xc6, 0xc6, 0x6a, 0x43, 0x91, 0x52, 0xf2, 0x33, 0x70, 0x7a, 0xec,
-		0x1a, 0x1e, 0x24, 0x66, 0x62, 0xa1, 0xc4, 0x8c, 0xfc, 0x91, 0x38, 0x09, 0x6a, 0xc6, 0x2d, 0xcc,
-		0xe8, 0xc6, 0x40, 0xc7, 0x2f, 0x1e, 0x63, 0x55, 0x1f, 0xe8, 0x3d, 0x89, 0x63, 0x1c, 0xbc, 0x8b,
-		0x3d, 0x7d, 0x9f, 0x39, 0x0a, 0xcc, 0x02, 0x15, 0x95, 0x22, 0x87, 0x52, 0x22, 0x97, 0xa1, 0xbd,
-		0x8e, 0x75, 0x4f, 0x65, 0x4a, 0xe4, 0xf2, 0x3f, 0x94, 0x5b, 0x64, 0xd0, 0x16, 0x03, 0xca, 0xdf,
-		0x78, 0x2c, 0x59, 0xe6, 0x20, 0xa5, 0x34, 0xda, 0xca, 0xab, 0x52, 0x02, 0x21, 0x28, 0xd1, 0x9f,
-		0x6a, 0x6b, 0xa3, 0xba, 0xd5, 0xba, 0xbe, 0x49, 0x64, 0x39, 0x07, 0x65, 0x21, 0x4b, 0x01, 0x4c,
-		0xc9, 0x4f, 0xc0, 0xa9, 0x31, 0x5e, 0xc5, 0x88, 0x43, 0xac, 0x9f, 0x88, 0x85, 0xb1, 0xa3, 0x9e,
-		0xc1, 0x26, 0xa4, 0x5d, 0x4f, 0xf3, 0xfa, 0x2e, 0x17, 0xe2, 0xf3, 0xd3, 0xba, 0x19, 0xcb, 0xe2,
-		0x47, 0x8b, 0x92, 0x2b, 0x9c, 0x8d, 0x7c, 0x09, 0x4a, 0xd1, 0x92, 0xf1, 0x32, 0x08, 0x94, 0x28,
-		0x2e, 0x5f, 0x01, 0x34, 0xec, 0x7d, 0x8c, 0x08, 0x2f, 0x63, 0xa3, 0xc2, 0xcb, 0x1f, 0x8d, 0xc1,
-		0x99, 0x23, 0x3c, 0x0d, 0xf4, 0xf2, 0x40, 0x27, 0x5f, 0x38, 0x8e, 0x9f, 0xb2, 0xcc, 0x60, 0x03,
-		0xdd, 0x7c, 0x16, 0x0a, 0x61, 0xf8, 0x74, 0x9d, 0xfc, 0x62, 0x3c, 0x98, 0xc4, 0xd1, 0x38, 0x38,
-		0x30, 0x81, 0xb1, 0xaf, 0xd2, 0x04, 0xbe, 0x0b, 0xc0, 0x3b, 0x10, 0xa7, 0xa8, 0xf9, 0x3a, 0xfa,
-		0xe0, 0x88, 0xfc, 0x22, 0xd6, 0xdb, 0x07, 0x7c, 0x12, 0xe4, 0x3c, 0xfe, 0xcb, 0x45, 0xad, 0x70,
-		0x52, 0xa0, 0x4f, 0xd7, 0x58, 0xd7, 0xff, 0x33, 0xb9, 0xd3, 0x2d, 0xc6, 0x41, 0xf2, 0x80, 0x81,
-		0x5d, 0xf4, 0x2a, 0x9c, 0x1a, 0x70, 0x14, 0x7c, 0xd6, 0xc9, 0x69, 0xfd, 0x85, 0x13, 0x51, 0x7f,
-		0x41, 0xb0, 0x0e, 0xaf, 0xf6, 0xa9, 0xe8, 0x6a, 0xff, 0x2a, 0x40, 0x90, 0x1c, 0x08, 0x8e, 0xfd,
-		0xc5, 0xc2, 0xc7, 0xfe, 0x2e, 0x41, 0x8a, 0x68, 0x92, 0x90, 0xd3, 0xb0, 0x29, 0x26, 0x9a, 0x10,
-		0x4a, 0x2e, 0x30, 0x6c, 0xd9, 0x00, 0x34, 0x9c, 0xa0, 0x1d, 0x53, 0xc5, 0xbb, 0xa3, 0x55, 0x3c,
-		0x34, 0x36, 0xd5, 0x3b, 0xba, 0xaa, 0x37, 0x20, 0x45, 0x47, 0x7e, 0xe4, 0x5d, 0xca, 0x6f, 0x00,
-		0xd0, 0x3c, 0xcf, 0x31, 0x76, 0xfa, 0x41, 0x05, 0x8b, 0xa3, 0x35, 0xa7, 0x2a, 0xf0, 0x6a, 0x0f,
-		0x70, 0x15, 0x9a, 0x0f, 0x48, 0x43, 0x6a, 0x14, 0x62, 0x28, 0x6f, 0x40, 0x29, 0x4a, 0x3b, 0xfa,
-		0x6e, 0x68, 0xf0, 0xb8, 0x4a, 0x4e, 0xf8, 0x37, 0xbe, 0x77, 0xc4, 0x1f, 0x3c, 0xa2, 0x1f, 0xf2,
-		0x07, 0xe2, 0x50, 0x08, 0x2b, 0xde, 0x5f, 0x3d, 0x17, 0x44, 0xfe, 0x96, 0x18, 0x64, 0xfd, 0xee,
-		0x1f, 0x71, 0xd8, 0x39, 0xb8, 0x23, 0xec, 0x6f, 0x5e, 0xb0, 0x6d, 0xa2, 0x84, 0xbf, 0x4d, 0x74,
-		0xc5, 0x5f, 0xfe, 0xc6, 0x25, 0x44, 0xc2, 0xb2, 0x16, 0x07, 0x0e, 0xf9, 0x6a, 0x7f, 0x65, 0xba,
-		0xab, 0x78, 0xf3, 0x90, 0x0a, 0x5f, 0xa3, 0x63, 0x1f, 0x72, 0x27, 0x74, 0xc2, 0x88, 0x4d, 0xc4,
-		0xf0, 0x9d, 0xbd, 0xd8, 0xb1, 0xef, 0xec, 0xf9, 0xb5, 0xc4, 0xc3, 0xb5, 0x7c, 0x77, 0x0c, 0xb2,
-		0x62, 0x4e, 0xa0, 0xf7, 0x84, 0x4f, 0x9e, 0x89, 0xad, 0xcd, 0xb1, 0xf6, 0x88, 0xf3, 0x0f, 0x1d,
-		0x3c, 0x1b, 0x3a, 0x70, 0x9d, 0x38, 0xee, 0x81, 0x6b, 0xee, 0xd9, 0xfd, 0x49, 0x0c, 0xa4, 0xc1,
-		0x19, 0xfb, 0x55, 0xb7, 0x6e, 0x78, 0x99, 0x4b, 0x8c, 0x58, 0xe6, 0xc6, 0x9d, 0xa2, 0x4e, 0x8e,
-		0x3b, 0x45, 0x3d, 0xdc, 0xeb, 0xd4, 0x7d, 0xf6, 0xfa, 0x83, 0x71, 0xc8, 0x87, 0xd2, 0xa3, 0xe8,
-		0xb9, 0xc8, 0x89, 0xec, 0xa5, 0xa3, 0x52, 0xa9, 0xa1, 0x23, 0xd9, 0x11, 0x31, 0xc5, 0x8f, 0x2f,
-		0xa6, 0xb7, 0xfe, 0x76, 0xd6, 0xe8, 0x0b, 0xac, 0xa9, 0x31, 0x17, 0x58, 0xff, 0x7a, 0x0c, 0xb2,
-		0xbe, 0xdb, 0x7d, 0xdc, 0x4d, 0xcc, 0x93, 0x90, 0xe6, 0x9e, 0x25, 0xdb, 0xc5, 0xe4, 0x5f, 0x23,
-		0xd3, 0xca, 0x15, 0xc8, 0x8a, 0xbf, 0x59, 0xc8, 0x57, 0x35, 0xff, 0xfb, 0xfc, 0x0b, 0x90, 0x0f,
-		0x6d, 0x00, 0x13, 0xd3, 0xb8, 0xd1, 0x78, 0xaf, 0x34, 0x53, 0xc9, 0xdc, 0xbd, 0xb7, 0x94, 0xd8,
-		0xc0, 0x77, 0xc8, 0x6c, 0x56, 0x1a, 0xf5, 0xeb, 0x8d, 0xfa, 0x4b, 0x52, 0xac, 0x92, 0xbf, 0x7b,
-		0x6f, 0x29, 0xa3, 0x60, 0x9a, 0x51, 0x3c, 0xff, 0x12, 0x94, 0x07, 0x06, 0x26, 0xea, 0xb6, 0x20,
-		0x28, 0xad, 0x6e, 0x6f, 0xad, 0x35, 0xeb, 0xd5, 0x76, 0x43, 0x65, 0xe7, 0x76, 0xd1, 0x29, 0x98,
-		0x5b, 0x6b, 0x5e, 0xbb, 0xde, 0x56, 0xeb, 0x6b, 0xcd, 0xc6, 0x46, 0x5b, 0xad, 0xb6, 0xdb, 0xd5,
-		0xfa, 0x4b, 0x52, 0xfc, 0xe2, 0xbd, 0x3c, 0x24, 0xab, 0xb5, 0x7a, 0x13, 0xd5, 0x21, 0x49, 0x53,
-		0x21, 0x47, 0x9e, 0x00, 0xab, 0x1c, 0x9d, 0x1b, 0x46, 0x57, 0x21, 0x45, 0xb3, 0x24, 0xe8, 0xe8,
-		0x23, 0x61, 0x95, 0x09, 0xc9, 0x62, 0xd2, 0x18, 0x3a, 0x23, 0x8f, 0x3c, 0x23, 0x56, 0x39, 0x3a,
-		0x77, 0x8c, 0xd6, 0x20, 0x23, 0x82, 0xe4, 0x49, 0x07, 0xb7, 0x2a, 0x13, 0x13, 0xba, 0xa4, 0x6b,
-		0x2c, 0xd9, 0x70, 0xf4, 0xf1, 0xb1, 0xca, 0x84, 0xac, 0x32, 0x6a, 0xfa, 0xf7, 0x99, 0x26, 0x9c,
-		0x08, 0xab, 0x4c, 0xca, 0x13, 0x23, 0x05, 0x72, 0x41, 0x1a, 0x67, 0xf2, 0xa1, 0xb8, 0xca, 0x14,
-		0x09, 0x73, 0xf4, 0x3e, 0x28, 0x46, 0x43, 0xdd, 0xe9, 0x4e, 0x9d, 0x55, 0xa6, 0xcc, 0x48, 0x13,
-		0xfe, 0xd1, 0xb8, 0x77, 0xba, 0x53, 0x68, 0x95, 0x29, 0x13, 0xd4, 0xe8, 0x75, 0x98, 0x1d, 0x8e,
-		0x4b, 0xa7, 0x3f, 0x94, 0x56, 0x39, 0x46, 0xca, 0x1a, 0xf5, 0x00, 0x8d, 0x88, 0x67, 0x8f, 0x71,
-		0x46, 0xad, 0x72, 0x9c, 0x0c, 0x36, 0xea, 0x40, 0x79, 0x30, 0x48, 0x9c, 0xf6, 0xcc, 0x5a, 0x65,
-		0xea, 0x6c, 0x36, 0xab, 0x25, 0x1a, 0x5c, 0x4e, 0x7b, 0x86, 0xad, 0x32, 0x75, 0x72, 0x1b, 0x6d,
-		0x03, 0x84, 0xe2, 0xc3, 0x29, 0xce, 0xb4, 0x55, 0xa6, 0x49, 0x73, 0x23, 0x1b, 0xe6, 0x46, 0x05,
-		0x8e, 0xc7, 0x39, 0xe2, 0x56, 0x39, 0x56, 0xf6, 0x9b, 0xe8, 0x73, 0x34, 0x04, 0x9c, 0xee, 0xc8,
-		0x5b, 0x65, 0xca, 0x34, 0x78, 0xad, 0x3a, 0xf6, 0x9c, 0xf3, 0x63, 0x47, 0x9e, 0x73, 0x0e, 0x4e,
-		0x2e, 0xfb, 0x67, 0x9b, 0x7f, 0xf5, 0x39, 0x78, 0x07, 0x7f, 0x41, 0xc7, 0xf5, 0xb4, 0x5b, 0x86,
-		0xb9, 0xe7, 0x3f, 0x65, 0xc4, 0xbf, 0xf9, 0x21, 0xe7, 0x93, 0xfc, 0xb9, 0x1e, 0x01, 0x9d, 0xf0,
-		0xa0, 0xd1, 0xd8, 0x57, 0x1e, 0x27, 0xdd, 0x87, 0x98, 0x7c, 0x7c, 0xf9, 0x88, 0xc7, 0x92, 0x26,
-		0x3c, 0xc9, 0x34, 0xe2, 0x31, 0xa5, 0xca, 0x91, 0x6f, 0x0c, 0x54, 0x8e, 0x3a, 0xf7, 0x2d, 0x7f,
-		0x5f, 0x0c, 0x4a, 0xd7, 0x0d, 0xd7, 0xb3, 0x1c, 0x43, 0xd7, 0xba, 0x74, 0x79, 0xb9, 0x32, 0xed,
-		0xad, 0xac, 0x5a, 0x8e, 0xb8, 0x2b, 0xfc, 0xbd, 0x25, 0x7e, 0x53, 0x68, 0x15, 0xd2, 0xb7, 0xb5,
-		0x2e, 0xbb, 0x13, 0x15, 0x7e, 0x6c, 0x6d, 0x50, 0xe6, 0x21, 0x3f, 0x2a, 0xcc, 0x85, 0xd1, 0xae,
-		0xc4, 0x17, 0x62, 0xf2, 0xb7, 0xc5, 0x40, 0x0a, 0x5a, 0xa6, 0x60, 0xdd, 0x72, 0x3a, 0x34, 0x14,
-		0xb0, 0xed, 0xd0, 0x59, 0x01, 0xf1, 0x49, 0x5d, 0x3e, 0xa3, 0x87, 0x7d, 0xbf, 0x6d, 0xbc, 0x93,
-		0x95, 0x0c, 0x39, 0x58, 0x23, 0xae, 0x9c, 0x27, 0x46, 0x5d, 0x39, 0x97, 0xbf, 0x97, 0xde, 0x70,
-		0xe9, 0xf5, 0x0c, 0x97, 0xcc, 0x0e, 0x85, 0x06, 0xf7, 0x37, 0x20, 0xe9, 0x68, 0x1e, 0x0f, 0x79,
-		0x6b, 0x97, 0x8f, 0xfd, 0x64, 0x14, 0xeb, 0x33, 0xe5, 0x81, 0x5e, 0x86, 0x6c, 0x4f, 0x3b, 0x50,
-		0x29, 0xbf, 0xf8, 0x57, 0xc5, 0x2f, 0xd3, 0xd3, 0x0e, 0x48, 0xfb, 0xd0, 0xfb, 0xa0, 0x4c, 0x58,
-		0xea, 0xfb, 0x9a, 0xb9, 0x87, 0x19, 0xe7, 0xc4, 0x57, 0xc5, 0xb9, 0xd8, 0xd3, 0x0e, 0xea, 0x94,
-		0x1b, 0xe1, 0xcf, 0x9f, 0xd6, 0xfa, 0x95, 0x18, 0xcf, 0x63, 0x50, 0xc1, 0x20, 0x0d, 0x24, 0xdd,
-		0xff, 0xa2, 0x95, 0x8a, 0x4d, 0x97, 0xc7, 0xc6, 0x69, 0xc2, 0x80, 0x58, 0x6b, 0x45, 0xd2, 0xbc,
-		0xcf, 0xbc, 0xb9, 0x18, 0x63, 0xb5, 0x96, 0xf5, 0x21, 0xb1, 0xe7, 0x59, 0x7a, 0x46, 0x9d, 0x72,
-		0xc0, 0x8b, 0xc2, 0xab, 0x66, 0x0c, 0x81, 0x51, 0x93, 0x72, 0xde, 0x87, 0x4f, 0xc5, 0x20, 0xbf,
-		0x1a, 0x7a, 0xcc, 0x71, 0x01, 0x32, 0x3d, 0xcb, 0x34, 0x6e, 0x61, 0xc7, 0xdf, 0x35, 0x63, 0x9f,
-		0xc4, 0xf3, 0x65, 0x7f, 0x13, 0xd0, 0x3b, 0x14, 0xef, 0x1d, 0x89, 0x6f, 0x42, 0x75, 0x07, 0xef,
-		0xb8, 0x86, 0x90, 0xb3, 0x22, 0x3e, 0xd1, 0xe3, 0x20, 0xb9, 0x58, 0xef, 0x3b, 0x86, 0x77, 0xa8,
-		0xea, 0x96, 0xe9, 0x69, 0xba, 0xc7, 0x33, 0x03, 0x65, 0x01, 0xaf, 0x33, 0x30, 0x61, 0xd2, 0xc1,
-		0x9e, 0x66, 0x74, 0xd9, 0x59, 0xd1, 0x9c, 0x22, 0x3e, 0x79, 0x53, 0xef, 0x66, 0xc2, 0x91, 0x71,
-		0x1d, 0x24, 0xcb, 0xc6, 0x4e, 0xe4, 0x88, 0x0c, 0xd3, 0xc6, 0x85, 0xdf, 0xfc, 0xf4, 0x53, 0xf3,
-		0x5c, 0xe0, 0xfc, 0x78, 0x05, 0xfb, 0xdb, 0x05, 0x4a, 0x59, 0x50, 0x88, 0xb3, 0x33, 0xaf, 0x46,
-		0xf6, 0xc9, 0xfa, 0x3b, 0xc1, 0xc3, 0x36, 0xf3, 0x43, 0x42, 0xad, 0x9a, 0x87, 0xb5, 0x85, 0xdf,
-		0x08, 0x58, 0x07, 0x91, 0xf3, 0x4b, 0xf8, 0x30, 0xbc, 0x69, 0x46, 0xd9, 0x90, 0xa0, 0xe2, 0x75,
-		0xcd, 0xe8, 0x8a, 0x3f, 0x9f, 0xaa, 0xf0, 0x2f, 0xb4, 0xe2, 0x27, 0x3c, 0x93, 0x34, 0x42, 0x93,
-		0xc7, 0xe9, 0x46, 0xcd, 0x32, 0x3b, 0xd1, 0xcc, 0x26, 0xaa, 0x43, 0xda, 0xb3, 0x6e, 0x61, 0x93,
-		0x0b, 0xa8, 0xf6, 0xc4, 0x31, 0xde, 0x86, 0x53, 0x38, 0x29, 0xfa, 0x7a, 0x90, 0x3a, 0xb8, 0x8b,
-		0xf7, 0xd8, 0x0d, 0xce, 0x7d, 0xcd, 0xc1, 0xec, 0xfd, 0x80, 0xfb, 0x7a, 0xf9, 0xad, 0xec, 0xb3,
-		0x6a, 0x51, 0x4e, 0x68, 0x2b, 0xfa, 0x5c, 0x68, 0x86, 0x6f, 0x67, 0x8f, 0xe9, 0x63, 0x48, 0xf3,
-		0xc2, 0xb6, 0x30, 0xf2, 0xbc, 0xe8, 0xe3, 0x20, 0xf5, 0xcd, 0x1d, 0xcb, 0xa4, 0x7f, 0x75, 0x90,
-		0xc7, 0x75, 0x59, 0xb6, 0x77, 0xea, 0xc3, 0xf9, 0xde, 0xe9, 0x16, 0x94, 0x02, 0x54, 0x3a, 0x43,
-		0x72, 0xc7, 0x9d, 0x21, 0x45, 0x9f, 0x01, 0x41, 0x41, 0xeb, 0x00, 0xc1, 0x1c, 0xa4, 0x9b, 0x77,
-		0xf9, 0xf1, 0x23, 0x16, 0xcc, 0xe6, 0x70, 0x67, 0x42, 0x0c, 0xd0, 0xd7, 0xc1, 0x5c, 0xcf, 0x30,
-		0x55, 0x17, 0x77, 0x77, 0x55, 0x2e, 0x39, 0xc2, 0x37, 0x7f, 0xfc, 0xd1, 0x9c, 0xed, 0x19, 0x66,
-		0x0b, 0x77, 0x77, 0x57, 0x7d, 0x2e, 0xe8, 0x5d, 0x70, 0x26, 0xe8, 0xbd, 0x65, 0xaa, 0xfb, 0x56,
-		0xb7, 0xa3, 0x3a, 0x78, 0x57, 0xd5, 0xe9, 0xeb, 0x7e, 0x05, 0x2a, 0xb3, 0x53, 0x3e, 0xca, 0xa6,
-		0x79, 0xdd, 0xea, 0x76, 0x14, 0xbc, 0x5b, 0x27, 0xc5, 0xe8, 0x61, 0x08, 0xba, 0xae, 0x1a, 0x1d,
-		0x77, 0xa1, 0xb8, 0x94, 0x38, 0x97, 0x54, 0x0a, 0x3e, 0xb0, 0xd9, 0x71, 0x57, 0xb2, 0x1f, 0xfa,
-		0xf8, 0xe2, 0xcc, 0x17, 0x3e, 0xbe, 0x38, 0x23, 0x5f, 0xa5, 0xaf, 0x5c, 0xf1, 0x79, 0x84, 0x5d,
-		0x74, 0x19, 0x72, 0x9a, 0xf8, 0x60, 0x77, 0xd8, 0x8e, 0x98, 0x87, 0x01, 0xaa, 0xfc, 0xe3, 0x31,
-		0x48, 0xaf, 0xde, 0xdc, 0xd2, 0x0c, 0x07, 0x35, 0x60, 0x36, 0x50, 0xcc, 0x69, 0xa7, 0x74, 0xa0,
-		0xcb, 0x62, 0x4e, 0x6f, 0x8c, 0x3b, 0x3c, 0x97, 0xab, 0x3d, 0xf4, 0x9b, 0x9f, 0x7e, 0xea, 0x41,
-		0xce, 0xe6, 0xe6, 0xc0, 0x39, 0x3a, 0xc1, 0x6f, 0xf0, 0x7c, 0x5d, 0xa8, 0xcf, 0x37, 0x20, 0xc3,
-		0x9a, 0xea, 0xa2, 0x17, 0x21, 0x65, 0x93, 0x1f, 0x7c, 0x4f, 0xe0, 0xec, 0x58, 0x05, 0xa7, 0xf8,
-		0x61, 0x75, 0x60, 0x74, 0xf2, 0xb7, 0xc5, 0x01, 0x56, 0x6f, 0xde, 0x6c, 0x3b, 0x86, 0xdd, 0xc5,
-		0xde, 0x5b, 0xd5, 0xf7, 0x6d, 0x38, 0x11, 0xba, 0x9d, 0xed, 0xe8, 0xc7, 0xef, 0xff, 0x5c, 0x70,
-		0x51, 0xdb, 0xd1, 0x47, 0xb2, 0xed, 0xb8, 0x9e, 0xcf, 0x36, 0x71, 0x7c, 0xb6, 0xab, 0xae, 0x37,
-		0x2c, 0xd9, 0x57, 0x20, 0x1f, 0x08, 0xc3, 0x45, 0x4d, 0xc8, 0x7a, 0xfc, 0x37, 0x17, 0xb0, 0x3c,
-		0x5e, 0xc0, 0x82, 0x2c, 0x2c, 0x64, 0x9f, 0x5c, 0xfe, 0xb3, 0x18, 0x40, 0x68, 0x8e, 0xfc, 0xc5,
-		0xd4, 0x31, 0xd4, 0x84, 0x34, 0xb7, 0xc4, 0x89, 0xfb, 0x7e, 0x83, 0x93, 0x31, 0x08, 0x09, 0xf5,
-		0x3b, 0xe2, 0x30, 0xb7, 0x2d, 0x66, 0xef, 0x5f, 0x7c, 0x19, 0x6c, 0x43, 0x06, 0x9b, 0x9e, 0x63,
-		0xf8, 0xbb, 0x5a, 0x4f, 0x8f, 0x1b, 0xf3, 0x11, 0x9d, 0x6a, 0x98, 0x9e, 0x73, 0x18, 0xd6, 0x00,
-		0xc1, 0x2b, 0x24, 0x8f, 0x8f, 0x26, 0x60, 0x61, 0x1c, 0x29, 0xf1, 0x86, 0x75, 0x07, 0x53, 0x40,
-		0xf4, 0xd2, 0x6a, 0x49, 0x80, 0xf9, 0x1a, 0xa3, 0x00, 0xf1, 0xca, 0x88, 0x72, 0x11, 0xd4, 0xfb,
-		0x73, 0xc3, 0x4a, 0x01, 0x07, 0xba, 0xca, 0xb4, 0xa1, 0x2c, 0x6e, 0xc1, 0xec, 0x68, 0x5d, 0xcd,
-		0xd4, 0x85, 0xbb, 0x7a, 0xac, 0x25, 0x41, 0xdc, 0xa4, 0xa9, 0x31, 0x16, 0xa8, 0x01, 0x19, 0xc1,
-		0x2d, 0x79, 0x7c, 0x6e, 0x82, 0x16, 0x3d, 0x04, 0x85, 0xf0, 0xc2, 0x40, 0x5d, 0x8f, 0xa4, 0x92,
-		0x0f, 0xad, 0x0b, 0x93, 0x56, 0x9e, 0xf4, 0x91, 0x2b, 0x0f, 0xf7, 0xee, 0x7e, 0x88, 0x1e, 0x56,
-		0xef, 0xfc, 0xe5, 0x1f, 0x96, 0x2d, 0x00, 0x36, 0x55, 0x89, 0x25, 0xe5, 0x23, 0x73, 0x1f, 0xf3,
-		0x3d, 0xc7, 0x98, 0xac, 0xba, 0xde, 0xd7, 0x6a, 0x84, 0x7e, 0x3b, 0x0e, 0x85, 0xf0, 0x08, 0xfd,
-		0x95, 0x5c, 0xb4, 0xd0, 0x46, 0x60, 0xa6, 0xd8, 0x85, 0x9e, 0xc7, 0xc7, 0x99, 0xa9, 0x21, 0x6d,
-		0x9e, 0x60, 0x9f, 0x3e, 0x9f, 0x80, 0x34, 0x3f, 0x71, 0xb7, 0x39, 0xe4, 0xc8, 0xc6, 0x26, 0x3d,
-		0x49, 0x50, 0x14, 0x4f, 0x12, 0x8c, 0xf4, 0x63, 0x1f, 0x81, 0x12, 0x09, 0x88, 0x23, 0xc7, 0xf8,
-		0x62, 0xe7, 0x8a, 0x34, 0xae, 0x0d, 0x0e, 0xad, 0xa3, 0x45, 0xc8, 0x13, 0xb4, 0xc0, 0x0e, 0x13,
-		0x1c, 0xe8, 0x69, 0x07, 0x0d, 0x06, 0x41, 0x4f, 0x01, 0xda, 0xf7, 0x13, 0x13, 0x6a, 0x20, 0x08,
-		0x82, 0x37, 0x1b, 0x94, 0x08, 0xf4, 0x07, 0x01, 0x48, 0x2b, 0x54, 0xf6, 0xec, 0x34, 0x7f, 0xd4,
-		0x9b, 0x40, 0x56, 0xe9, 0xd3, 0xd3, 0xdf, 0x1c, 0x63, 0xfe, 0xf0, 0x40, 0xd8, 0xcc, 0xc3, 0x91,
-		0xf6, 0x14, 0x93, 0xe2, 0x8f, 0xdf, 0x5c, 0xac, 0x1c, 0x6a, 0xbd, 0xee, 0x8a, 0x3c, 0x82, 0x8f,
-		0x3c, 0x2a, 0x92, 0x27, 0x8e, 0x73, 0x34, 0xec, 0x46, 0x4d, 0x90, 0x6e, 0xe1, 0x43, 0xd5, 0xe1,
-		0x7f, 0x93, 0x5b, 0xdd, 0xc5, 0x98, 0x07, 0x2e, 0xa7, 0x97, 0x47, 0x3c, 0x02, 0xbe, 0x5c, 0xb7,
-		0x0c, 0x93, 0x6f, 0x81, 0x95, 0x6e, 0xe1, 0x43, 0x85, 0xd3, 0x5d, 0xc5, 0x78, 0xe5, 0x1d, 0x64,
-		0xa6, 0xdc, 0xfd, 0xfc, 0x4f, 0x9e, 0x3f, 0x13, 0x7a, 0xd0, 0xfa, 0xc0, 0xcf, 0xcd, 0xb1, 0xe1,
-		0x25, 0x4e, 0x2f, 0x0a, 0x16, 0x20, 0xff, 0xd8, 0xff, 0x3a, 0x40, 0x28, 0x28, 0x88, 0x1d, 0x1d,
-		0x6c, 0x04, 0xf4, 0x91, 0x60, 0x23, 0x34, 0x3d, 0xdf, 0x13, 0xd8, 0xff, 0xf8, 0xa4, 0xde, 0x84,
-		0x35, 0x93, 0x13, 0xd1, 0x59, 0x3f, 0x23, 0xff, 0xeb, 0x18, 0x9c, 0x1e, 0xd2, 0x64, 0xbf, 0xc9,
-		0x3a, 0x20, 0x27, 0x54, 0x48, 0x35, 0x42, 0xec, 0x33, 0xdf, 0xdf, 0xc4, 0x98, 0x75, 0x86, 0x16,
-		0x81, 0xb7, 0x66, 0x21, 0xe3, 0x56, 0xec, 0xd7, 0x63, 0x30, 0x1f, 0x6e, 0x80, 0xdf, 0x95, 0x16,
-		0x14, 0xc2, 0x55, 0xf3, 0x4e, 0xbc, 0x63, 0x9a, 0x4e, 0x84, 0xdb, 0x1f, 0x61, 0x82, 0x6e, 0x06,
-		0xd6, 0x82, 0x25, 0x05, 0x9f, 0x99, 0x5a, 0x28, 0xa2, 0x61, 0x23, 0xad, 0x06, 0x1b, 0x9b, 0xdf,
-		0x8f, 0x41, 0x72, 0xcb, 0xb2, 0xba, 0xe8, 0xfd, 0x30, 0x6b, 0x5a, 0x9e, 0x4a, 0x66, 0x16, 0xee,
-		0xa8, 0x3c, 0x47, 0xc0, 0x2c, 0x71, 0xe3, 0x48, 0x59, 0x7d, 0xf1, 0xcd, 0xc5, 0x61, 0xca, 0x51,
-		0x8f, 0xca, 0x97, 0x4d, 0xcb, 0xab, 0x51, 0xa4, 0x36, 0x4b, 0x23, 0xec, 0x42, 0x31, 0x5a, 0x1d,
-		0xb3, 0xd6, 0xd5, 0x49, 0xd5, 0x15, 0x27, 0x56, 0x55, 0xd8, 0x09, 0xd5, 0xc3, 0xde, 0x88, 0xfe,
-		0x43, 0x32, 0x72, 0xaf, 0x82, 0x74, 0x73, 0xf0, 0xfc, 0x52, 0x03, 0x32, 0xe2, 0xbc, 0x52, 0x6c,
-		0xca, 0xa3, 0x50, 0x61, 0x71, 0x72, 0x5a, 0xf9, 0x33, 0x71, 0x38, 0x5d, 0xb7, 0x4c, 0x97, 0x27,
-		0x73, 0xf8, 0x84, 0x66, 0x29, 0xd8, 0x43, 0xf4, 0xf8, 0x98, 0x54, 0x53, 0x61, 0x38, 0xa1, 0x74,
-		0x13, 0xca, 0x64, 0x65, 0xd5, 0x2d, 0xf3, 0xab, 0xcc, 0x27, 0x15, 0xad, 0x6e, 0x87, 0xb7, 0xe8,
-		0x16, 0x3e, 0x24, 0x7c, 0x4d, 0x7c, 0x27, 0xc2, 0x37, 0x71, 0x7f, 0x7c, 0x4d, 0x7c, 0x27, 0xc4,
-		0x37, 0xd8, 0x2a, 0x4f, 0x46, 0xb6, 0xca, 0x2f, 0x43, 0x82, 0x58, 0xc1, 0xd4, 0x31, 0xec, 0x06,
-		0x21, 0x08, 0xad, 0x66, 0x2d, 0x38, 0xcd, 0x13, 0x04, 0xee, 0xe6, 0x2e, 0x95, 0x28, 0xa6, 0x1d,
-		0x7a, 0x09, 0x1f, 0x8e, 0xc8, 0x16, 0x14, 0xa6, 0xca, 0x16, 0x9c, 0xff, 0xd9, 0x18, 0x40, 0x90,
-		0x17, 0x43, 0x4f, 0xc2, 0xa9, 0xda, 0xe6, 0xc6, 0xaa, 0xda, 0x6a, 0x57, 0xdb, 0xdb, 0xad, 0xe8,
-		0x9f, 0x8c, 0x11, 0x8f, 0x60, 0xb9, 0x36, 0xd6, 0x8d, 0x5d, 0x03, 0x77, 0xd0, 0xa3, 0x30, 0x1f,
-		0xc5, 0x26, 0x5f, 0x8d, 0x55, 0x29, 0x56, 0x29, 0xdc, 0xbd, 0xb7, 0x94, 0x65, 0xa1, 0x01, 0xee,
-		0xa0, 0x73, 0x70, 0x62, 0x18, 0xaf, 0xb9, 0x71, 0x4d, 0x8a, 0x57, 0x8a, 0x77, 0xef, 0x2d, 0xe5,
-		0xfc, 0x18, 0x02, 0xc9, 0x80, 0xc2, 0x98, 0x9c, 0x5f, 0xa2, 0x02, 0x77, 0xef, 0x2d, 0xa5, 0xd9,
-		0x6c, 0xa9, 0x24, 0x3f, 0xf4, 0x23, 0x67, 0x67, 0xce, 0x7f, 0x03, 0x40, 0xd3, 0xdc, 0x75, 0x34,
-		0x9d, 0x5a, 0x85, 0x0a, 0x9c, 0x6c, 0x6e, 0x5c, 0x55, 0xaa, 0xf5, 0x76, 0x73, 0x73, 0x63, 0xe0,
-		0x2f, 0xdd, 0x44, 0xcb, 0x56, 0x37, 0xb7, 0x6b, 0x6b, 0x0d, 0xb5, 0xd5, 0xbc, 0xb6, 0xc1, 0x76,
-		0xfc, 0x23, 0x65, 0xef, 0xdd, 0x68, 0x37, 0xd7, 0x1b, 0x52, 0xbc, 0x76, 0x79, 0xec, 0x8e, 0xd2,
-		0x03, 0x91, 0x79, 0x18, 0xac, 0x44, 0x91, 0x6d, 0xa4, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x18,
-		0x00, 0x33, 0xab, 0x2d, 0xa3, 0x00, 0x00,
+		// 11446 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x90, 0x1c, 0xc7,
+		0x75, 0xd8, 0xed, 0xe7, 0xed, 0xbe, 0xfd, 0xb8, 0xb9, 0xbe, 0x03, 0xb8, 0x58, 0x90, 0xc0, 0x71,
+		0x28, 0x92, 0x20, 0x28, 0x1e, 0x48, 0x90, 0x00, 0xc5, 0x85, 0x24, 0x66, 0x6f, 0x6f, 0x01, 0x1c,
+		0x78, 0x5f, 0x9c, 0xbd, 0x83, 0x48, 0xda, 0xd6, 0x78, 0x6e, 0xb7, 0xef, 0x6e, 0x89, 0xdd, 0x99,
+		0xe1, 0xce, 0x2c, 0x80, 0x63, 0xa5, 0x52, 0x72, 0x64, 0x27, 0x36, 0xfc, 0x11, 0x39, 0x76, 0xd9,
+		0xb2, 0x25, 0xd8, 0x92, 0x1d, 0x5b, 0xb2, 0xf3, 0x65, 0xcb, 0x8a, 0x3f, 0xe2, 0x8a, 0x63, 0xe7,
+		0xd3, 0x76, 0x25, 0x29, 0xc5, 0x55, 0x49, 0x9c, 0x54, 0x99, 0xb1, 0x25, 0x57, 0xac, 0xc8, 0x72,
+		0xe2, 0x0f, 0x3a, 0x71, 0x4a, 0x95, 0x54, 0xea, 0xf5, 0xc7, 0x7c, 0xec, 0xc7, 0xcd, 0x1e, 0x48,
+		0xca, 0x8e, 0x9d, 0x3f, 0xc0, 0xcd, 0xeb, 0xf7, 0x5e, 0x77, 0xbf, 0x7e, 0xfd, 0xfa, 0xf5, 0xeb,
+		0xd7, 0xbd, 0xf0, 0x5b, 0x4b, 0xb0, 0xb0, 0x67, 0x59, 0x7b, 0x1d, 0x7a, 0xce, 0xee, 0x59, 0xae,
+		0xb5, 0xd3, 0xdf, 0x3d, 0xd7, 0xa2, 0x4e, 0xb3, 0xd7, 0xb6, 0x5d, 0xab, 0xb7, 0xc8, 0x60, 0x64,
+		0x86, 0x63, 0x2c, 0x4a, 0x0c, 0x75, 0x0d, 0x66, 0x2f, 0xb7, 0x3b, 0x74, 0xd9, 0x43, 0x6c, 0x50,
+		0x97, 0xbc, 0x07, 0x92, 0xbb, 0xed, 0x0e, 0x2d, 0xc5, 0x16, 0x12, 0x67, 0x72, 0xe7, 0xdf, 0xb5,
+		0x38, 0x40, 0xb4, 0x18, 0xa6, 0xd8, 0x44, 0xb0, 0xc6, 0x28, 0xd4, 0xff, 0x93, 0x84, 0xb9, 0x11,
+		0xa5, 0x84, 0x40, 0xd2, 0x34, 0xba, 0xc8, 0x31, 0x76, 0x26, 0xab, 0xb1, 0xbf, 0x49, 0x09, 0xa6,
+		0x6d, 0xa3, 0x79, 0xc3, 0xd8, 0xa3, 0xa5, 0x38, 0x03, 0xcb, 0x4f, 0x72, 0x0a, 0xa0, 0x45, 0x6d,
+		0x6a, 0xb6, 0xa8, 0xd9, 0x3c, 0x28, 0x25, 0x16, 0x12, 0x67, 0xb2, 0x5a, 0x00, 0x42, 0x1e, 0x87,
+		0x59, 0xbb, 0xbf, 0xd3, 0x69, 0x37, 0xf5, 0x00, 0x1a, 0x2c, 0x24, 0xce, 0xa4, 0x34, 0x85, 0x17,
+		0x2c, 0xfb, 0xc8, 0x8f, 0xc2, 0xcc, 0x2d, 0x6a, 0xdc, 0x08, 0xa2, 0xe6, 0x18, 0x6a, 0x11, 0xc1,
+		0x01, 0xc4, 0x1a, 0xe4, 0xbb, 0xd4, 0x71, 0x8c, 0x3d, 0xaa, 0xbb, 0x07, 0x36, 0x2d, 0x25, 0x59,
+		0xef, 0x17, 0x86, 0x7a, 0x3f, 0xd8, 0xf3, 0x9c, 0xa0, 0xda, 0x3a, 0xb0, 0x29, 0xa9, 0x42, 0x96,
+		0x9a, 0xfd, 0x2e, 0xe7, 0x90, 0x1a, 0x23, 0xbf, 0xba, 0xd9, 0xef, 0x0e, 0x72, 0xc9, 0x20, 0x99,
+		0x60, 0x31, 0xed, 0xd0, 0xde, 0xcd, 0x76, 0x93, 0x96, 0xd2, 0x8c, 0xc1, 0xa3, 0x43, 0x0c, 0x1a,
+		0xbc, 0x7c, 0x90, 0x87, 0xa4, 0x23, 0x35, 0xc8, 0xd2, 0xdb, 0x2e, 0x35, 0x9d, 0xb6, 0x65, 0x96,
+		0xa6, 0x19, 0x93, 0x87, 0x47, 0x8c, 0x22, 0xed, 0xb4, 0x06, 0x59, 0xf8, 0x74, 0xe4, 0x22, 0x4c,
+		0x5b, 0xb6, 0xdb, 0xb6, 0x4c, 0xa7, 0x94, 0x59, 0x88, 0x9d, 0xc9, 0x9d, 0xbf, 0x7f, 0xa4, 0x22,
+		0x6c, 0x70, 0x1c, 0x4d, 0x22, 0x93, 0x15, 0x50, 0x1c, 0xab, 0xdf, 0x6b, 0x52, 0xbd, 0x69, 0xb5,
+		0xa8, 0xde, 0x36, 0x77, 0xad, 0x52, 0x96, 0x31, 0x38, 0x3d, 0xdc, 0x11, 0x86, 0x58, 0xb3, 0x5a,
+		0x74, 0xc5, 0xdc, 0xb5, 0xb4, 0xa2, 0x13, 0xfa, 0x26, 0xc7, 0x21, 0xed, 0x1c, 0x98, 0xae, 0x71,
+		0xbb, 0x94, 0x67, 0x1a, 0x22, 0xbe, 0x50, 0x75, 0x68, 0xab, 0x8d, 0xd5, 0x95, 0x0a, 0x5c, 0x75,
+		0xc4, 0xa7, 0xfa, 0x73, 0x69, 0x98, 0x99, 0x44, 0xf9, 0x2e, 0x41, 0x6a, 0x17, 0xfb, 0x5f, 0x8a,
+		0x1f, 0x45, 0x3a, 0x9c, 0x26, 0x2c, 0xde, 0xf4, 0x3d, 0x8a, 0xb7, 0x0a, 0x39, 0x93, 0x3a, 0x2e,
+		0x6d, 0x71, 0x5d, 0x49, 0x4c, 0xa8, 0x6d, 0xc0, 0x89, 0x86, 0x95, 0x2d, 0x79, 0x4f, 0xca, 0xf6,
+		0x12, 0xcc, 0x78, 0x4d, 0xd2, 0x7b, 0x86, 0xb9, 0x27, 0xb5, 0xf6, 0x5c, 0x54, 0x4b, 0x16, 0xeb,
+		0x92, 0x4e, 0x43, 0x32, 0xad, 0x48, 0x43, 0xdf, 0x64, 0x19, 0xc0, 0x32, 0xa9, 0xb5, 0xab, 0xb7,
+		0x68, 0xb3, 0x53, 0xca, 0x8c, 0x91, 0xd2, 0x06, 0xa2, 0x0c, 0x49, 0xc9, 0xe2, 0xd0, 0x66, 0x87,
+		0x3c, 0xe7, 0x2b, 0xe1, 0xf4, 0x18, 0x1d, 0x5a, 0xe3, 0xd3, 0x6f, 0x48, 0x0f, 0xb7, 0xa1, 0xd8,
+		0xa3, 0x38, 0x23, 0x68, 0x4b, 0xf4, 0x2c, 0xcb, 0x1a, 0xb1, 0x18, 0xd9, 0x33, 0x4d, 0x90, 0xf1,
+		0x8e, 0x15, 0x7a, 0xc1, 0x4f, 0xf2, 0x10, 0x78, 0x00, 0x9d, 0xa9, 0x15, 0x30, 0xfb, 0x94, 0x97,
+		0xc0, 0x75, 0xa3, 0x4b, 0xcb, 0xaf, 0x43, 0x31, 0x2c, 0x1e, 0x32, 0x0f, 0x29, 0xc7, 0x35, 0x7a,
+		0x2e, 0xd3, 0xc2, 0x94, 0xc6, 0x3f, 0x88, 0x02, 0x09, 0x6a, 0xb6, 0x98, 0xfd, 0x4b, 0x69, 0xf8,
+		0x27, 0xf9, 0x4b, 0x7e, 0x87, 0x13, 0xac, 0xc3, 0x8f, 0x0c, 0x8f, 0x68, 0x88, 0xf3, 0x60, 0xbf,
+		0xcb, 0xcf, 0x42, 0x21, 0xd4, 0x81, 0x49, 0xab, 0x56, 0xff, 0x32, 0x1c, 0x1b, 0xc9, 0x9a, 0xbc,
+		0x04, 0xf3, 0x7d, 0xb3, 0x6d, 0xba, 0xb4, 0x67, 0xf7, 0x28, 0x6a, 0x2c, 0xaf, 0xaa, 0xf4, 0x3b,
+		0xd3, 0x63, 0x74, 0x6e, 0x3b, 0x88, 0xcd, 0xb9, 0x68, 0x73, 0xfd, 0x61, 0xe0, 0xd9, 0x6c, 0xe6,
+		0x8b, 0xd3, 0xca, 0x87, 0x3e, 0xf4, 0xa1, 0x0f, 0xc5, 0xd5, 0x5f, 0x4a, 0xc3, 0xfc, 0xa8, 0x39,
+		0x33, 0x72, 0xfa, 0x1e, 0x87, 0xb4, 0xd9, 0xef, 0xee, 0xd0, 0x1e, 0x13, 0x52, 0x4a, 0x13, 0x5f,
+		0xa4, 0x0a, 0xa9, 0x8e, 0xb1, 0x43, 0x3b, 0xa5, 0xe4, 0x42, 0xec, 0x4c, 0xf1, 0xfc, 0xe3, 0x13,
+		0xcd, 0xca, 0xc5, 0x55, 0x24, 0xd1, 0x38, 0x25, 0x79, 0x3f, 0x24, 0x85, 0xf1, 0x46, 0x0e, 0x67,
+		0x27, 0xe3, 0x80, 0x73, 0x49, 0x63, 0x74, 0xe4, 0x24, 0x64, 0xf1, 0x7f, 0xae, 0x1b, 0x69, 0xd6,
+		0xe6, 0x0c, 0x02, 0x50, 0x2f, 0x48, 0x19, 0x32, 0x6c, 0x9a, 0xb4, 0xa8, 0x5c, 0xf4, 0xbc, 0x6f,
+		0x54, 0xac, 0x16, 0xdd, 0x35, 0xfa, 0x1d, 0x57, 0xbf, 0x69, 0x74, 0xfa, 0x94, 0x29, 0x7c, 0x56,
+		0xcb, 0x0b, 0xe0, 0x75, 0x84, 0x91, 0xd3, 0x90, 0xe3, 0xb3, 0xaa, 0x6d, 0xb6, 0xe8, 0x6d, 0x66,
+		0x57, 0x53, 0x1a, 0x9f, 0x68, 0x2b, 0x08, 0xc1, 0xea, 0x5f, 0x75, 0x2c, 0x53, 0xaa, 0x26, 0xab,
+		0x02, 0x01, 0xac, 0xfa, 0x67, 0x07, 0x4d, 0xfa, 0x03, 0xa3, 0xbb, 0x37, 0x34, 0x97, 0x1e, 0x85,
+		0x19, 0x86, 0xf1, 0xb4, 0x18, 0x7a, 0xa3, 0x53, 0x9a, 0x5d, 0x88, 0x9d, 0xc9, 0x68, 0x45, 0x0e,
+		0xde, 0x10, 0x50, 0xf5, 0xa7, 0xe3, 0x90, 0x64, 0x86, 0x65, 0x06, 0x72, 0x5b, 0x2f, 0x6f, 0xd6,
+		0xf5, 0xe5, 0x8d, 0xed, 0xa5, 0xd5, 0xba, 0x12, 0x23, 0x45, 0x00, 0x06, 0xb8, 0xbc, 0xba, 0x51,
+		0xdd, 0x52, 0xe2, 0xde, 0xf7, 0xca, 0xfa, 0xd6, 0xc5, 0x67, 0x94, 0x84, 0x47, 0xb0, 0xcd, 0x01,
+		0xc9, 0x20, 0xc2, 0xd3, 0xe7, 0x95, 0x14, 0x51, 0x20, 0xcf, 0x19, 0xac, 0xbc, 0x54, 0x5f, 0xbe,
+		0xf8, 0x8c, 0x92, 0x0e, 0x43, 0x9e, 0x3e, 0xaf, 0x4c, 0x93, 0x02, 0x64, 0x19, 0x64, 0x69, 0x63,
+		0x63, 0x55, 0xc9, 0x78, 0x3c, 0x1b, 0x5b, 0xda, 0xca, 0xfa, 0x15, 0x25, 0xeb, 0xf1, 0xbc, 0xa2,
+		0x6d, 0x6c, 0x6f, 0x2a, 0xe0, 0x71, 0x58, 0xab, 0x37, 0x1a, 0xd5, 0x2b, 0x75, 0x25, 0xe7, 0x61,
+		0x2c, 0xbd, 0xbc, 0x55, 0x6f, 0x28, 0xf9, 0x50, 0xb3, 0x9e, 0x3e, 0xaf, 0x14, 0xbc, 0x2a, 0xea,
+		0xeb, 0xdb, 0x6b, 0x4a, 0x91, 0xcc, 0x42, 0x81, 0x57, 0x21, 0x1b, 0x31, 0x33, 0x00, 0xba, 0xf8,
+		0x8c, 0xa2, 0xf8, 0x0d, 0xe1, 0x5c, 0x66, 0x43, 0x80, 0x8b, 0xcf, 0x28, 0x44, 0xad, 0x41, 0x8a,
+		0xa9, 0x21, 0x21, 0x50, 0x5c, 0xad, 0x2e, 0xd5, 0x57, 0xf5, 0x8d, 0xcd, 0xad, 0x95, 0x8d, 0xf5,
+		0xea, 0xaa, 0x12, 0xf3, 0x61, 0x5a, 0xfd, 0xc5, 0xed, 0x15, 0xad, 0xbe, 0xac, 0xc4, 0x83, 0xb0,
+		0xcd, 0x7a, 0x75, 0xab, 0xbe, 0xac, 0x24, 0xd4, 0x26, 0xcc, 0x8f, 0x32, 0xa8, 0x23, 0xa7, 0x50,
+		0x40, 0x17, 0xe2, 0x63, 0x74, 0x81, 0xf1, 0x1a, 0xd4, 0x05, 0xf5, 0x0b, 0x71, 0x98, 0x1b, 0xb1,
+		0xa8, 0x8c, 0xac, 0xe4, 0x79, 0x48, 0x71, 0x5d, 0xe6, 0xcb, 0xec, 0x63, 0x23, 0x57, 0x27, 0xa6,
+		0xd9, 0x43, 0x4b, 0x2d, 0xa3, 0x0b, 0x3a, 0x21, 0x89, 0x31, 0x4e, 0x08, 0xb2, 0x18, 0x52, 0xd8,
+		0xaf, 0x1b, 0x32, 0xfe, 0x7c, 0x7d, 0xbc, 0x38, 0xc9, 0xfa, 0xc8, 0x60, 0x47, 0x5b, 0x04, 0x52,
+		0x23, 0x16, 0x81, 0x4b, 0x30, 0x3b, 0xc4, 0x68, 0x62, 0x63, 0xfc, 0xe1, 0x18, 0x94, 0xc6, 0x09,
+		0x27, 0xc2, 0x24, 0xc6, 0x43, 0x26, 0xf1, 0xd2, 0xa0, 0x04, 0x1f, 0x1c, 0x3f, 0x08, 0x43, 0x63,
+		0xfd, 0xa9, 0x18, 0x1c, 0x1f, 0xed, 0x6c, 0x8e, 0x6c, 0xc3, 0xfb, 0x21, 0xdd, 0xa5, 0xee, 0xbe,
+		0x25, 0xdd, 0xaa, 0x47, 0x46, 0x2c, 0xd6, 0x58, 0x3c, 0x38, 0xd8, 0x82, 0x8a, 0x3c, 0x37, 0xd8,
+		0xd6, 0xd3, 0xe3, 0x5c, 0xdf, 0xa1, 0x96, 0x7e, 0x4b, 0x1c, 0x8e, 0x8d, 0x64, 0x3e, 0xb2, 0xa1,
+		0x0f, 0x00, 0xb4, 0x4d, 0xbb, 0xef, 0x72, 0xd7, 0x89, 0x5b, 0xe2, 0x2c, 0x83, 0x30, 0xe3, 0x85,
+		0x56, 0xb6, 0xef, 0x7a, 0xe5, 0x09, 0x56, 0x0e, 0x1c, 0xc4, 0x10, 0xde, 0xe3, 0x37, 0x34, 0xc9,
+		0x1a, 0x7a, 0x6a, 0x4c, 0x4f, 0x87, 0x14, 0xf3, 0x49, 0x50, 0x9a, 0x9d, 0x36, 0x35, 0x5d, 0xdd,
+		0x71, 0x7b, 0xd4, 0xe8, 0xb6, 0xcd, 0x3d, 0xb6, 0xd4, 0x64, 0x2a, 0xa9, 0x5d, 0xa3, 0xe3, 0x50,
+		0x6d, 0x86, 0x17, 0x37, 0x64, 0x29, 0x52, 0x30, 0x05, 0xea, 0x05, 0x28, 0xd2, 0x21, 0x0a, 0x5e,
+		0xec, 0x51, 0xa8, 0xdf, 0x99, 0x85, 0x5c, 0xc0, 0x35, 0x27, 0x0f, 0x42, 0xfe, 0x55, 0xe3, 0xa6,
+		0xa1, 0xcb, 0xed, 0x16, 0x97, 0x44, 0x0e, 0x61, 0x9b, 0x1c, 0x44, 0x9e, 0x84, 0x79, 0x86, 0x62,
+		0xf5, 0x5d, 0xda, 0xd3, 0x9b, 0x1d, 0xc3, 0x71, 0x98, 0xd0, 0x32, 0x0c, 0x95, 0x60, 0xd9, 0x06,
+		0x16, 0xd5, 0x64, 0x09, 0xb9, 0x00, 0x73, 0x8c, 0xa2, 0xdb, 0xef, 0xb8, 0x6d, 0xbb, 0x43, 0x75,
+		0xdc, 0x00, 0x3a, 0x25, 0x08, 0xb6, 0x6c, 0x16, 0x31, 0xd6, 0x04, 0x02, 0xb6, 0xc8, 0x21, 0xcb,
+		0xf0, 0x00, 0x23, 0xdb, 0xa3, 0x26, 0xed, 0x19, 0x2e, 0xd5, 0xe9, 0x6b, 0x7d, 0xa3, 0xe3, 0xe8,
+		0x86, 0xd9, 0xd2, 0xf7, 0x0d, 0x67, 0xbf, 0x34, 0x8f, 0x0c, 0x96, 0xe2, 0xa5, 0x98, 0x76, 0x02,
+		0x11, 0xaf, 0x08, 0xbc, 0x3a, 0x43, 0xab, 0x9a, 0xad, 0xab, 0x86, 0xb3, 0x4f, 0x2a, 0x70, 0x9c,
+		0x71, 0x71, 0xdc, 0x5e, 0xdb, 0xdc, 0xd3, 0x9b, 0xfb, 0xb4, 0x79, 0x43, 0xef, 0xbb, 0xbb, 0xef,
+		0x29, 0x9d, 0x0c, 0xd6, 0xcf, 0x5a, 0xd8, 0x60, 0x38, 0x35, 0x44, 0xd9, 0x76, 0x77, 0xdf, 0x43,
+		0x1a, 0x90, 0xc7, 0xc1, 0xe8, 0xb6, 0x5f, 0xa7, 0xfa, 0xae, 0xd5, 0x63, 0x6b, 0x68, 0x71, 0x84,
+		0x69, 0x0a, 0x48, 0x70, 0x71, 0x43, 0x10, 0xac, 0x59, 0x2d, 0x5a, 0x49, 0x35, 0x36, 0xeb, 0xf5,
+		0x65, 0x2d, 0x27, 0xb9, 0x5c, 0xb6, 0x7a, 0xa8, 0x50, 0x7b, 0x96, 0x27, 0xe0, 0x1c, 0x57, 0xa8,
+		0x3d, 0x4b, 0x8a, 0xf7, 0x02, 0xcc, 0x35, 0x9b, 0xbc, 0xcf, 0xed, 0xa6, 0x2e, 0xb6, 0x69, 0x4e,
+		0x49, 0x09, 0x09, 0xab, 0xd9, 0xbc, 0xc2, 0x11, 0x84, 0x8e, 0x3b, 0xe4, 0x39, 0x38, 0xe6, 0x0b,
+		0x2b, 0x48, 0x38, 0x3b, 0xd4, 0xcb, 0x41, 0xd2, 0x0b, 0x30, 0x67, 0x1f, 0x0c, 0x13, 0x92, 0x50,
+		0x8d, 0xf6, 0xc1, 0x20, 0xd9, 0xb3, 0x30, 0x6f, 0xef, 0xdb, 0xc3, 0x74, 0x67, 0x83, 0x74, 0xc4,
+		0xde, 0xb7, 0x07, 0x09, 0x1f, 0x66, 0x7b, 0xf6, 0x1e, 0x6d, 0x1a, 0x2e, 0x6d, 0x95, 0xee, 0x0b,
+		0xa2, 0x07, 0x0a, 0xc8, 0x22, 0x28, 0xcd, 0xa6, 0x4e, 0x4d, 0x63, 0xa7, 0x43, 0x75, 0xa3, 0x47,
+		0x4d, 0xc3, 0x29, 0x9d, 0x66, 0xc8, 0x49, 0xb7, 0xd7, 0xa7, 0x5a, 0xb1, 0xd9, 0xac, 0xb3, 0xc2,
+		0x2a, 0x2b, 0x23, 0x67, 0x61, 0xd6, 0xda, 0x79, 0xb5, 0xc9, 0x35, 0x52, 0xb7, 0x7b, 0x74, 0xb7,
+		0x7d, 0xbb, 0xf4, 0x2e, 0x26, 0xde, 0x19, 0x2c, 0x60, 0xfa, 0xb8, 0xc9, 0xc0, 0xe4, 0x31, 0x50,
+		0x9a, 0xce, 0xbe, 0xd1, 0xb3, 0x99, 0x49, 0x76, 0x6c, 0xa3, 0x49, 0x4b, 0x0f, 0x73, 0x54, 0x0e,
+		0x5f, 0x97, 0x60, 0x9c, 0x11, 0xce, 0xad, 0xf6, 0xae, 0x2b, 0x39, 0x3e, 0xca, 0x67, 0x04, 0x83,
+		0x09, 0x6e, 0x67, 0x40, 0x41, 0x49, 0x84, 0x2a, 0x3e, 0xc3, 0xd0, 0x8a, 0xf6, 0xbe, 0x1d, 0xac,
+		0xf7, 0x21, 0x28, 0xd8, 0xfb, 0xc1, 0x4a, 0x1f, 0xe3, 0x8e, 0x9b, 0xbd, 0x1f, 0xa8, 0xf1, 0x19,
+		0x38, 0x8e, 0x48, 0x5d, 0xea, 0x1a, 0x2d, 0xc3, 0x35, 0x02, 0xd8, 0xef, 0x66, 0xd8, 0x28, 0xf6,
+		0x35, 0x51, 0x18, 0x6a, 0x67, 0xaf, 0xbf, 0x73, 0xe0, 0x29, 0xd6, 0x13, 0xbc, 0x9d, 0x08, 0x93,
+		0xaa, 0xf5, 0x8e, 0x39, 0xe7, 0x6a, 0x05, 0xf2, 0x41, 0xbd, 0x27, 0x59, 0xe0, 0x9a, 0xaf, 0xc4,
+		0xd0, 0x09, 0xaa, 0x6d, 0x2c, 0xa3, 0xfb, 0xf2, 0x4a, 0x5d, 0x89, 0xa3, 0x1b, 0xb5, 0xba, 0xb2,
+		0x55, 0xd7, 0xb5, 0xed, 0xf5, 0xad, 0x95, 0xb5, 0xba, 0x92, 0x08, 0x38, 0xf6, 0xd7, 0x92, 0x99,
+		0x47, 0x94, 0x47, 0xd5, 0x9f, 0x4f, 0x40, 0x31, 0xbc, 0x53, 0x23, 0xef, 0x85, 0xfb, 0x64, 0xc0,
+		0xc5, 0xa1, 0xae, 0x7e, 0xab, 0xdd, 0x63, 0x13, 0xb2, 0x6b, 0xf0, 0xc5, 0xd1, 0xd3, 0x9f, 0x79,
+		0x81, 0xd5, 0xa0, 0xee, 0x07, 0xda, 0x3d, 0x9c, 0x6e, 0x5d, 0xc3, 0x25, 0xab, 0x70, 0xda, 0xb4,
+		0x74, 0xc7, 0x35, 0xcc, 0x96, 0xd1, 0x6b, 0xe9, 0x7e, 0xa8, 0x4b, 0x37, 0x9a, 0x4d, 0xea, 0x38,
+		0x16, 0x5f, 0x08, 0x3d, 0x2e, 0xf7, 0x9b, 0x56, 0x43, 0x20, 0xfb, 0x2b, 0x44, 0x55, 0xa0, 0x0e,
+		0xa8, 0x6f, 0x62, 0x9c, 0xfa, 0x9e, 0x84, 0x6c, 0xd7, 0xb0, 0x75, 0x6a, 0xba, 0xbd, 0x03, 0xe6,
+		0x9f, 0x67, 0xb4, 0x4c, 0xd7, 0xb0, 0xeb, 0xf8, 0x4d, 0xae, 0xc3, 0x23, 0x3e, 0xaa, 0xde, 0xa1,
+		0x7b, 0x46, 0xf3, 0x40, 0x67, 0xce, 0x38, 0x0b, 0x1b, 0xe8, 0x4d, 0xcb, 0xdc, 0xed, 0xb4, 0x9b,
+		0xae, 0x53, 0xca, 0x79, 0x36, 0x4e, 0xf5, 0x29, 0x56, 0x19, 0xc1, 0x35, 0xc7, 0x32, 0x99, 0x0f,
+		0x5e, 0x93, 0xd8, 0x5f, 0x95, 0xed, 0xd7, 0xb5, 0x64, 0x26, 0xa9, 0xa4, 0xae, 0x25, 0x33, 0x29,
+		0x25, 0x7d, 0x2d, 0x99, 0x49, 0x2b, 0xd3, 0xd7, 0x92, 0x99, 0x8c, 0x92, 0xbd, 0x96, 0xcc, 0x64,
+		0x15, 0x50, 0x7f, 0x26, 0x03, 0xf9, 0xe0, 0xce, 0x00, 0x37, 0x5a, 0x4d, 0xb6, 0x36, 0xc6, 0x98,
+		0xf5, 0x7c, 0xe8, 0xd0, 0x7d, 0xc4, 0x62, 0x0d, 0x17, 0xcd, 0x4a, 0x9a, 0xbb, 0xe1, 0x1a, 0xa7,
+		0x44, 0x87, 0x05, 0xd5, 0x9a, 0x72, 0xb7, 0x27, 0xa3, 0x89, 0x2f, 0x72, 0x05, 0xd2, 0xaf, 0x3a,
+		0x8c, 0x77, 0x9a, 0xf1, 0x7e, 0xd7, 0xe1, 0xbc, 0xaf, 0x35, 0x18, 0xf3, 0xec, 0xb5, 0x86, 0xbe,
+		0xbe, 0xa1, 0xad, 0x55, 0x57, 0x35, 0x41, 0x4e, 0x4e, 0x40, 0xb2, 0x63, 0xbc, 0x7e, 0x10, 0x5e,
+		0x5e, 0x19, 0x88, 0x2c, 0xc2, 0x4c, 0xdf, 0xbc, 0x49, 0x7b, 0xed, 0xdd, 0x36, 0x0e, 0x15, 0x62,
+		0xcd, 0x04, 0xb1, 0x8a, 0x7e, 0xe9, 0x2a, 0xe2, 0x4f, 0xa8, 0x1e, 0x27, 0x20, 0x89, 0x41, 0xc5,
+		0xf0, 0x22, 0xc8, 0x40, 0xe4, 0x0c, 0xe4, 0x5b, 0x74, 0xa7, 0xbf, 0xa7, 0xf7, 0x68, 0xcb, 0x68,
+		0xba, 0x61, 0xd3, 0x9f, 0x63, 0x45, 0x1a, 0x2b, 0x21, 0x2f, 0x40, 0x16, 0xc7, 0xc8, 0x64, 0x63,
+		0x3c, 0xcb, 0x44, 0xf0, 0xc4, 0xe1, 0x22, 0xe0, 0xff, 0x6b, 0x92, 0x48, 0xf3, 0xe9, 0xc9, 0x65,
+		0x48, 0xbb, 0x46, 0x6f, 0x8f, 0xba, 0xcc, 0xf2, 0x17, 0xcf, 0x2f, 0x4e, 0xc2, 0x69, 0x8b, 0x51,
+		0xb0, 0x3d, 0xad, 0xa0, 0x7e, 0x07, 0xad, 0xcc, 0x39, 0x48, 0x31, 0xf5, 0x20, 0x00, 0x42, 0x41,
+		0x94, 0x29, 0x92, 0x81, 0x64, 0x6d, 0x43, 0x43, 0x4b, 0xa3, 0x40, 0x9e, 0x43, 0xf5, 0xcd, 0x95,
+		0x7a, 0xad, 0xae, 0xc4, 0xd5, 0x0b, 0x90, 0xe6, 0x63, 0x8e, 0x56, 0xc8, 0x1b, 0x75, 0x65, 0x4a,
+		0x7c, 0x0a, 0x1e, 0x31, 0x59, 0xba, 0xbd, 0xb6, 0x54, 0xd7, 0x94, 0xb8, 0xba, 0x0d, 0x33, 0x03,
+		0x72, 0x22, 0xc7, 0x60, 0x56, 0xab, 0x6f, 0xd5, 0xd7, 0x71, 0x9f, 0xa5, 0x6f, 0xaf, 0xbf, 0xb0,
+		0xbe, 0xf1, 0x81, 0x75, 0x65, 0x2a, 0x0c, 0x96, 0x26, 0x2d, 0x46, 0xe6, 0x41, 0xf1, 0xc1, 0x8d,
+		0x8d, 0x6d, 0x8d, 0xb5, 0xe6, 0xdb, 0xe2, 0xa0, 0x0c, 0x4a, 0x8d, 0xdc, 0x07, 0x73, 0x5b, 0x55,
+		0xed, 0x4a, 0x7d, 0x4b, 0xe7, 0x7b, 0x47, 0x8f, 0xf5, 0x3c, 0x28, 0xc1, 0x82, 0xcb, 0x2b, 0x6c,
+		0x6b, 0x7c, 0x1a, 0x4e, 0x06, 0xa1, 0xf5, 0x97, 0xb6, 0xea, 0xeb, 0x0d, 0x56, 0x79, 0x75, 0xfd,
+		0x0a, 0xda, 0xd7, 0x01, 0x7e, 0x72, 0xb7, 0x9a, 0xc0, 0xa6, 0x86, 0xf9, 0xd5, 0x57, 0x97, 0x95,
+		0xe4, 0x20, 0x78, 0x63, 0xbd, 0xbe, 0x71, 0x59, 0x49, 0x0d, 0xd6, 0xce, 0x76, 0xb0, 0x69, 0x52,
+		0x86, 0xe3, 0x83, 0x50, 0xbd, 0xbe, 0xbe, 0xa5, 0xbd, 0xac, 0x4c, 0x0f, 0x56, 0xdc, 0xa8, 0x6b,
+		0xd7, 0x57, 0x6a, 0x75, 0x25, 0x43, 0x8e, 0x03, 0x09, 0xb7, 0x68, 0xeb, 0xea, 0xc6, 0xb2, 0x92,
+		0x1d, 0xb2, 0x28, 0xaa, 0x03, 0xf9, 0xe0, 0x36, 0xf2, 0xab, 0x13, 0x4b, 0xfa, 0x68, 0x1c, 0x72,
+		0x81, 0x6d, 0x21, 0xfa, 0xf3, 0x46, 0xa7, 0x63, 0xdd, 0xd2, 0x8d, 0x4e, 0xdb, 0x70, 0x84, 0xbd,
+		0x01, 0x06, 0xaa, 0x22, 0x64, 0xd2, 0xf9, 0x3d, 0xb9, 0x85, 0x4f, 0xff, 0x59, 0xb4, 0xf0, 0x29,
+		0x25, 0xad, 0xfe, 0x40, 0x0c, 0x94, 0xc1, 0xfd, 0xde, 0x40, 0xf7, 0x63, 0xe3, 0xba, 0xff, 0x55,
+		0x19, 0xbb, 0x8f, 0xc7, 0xa0, 0x18, 0xde, 0xe4, 0x0d, 0x34, 0xef, 0xc1, 0x3f, 0xd5, 0xe6, 0xfd,
+		0x66, 0x1c, 0x0a, 0xa1, 0xad, 0xdd, 0xa4, 0xad, 0x7b, 0x0d, 0x66, 0xdb, 0x2d, 0xda, 0xb5, 0x2d,
+		0x17, 0x4f, 0x9b, 0xf4, 0x0e, 0xbd, 0x49, 0x3b, 0x25, 0x95, 0x19, 0xe5, 0x73, 0x87, 0x6f, 0x1e,
+		0x17, 0x57, 0x7c, 0xba, 0x55, 0x24, 0xab, 0xcc, 0xad, 0x2c, 0xd7, 0xd7, 0x36, 0x37, 0xb6, 0xea,
+		0xeb, 0xb5, 0x97, 0xa5, 0x75, 0xd1, 0x94, 0xf6, 0x00, 0xda, 0x3b, 0x68, 0xb4, 0x37, 0x41, 0x19,
+		0x6c, 0x14, 0xda, 0x8a, 0x11, 0xcd, 0x52, 0xa6, 0xc8, 0x1c, 0xcc, 0xac, 0x6f, 0xe8, 0x8d, 0x95,
+		0xe5, 0xba, 0x5e, 0xbf, 0x7c, 0xb9, 0x5e, 0xdb, 0x6a, 0xf0, 0x70, 0xa0, 0x87, 0xbd, 0xa5, 0xc4,
+		0x83, 0x22, 0xfe, 0xfe, 0x04, 0xcc, 0x8d, 0x68, 0x09, 0xa9, 0x8a, 0x8d, 0x3c, 0x8f, 0x2d, 0x3c,
+		0x31, 0x49, 0xeb, 0x17, 0xd1, 0x95, 0xde, 0x34, 0x7a, 0xae, 0xd8, 0xf7, 0x3f, 0x06, 0x28, 0x25,
+		0xd3, 0xc5, 0x95, 0xbd, 0x27, 0xc2, 0xac, 0x7c, 0x77, 0x3f, 0xe3, 0xc3, 0x79, 0xa4, 0xf5, 0xdd,
+		0x40, 0x6c, 0xcb, 0x69, 0xbb, 0xed, 0x9b, 0x78, 0x86, 0x25, 0x63, 0xb2, 0xb8, 0xdb, 0x4f, 0x6a,
+		0x8a, 0x2c, 0x59, 0x31, 0x5d, 0x0f, 0xdb, 0xa4, 0x7b, 0xc6, 0x00, 0x36, 0x7a, 0x1e, 0x09, 0x4d,
+		0x91, 0x25, 0x1e, 0xf6, 0x83, 0x90, 0x6f, 0x59, 0x7d, 0xdc, 0x02, 0x71, 0x3c, 0xb4, 0x16, 0x31,
+		0x2d, 0xc7, 0x61, 0x1e, 0x8a, 0xd8, 0xdc, 0xfa, 0xc1, 0xe0, 0xbc, 0x96, 0xe3, 0x30, 0x8e, 0xf2,
+		0x28, 0xcc, 0x18, 0x7b, 0x7b, 0x3d, 0x64, 0x2e, 0x19, 0xf1, 0xed, 0x7a, 0xd1, 0x03, 0x33, 0xc4,
+		0xf2, 0x35, 0xc8, 0x48, 0x39, 0xa0, 0x07, 0x8b, 0x92, 0xd0, 0x6d, 0x1e, 0x83, 0x8a, 0x63, 0x7c,
+		0xd8, 0x94, 0x85, 0x0f, 0x42, 0xbe, 0xed, 0xe8, 0xfe, 0xd9, 0x56, 0x7c, 0x21, 0x7e, 0x26, 0xa3,
+		0xe5, 0xda, 0x8e, 0x77, 0x2e, 0xa0, 0x7e, 0x2a, 0x0e, 0xc5, 0xf0, 0xa9, 0x1d, 0x59, 0x86, 0x4c,
+		0xc7, 0x6a, 0x1a, 0x4c, 0xb5, 0xf8, 0x91, 0xf1, 0x99, 0x88, 0x83, 0xbe, 0xc5, 0x55, 0x81, 0xaf,
+		0x79, 0x94, 0xe5, 0x7f, 0x13, 0x83, 0x8c, 0x04, 0x93, 0xe3, 0x90, 0xb4, 0x0d, 0x77, 0x9f, 0xb1,
+		0x4b, 0x2d, 0xc5, 0x95, 0x98, 0xc6, 0xbe, 0x11, 0xee, 0xd8, 0x86, 0x59, 0x8a, 0xfb, 0x70, 0xfc,
+		0xc6, 0x71, 0xed, 0x50, 0xa3, 0xc5, 0x62, 0x01, 0x56, 0xb7, 0x4b, 0x4d, 0xd7, 0x91, 0xe3, 0x2a,
+		0xe0, 0x35, 0x01, 0xc6, 0xc3, 0x63, 0xb7, 0x67, 0xb4, 0x3b, 0x21, 0xdc, 0x24, 0xc3, 0x55, 0x64,
+		0x81, 0x87, 0x5c, 0x81, 0x13, 0x92, 0x6f, 0x8b, 0xba, 0x46, 0x73, 0x9f, 0xb6, 0x7c, 0xa2, 0x34,
+		0x8b, 0xf9, 0xdd, 0x27, 0x10, 0x96, 0x45, 0xb9, 0xa4, 0x55, 0x3f, 0x17, 0x87, 0x59, 0x19, 0xbd,
+		0x68, 0x79, 0xc2, 0x5a, 0x03, 0x30, 0x4c, 0xd3, 0x72, 0x83, 0xe2, 0x1a, 0x56, 0xe5, 0x21, 0xba,
+		0xc5, 0xaa, 0x47, 0xa4, 0x05, 0x18, 0x94, 0x7f, 0x37, 0x06, 0xe0, 0x17, 0x8d, 0x95, 0xdb, 0x69,
+		0xc8, 0x89, 0x33, 0x59, 0x76, 0xb0, 0xcf, 0x03, 0x5e, 0xc0, 0x41, 0x18, 0xe7, 0xc0, 0xb0, 0xe4,
+		0x0e, 0xdd, 0x6b, 0x9b, 0xe2, 0x3c, 0x85, 0x7f, 0xc8, 0xb0, 0x64, 0xd2, 0x3f, 0x9e, 0xd2, 0x20,
+		0xe3, 0xd0, 0xae, 0x61, 0xba, 0xed, 0xa6, 0x38, 0x21, 0xb9, 0x78, 0xa4, 0xc6, 0x2f, 0x36, 0x04,
+		0xb5, 0xe6, 0xf1, 0x51, 0xcf, 0x40, 0x46, 0x42, 0xd1, 0xf1, 0x5b, 0xdf, 0x58, 0xaf, 0x2b, 0x53,
+		0x64, 0x1a, 0x12, 0x8d, 0xfa, 0x96, 0x12, 0xc3, 0x6d, 0x67, 0x75, 0x75, 0xa5, 0xda, 0x50, 0xe2,
+		0x4b, 0x7f, 0x05, 0xe6, 0x9a, 0x56, 0x77, 0xb0, 0xc2, 0x25, 0x65, 0x20, 0xe4, 0xe7, 0x5c, 0x8d,
+		0xbd, 0xf2, 0x84, 0x40, 0xda, 0xb3, 0x3a, 0x86, 0xb9, 0xb7, 0x68, 0xf5, 0xf6, 0xfc, 0xb4, 0x08,
+		0xdc, 0x1d, 0x38, 0x81, 0xe4, 0x08, 0x7b, 0xe7, 0x4f, 0x62, 0xb1, 0x1f, 0x8a, 0x27, 0xae, 0x6c,
+		0x2e, 0xfd, 0x78, 0xbc, 0x7c, 0x85, 0x13, 0x6e, 0xca, 0xee, 0x68, 0x74, 0xb7, 0x43, 0x9b, 0xd8,
+		0x78, 0xf8, 0xbd, 0xc7, 0x61, 0x7e, 0xcf, 0xda, 0xb3, 0x18, 0xa7, 0x73, 0xf8, 0x17, 0x6f, 0x04,
+		0xc9, 0x7a, 0xd0, 0x72, 0x64, 0x12, 0x46, 0x65, 0x1d, 0xe6, 0x04, 0xb2, 0xce, 0x8e, 0x6f, 0x79,
+		0x70, 0x81, 0x1c, 0x1a, 0xd9, 0x2e, 0xfd, 0xe4, 0x6f, 0x33, 0xaf, 0x44, 0x9b, 0x15, 0xa4, 0x58,
+		0xc6, 0xe3, 0x0f, 0x15, 0x0d, 0x8e, 0x85, 0xf8, 0x71, 0x1b, 0x41, 0x7b, 0x11, 0x1c, 0xff, 0xb9,
+		0xe0, 0x38, 0x17, 0xe0, 0xd8, 0x10, 0xa4, 0x95, 0x1a, 0x14, 0x8e, 0xc2, 0xeb, 0x5f, 0x08, 0x5e,
+		0x79, 0x1a, 0x64, 0x72, 0x05, 0x66, 0x18, 0x93, 0x66, 0xdf, 0x71, 0xad, 0x2e, 0x33, 0xc0, 0x87,
+		0xb3, 0xf9, 0x97, 0xbf, 0xcd, 0x27, 0x6d, 0x11, 0xc9, 0x6a, 0x1e, 0x55, 0xa5, 0x02, 0xec, 0xc4,
+		0x1a, 0x4f, 0x92, 0x23, 0x38, 0xfc, 0xb2, 0x68, 0x88, 0x87, 0x5f, 0xb9, 0x0e, 0xf3, 0xf8, 0x37,
+		0xb3, 0x8f, 0xc1, 0x96, 0x44, 0x87, 0xc1, 0x4b, 0xff, 0xf6, 0xc3, 0xdc, 0x2e, 0xcc, 0x79, 0x0c,
+		0x02, 0x6d, 0x0a, 0x8c, 0xe2, 0x1e, 0x75, 0x5d, 0xda, 0x73, 0x74, 0xa3, 0x33, 0xaa, 0x79, 0x81,
+		0x38, 0x62, 0xe9, 0xfb, 0xbe, 0x1c, 0x1e, 0xc5, 0x2b, 0x9c, 0xb2, 0xda, 0xe9, 0x54, 0xb6, 0xe1,
+		0xbe, 0x11, 0x5a, 0x31, 0x01, 0xcf, 0xef, 0x17, 0x3c, 0xe7, 0x87, 0x34, 0x03, 0xd9, 0x6e, 0x82,
+		0x84, 0x7b, 0x63, 0x39, 0x01, 0xcf, 0x8f, 0x09, 0x9e, 0x44, 0xd0, 0xca, 0x21, 0x45, 0x8e, 0xd7,
+		0x60, 0xf6, 0x26, 0xed, 0xed, 0x58, 0x8e, 0x88, 0xdd, 0x4e, 0xc0, 0xee, 0xe3, 0x82, 0xdd, 0x8c,
+		0x20, 0x64, 0xc1, 0x5c, 0xe4, 0xf5, 0x1c, 0x64, 0x76, 0x8d, 0x26, 0x9d, 0x80, 0xc5, 0x5d, 0xc1,
+		0x62, 0x1a, 0xf1, 0x91, 0xb4, 0x0a, 0xf9, 0x3d, 0x4b, 0x2c, 0x91, 0xd1, 0xe4, 0x3f, 0x20, 0xc8,
+		0x73, 0x92, 0x46, 0xb0, 0xb0, 0x2d, 0xbb, 0xdf, 0xc1, 0xf5, 0x33, 0x9a, 0xc5, 0x0f, 0x4a, 0x16,
+		0x92, 0x46, 0xb0, 0x38, 0x82, 0x58, 0x3f, 0x21, 0x59, 0x38, 0x01, 0x79, 0x3e, 0x8f, 0x47, 0xba,
+		0x9d, 0x03, 0xcb, 0x9c, 0xa4, 0x11, 0x9f, 0x14, 0x1c, 0x40, 0x90, 0x20, 0x83, 0x4b, 0x90, 0x9d,
+		0x74, 0x20, 0x7e, 0xe4, 0xcb, 0x72, 0x7a, 0xc8, 0x11, 0xb8, 0x02, 0x33, 0xd2, 0x40, 0x61, 0x0a,
+		0x48, 0x34, 0x8b, 0x1f, 0x15, 0x2c, 0x8a, 0x01, 0x32, 0xd1, 0x0d, 0x97, 0x3a, 0xee, 0x1e, 0x9d,
+		0x84, 0xc9, 0xa7, 0x64, 0x37, 0x04, 0x89, 0x10, 0xe5, 0x0e, 0x35, 0x9b, 0xfb, 0x93, 0x71, 0xf8,
+		0xb4, 0x14, 0xa5, 0xa4, 0x41, 0x16, 0x35, 0x28, 0x74, 0x8d, 0x9e, 0xb3, 0x6f, 0x74, 0x26, 0x1a,
+		0x8e, 0x1f, 0x13, 0x3c, 0xf2, 0x1e, 0x91, 0x90, 0x48, 0xdf, 0x3c, 0x0a, 0x9b, 0x1f, 0x97, 0x12,
+		0xe9, 0x9b, 0x21, 0x46, 0x9b, 0x30, 0xef, 0xb8, 0x2c, 0xd0, 0x7d, 0x14, 0x6e, 0x7f, 0x5b, 0x4e,
+		0x3d, 0x4e, 0xbb, 0x16, 0xe4, 0x78, 0x09, 0xb2, 0x4e, 0xfb, 0xf5, 0x89, 0xd8, 0xfc, 0x1d, 0x39,
+		0xd2, 0x8c, 0x00, 0x89, 0x5f, 0x86, 0x13, 0x23, 0x97, 0x89, 0x09, 0x98, 0xfd, 0x5d, 0xc1, 0xec,
+		0xf8, 0x88, 0xa5, 0x42, 0x98, 0x84, 0xa3, 0xb2, 0xfc, 0x7b, 0xd2, 0x24, 0xd0, 0x01, 0x5e, 0x9b,
+		0xb8, 0x69, 0x71, 0x8c, 0xdd, 0xa3, 0x49, 0xed, 0xef, 0x4b, 0xa9, 0x71, 0xda, 0x90, 0xd4, 0xb6,
+		0xe0, 0xb8, 0xe0, 0x78, 0xb4, 0x71, 0xfd, 0x09, 0x69, 0x58, 0x39, 0xf5, 0x76, 0x78, 0x74, 0xbf,
+		0x06, 0xca, 0x9e, 0x38, 0xa5, 0x77, 0xec, 0xe8, 0x18, 0x1d, 0x8e, 0xe6, 0xfc, 0x93, 0x82, 0xb3,
+		0xb4, 0xf8, 0x9e, 0x7b, 0xed, 0xac, 0x19, 0x36, 0x32, 0x7f, 0x09, 0x4a, 0x92, 0x79, 0xdf, 0xec,
+		0xd1, 0xa6, 0xb5, 0x67, 0xb6, 0x5f, 0xa7, 0xad, 0x09, 0x58, 0x7f, 0x66, 0x60, 0xa8, 0xb6, 0x03,
+		0xe4, 0xc8, 0x79, 0x05, 0x14, 0xcf, 0x57, 0xd1, 0xdb, 0x5d, 0xdb, 0xea, 0xb9, 0x11, 0x1c, 0x7f,
+		0x4a, 0x8e, 0x94, 0x47, 0xb7, 0xc2, 0xc8, 0x2a, 0x75, 0xe0, 0xd9, 0x1f, 0x93, 0xaa, 0xe4, 0x67,
+		0x05, 0xa3, 0x82, 0x4f, 0x25, 0x0c, 0x47, 0xd3, 0xea, 0xda, 0x46, 0x6f, 0x12, 0xfb, 0xf7, 0x0f,
+		0xa4, 0xe1, 0x10, 0x24, 0xc2, 0x70, 0xa0, 0x47, 0x87, 0xab, 0xfd, 0x04, 0x1c, 0x7e, 0x5a, 0x1a,
+		0x0e, 0x49, 0x23, 0x58, 0x48, 0x87, 0x61, 0x02, 0x16, 0x3f, 0x23, 0x59, 0x48, 0x1a, 0x64, 0xf1,
+		0xa2, 0xbf, 0xd0, 0xf6, 0xe8, 0x5e, 0xdb, 0x71, 0x7b, 0xdc, 0x25, 0x3f, 0x9c, 0xd5, 0xcf, 0x7e,
+		0x39, 0xec, 0x84, 0x69, 0x01, 0x52, 0xb4, 0x44, 0xe2, 0xe8, 0x83, 0x6d, 0xd9, 0xa2, 0x1b, 0xf6,
+		0x73, 0xd2, 0x12, 0x05, 0xc8, 0xb0, 0x6d, 0x01, 0x0f, 0x11, 0xc5, 0xde, 0xc4, 0x8d, 0xca, 0x04,
+		0xec, 0xfe, 0xe1, 0x40, 0xe3, 0x1a, 0x92, 0x16, 0x79, 0x06, 0xfc, 0x9f, 0xbe, 0x79, 0x83, 0x1e,
+		0x4c, 0xa4, 0x9d, 0x3f, 0x3f, 0xe0, 0xff, 0x6c, 0x73, 0x4a, 0x6e, 0x43, 0x66, 0x06, 0xfc, 0x29,
+		0x12, 0x95, 0xeb, 0x57, 0xfa, 0x86, 0x37, 0x45, 0x7f, 0xc3, 0xee, 0x54, 0x65, 0x15, 0x14, 0x01,
+		0xf1, 0x1d, 0xd8, 0x48, 0x66, 0x1f, 0x7e, 0xd3, 0xd3, 0xf3, 0x90, 0xcf, 0x53, 0xb9, 0x0c, 0x85,
+		0x90, 0xc3, 0x13, 0xcd, 0xea, 0x1b, 0x05, 0xab, 0x7c, 0xd0, 0xdf, 0xa9, 0x5c, 0x80, 0x24, 0x3a,
+		0x2f, 0xd1, 0xe4, 0xdf, 0x24, 0xc8, 0x19, 0x7a, 0xe5, 0x7d, 0x90, 0x91, 0x4e, 0x4b, 0x34, 0xe9,
+		0x5f, 0x13, 0xa4, 0x1e, 0x09, 0x92, 0x4b, 0x87, 0x25, 0x9a, 0xfc, 0xaf, 0x4b, 0x72, 0x49, 0x82,
+		0xe4, 0x93, 0x8b, 0xf0, 0x17, 0xbf, 0x35, 0xc9, 0xc9, 0x25, 0x49, 0x05, 0xb3, 0x4f, 0xb8, 0xa7,
+		0x12, 0x4d, 0xfd, 0x2d, 0xa2, 0x72, 0x49, 0x51, 0x79, 0x16, 0x52, 0x13, 0x0a, 0xfc, 0xdb, 0x05,
+		0x29, 0xc7, 0xaf, 0xd4, 0x20, 0x17, 0xf0, 0x4e, 0xa2, 0xc9, 0xbf, 0x43, 0x90, 0x07, 0xa9, 0xb0,
+		0xe9, 0xc2, 0x3b, 0x89, 0x66, 0xf0, 0x37, 0x64, 0xd3, 0x05, 0x05, 0x8a, 0x4d, 0x3a, 0x26, 0xd1,
+		0xd4, 0x1f, 0x91, 0x52, 0x97, 0x24, 0x95, 0xe7, 0x21, 0xeb, 0x2d, 0x36, 0xd1, 0xf4, 0xdf, 0x29,
+		0xe8, 0x7d, 0x1a, 0x94, 0x40, 0xdf, 0x3c, 0x02, 0x8b, 0xbf, 0x29, 0x25, 0x10, 0xa0, 0xc2, 0x69,
+		0x34, 0xe8, 0xc0, 0x44, 0x73, 0xfa, 0x2e, 0x39, 0x8d, 0x06, 0xfc, 0x17, 0x1c, 0x4d, 0x66, 0xf3,
+		0xa3, 0x59, 0x7c, 0xb7, 0x1c, 0x4d, 0x86, 0x8f, 0xcd, 0x18, 0xf4, 0x08, 0xa2, 0x79, 0x7c, 0xaf,
+		0x6c, 0xc6, 0x80, 0x43, 0x50, 0xd9, 0x04, 0x32, 0xec, 0x0d, 0x44, 0xf3, 0xfb, 0xa8, 0xe0, 0x37,
+		0x3b, 0xe4, 0x0c, 0x54, 0x3e, 0x00, 0xc7, 0x47, 0x7b, 0x02, 0xd1, 0x5c, 0xbf, 0xef, 0xcd, 0x81,
+		0xbd, 0x5b, 0xd0, 0x11, 0xa8, 0x6c, 0xc1, 0xfc, 0x28, 0x2f, 0x20, 0x9a, 0xed, 0xf7, 0xbf, 0x19,
+		0x36, 0xdc, 0x41, 0x27, 0xa0, 0x52, 0x05, 0xf0, 0x17, 0xe0, 0x68, 0x5e, 0x1f, 0x17, 0xbc, 0x02,
+		0x44, 0x38, 0x35, 0xc4, 0xfa, 0x1b, 0x4d, 0x7f, 0x57, 0x4e, 0x0d, 0x41, 0x81, 0x53, 0x43, 0x2e,
+		0xbd, 0xd1, 0xd4, 0x3f, 0x20, 0xa7, 0x86, 0x24, 0x41, 0xcd, 0x0e, 0xac, 0x6e, 0xd1, 0x1c, 0x3e,
+		0x29, 0x35, 0x3b, 0x40, 0x55, 0x59, 0x87, 0xd9, 0xa1, 0x05, 0x31, 0x9a, 0xd5, 0x0f, 0x09, 0x56,
+		0xca, 0xe0, 0x7a, 0x18, 0x5c, 0xbc, 0xc4, 0x62, 0x18, 0xcd, 0xed, 0x87, 0x07, 0x16, 0x2f, 0xb1,
+		0x16, 0x56, 0x2e, 0x41, 0xc6, 0xec, 0x77, 0x3a, 0x38, 0x79, 0xc8, 0xe1, 0xf9, 0xb9, 0xa5, 0xff,
+		0xfa, 0x15, 0x21, 0x1d, 0x49, 0x50, 0xb9, 0x00, 0x29, 0xda, 0xdd, 0xa1, 0xad, 0x28, 0xca, 0x2f,
+		0x7d, 0x45, 0x1a, 0x4c, 0xc4, 0xae, 0x3c, 0x0f, 0xc0, 0x43, 0x23, 0xec, 0xe0, 0x3c, 0x82, 0xf6,
+		0x77, 0xbf, 0x22, 0x12, 0xe2, 0x7c, 0x12, 0x9f, 0x01, 0x4f, 0xaf, 0x3b, 0x9c, 0xc1, 0x97, 0xc3,
+		0x0c, 0xd8, 0x88, 0x3c, 0x07, 0xd3, 0x78, 0x90, 0xe6, 0x1a, 0x7b, 0x51, 0xd4, 0xbf, 0x27, 0xa8,
+		0x25, 0x3e, 0x0a, 0xac, 0x6b, 0xf5, 0xa8, 0x6b, 0xec, 0x39, 0x51, 0xb4, 0xff, 0x4d, 0xd0, 0x7a,
+		0x04, 0x48, 0xdc, 0x34, 0x1c, 0x77, 0x92, 0x7e, 0xff, 0x77, 0x49, 0x2c, 0x09, 0xb0, 0xd1, 0xf8,
+		0xf7, 0x0d, 0x7a, 0x10, 0x45, 0xfb, 0xfb, 0xb2, 0xd1, 0x02, 0xbf, 0xf2, 0x3e, 0xc8, 0xe2, 0x9f,
+		0x3c, 0xcb, 0x35, 0x82, 0xf8, 0x0f, 0x04, 0xb1, 0x4f, 0x81, 0x35, 0x3b, 0x6e, 0xcb, 0x6d, 0x47,
+		0x0b, 0xfb, 0x0f, 0xc5, 0x48, 0x4b, 0xfc, 0x4a, 0x15, 0x72, 0x8e, 0xdb, 0x6a, 0xf5, 0x85, 0x7f,
+		0x1a, 0x41, 0xfe, 0x47, 0x5f, 0xf1, 0x42, 0x16, 0x1e, 0x0d, 0x8e, 0xf6, 0xad, 0x1b, 0xae, 0x6d,
+		0xb1, 0xf3, 0x96, 0x28, 0x0e, 0x6f, 0x0a, 0x0e, 0x01, 0x92, 0x4a, 0x0d, 0xf2, 0xd8, 0x97, 0x1e,
+		0xb5, 0x29, 0x3b, 0x1c, 0x8b, 0x60, 0xf1, 0xc7, 0x42, 0x00, 0x21, 0xa2, 0xa5, 0xaf, 0xff, 0xe5,
+		0xcf, 0x9f, 0x8a, 0x7d, 0xee, 0xf3, 0xa7, 0x62, 0xbf, 0xf9, 0xf9, 0x53, 0xb1, 0x8f, 0x7c, 0xe1,
+		0xd4, 0xd4, 0xe7, 0xbe, 0x70, 0x6a, 0xea, 0xd7, 0xbf, 0x70, 0x6a, 0x6a, 0x74, 0x94, 0x18, 0xae,
+		0x58, 0x57, 0x2c, 0x1e, 0x1f, 0x7e, 0xe5, 0xe1, 0xbd, 0xb6, 0xbb, 0xdf, 0xdf, 0x59, 0x6c, 0x5a,
+		0xdd, 0x73, 0x4d, 0xcb, 0xe9, 0x5a, 0xce, 0xb9, 0x70, 0x5c, 0x97, 0xfd, 0x05, 0xff, 0x3b, 0x06,
+		0x27, 0x38, 0x1b, 0x3f, 0x9c, 0x6b, 0x98, 0x07, 0xe3, 0x2e, 0xd3, 0x5d, 0x84, 0x44, 0xd5, 0x3c,
+		0x20, 0x27, 0xb8, 0x81, 0xd3, 0xfb, 0xbd, 0x8e, 0x48, 0xb5, 0x9c, 0xc6, 0xef, 0xed, 0x5e, 0x07,
+		0xc3, 0xec, 0x32, 0x1f, 0x1a, 0x8f, 0x73, 0xf8, 0xc7, 0xd2, 0x77, 0xc4, 0x8e, 0xd6, 0x93, 0x4c,
+		0xd5, 0x3c, 0x60, 0x1d, 0xd9, 0x8c, 0xbd, 0xf2, 0xee, 0xc8, 0x38, 0xf7, 0x0d, 0xd3, 0xba, 0x65,
+		0x62, 0xb3, 0xed, 0x1d, 0x19, 0xe3, 0x3e, 0x35, 0x18, 0xe3, 0xfe, 0x00, 0xed, 0x74, 0x5e, 0x40,
+		0x3c, 0x4c, 0x5f, 0x70, 0x76, 0xd2, 0x3c, 0xab, 0x1f, 0xbe, 0x2b, 0x0e, 0xa7, 0x86, 0xc2, 0xd9,
+		0x42, 0x09, 0xc6, 0x09, 0xa1, 0x02, 0x99, 0x65, 0xa9, 0x5b, 0x25, 0xbc, 0xca, 0xd6, 0xb4, 0xcc,
+		0x96, 0xc3, 0x04, 0x91, 0xd0, 0xe4, 0x27, 0x0a, 0xc2, 0x34, 0x4c, 0xcb, 0x11, 0xc9, 0xca, 0xfc,
+		0x63, 0xe9, 0x63, 0x47, 0x14, 0x44, 0x41, 0xd6, 0x24, 0xa5, 0xf1, 0xd4, 0x84, 0xd2, 0x90, 0x9d,
+		0x08, 0x45, 0xfe, 0x27, 0x95, 0xca, 0xf7, 0xc6, 0xe1, 0xf4, 0xa0, 0x54, 0x70, 0x66, 0x39, 0xae,
+		0xd1, 0xb5, 0xc7, 0x89, 0xe5, 0x12, 0x64, 0xb7, 0x24, 0xce, 0x91, 0xe5, 0x72, 0xf7, 0x88, 0x72,
+		0x29, 0x7a, 0x55, 0x49, 0xc1, 0x9c, 0x9f, 0x50, 0x30, 0x5e, 0x3f, 0xee, 0x49, 0x32, 0xff, 0x2b,
+		0x0d, 0x27, 0xf8, 0x74, 0xd2, 0xf9, 0x54, 0xe2, 0x1f, 0x42, 0x26, 0xf9, 0x60, 0x51, 0xf4, 0x39,
+		0x89, 0xfa, 0x02, 0xcc, 0xad, 0xa0, 0xb5, 0xc0, 0x5d, 0x90, 0x7f, 0xc2, 0x33, 0x32, 0x9f, 0x7b,
+		0x21, 0xe4, 0xf0, 0x8b, 0xf3, 0xad, 0x20, 0x48, 0xfd, 0x86, 0x18, 0x28, 0x8d, 0xa6, 0xd1, 0x31,
+		0x7a, 0x6f, 0x95, 0x15, 0x79, 0x16, 0x80, 0xa7, 0x7b, 0x78, 0x17, 0xf7, 0x8a, 0xe7, 0x4b, 0x8b,
+		0xc1, 0xce, 0x2d, 0xf2, 0x9a, 0x50, 0x38, 0x5a, 0x96, 0xe1, 0xe2, 0x9f, 0x67, 0x5f, 0x02, 0xf0,
+		0x0b, 0xc8, 0x49, 0xb8, 0xaf, 0x51, 0xab, 0xae, 0x56, 0x35, 0x99, 0x24, 0xd4, 0xd8, 0xac, 0xd7,
+		0x56, 0x2e, 0xaf, 0xd4, 0x97, 0x95, 0x29, 0xcc, 0xaf, 0x09, 0x16, 0x7a, 0x49, 0x4d, 0xc7, 0x60,
+		0x36, 0x08, 0xe7, 0xb7, 0x54, 0xe2, 0xe8, 0x29, 0xb6, 0xbb, 0x76, 0x87, 0xb2, 0x93, 0x47, 0xbd,
+		0x2d, 0xa5, 0x16, 0xed, 0x84, 0xfc, 0xca, 0xbf, 0xe7, 0x37, 0x17, 0xe6, 0x7c, 0x72, 0x4f, 0xe6,
+		0x95, 0x55, 0x98, 0xc5, 0x5c, 0x4a, 0x3b, 0xc4, 0x32, 0xc2, 0x54, 0x23, 0x43, 0x76, 0x96, 0x2a,
+		0x28, 0x7d, 0x6e, 0xcf, 0x42, 0xda, 0x61, 0xbd, 0x8f, 0x62, 0xf1, 0xab, 0x82, 0x85, 0x40, 0xaf,
+		0x98, 0x30, 0x8b, 0x9e, 0x1f, 0x06, 0x88, 0xfc, 0x66, 0x1c, 0x1e, 0x67, 0xf8, 0x47, 0x3f, 0xf5,
+		0x24, 0x3b, 0x59, 0x7d, 0x30, 0x3c, 0x2c, 0x23, 0xd4, 0x49, 0x53, 0x04, 0x6f, 0xbf, 0xa1, 0x14,
+		0x8a, 0xb2, 0x3e, 0xd1, 0xe0, 0xc3, 0x2b, 0xfb, 0x05, 0x51, 0xd9, 0xa9, 0x51, 0x3a, 0x10, 0xa8,
+		0xa9, 0x20, 0xb8, 0xf2, 0x82, 0xa5, 0xfa, 0xb8, 0x39, 0xfd, 0xca, 0xe3, 0xc3, 0xab, 0x13, 0xff,
+		0xef, 0x09, 0xc6, 0xf9, 0x52, 0xb0, 0x1a, 0x6f, 0xee, 0x7d, 0x2c, 0x09, 0xb3, 0x98, 0xfe, 0x6f,
+		0x9d, 0x63, 0xff, 0x8a, 0x39, 0x97, 0x62, 0x1f, 0x13, 0x1c, 0x4a, 0x5e, 0xe4, 0x53, 0x21, 0x5a,
+		0x63, 0xfe, 0xe0, 0xdb, 0x7e, 0x34, 0xe5, 0x4f, 0x97, 0xca, 0x1a, 0x28, 0x32, 0x89, 0x97, 0x9a,
+		0x4d, 0xab, 0x35, 0x51, 0x94, 0xe2, 0x0f, 0x25, 0x0f, 0x19, 0xdf, 0xaa, 0x0b, 0xd2, 0xca, 0x7b,
+		0xf1, 0xa4, 0x4f, 0xb0, 0x89, 0xf2, 0x4c, 0x24, 0x13, 0x8f, 0x02, 0xfd, 0x12, 0x3e, 0x33, 0x27,
+		0xf1, 0x42, 0xdf, 0x94, 0xf4, 0x7c, 0x86, 0x62, 0x86, 0x44, 0xe5, 0x0a, 0x14, 0x5b, 0x96, 0xe9,
+		0xea, 0x56, 0xb7, 0xed, 0xd2, 0xae, 0xed, 0x46, 0xfa, 0x75, 0x7f, 0xcc, 0x99, 0x64, 0xb4, 0x02,
+		0xd2, 0x6d, 0x48, 0x32, 0x6c, 0x09, 0xbf, 0xa7, 0x37, 0x49, 0x4b, 0xfe, 0x87, 0xd7, 0x12, 0x46,
+		0x83, 0x2d, 0xb9, 0x27, 0xed, 0x70, 0x5a, 0x37, 0x84, 0x31, 0x77, 0x6f, 0x73, 0x2d, 0xf0, 0xb4,
+		0xe3, 0x53, 0x09, 0x38, 0x25, 0x90, 0x77, 0x0c, 0x87, 0x9e, 0xbb, 0xf9, 0xd4, 0x0e, 0x75, 0x8d,
+		0xa7, 0xce, 0x35, 0xad, 0xb6, 0x5c, 0xc9, 0xe7, 0x78, 0xf9, 0x22, 0x96, 0x2f, 0x8a, 0xf2, 0xf2,
+		0xc8, 0xe3, 0xee, 0xf2, 0x78, 0x23, 0x5f, 0x1e, 0xd6, 0x41, 0xb5, 0x03, 0xc9, 0x9a, 0xd5, 0x36,
+		0x71, 0x6d, 0x6b, 0x51, 0xd3, 0xea, 0x0a, 0x73, 0xcb, 0x3f, 0xc8, 0x55, 0x48, 0x1b, 0x5d, 0xab,
+		0x6f, 0xba, 0xdc, 0xd4, 0x2e, 0x3d, 0xf9, 0xcb, 0x6f, 0x9c, 0x9e, 0xfa, 0x4f, 0x6f, 0x9c, 0x3e,
+		0xc6, 0xd9, 0x3a, 0xad, 0x1b, 0x8b, 0x6d, 0xeb, 0x5c, 0xd7, 0x70, 0xf7, 0x71, 0xfa, 0xfe, 0xda,
+		0x67, 0x9f, 0x00, 0x51, 0xdf, 0x8a, 0xe9, 0x7e, 0xfa, 0x77, 0x7e, 0xe2, 0x6c, 0x4c, 0x13, 0xf4,
+		0x95, 0xe4, 0x17, 0x3f, 0x71, 0x3a, 0xa6, 0xda, 0x30, 0xbd, 0x4c, 0x9b, 0x87, 0x54, 0xb8, 0x32,
+		0x50, 0xe1, 0x53, 0xa2, 0xc2, 0x93, 0xc3, 0x15, 0xf2, 0x84, 0xbd, 0x65, 0xda, 0x0c, 0x54, 0xbb,
+		0x4c, 0x9b, 0xe1, 0x1a, 0x97, 0x96, 0x7f, 0xfd, 0xb7, 0x4e, 0x4d, 0x7d, 0xe8, 0xf3, 0xa7, 0xa6,
+		0xc6, 0x0e, 0x99, 0x1a, 0x3d, 0x64, 0xde, 0x48, 0xfd, 0x68, 0x12, 0x1e, 0x60, 0x37, 0x42, 0x7b,
+		0xdd, 0xb6, 0xe9, 0x9e, 0x6b, 0xf6, 0x0e, 0x6c, 0xd7, 0xc2, 0x89, 0x6b, 0xed, 0x8a, 0x81, 0x9a,
+		0xf5, 0x8b, 0x17, 0x79, 0xf1, 0xe8, 0x61, 0x52, 0x77, 0x21, 0xb5, 0x89, 0x74, 0x28, 0x08, 0xd7,
+		0x72, 0x8d, 0x8e, 0xf0, 0x36, 0xf8, 0x07, 0x42, 0xf9, 0x2d, 0xd2, 0x38, 0x87, 0xb6, 0xe5, 0x05,
+		0xd2, 0x0e, 0x35, 0x76, 0xf9, 0x65, 0x9c, 0x04, 0x73, 0x53, 0x33, 0x08, 0x60, 0xf7, 0x6e, 0xe6,
+		0x21, 0x65, 0xf4, 0x79, 0xc2, 0x4c, 0x02, 0xfd, 0x57, 0xf6, 0xa1, 0xbe, 0x00, 0xd3, 0xe2, 0xdc,
+		0x1c, 0x33, 0x46, 0x6e, 0xd0, 0x03, 0x56, 0x4f, 0x5e, 0xc3, 0x3f, 0xc9, 0x22, 0xa4, 0x58, 0xe3,
+		0xc5, 0x2d, 0xc3, 0xd2, 0xe2, 0x50, 0xeb, 0x17, 0x59, 0x23, 0x35, 0x8e, 0xa6, 0x5e, 0x83, 0xcc,
+		0xb2, 0x85, 0xda, 0x13, 0xe6, 0x96, 0xe5, 0xdc, 0x58, 0x9b, 0xed, 0xbe, 0x18, 0x3b, 0x8d, 0x7f,
+		0x60, 0x2a, 0x39, 0xbf, 0x9c, 0x25, 0x92, 0x7e, 0xc4, 0x97, 0x5a, 0x83, 0x69, 0xc6, 0x7b, 0xc3,
+		0xc6, 0xa5, 0xde, 0xcb, 0x57, 0xcf, 0x8a, 0xab, 0xba, 0x82, 0x7d, 0xdc, 0x6f, 0x2c, 0x81, 0x24,
+		0x5e, 0xc0, 0x10, 0xfd, 0x66, 0x7f, 0xab, 0xef, 0x87, 0x8c, 0x60, 0xe2, 0x90, 0xf3, 0x90, 0xb0,
+		0x6c, 0x47, 0xa4, 0xed, 0x94, 0xc7, 0x75, 0x65, 0xc3, 0x5e, 0x4a, 0xa2, 0x52, 0x69, 0x88, 0xbc,
+		0xb4, 0x3e, 0x56, 0x2d, 0x9e, 0x09, 0xa9, 0x45, 0x97, 0xba, 0x3b, 0xbb, 0xae, 0xff, 0x07, 0x1f,
+		0xce, 0x21, 0x55, 0xf0, 0x14, 0xe5, 0x6e, 0x1c, 0x4e, 0x05, 0x4a, 0x6f, 0xd2, 0x1e, 0x06, 0x8e,
+		0xb8, 0x36, 0x09, 0x4d, 0x21, 0x81, 0x06, 0x8a, 0xf2, 0x31, 0xaa, 0xf2, 0x3e, 0x48, 0x54, 0x6d,
+		0x1b, 0xef, 0x27, 0xb3, 0xef, 0xa6, 0xc5, 0x75, 0x25, 0xa9, 0x79, 0xdf, 0x58, 0xe6, 0x58, 0xbb,
+		0xee, 0x2d, 0xa3, 0xe7, 0xdd, 0x5d, 0x96, 0xdf, 0xea, 0x73, 0x90, 0xad, 0x59, 0xa6, 0x43, 0x4d,
+		0xa7, 0xcf, 0x7c, 0xd8, 0x9d, 0x8e, 0xd5, 0xbc, 0x21, 0x38, 0xf0, 0x0f, 0x14, 0xb6, 0x61, 0xdb,
+		0x8c, 0x32, 0xa9, 0xe1, 0x9f, 0x62, 0xf6, 0x6c, 0x8c, 0x15, 0xcf, 0x85, 0xa3, 0x89, 0x47, 0x74,
+		0xd0, 0x77, 0x46, 0x63, 0x70, 0xff, 0xf0, 0x44, 0xba, 0x41, 0x0f, 0x9c, 0xa3, 0xce, 0xa3, 0x97,
+		0x20, 0xbb, 0xc9, 0x9e, 0x15, 0x79, 0x81, 0x1e, 0x90, 0x32, 0xbe, 0x3d, 0x71, 0xfe, 0xc2, 0x85,
+		0xa7, 0x9e, 0xe3, 0x5a, 0x7e, 0x75, 0x4a, 0x93, 0x00, 0x72, 0x0a, 0xb2, 0x0e, 0x6d, 0xda, 0xe7,
+		0x2f, 0x5c, 0xbc, 0xf1, 0x14, 0x57, 0xab, 0xab, 0x53, 0x9a, 0x0f, 0xaa, 0x64, 0xb0, 0xc7, 0x5f,
+		0xfc, 0xe4, 0xe9, 0xd8, 0x52, 0x0a, 0x12, 0x4e, 0xbf, 0xfb, 0x8e, 0xe9, 0xc6, 0xef, 0xa7, 0x61,
+		0x21, 0x50, 0xca, 0x17, 0x85, 0x9b, 0x46, 0xa7, 0xdd, 0x32, 0xfc, 0xc7, 0x60, 0x94, 0x40, 0xff,
+		0x19, 0xc6, 0x18, 0x6b, 0x7f, 0xa8, 0x14, 0xd5, 0xcf, 0xc4, 0x20, 0x7f, 0x5d, 0x72, 0xc6, 0xd7,
+		0x63, 0x2e, 0x01, 0x78, 0x35, 0xc9, 0xa9, 0x72, 0x72, 0x71, 0xb0, 0xae, 0x45, 0x8f, 0x46, 0x0b,
+		0xa0, 0x93, 0x67, 0x99, 0x02, 0xda, 0x96, 0x23, 0xee, 0xb1, 0x46, 0x90, 0x7a, 0xc8, 0x98, 0x80,
+		0xc9, 0xac, 0x9a, 0x7e, 0xd3, 0x72, 0x31, 0x25, 0xc4, 0xb6, 0x6e, 0x89, 0xd7, 0x01, 0x12, 0x9a,
+		0xc2, 0x4a, 0xae, 0xb3, 0x82, 0x4d, 0x84, 0x63, 0xa3, 0xb3, 0x1e, 0x17, 0xdc, 0x8e, 0x19, 0xad,
+		0x56, 0x8f, 0x3a, 0x8e, 0x30, 0x5c, 0xf2, 0x13, 0x2f, 0xcf, 0xda, 0xfd, 0x1d, 0x5d, 0x5a, 0x09,
+		0xbc, 0x7e, 0x3c, 0x62, 0xce, 0x4b, 0xdd, 0x10, 0xb3, 0x3e, 0x6d, 0xf7, 0x77, 0x50, 0x53, 0x1e,
+		0x84, 0xfc, 0x88, 0xc6, 0xe4, 0x6e, 0xfa, 0xed, 0x60, 0x2f, 0xd9, 0x88, 0x1e, 0xe8, 0x76, 0xaf,
+		0x6d, 0xf5, 0xda, 0xee, 0x01, 0x4b, 0xb7, 0x4b, 0x68, 0x8a, 0x2c, 0xd8, 0x14, 0x70, 0xf5, 0x06,
+		0xcc, 0x34, 0x98, 0x9b, 0xee, 0xb7, 0xfc, 0x82, 0xdf, 0xbe, 0x58, 0x74, 0xfb, 0xc6, 0xb6, 0x2c,
+		0x3e, 0xd4, 0xb2, 0xb3, 0xff, 0x21, 0x06, 0xb9, 0x25, 0x9c, 0xb8, 0x2b, 0xcb, 0x97, 0x3b, 0xc6,
+		0x1e, 0x79, 0x0a, 0x8e, 0x2d, 0xad, 0x6e, 0xd4, 0x5e, 0xd0, 0x57, 0x96, 0xf5, 0xcb, 0xab, 0xd5,
+		0x2b, 0x7e, 0x7e, 0x6f, 0xf9, 0xf8, 0x9d, 0xbb, 0x0b, 0x24, 0x80, 0xbb, 0x6d, 0xb2, 0xbd, 0x24,
+		0x39, 0x07, 0xf3, 0x61, 0x92, 0xea, 0x52, 0x03, 0x93, 0x7d, 0x63, 0xe5, 0x63, 0x77, 0xee, 0x2e,
+		0xcc, 0x06, 0x28, 0xaa, 0x3b, 0x0e, 0x35, 0xdd, 0x61, 0x82, 0xda, 0xc6, 0xda, 0xda, 0xca, 0x96,
+		0x12, 0x1f, 0x22, 0xc0, 0x24, 0xcb, 0xb6, 0x4b, 0x1e, 0x83, 0xd9, 0x30, 0xc1, 0xfa, 0xca, 0xaa,
+		0x92, 0x28, 0x93, 0x3b, 0x77, 0x17, 0x8a, 0x01, 0xec, 0xf5, 0x76, 0xa7, 0x9c, 0xf9, 0xe6, 0x1f,
+		0x3e, 0x35, 0xf5, 0xe9, 0xbf, 0x75, 0x2a, 0xb6, 0xb4, 0x36, 0x76, 0xce, 0x3d, 0x7d, 0xb4, 0x39,
+		0x17, 0x5e, 0xb7, 0xbf, 0x72, 0x3f, 0xdc, 0x3f, 0x58, 0x18, 0x32, 0xc6, 0x93, 0x4e, 0xb7, 0xa8,
+		0xd8, 0x42, 0xf9, 0x70, 0xf7, 0xa0, 0x1c, 0xb1, 0x28, 0x94, 0x23, 0x0d, 0x83, 0xfa, 0x1c, 0x14,
+		0x30, 0x1d, 0xb8, 0x41, 0xdd, 0xab, 0xd4, 0x68, 0xd1, 0x5e, 0xd8, 0x7f, 0x28, 0x48, 0xff, 0x81,
+		0x40, 0x92, 0x39, 0x09, 0x7c, 0xfd, 0x64, 0x7f, 0xab, 0xfb, 0x90, 0x44, 0x52, 0xdf, 0xb7, 0x10,
+		0x14, 0xec, 0x03, 0xa1, 0x3b, 0x07, 0x2e, 0x75, 0x04, 0x09, 0xff, 0x20, 0xcf, 0x48, 0x0f, 0x21,
+		0x71, 0xb8, 0x87, 0x20, 0xa6, 0x97, 0xf0, 0x13, 0x3a, 0x30, 0x2d, 0x86, 0xd8, 0x6b, 0x48, 0xcc,
+		0x6f, 0x08, 0x59, 0x83, 0x19, 0xdb, 0xe8, 0xb9, 0xec, 0x66, 0xe1, 0x3e, 0xeb, 0x85, 0x98, 0xc1,
+		0xa7, 0x87, 0xed, 0x49, 0xa8, 0xb3, 0xa2, 0x96, 0x82, 0x1d, 0x04, 0xaa, 0xff, 0x25, 0x09, 0x69,
+		0x21, 0x8c, 0xf7, 0xc1, 0xb4, 0x10, 0xab, 0x98, 0x73, 0x0f, 0x2c, 0x0e, 0x2f, 0xb3, 0x8b, 0xde,
+		0x72, 0x28, 0xf8, 0x49, 0x1a, 0xf2, 0x08, 0x64, 0x9a, 0xfb, 0x46, 0xdb, 0xd4, 0xdb, 0x2d, 0xe1,
+		0x80, 0xe6, 0x3e, 0xff, 0xc6, 0xe9, 0xe9, 0x1a, 0xc2, 0x56, 0x96, 0xb5, 0x69, 0x56, 0xb8, 0xd2,
+		0x42, 0x9f, 0x66, 0x9f, 0xb6, 0xf7, 0xf6, 0x5d, 0x61, 0x37, 0xc4, 0x17, 0x3e, 0xce, 0x85, 0x0a,
+		0x21, 0xee, 0x9d, 0x97, 0x87, 0x76, 0x16, 0x5e, 0xe8, 0x67, 0x29, 0x83, 0x15, 0x7f, 0xe4, 0x3f,
+		0x9f, 0x8e, 0x69, 0x8c, 0x82, 0xd4, 0xa0, 0xd0, 0x31, 0x1c, 0x57, 0x67, 0xeb, 0x31, 0x56, 0x9f,
+		0x62, 0x2c, 0x4e, 0x0c, 0x0b, 0x44, 0x08, 0x56, 0x34, 0x3d, 0x87, 0x54, 0x1c, 0xd4, 0xc2, 0x6b,
+		0xb1, 0x8c, 0x49, 0x93, 0xcd, 0x40, 0xee, 0x25, 0xa6, 0x99, 0xdc, 0x8b, 0x08, 0xe7, 0x13, 0x93,
+		0xf9, 0x8a, 0x27, 0x21, 0xcb, 0x6e, 0xba, 0x32, 0x14, 0x9e, 0xbe, 0x9e, 0x41, 0x00, 0x2b, 0x7c,
+		0x14, 0x66, 0x7c, 0xab, 0xcf, 0x51, 0x32, 0x9c, 0x8b, 0x0f, 0x66, 0x88, 0x4f, 0xc2, 0xbc, 0x49,
+		0x6f, 0xbb, 0xba, 0x0f, 0xe6, 0xd8, 0x59, 0x86, 0x4d, 0xb0, 0xec, 0x7a, 0x98, 0xe2, 0x61, 0x28,
+		0x36, 0xa5, 0xf0, 0x39, 0x2e, 0x30, 0xdc, 0x82, 0x07, 0x65, 0x68, 0x27, 0x20, 0x63, 0xd8, 0x36,
+		0x47, 0xc8, 0x09, 0xab, 0x6f, 0xdb, 0xac, 0xe8, 0x2c, 0xcc, 0xb2, 0x3e, 0xf6, 0xa8, 0xd3, 0xef,
+		0xb8, 0x82, 0x49, 0x9e, 0xe1, 0xcc, 0x60, 0x81, 0xc6, 0xe1, 0x0c, 0xf7, 0x21, 0x28, 0xd0, 0x9b,
+		0x78, 0x77, 0xa0, 0x49, 0x39, 0x5e, 0x81, 0xe1, 0xe5, 0x25, 0x90, 0x21, 0x3d, 0x06, 0x9e, 0x35,
+		0xd7, 0xe5, 0x4a, 0x53, 0xe4, 0xfc, 0x24, 0xbc, 0xca, 0xc1, 0x6a, 0x09, 0x92, 0xcb, 0x86, 0x6b,
+		0xa0, 0xbb, 0xe4, 0xde, 0xe6, 0xcb, 0x67, 0x5e, 0xc3, 0x3f, 0xd5, 0x9f, 0x49, 0x40, 0xf2, 0xba,
+		0xe5, 0x52, 0xf2, 0x74, 0xc0, 0x95, 0x2d, 0x8e, 0xd2, 0xe7, 0x46, 0x7b, 0xcf, 0xa4, 0xad, 0x35,
+		0x67, 0x2f, 0xf0, 0x2c, 0x8d, 0xaf, 0x4e, 0xf1, 0x90, 0x3a, 0xcd, 0x43, 0xaa, 0x67, 0xf5, 0xcd,
+		0x96, 0x4c, 0xfc, 0x66, 0x1f, 0xa4, 0x0e, 0x19, 0x4f, 0x4b, 0x92, 0x51, 0x5a, 0x32, 0x83, 0x5a,
+		0x82, 0x3a, 0x2c, 0x00, 0xda, 0xf4, 0x8e, 0x50, 0x96, 0x25, 0xc8, 0x7a, 0xc6, 0xab, 0x94, 0x3a,
+		0x82, 0xc2, 0xfa, 0x64, 0xb8, 0x44, 0x7a, 0x63, 0xef, 0x09, 0x8f, 0x6b, 0x9c, 0xe2, 0x15, 0x08,
+		0xe9, 0x85, 0xd4, 0x4a, 0x3c, 0x91, 0x33, 0xcd, 0xfa, 0xe5, 0xab, 0x15, 0x7f, 0x26, 0xe7, 0x7e,
+		0xcc, 0xa4, 0xdb, 0x33, 0x0d, 0xb7, 0xdf, 0xa3, 0x42, 0xf3, 0x7c, 0x00, 0x96, 0xfa, 0x97, 0x20,
+		0xb8, 0xa6, 0xf9, 0x00, 0x72, 0x0e, 0xe6, 0xbc, 0x0f, 0xdd, 0xe7, 0xc2, 0xb5, 0x8c, 0x78, 0x45,
+		0x0d, 0x59, 0xa2, 0xfe, 0x62, 0x0c, 0xd2, 0x62, 0xc5, 0xf2, 0x87, 0x21, 0x36, 0x7a, 0x18, 0xe2,
+		0xe3, 0x86, 0x21, 0x71, 0xef, 0xc3, 0x50, 0x05, 0xf0, 0x9a, 0xe9, 0x88, 0x87, 0x50, 0x46, 0xb8,
+		0x55, 0xbc, 0x89, 0x8d, 0xf6, 0x9e, 0x98, 0xf7, 0x01, 0x22, 0xf5, 0x37, 0x62, 0x90, 0xf5, 0xca,
+		0x49, 0x15, 0x0a, 0xb2, 0x5d, 0xfa, 0x6e, 0xc7, 0xd8, 0x13, 0xaa, 0xf8, 0xc0, 0xd8, 0xc6, 0xe1,
+		0x2a, 0xac, 0xe5, 0x44, 0x7b, 0xf0, 0x63, 0xf4, 0xb0, 0xc6, 0xc7, 0x0c, 0x6b, 0x48, 0x8f, 0x12,
+		0xf7, 0xa6, 0x47, 0xa1, 0x11, 0x4f, 0x0e, 0x8c, 0xb8, 0xfa, 0x5b, 0x31, 0xf1, 0x62, 0x57, 0x8b,
+		0xb6, 0xfe, 0x34, 0x87, 0xea, 0x15, 0xa1, 0x5b, 0x2d, 0xda, 0xd2, 0x87, 0xc6, 0xec, 0xa1, 0x61,
+		0x8e, 0xe1, 0x36, 0xfb, 0x63, 0x47, 0x24, 0x97, 0x86, 0x3f, 0x86, 0x9f, 0x8d, 0xc3, 0xec, 0x10,
+		0xfe, 0x9f, 0xbf, 0xb1, 0x0c, 0xcf, 0xde, 0xd4, 0x84, 0xb3, 0x37, 0x3d, 0x76, 0xf6, 0xfe, 0x54,
+		0x9c, 0x05, 0x00, 0x6c, 0xcb, 0x31, 0x3a, 0x5f, 0x0d, 0xdb, 0x7b, 0x12, 0xb2, 0xb6, 0xd5, 0xd1,
+		0x79, 0x09, 0xbf, 0x7a, 0x93, 0xb1, 0xad, 0x8e, 0x36, 0xa4, 0x66, 0xa9, 0xb7, 0xc9, 0x30, 0xa7,
+		0xdf, 0x86, 0x41, 0x98, 0x1e, 0x9c, 0x50, 0x3d, 0xc8, 0x73, 0x51, 0x08, 0xaf, 0xe9, 0x49, 0x94,
+		0x01, 0xfe, 0x55, 0x8a, 0x0d, 0x7b, 0x79, 0xbc, 0xd9, 0x1c, 0x53, 0x4b, 0xef, 0x7b, 0x14, 0xdc,
+		0xc9, 0x28, 0xc5, 0xc7, 0x51, 0x70, 0x2d, 0xd6, 0x04, 0x9e, 0xfa, 0x3d, 0x31, 0x80, 0x55, 0x94,
+		0x2c, 0xeb, 0x2f, 0xfa, 0x3b, 0x0e, 0x6b, 0x82, 0x1e, 0xaa, 0xf9, 0xd4, 0xb8, 0x41, 0x13, 0xf5,
+		0xe7, 0x9d, 0x60, 0xbb, 0x6b, 0x50, 0xf0, 0x75, 0xdb, 0xa1, 0xb2, 0x31, 0xa7, 0x0e, 0xd9, 0x95,
+		0x36, 0xa8, 0xab, 0xe5, 0x6f, 0x06, 0xbe, 0xd4, 0x7f, 0x12, 0x83, 0x2c, 0x6b, 0x13, 0xbe, 0xf0,
+		0x11, 0x1a, 0xc3, 0xd8, 0xbd, 0x8f, 0xe1, 0x03, 0x00, 0x9c, 0x0d, 0x66, 0xb0, 0x08, 0xcd, 0xca,
+		0x32, 0x08, 0xe6, 0xa5, 0x90, 0x8b, 0x9e, 0xc0, 0x13, 0x87, 0x0b, 0x5c, 0xee, 0x5a, 0x85, 0xd8,
+		0xef, 0x83, 0x69, 0xf6, 0xa6, 0xe4, 0x6d, 0x47, 0x6c, 0x44, 0xf1, 0x21, 0xa9, 0xad, 0xdb, 0x8e,
+		0xfa, 0x2a, 0x4c, 0x6f, 0xdd, 0xe6, 0xf1, 0xc4, 0x93, 0x90, 0xed, 0x59, 0x96, 0xf0, 0xfe, 0xb8,
+		0xd7, 0x9d, 0x41, 0x00, 0x73, 0x76, 0x64, 0x0c, 0x2d, 0xee, 0xc7, 0xd0, 0xfc, 0x20, 0x60, 0x62,
+		0xa2, 0x20, 0x20, 0xee, 0x3e, 0x0b, 0xa1, 0x99, 0x44, 0xde, 0x0d, 0xf7, 0x35, 0x56, 0xae, 0xac,
+		0xd7, 0x97, 0xf5, 0xb5, 0xc6, 0x95, 0x81, 0x6b, 0xf5, 0xe5, 0x99, 0x3b, 0x77, 0x17, 0x72, 0x62,
+		0xdb, 0x39, 0x0e, 0x7b, 0x53, 0xab, 0x5f, 0xdf, 0xd8, 0xaa, 0x2b, 0x31, 0x8e, 0xbd, 0xd9, 0xa3,
+		0x37, 0x2d, 0x97, 0x3f, 0xda, 0xfa, 0x24, 0x9c, 0x18, 0x81, 0xed, 0x6d, 0x3e, 0x67, 0xef, 0xdc,
+		0x5d, 0x28, 0x6c, 0xf6, 0x28, 0xd7, 0x32, 0x46, 0xb1, 0x08, 0xa5, 0x61, 0x8a, 0x8d, 0xcd, 0x8d,
+		0x46, 0x75, 0x55, 0x59, 0x28, 0x2b, 0x77, 0xee, 0x2e, 0xe4, 0xa5, 0xc9, 0x40, 0xfc, 0x77, 0x7e,
+		0xf7, 0xf9, 0x2d, 0x19, 0x78, 0x60, 0xb0, 0xf0, 0x9c, 0x6d, 0xf4, 0x8c, 0xee, 0x51, 0xb7, 0x9f,
+		0x11, 0x07, 0xfe, 0xea, 0x67, 0xe2, 0x30, 0xe3, 0x6d, 0x6e, 0x36, 0x59, 0x0d, 0xe4, 0xe9, 0x60,
+		0xc4, 0x2f, 0x37, 0x76, 0xed, 0xe0, 0xd8, 0x32, 0x20, 0x88, 0x47, 0x3d, 0xc2, 0x49, 0x16, 0x93,
+		0x6a, 0x61, 0xc4, 0xfa, 0x26, 0x30, 0x04, 0xa9, 0x47, 0x41, 0x9e, 0x87, 0xac, 0x37, 0xc5, 0xbc,
+		0x87, 0xcd, 0xc6, 0xcf, 0x49, 0x41, 0xef, 0xd3, 0xe0, 0x5b, 0x63, 0x72, 0x1b, 0x97, 0x1c, 0xb7,
+		0x31, 0xbc, 0xce, 0x11, 0x04, 0xb1, 0xc4, 0x27, 0x4f, 0x42, 0xd2, 0xd8, 0x69, 0xb6, 0x4b, 0xa9,
+		0xe1, 0x90, 0x0b, 0xa7, 0xab, 0x2e, 0xd5, 0x56, 0x04, 0x11, 0xc3, 0x54, 0x57, 0x44, 0x30, 0x45,
+		0xc8, 0x8b, 0x3d, 0x23, 0x73, 0x5b, 0xe7, 0x7b, 0x61, 0xee, 0x5d, 0x64, 0xba, 0xc6, 0xed, 0x25,
+		0xfc, 0xc6, 0x09, 0x88, 0x85, 0x7b, 0xe2, 0xa1, 0x82, 0x84, 0x96, 0xee, 0x1a, 0xb7, 0xaf, 0x18,
+		0xce, 0xb5, 0x64, 0x26, 0xa1, 0x24, 0xd5, 0x1f, 0x43, 0x4f, 0x25, 0x24, 0x15, 0xf2, 0x38, 0x10,
+		0xa4, 0xc0, 0x33, 0x38, 0x9c, 0xba, 0x4c, 0xbc, 0x92, 0xef, 0x4c, 0xd7, 0xb8, 0x5d, 0xdd, 0xa3,
+		0xeb, 0xfd, 0x2e, 0x6b, 0x80, 0x43, 0xf0, 0xc0, 0x4e, 0x20, 0xcb, 0x91, 0x15, 0xe2, 0x3f, 0x31,
+		0xfc, 0x30, 0xaa, 0x40, 0xe0, 0x0b, 0xc0, 0x47, 0x71, 0x01, 0x28, 0x72, 0x7e, 0xb2, 0x24, 0xdc,
+		0x95, 0x44, 0xb8, 0x2b, 0xea, 0xf3, 0x30, 0x33, 0x30, 0x02, 0x44, 0x85, 0x82, 0x88, 0x58, 0xb1,
+		0xe3, 0x73, 0xbe, 0xc3, 0xc9, 0x6a, 0x39, 0x1e, 0x99, 0x62, 0xe9, 0x04, 0x95, 0xcc, 0xcf, 0x62,
+		0x98, 0x14, 0x0f, 0x73, 0x1e, 0x87, 0x42, 0x68, 0x0c, 0x64, 0x14, 0x39, 0xe6, 0x47, 0x91, 0x7d,
+		0xe4, 0x57, 0x20, 0x8f, 0x06, 0x88, 0xb6, 0x04, 0xee, 0x23, 0x30, 0xc3, 0x0d, 0xe4, 0xa0, 0xac,
+		0xb9, 0xc3, 0xb3, 0x26, 0x05, 0xae, 0x42, 0xc1, 0xc7, 0xf3, 0xc5, 0x9e, 0x93, 0x58, 0x57, 0x0c,
+		0x47, 0xdd, 0x00, 0xf0, 0x07, 0x95, 0x54, 0xe1, 0x01, 0x34, 0x1e, 0xc1, 0x74, 0x4f, 0xf1, 0xa4,
+		0x55, 0xc8, 0x63, 0x2c, 0x23, 0x92, 0x9f, 0xd2, 0xc9, 0x1f, 0xb6, 0xba, 0xca, 0x30, 0x96, 0x5e,
+		0xfc, 0xf4, 0xe7, 0x4f, 0xc5, 0xde, 0x19, 0x5b, 0xf0, 0x1f, 0x5f, 0x86, 0x93, 0x81, 0x42, 0x54,
+		0xc0, 0x50, 0x20, 0x6a, 0xc6, 0x2f, 0x5c, 0xc4, 0xc2, 0xa8, 0x80, 0xd2, 0xa1, 0xf1, 0xdf, 0xf2,
+		0xe1, 0x66, 0x27, 0x3a, 0xda, 0x14, 0x1d, 0xef, 0x1a, 0x1d, 0x94, 0xff, 0xc7, 0x59, 0x98, 0xd6,
+		0xe8, 0x6b, 0x7d, 0xea, 0xb8, 0xe4, 0x3c, 0x24, 0x69, 0x73, 0xdf, 0x1a, 0x15, 0x03, 0xc5, 0x0e,
+		0x2e, 0x0a, 0xbc, 0x7a, 0x73, 0xdf, 0xba, 0x3a, 0xa5, 0x31, 0x5c, 0x72, 0x01, 0x52, 0xbb, 0x9d,
+		0xbe, 0x08, 0x5f, 0x0d, 0xd8, 0xac, 0x20, 0xd1, 0x65, 0x44, 0xba, 0x3a, 0xa5, 0x71, 0x6c, 0xac,
+		0x8a, 0xbd, 0x68, 0x9d, 0x38, 0xbc, 0x2a, 0xbc, 0xf6, 0x8c, 0x55, 0x21, 0x2e, 0x59, 0x02, 0x68,
+		0x9b, 0x6d, 0x57, 0x67, 0xa1, 0x9d, 0x52, 0x6a, 0xd8, 0x58, 0x85, 0x29, 0xdb, 0x2e, 0x0b, 0x06,
+		0xe1, 0xd1, 0x41, 0x5b, 0x7e, 0x60, 0x73, 0x5f, 0xeb, 0xd3, 0xde, 0x41, 0x29, 0x7d, 0x78, 0x73,
+		0x5f, 0x44, 0x24, 0x6c, 0x2e, 0xc3, 0x46, 0x23, 0xcb, 0x1f, 0xc7, 0x73, 0x6f, 0x97, 0x32, 0xc3,
+		0x66, 0x2e, 0x48, 0xc9, 0x5e, 0xc8, 0xdb, 0xba, 0x8d, 0xe7, 0x19, 0x4d, 0xfe, 0x27, 0x79, 0x8f,
+		0xe7, 0x82, 0xe5, 0x86, 0xbd, 0x9e, 0x10, 0x2d, 0x0f, 0xfb, 0x4c, 0x49, 0x57, 0x8c, 0xac, 0x43,
+		0xb1, 0xd3, 0x76, 0x5c, 0xdd, 0x31, 0x0d, 0xdb, 0xd9, 0xb7, 0x5c, 0x87, 0xc5, 0x4f, 0xf0, 0x05,
+		0xe8, 0x31, 0x1c, 0x56, 0xdb, 0x8e, 0xdb, 0x90, 0xc8, 0x57, 0xa7, 0xb4, 0x42, 0x27, 0x08, 0x40,
+		0x7e, 0xd6, 0xee, 0x2e, 0xed, 0x79, 0x0c, 0x4b, 0x85, 0xc3, 0xf9, 0x6d, 0x20, 0xb6, 0xa4, 0x47,
+		0x7e, 0x56, 0x10, 0x40, 0xbe, 0x06, 0xe6, 0x3a, 0x96, 0xd1, 0xf2, 0xd8, 0xe9, 0xcd, 0xfd, 0xbe,
+		0x79, 0x83, 0x05, 0x65, 0xf0, 0x99, 0xd2, 0x71, 0x8d, 0xb4, 0x8c, 0x96, 0x64, 0x51, 0x43, 0x82,
+		0xab, 0x53, 0xda, 0x6c, 0x67, 0x10, 0x48, 0x3e, 0x08, 0xf3, 0x86, 0x6d, 0x77, 0x0e, 0x06, 0xb9,
+		0xcf, 0x30, 0xee, 0x67, 0xc7, 0x71, 0xaf, 0x22, 0xcd, 0x20, 0x7b, 0x62, 0x0c, 0x41, 0xc9, 0x16,
+		0x86, 0x93, 0x28, 0xbb, 0x31, 0x65, 0x0b, 0x2f, 0x83, 0xbd, 0x27, 0x85, 0x4f, 0xc5, 0x8f, 0xe1,
+		0xbd, 0xc9, 0xf1, 0xa5, 0x53, 0x72, 0x75, 0x0a, 0x23, 0x4f, 0x21, 0x10, 0xe7, 0x6a, 0x35, 0x29,
+		0x7b, 0xee, 0x4e, 0x70, 0x9d, 0x8d, 0xe2, 0xca, 0xf0, 0xc3, 0x5c, 0x43, 0x20, 0x52, 0x87, 0x1c,
+		0xdf, 0x8a, 0xe2, 0xc1, 0x0c, 0x65, 0xaf, 0x50, 0xe5, 0xce, 0xab, 0x63, 0x67, 0x28, 0x43, 0xc5,
+		0x28, 0xd7, 0xd5, 0x29, 0x0d, 0xa8, 0xf7, 0x45, 0x0c, 0x38, 0xc6, 0x1e, 0xe4, 0x3a, 0xd0, 0xc3,
+		0x86, 0xb7, 0x34, 0xc7, 0x18, 0x3e, 0x3e, 0x8e, 0xe1, 0x75, 0x46, 0x74, 0x3d, 0x68, 0x87, 0xaf,
+		0x4e, 0x69, 0x73, 0x37, 0x87, 0xc1, 0xa8, 0x62, 0xbb, 0x6d, 0xd3, 0xe8, 0xe0, 0xbb, 0x90, 0xdc,
+		0x9b, 0x99, 0x3f, 0x5c, 0xc5, 0x2e, 0x0b, 0x6c, 0xb6, 0xb0, 0xa2, 0x8a, 0xed, 0x06, 0x01, 0x4b,
+		0xd3, 0x22, 0xd7, 0xd3, 0x7b, 0x5a, 0x6d, 0x5a, 0xc9, 0xf0, 0xe7, 0xd4, 0xae, 0x25, 0x33, 0xa0,
+		0xe4, 0xd4, 0x47, 0x21, 0x17, 0x30, 0x4c, 0x78, 0x0a, 0x25, 0xb2, 0x61, 0x64, 0xd6, 0xa8, 0xf8,
+		0x54, 0x8b, 0x90, 0x0f, 0x1a, 0x23, 0xf5, 0x23, 0x31, 0xc8, 0x05, 0xec, 0x0c, 0x52, 0x06, 0x23,
+		0xd2, 0x59, 0xdf, 0x53, 0x79, 0x48, 0x2e, 0x6d, 0xb2, 0x9c, 0x1f, 0xbf, 0xe6, 0x19, 0x50, 0xac,
+		0xac, 0xf8, 0x38, 0x84, 0x7d, 0xde, 0xf6, 0x50, 0x12, 0x0c, 0x05, 0xec, 0xf3, 0xb6, 0x44, 0x78,
+		0x10, 0xf2, 0xd8, 0x6f, 0x3d, 0xe8, 0x2f, 0x65, 0xb5, 0x1c, 0xc2, 0x04, 0x8a, 0xfa, 0xaf, 0xe2,
+		0xa0, 0x0c, 0x1a, 0x30, 0x2f, 0x54, 0x1d, 0x3b, 0x72, 0xa8, 0xfa, 0xc4, 0x60, 0x90, 0xdc, 0x8f,
+		0x8b, 0xaf, 0x82, 0xe2, 0x87, 0x77, 0xf9, 0x52, 0x33, 0xde, 0xff, 0x1b, 0x70, 0x54, 0xb5, 0x99,
+		0x66, 0x18, 0x40, 0x2e, 0x87, 0x0e, 0x2b, 0xe5, 0x4f, 0x3e, 0x0c, 0x0e, 0xb8, 0xe7, 0xc4, 0x6c,
+		0xdb, 0x2d, 0xc3, 0xa5, 0x32, 0x3e, 0xe6, 0x53, 0xa2, 0xaf, 0x81, 0xd1, 0x64, 0xc7, 0x35, 0x5c,
+		0x2a, 0x7c, 0x0d, 0x1e, 0x79, 0x28, 0x18, 0xb6, 0xdd, 0x40, 0x28, 0xf7, 0x35, 0x1e, 0x86, 0x22,
+		0xda, 0xf4, 0xb6, 0xd1, 0x91, 0xae, 0x42, 0x9a, 0xbb, 0x24, 0x02, 0xca, 0xbd, 0x03, 0xb5, 0x05,
+		0xf9, 0xa0, 0x3d, 0xf7, 0xf6, 0x54, 0xb1, 0xc0, 0x9e, 0x8a, 0x88, 0xb7, 0x3e, 0xb8, 0x7c, 0xe4,
+		0xfb, 0x28, 0xa3, 0x0f, 0x0d, 0xe6, 0xd9, 0xfe, 0xeb, 0x26, 0x0f, 0x96, 0x64, 0x34, 0xfe, 0xa1,
+		0x6a, 0x50, 0x0c, 0xdb, 0x7e, 0x52, 0x84, 0xb8, 0x7b, 0x5b, 0xd4, 0x12, 0x77, 0x6f, 0xa3, 0xa7,
+		0xeb, 0xbd, 0x90, 0x5b, 0x1c, 0xb1, 0xda, 0x09, 0x3a, 0x3f, 0xd6, 0xa1, 0xce, 0x40, 0x41, 0xf2,
+		0xe4, 0x9b, 0xf2, 0xe3, 0x30, 0x3f, 0xca, 0xc4, 0xab, 0xfb, 0x30, 0x3f, 0xca, 0x54, 0x93, 0x0b,
+		0x90, 0xf1, 0x6c, 0xfc, 0x88, 0xdd, 0x31, 0xab, 0x56, 0x22, 0x6b, 0x1e, 0x6a, 0x28, 0x9c, 0x1f,
+		0x0f, 0x85, 0xf3, 0xd5, 0xaf, 0x87, 0xd2, 0x38, 0xfb, 0x3d, 0x10, 0xe4, 0x4b, 0x7a, 0x02, 0x3b,
+		0x0e, 0x69, 0xf1, 0x14, 0x65, 0x9c, 0x1d, 0x60, 0x89, 0x2f, 0x14, 0x24, 0xb7, 0xe5, 0x09, 0x06,
+		0xe6, 0x1f, 0xaa, 0x0e, 0x27, 0xc6, 0xda, 0xf0, 0xf1, 0x47, 0x61, 0x9c, 0x91, 0x38, 0x0a, 0x6b,
+		0xca, 0xe6, 0x38, 0xac, 0xaf, 0x32, 0x91, 0x85, 0x7f, 0xa9, 0x1f, 0x4d, 0xc0, 0xf1, 0xd1, 0x96,
+		0x9c, 0x2c, 0x40, 0x1e, 0xfd, 0x56, 0x37, 0xec, 0xe2, 0x42, 0xd7, 0xb8, 0xbd, 0x25, 0xfc, 0x5b,
+		0x71, 0x94, 0x10, 0xf7, 0x8e, 0x12, 0xc8, 0x36, 0xcc, 0x76, 0xac, 0xa6, 0xd1, 0xd1, 0x03, 0x47,
+		0x39, 0x62, 0x12, 0x3d, 0x34, 0x24, 0xec, 0x70, 0xc8, 0x10, 0x0d, 0x8e, 0xd0, 0xff, 0x19, 0xc6,
+		0x63, 0xd5, 0x3b, 0xf5, 0x21, 0xcb, 0x90, 0xeb, 0xb6, 0x9d, 0x1d, 0xba, 0x6f, 0xdc, 0x6c, 0x5b,
+		0x3d, 0x31, 0x9b, 0x86, 0x95, 0x66, 0xcd, 0xc7, 0x91, 0x27, 0x4c, 0x01, 0xb2, 0xc0, 0x90, 0xa4,
+		0x46, 0x1e, 0x7c, 0xa5, 0x8f, 0x6c, 0x4d, 0xc6, 0x9d, 0x21, 0x4d, 0x8f, 0x3d, 0x43, 0x1a, 0x75,
+		0x60, 0x93, 0x19, 0x7d, 0x60, 0xf3, 0xcd, 0xc1, 0xa1, 0x09, 0xaf, 0x7d, 0x43, 0x67, 0x38, 0xa4,
+		0x01, 0xf3, 0x82, 0xbe, 0x15, 0x92, 0xfd, 0x88, 0x54, 0x07, 0x3e, 0xbf, 0x06, 0x65, 0x4e, 0x24,
+		0xf9, 0x78, 0xb1, 0x27, 0xee, 0x4d, 0xec, 0xf2, 0x10, 0x35, 0x19, 0x38, 0x44, 0xfd, 0x7f, 0x6c,
+		0x28, 0x3e, 0x9c, 0x80, 0xd9, 0x21, 0x47, 0x62, 0xe4, 0xe9, 0xf0, 0xb8, 0x88, 0xac, 0xec, 0x58,
+		0xe2, 0xc8, 0x1d, 0x13, 0x63, 0x9d, 0x8c, 0x1e, 0xeb, 0xd4, 0xdb, 0x38, 0xd6, 0xe9, 0x7b, 0x1b,
+		0xeb, 0x77, 0x74, 0x14, 0x3e, 0x1e, 0x83, 0xf2, 0x78, 0xef, 0x6b, 0xe4, 0x70, 0x1c, 0xe9, 0x00,
+		0x61, 0xdc, 0x1a, 0xf7, 0x30, 0x14, 0x07, 0x7c, 0x43, 0xae, 0xca, 0x85, 0xd0, 0x2e, 0x5c, 0xfd,
+		0xc6, 0x04, 0xcc, 0x8f, 0x72, 0xe0, 0x46, 0xcc, 0xd6, 0x17, 0x61, 0xae, 0x45, 0x9b, 0xed, 0xd6,
+		0xbd, 0x4e, 0xd6, 0x59, 0x41, 0xfd, 0xff, 0xe7, 0xea, 0xb0, 0x96, 0x7c, 0x37, 0x40, 0x46, 0xa3,
+		0x8e, 0x8d, 0xfe, 0x18, 0x1e, 0x47, 0xd0, 0xdb, 0x4d, 0x6a, 0xbb, 0x7e, 0x52, 0xc5, 0xa8, 0x2d,
+		0x02, 0xc7, 0xae, 0x4b, 0x4c, 0xdc, 0x20, 0x7b, 0x64, 0x78, 0x32, 0xc3, 0x62, 0x00, 0xe3, 0xb7,
+		0xf3, 0x82, 0x3c, 0x18, 0x04, 0xb8, 0x28, 0x83, 0x00, 0x89, 0xb1, 0xfb, 0x5b, 0x4e, 0x35, 0x10,
+		0x05, 0x78, 0x5a, 0x44, 0x01, 0x92, 0x11, 0x95, 0x85, 0xc2, 0x00, 0xb5, 0x50, 0x18, 0x20, 0x1d,
+		0xd1, 0xcd, 0x31, 0x71, 0x80, 0x8b, 0x32, 0x0e, 0x30, 0x1d, 0xd1, 0xe2, 0x81, 0x40, 0xc0, 0xfb,
+		0x02, 0x81, 0x80, 0xec, 0x70, 0xb4, 0x35, 0x44, 0x3a, 0x22, 0x12, 0xf0, 0x9c, 0x17, 0x09, 0xc8,
+		0x8f, 0x8d, 0x22, 0x08, 0xe2, 0xc1, 0x50, 0xc0, 0xc6, 0x50, 0x28, 0xa0, 0x20, 0x7e, 0xd8, 0x68,
+		0x1c, 0x8b, 0x88, 0x58, 0xc0, 0xc6, 0x50, 0x2c, 0xa0, 0x18, 0xc1, 0x30, 0x22, 0x18, 0xf0, 0xb5,
+		0xa3, 0x83, 0x01, 0xe3, 0xb7, 0xeb, 0xa2, 0x99, 0x93, 0x45, 0x03, 0xf4, 0x31, 0xd1, 0x00, 0x65,
+		0xec, 0xce, 0x95, 0xb3, 0x9f, 0x38, 0x1c, 0xb0, 0x3d, 0x22, 0x1c, 0xc0, 0x37, 0xee, 0x67, 0xc6,
+		0x32, 0x9f, 0x20, 0x1e, 0xb0, 0x3d, 0x22, 0x1e, 0x40, 0x22, 0xd9, 0x46, 0x06, 0x04, 0x2e, 0x87,
+		0x03, 0x02, 0x73, 0x63, 0xbc, 0x4e, 0x7f, 0xb6, 0x8f, 0x89, 0x08, 0xec, 0x8c, 0x8b, 0x08, 0xf0,
+		0x5d, 0xfb, 0xbb, 0xc7, 0x72, 0x3c, 0x42, 0x48, 0x60, 0x63, 0x28, 0x24, 0x70, 0x2c, 0x42, 0xd3,
+		0x26, 0x8f, 0x09, 0xf0, 0x87, 0xd6, 0xf9, 0x13, 0xeb, 0xa0, 0xe4, 0xae, 0x25, 0x33, 0x39, 0x25,
+		0xaf, 0x3e, 0x06, 0xb3, 0x92, 0x95, 0x67, 0xe7, 0x70, 0xaf, 0x40, 0x7b, 0x3d, 0xab, 0x27, 0xef,
+		0x31, 0xb0, 0x0f, 0xf5, 0x0c, 0xe4, 0x3d, 0xd4, 0xc3, 0xe3, 0x07, 0x6c, 0x4f, 0x16, 0xb0, 0x63,
+		0xea, 0xcf, 0xc6, 0x20, 0x1f, 0x34, 0x51, 0xa1, 0xfd, 0x65, 0x56, 0xec, 0x2f, 0x03, 0x51, 0x85,
+		0x78, 0x38, 0xaa, 0x80, 0xcf, 0x29, 0xdb, 0x43, 0x01, 0x03, 0xc3, 0xf6, 0x02, 0x06, 0x32, 0x81,
+		0x8a, 0xc7, 0x1e, 0xc4, 0xb2, 0xc4, 0x4f, 0x13, 0x67, 0xbc, 0x64, 0x32, 0xbe, 0xd5, 0x25, 0x4f,
+		0xc0, 0x5c, 0x00, 0xd7, 0xdb, 0xc3, 0xf1, 0xdd, 0xb3, 0xe2, 0x61, 0x57, 0xc5, 0x66, 0xee, 0x9f,
+		0xc5, 0x60, 0x76, 0xc8, 0x44, 0x8e, 0x0c, 0x0a, 0xc4, 0xde, 0xa6, 0xa0, 0x40, 0xfc, 0x9e, 0x83,
+		0x02, 0xc1, 0x3d, 0x69, 0x22, 0xbc, 0x27, 0xfd, 0x9f, 0x31, 0x28, 0x84, 0x2c, 0x35, 0x0e, 0x41,
+		0xd3, 0x6a, 0x51, 0xb1, 0x4b, 0x64, 0x7f, 0xa3, 0x4b, 0xd2, 0xb1, 0xf6, 0xc4, 0x5e, 0x10, 0xff,
+		0x44, 0x2c, 0x6f, 0xe1, 0xc9, 0x8a, 0x75, 0xc5, 0xdb, 0x60, 0xa6, 0x82, 0xf7, 0x38, 0xc4, 0xe5,
+		0x86, 0xb4, 0x7f, 0xb9, 0xc1, 0xbb, 0x7c, 0x3c, 0x1d, 0xb8, 0x7c, 0x4c, 0xde, 0x03, 0x59, 0x16,
+		0xec, 0xd7, 0x2d, 0x9b, 0x2f, 0xd6, 0x03, 0xae, 0x4d, 0xf8, 0x62, 0x83, 0xc3, 0x52, 0xae, 0xd9,
+		0x5f, 0x01, 0x8f, 0x23, 0x1b, 0xf2, 0x38, 0xee, 0x87, 0x2c, 0xb6, 0x9e, 0xff, 0xba, 0x05, 0x88,
+		0x9b, 0xeb, 0x12, 0xa0, 0xfe, 0x78, 0x1c, 0x66, 0x06, 0x16, 0x9a, 0x91, 0x7d, 0x1f, 0x75, 0x8c,
+		0x3c, 0x99, 0x3c, 0x4e, 0x01, 0xec, 0x19, 0x8e, 0x7e, 0xcb, 0x30, 0x5d, 0xda, 0x12, 0x42, 0x09,
+		0x40, 0xf0, 0x22, 0x03, 0x7e, 0xf5, 0x1d, 0xda, 0x12, 0xd1, 0x17, 0xef, 0x1b, 0x6f, 0x23, 0xd1,
+		0x9b, 0xec, 0x75, 0x57, 0xfe, 0x46, 0xf2, 0xf1, 0xe1, 0xed, 0x30, 0x16, 0x2f, 0x95, 0x70, 0xb0,
+		0xbf, 0xf4, 0xc6, 0x69, 0x85, 0x63, 0xbf, 0xdb, 0xbb, 0x30, 0xa6, 0x09, 0xfa, 0xb0, 0x14, 0x32,
+		0x03, 0x52, 0x60, 0x71, 0xc0, 0xbc, 0xdc, 0xde, 0xa3, 0x4c, 0x79, 0x8e, 0xb7, 0x56, 0xe8, 0xd2,
+		0xae, 0x6d, 0x59, 0x1d, 0x9d, 0xcf, 0xf1, 0x2a, 0x14, 0x3d, 0x59, 0xf1, 0xd5, 0x94, 0xfd, 0xf2,
+		0x94, 0x8b, 0xa1, 0xb1, 0x90, 0x13, 0x9c, 0xe7, 0x40, 0x3e, 0xa7, 0xae, 0x25, 0x33, 0x31, 0x05,
+		0x1f, 0xfc, 0x8e, 0x2b, 0x09, 0x75, 0x13, 0x8e, 0x8d, 0x5c, 0x57, 0xc9, 0xb3, 0x90, 0xf5, 0x97,
+		0x64, 0x9e, 0xa6, 0x7f, 0x48, 0xa4, 0xc5, 0xc7, 0x55, 0x7f, 0x21, 0x06, 0xc7, 0x46, 0xae, 0xac,
+		0xa4, 0x0e, 0x69, 0x9e, 0x33, 0x29, 0xf2, 0x63, 0x9e, 0x98, 0x6c, 0x45, 0x5e, 0xe4, 0x09, 0x95,
+		0x9a, 0x20, 0x56, 0x3f, 0x08, 0x69, 0x0e, 0x21, 0x39, 0x98, 0xf6, 0xdf, 0x91, 0x06, 0x48, 0x57,
+		0x6b, 0xb5, 0xfa, 0xa6, 0x7c, 0x14, 0x76, 0x69, 0x43, 0xc3, 0x1f, 0x92, 0x03, 0x48, 0x6b, 0xf5,
+		0x6b, 0xf5, 0xda, 0x96, 0x92, 0xc0, 0x9f, 0x5e, 0xe3, 0x7f, 0xeb, 0x97, 0xf1, 0x57, 0x01, 0xb6,
+		0x94, 0x64, 0x00, 0xd4, 0xa8, 0xaf, 0x2f, 0xd7, 0x35, 0x25, 0xa5, 0x3e, 0x05, 0x27, 0x64, 0x3b,
+		0x86, 0x23, 0x42, 0x5e, 0x60, 0x26, 0x16, 0x08, 0xcc, 0xe0, 0x8b, 0xf2, 0xe5, 0xf1, 0x0b, 0x33,
+		0xb9, 0x36, 0xd0, 0xf1, 0xf3, 0x47, 0x58, 0xd5, 0x07, 0x7a, 0x8f, 0xfb, 0x98, 0x1e, 0xdd, 0xa5,
+		0x6e, 0x73, 0x9f, 0x3b, 0x0a, 0xdc, 0x02, 0x15, 0xb4, 0x82, 0x80, 0x32, 0x22, 0x87, 0xa3, 0xbd,
+		0x4a, 0x9b, 0xae, 0xce, 0x95, 0xc8, 0x11, 0x3f, 0x94, 0x5b, 0xe0, 0xd0, 0x06, 0x07, 0xaa, 0x5f,
+		0x7f, 0x24, 0x59, 0x66, 0x21, 0xa5, 0xd5, 0xf1, 0xe9, 0xff, 0x04, 0xfe, 0xbe, 0x1c, 0xfb, 0x53,
+		0x6f, 0xac, 0x57, 0x37, 0x1b, 0x57, 0x37, 0x50, 0x96, 0x73, 0x30, 0x23, 0x65, 0x29, 0x81, 0x29,
+		0xf5, 0x71, 0xb8, 0x6f, 0x8c, 0x57, 0x31, 0x22, 0x89, 0xf5, 0x93, 0xb1, 0x20, 0x76, 0xd8, 0x33,
+		0xd8, 0x80, 0xb4, 0xe3, 0x1a, 0x6e, 0xdf, 0x11, 0x42, 0x7c, 0x76, 0x52, 0x37, 0x63, 0x51, 0xfe,
+		0xd1, 0x60, 0xe4, 0x9a, 0x60, 0xa3, 0x5e, 0x80, 0x62, 0xb8, 0x64, 0xbc, 0x0c, 0x7c, 0x25, 0x8a,
+		0xab, 0x97, 0x80, 0x0c, 0x7b, 0x1f, 0x23, 0xb6, 0x97, 0xb1, 0x51, 0xdb, 0xcb, 0x1f, 0x89, 0xc1,
+		0xc9, 0x43, 0x3c, 0x0d, 0xf2, 0xe2, 0x40, 0x27, 0x9f, 0x3b, 0x8a, 0x9f, 0xb2, 0xc8, 0x61, 0x03,
+		0xdd, 0x7c, 0x1a, 0xf2, 0x41, 0xf8, 0x64, 0x9d, 0xfc, 0x52, 0x1c, 0x8e, 0x8d, 0x74, 0x5a, 0x02,
+		0x26, 0x30, 0xf6, 0x16, 0x4d, 0xe0, 0x7b, 0x01, 0xdc, 0xdb, 0x32, 0x8b, 0x5a, 0xac, 0xa3, 0x0f,
+		0x8c, 0x88, 0x2f, 0xd2, 0xe6, 0xd6, 0x6d, 0x31, 0x09, 0xb2, 0xae, 0xf8, 0x0b, 0xe3, 0x27, 0x81,
+		0xa0, 0x40, 0x9f, 0xad, 0xb1, 0x8e, 0xf7, 0x33, 0xb9, 0x93, 0x2d, 0xc6, 0xca, 0xcd, 0x30, 0xd8,
+		0x21, 0x2f, 0xc3, 0x7d, 0x03, 0x8e, 0x82, 0xc7, 0x3a, 0x39, 0xa9, 0xbf, 0x70, 0x2c, 0xec, 0x2f,
+		0x48, 0xd6, 0xc1, 0xd5, 0x3e, 0x15, 0x5e, 0xed, 0x5f, 0x06, 0xf0, 0x83, 0x03, 0x7e, 0xda, 0x5f,
+		0x2c, 0x98, 0xf6, 0x77, 0x01, 0x52, 0xa8, 0x49, 0x52, 0x4e, 0xc3, 0xa6, 0x18, 0x35, 0x21, 0x10,
+		0x5c, 0xe0, 0xd8, 0x6a, 0x1b, 0xc8, 0x70, 0x80, 0x76, 0x4c, 0x15, 0xef, 0x0b, 0x57, 0xf1, 0xe0,
+		0xd8, 0x50, 0xef, 0xe8, 0xaa, 0x5e, 0x87, 0x14, 0x1b, 0xf9, 0x91, 0x77, 0x29, 0xbf, 0x0e, 0xc0,
+		0x70, 0xdd, 0x5e, 0x7b, 0xa7, 0xef, 0x57, 0x70, 0x7a, 0xb4, 0xe6, 0x54, 0x25, 0xde, 0xd2, 0xfd,
+		0x42, 0x85, 0xe6, 0x7d, 0xd2, 0x80, 0x1a, 0x05, 0x18, 0xaa, 0xeb, 0x50, 0x0c, 0xd3, 0x8e, 0xbe,
+		0x1b, 0xea, 0x3f, 0xae, 0x92, 0x95, 0xfe, 0x8d, 0xe7, 0x1d, 0x89, 0x07, 0x8f, 0xd8, 0x87, 0xfa,
+		0xa1, 0x38, 0xe4, 0x83, 0x8a, 0xf7, 0x17, 0xcf, 0x05, 0x51, 0xbf, 0x29, 0x06, 0x19, 0xaf, 0xfb,
+		0x87, 0x24, 0x3b, 0xfb, 0x77, 0x84, 0xbd, 0xc3, 0x0b, 0x7e, 0x4c, 0x94, 0xf0, 0x8e, 0x89, 0x2e,
+		0x79, 0xcb, 0xdf, 0xb8, 0x80, 0x48, 0x50, 0xd6, 0x32, 0xe1, 0x50, 0xac, 0xf6, 0x97, 0x26, 0xbb,
+		0x8a, 0x87, 0x47, 0x58, 0x81, 0x6b, 0x74, 0xfc, 0x43, 0x6d, 0x05, 0x32, 0x8c, 0xf8, 0x44, 0x0c,
+		0xde, 0xd9, 0x8b, 0x1d, 0xf9, 0xce, 0x9e, 0x57, 0x4b, 0x3c, 0x58, 0xcb, 0x77, 0xc5, 0x20, 0x23,
+		0xe7, 0x04, 0x79, 0x7f, 0x30, 0xf3, 0x4c, 0x1e, 0x6d, 0x8e, 0xb5, 0x47, 0x82, 0xbf, 0x4f, 0x32,
+		0x9c, 0x70, 0x9d, 0x38, 0x6a, 0xc2, 0xb5, 0xf0, 0xec, 0xfe, 0x08, 0x7f, 0xca, 0x65, 0x60, 0xc6,
+		0xbe, 0xe5, 0xd6, 0x0d, 0x2f, 0x73, 0x89, 0x11, 0xcb, 0xdc, 0xb8, 0x2c, 0xea, 0xe4, 0xb8, 0x2c,
+		0xea, 0xe1, 0x5e, 0xa7, 0xee, 0xb1, 0xd7, 0x1f, 0x8e, 0x43, 0x2e, 0x10, 0x1e, 0x25, 0xcf, 0x84,
+		0x32, 0xb2, 0x17, 0x0e, 0x0b, 0xa5, 0x06, 0x52, 0xb2, 0x43, 0x62, 0x8a, 0x1f, 0x5d, 0x4c, 0x6f,
+		0xff, 0xed, 0xac, 0xd1, 0x17, 0x58, 0x53, 0x63, 0x2e, 0xb0, 0xfe, 0xd5, 0x18, 0x64, 0x3c, 0xb7,
+		0xfb, 0xa8, 0x87, 0x98, 0xc7, 0x21, 0x2d, 0x3c, 0x4b, 0x7e, 0x8a, 0x29, 0xbe, 0x46, 0x86, 0x95,
+		0xcb, 0x90, 0x91, 0xbf, 0x59, 0x28, 0x56, 0x35, 0xef, 0xfb, 0xec, 0x73, 0x90, 0x0b, 0x1c, 0x00,
+		0xa3, 0x69, 0x5c, 0xaf, 0x7f, 0x40, 0x99, 0x2a, 0x4f, 0xdf, 0xb9, 0xbb, 0x90, 0x58, 0xa7, 0xb7,
+		0x70, 0x36, 0x6b, 0xf5, 0xda, 0xd5, 0x7a, 0xed, 0x05, 0x25, 0x56, 0xce, 0xdd, 0xb9, 0xbb, 0x30,
+		0xad, 0x51, 0x16, 0x51, 0x3c, 0xfb, 0x02, 0xcc, 0x0c, 0x0c, 0x4c, 0xd8, 0x6d, 0x21, 0x50, 0x5c,
+		0xde, 0xde, 0x5c, 0x5d, 0xa9, 0x55, 0xb7, 0xea, 0x3a, 0xcf, 0xdb, 0xc5, 0x1f, 0x98, 0x59, 0x5d,
+		0xb9, 0x72, 0x75, 0x4b, 0xaf, 0xad, 0xae, 0xd4, 0xd7, 0xb7, 0xf4, 0xea, 0xd6, 0x56, 0xb5, 0xf6,
+		0x82, 0x12, 0x3f, 0x7f, 0x37, 0x07, 0x49, 0xcc, 0xce, 0x23, 0x35, 0x48, 0xb2, 0x50, 0xc8, 0xa1,
+		0x19, 0x60, 0xe5, 0xc3, 0x63, 0xc3, 0xe4, 0x32, 0xa4, 0x58, 0x94, 0x84, 0x1c, 0x9e, 0x12, 0x56,
+		0x8e, 0x08, 0x16, 0x63, 0x63, 0xd8, 0x8c, 0x3c, 0x34, 0x47, 0xac, 0x7c, 0x78, 0xec, 0x98, 0xac,
+		0xc2, 0xb4, 0xdc, 0x24, 0x47, 0x25, 0x6e, 0x95, 0x23, 0x03, 0xba, 0xd8, 0x35, 0x1e, 0x6c, 0x38,
+		0x3c, 0x7d, 0xac, 0x1c, 0x11, 0x55, 0xc6, 0x27, 0x34, 0xc4, 0x76, 0x34, 0x22, 0x23, 0xac, 0x1c,
+		0x15, 0x27, 0x26, 0x1a, 0x64, 0xfd, 0x30, 0x4e, 0x74, 0x52, 0x5c, 0x79, 0x82, 0x80, 0x39, 0xf9,
+		0x20, 0x14, 0xc2, 0x5b, 0xdd, 0xc9, 0xb2, 0xce, 0xca, 0x13, 0x46, 0xa4, 0x91, 0x7f, 0x78, 0xdf,
+		0x3b, 0x59, 0x16, 0x5a, 0x79, 0xc2, 0x00, 0x35, 0x79, 0x15, 0x66, 0x87, 0xf7, 0xa5, 0x93, 0x27,
+		0xa5, 0x95, 0x8f, 0x10, 0xb2, 0x26, 0x5d, 0x20, 0x23, 0xf6, 0xb3, 0x47, 0xc8, 0x51, 0x2b, 0x1f,
+		0x25, 0x82, 0x4d, 0x5a, 0x30, 0x33, 0xb8, 0x49, 0x9c, 0x34, 0x67, 0xad, 0x3c, 0x71, 0x34, 0x9b,
+		0xd7, 0x12, 0xde, 0x5c, 0x4e, 0x9a, 0xc3, 0x56, 0x9e, 0x38, 0xb8, 0x4d, 0xb6, 0x01, 0x02, 0xfb,
+		0xc3, 0x09, 0x72, 0xda, 0xca, 0x93, 0x84, 0xb9, 0x89, 0x0d, 0x73, 0xa3, 0x36, 0x8e, 0x47, 0x49,
+		0x71, 0x2b, 0x1f, 0x29, 0xfa, 0x8d, 0xfa, 0x1c, 0xde, 0x02, 0x4e, 0x96, 0xf2, 0x56, 0x9e, 0x30,
+		0x0c, 0xbe, 0x54, 0x1d, 0x9b, 0xe7, 0xfc, 0xe8, 0xa1, 0x79, 0xce, 0x7e, 0xe6, 0xb2, 0x97, 0xdb,
+		0xfc, 0xef, 0x9e, 0x83, 0x77, 0x89, 0x17, 0x74, 0x1c, 0xd7, 0xb8, 0xd1, 0x36, 0xf7, 0xbc, 0xa7,
+		0x8c, 0xc4, 0xb7, 0x48, 0x72, 0x3e, 0xce, 0xb1, 0x16, 0x25, 0x34, 0xe2, 0x41, 0xa3, 0xb1, 0xaf,
+		0x3c, 0x46, 0xdd, 0x87, 0x88, 0x4e, 0x5f, 0x3e, 0xe4, 0xb1, 0xa4, 0x88, 0x27, 0x99, 0x46, 0x3c,
+		0xa6, 0x54, 0x3e, 0xf4, 0x8d, 0x81, 0xf2, 0x61, 0x79, 0xdf, 0xea, 0xf7, 0xc6, 0xa0, 0x78, 0xb5,
+		0xed, 0xb8, 0x56, 0xaf, 0xdd, 0x34, 0x3a, 0x6c, 0x79, 0xb9, 0x34, 0xe9, 0xad, 0xac, 0xa5, 0x2c,
+		0xba, 0x2b, 0xe2, 0xbd, 0x25, 0x4e, 0x42, 0x96, 0x21, 0x7d, 0xd3, 0xe8, 0xf0, 0x3b, 0x51, 0xc1,
+		0xc7, 0xd6, 0x06, 0x65, 0x1e, 0xf0, 0xa3, 0x82, 0x5c, 0x38, 0x6d, 0x25, 0x5e, 0x8a, 0xa9, 0xdf,
+		0x1a, 0x03, 0xc5, 0x6f, 0x99, 0x46, 0x9b, 0x56, 0xaf, 0xc5, 0xb6, 0x02, 0xb6, 0x1d, 0xc8, 0x15,
+		0x90, 0x9f, 0xe4, 0x19, 0xe1, 0x64, 0xc5, 0x23, 0x9d, 0xac, 0x64, 0xc0, 0xc1, 0x1a, 0x71, 0xe5,
+		0x3c, 0x31, 0xea, 0xca, 0xb9, 0xfa, 0x3d, 0xec, 0x86, 0x4b, 0xb7, 0xdb, 0x76, 0x70, 0x76, 0x68,
+		0x6c, 0x73, 0x7f, 0x0d, 0x92, 0x3d, 0xc3, 0x15, 0x5b, 0xde, 0xa5, 0x8b, 0x47, 0x7e, 0x32, 0x8a,
+		0xf7, 0x99, 0xf1, 0x20, 0x2f, 0x02, 0xde, 0x90, 0xd0, 0x19, 0xbf, 0xf8, 0x5b, 0xe2, 0x87, 0x17,
+		0x45, 0xb0, 0x7d, 0xe4, 0x83, 0x80, 0xf7, 0x3c, 0xf0, 0x64, 0xd9, 0xdc, 0xa3, 0x9c, 0x73, 0xe2,
+		0x2d, 0x71, 0x2e, 0x74, 0x8d, 0xdb, 0x35, 0xc6, 0x0d, 0xf9, 0x8b, 0xa7, 0xb5, 0x7e, 0x29, 0x06,
+		0xe0, 0x0b, 0x86, 0x18, 0x78, 0xe8, 0x22, 0xbf, 0x58, 0xa5, 0xf2, 0xd0, 0xe5, 0xd1, 0x71, 0x9a,
+		0x30, 0x20, 0xd6, 0xa5, 0x02, 0x36, 0xef, 0x73, 0x6f, 0x9c, 0x8e, 0xf1, 0x5a, 0x67, 0x9a, 0x43,
+		0x62, 0xcf, 0xf1, 0xf0, 0x8c, 0x3e, 0xe1, 0x80, 0x17, 0xa4, 0x57, 0xcd, 0x19, 0x02, 0xa7, 0xc6,
+		0x72, 0xd1, 0x87, 0x4f, 0xc7, 0x20, 0xb7, 0x1c, 0x78, 0xcc, 0x11, 0x4f, 0xcd, 0x2c, 0xb3, 0x7d,
+		0x83, 0xf6, 0xbc, 0x53, 0x33, 0xfe, 0x89, 0x9e, 0x2f, 0xff, 0x4d, 0x40, 0xf7, 0x40, 0xbe, 0x77,
+		0x24, 0xbf, 0x91, 0xea, 0x16, 0xdd, 0x71, 0xda, 0x52, 0xce, 0x9a, 0xfc, 0xc4, 0x14, 0x08, 0x87,
+		0x36, 0xfb, 0x18, 0xcc, 0xc7, 0x9f, 0x03, 0x75, 0xf1, 0xb7, 0x7e, 0x79, 0x64, 0x60, 0x46, 0xc2,
+		0x6b, 0x1c, 0x8c, 0x4c, 0x5a, 0xd4, 0x35, 0xda, 0x1d, 0x9e, 0x2b, 0x9a, 0xd5, 0xe4, 0xa7, 0x68,
+		0xea, 0x9d, 0xe9, 0xe0, 0xce, 0xb8, 0x06, 0x8a, 0x65, 0xd3, 0x5e, 0x28, 0x45, 0x86, 0x6b, 0x63,
+		0xe9, 0xd7, 0x3e, 0xfb, 0xc4, 0xbc, 0x10, 0xb8, 0x48, 0xaf, 0xe0, 0xbf, 0x5d, 0xa0, 0xcd, 0x48,
+		0x0a, 0x01, 0x26, 0x2f, 0x87, 0xce, 0xc9, 0xfa, 0x3b, 0xfe, 0xc3, 0x36, 0xf3, 0x43, 0x42, 0xad,
+		0x9a, 0x07, 0x4b, 0xa5, 0x5f, 0xf5, 0x59, 0xfb, 0x3b, 0x67, 0x7c, 0x4a, 0x26, 0x70, 0x68, 0xc6,
+		0xd8, 0xe0, 0xa6, 0xe2, 0x55, 0xa3, 0xdd, 0x91, 0x3f, 0x9f, 0xaa, 0x89, 0x2f, 0x52, 0xf1, 0x02,
+		0x9e, 0x49, 0xb6, 0x43, 0x53, 0xc7, 0xe9, 0xc6, 0x92, 0x65, 0xb6, 0xc2, 0x91, 0x4d, 0x52, 0x83,
+		0xb4, 0x6b, 0xdd, 0xa0, 0xa6, 0x10, 0xd0, 0xd2, 0xe3, 0x47, 0x78, 0x1b, 0x4e, 0x13, 0xa4, 0xe4,
+		0x6b, 0x41, 0x69, 0xd1, 0x0e, 0xdd, 0x63, 0x92, 0xc3, 0xdf, 0x81, 0xa7, 0xfc, 0xfd, 0x80, 0x7b,
+		0x7a, 0xf9, 0x6d, 0xc6, 0x63, 0xd5, 0x60, 0x9c, 0xc8, 0x66, 0xf8, 0xb9, 0xd0, 0x69, 0x71, 0x9c,
+		0x3d, 0xa6, 0x8f, 0x01, 0xcd, 0x0b, 0xda, 0xc2, 0x20, 0x0b, 0xd4, 0xa0, 0xbe, 0xb9, 0x63, 0x99,
+		0xec, 0x57, 0x07, 0xc5, 0xbe, 0x2e, 0xc3, 0xcf, 0x4e, 0x3d, 0xb8, 0x38, 0x3b, 0xdd, 0x84, 0xa2,
+		0x8f, 0xca, 0x66, 0x48, 0xf6, 0xa8, 0x33, 0xa4, 0xe0, 0x31, 0x40, 0x14, 0xfc, 0x79, 0x42, 0x7f,
+		0x0e, 0x96, 0x40, 0xe4, 0xa8, 0x44, 0xce, 0xe6, 0x60, 0x67, 0x02, 0x0c, 0xf0, 0x9a, 0x45, 0xb7,
+		0x6d, 0xea, 0x0e, 0xed, 0xec, 0xea, 0x42, 0x72, 0xc8, 0x37, 0x77, 0xf4, 0xd1, 0x9c, 0xed, 0xb6,
+		0xcd, 0x06, 0xed, 0xec, 0x2e, 0x7b, 0x5c, 0xc8, 0x7b, 0xe1, 0xa4, 0xdf, 0x7b, 0xcb, 0xd4, 0xf7,
+		0xad, 0x4e, 0x4b, 0xef, 0xd1, 0x5d, 0xbd, 0xc9, 0x5e, 0xf7, 0xcb, 0x33, 0x99, 0xdd, 0xe7, 0xa1,
+		0x6c, 0x98, 0x57, 0xad, 0x4e, 0x4b, 0xa3, 0xbb, 0x35, 0x2c, 0xc6, 0x83, 0x34, 0x9f, 0xba, 0xdd,
+		0xc2, 0xac, 0x94, 0x04, 0xa6, 0xc6, 0x7b, 0xc0, 0x95, 0x96, 0x53, 0xc9, 0x7c, 0xf3, 0x27, 0x4e,
+		0x4f, 0x7d, 0xf1, 0x13, 0xa7, 0xa7, 0xd4, 0xcb, 0xec, 0x95, 0x2b, 0x31, 0x8f, 0xa8, 0x43, 0x2e,
+		0x42, 0xd6, 0x90, 0x1f, 0xfc, 0x0e, 0xdb, 0x21, 0xf3, 0xd0, 0x47, 0xc5, 0xeb, 0x7b, 0xe9, 0xe5,
+		0xeb, 0x9b, 0x46, 0xbb, 0x47, 0xea, 0xf8, 0xae, 0xa9, 0x54, 0xcc, 0x49, 0xa7, 0xb4, 0xaf, 0xcb,
+		0x72, 0x4e, 0xaf, 0x8f, 0x4b, 0x9e, 0xcb, 0x2e, 0x3d, 0xf8, 0x6b, 0x9f, 0x7d, 0xe2, 0x01, 0xc1,
+		0xe6, 0xfa, 0x40, 0x1e, 0x9d, 0xe4, 0x37, 0x98, 0x5f, 0x17, 0xe8, 0xf3, 0x35, 0x98, 0xe6, 0x4d,
+		0x75, 0xc8, 0xf3, 0x90, 0xb2, 0xf1, 0x8f, 0x52, 0x2c, 0xf4, 0xd4, 0xe9, 0xb0, 0x82, 0x33, 0xfc,
+		0xa0, 0x3a, 0x70, 0x3a, 0xf5, 0x5b, 0xe3, 0x00, 0xcb, 0xd7, 0xaf, 0x6f, 0xf5, 0xda, 0x76, 0x87,
+		0xba, 0x6f, 0x57, 0xdf, 0xb7, 0xe1, 0x98, 0xdf, 0x77, 0xa7, 0xd7, 0x3c, 0x7a, 0xff, 0xe7, 0xfc,
+		0x8b, 0xda, 0xbd, 0xe6, 0x48, 0xb6, 0x2d, 0xc7, 0xf5, 0xd8, 0x26, 0x8e, 0xce, 0x76, 0xd9, 0x71,
+		0x87, 0x25, 0xfb, 0x12, 0xe4, 0x7c, 0x61, 0x38, 0x64, 0x05, 0x32, 0xae, 0xf8, 0x5b, 0x08, 0x58,
+		0x1d, 0x2f, 0x60, 0x49, 0x16, 0x14, 0xb2, 0x47, 0xae, 0xfe, 0x49, 0x0c, 0x20, 0x30, 0x47, 0xfe,
+		0x6c, 0xea, 0x18, 0x06, 0x10, 0x84, 0x25, 0x4e, 0xdc, 0xf3, 0x1b, 0x9c, 0x9c, 0x41, 0x40, 0xa8,
+		0xdf, 0x1e, 0xc7, 0xdf, 0x0d, 0x16, 0xb3, 0xf7, 0xcf, 0xbe, 0x0c, 0xb6, 0x61, 0x9a, 0x9a, 0x6e,
+		0xaf, 0xed, 0x9d, 0x6a, 0x3d, 0x39, 0x6e, 0xcc, 0x47, 0x74, 0xaa, 0x6e, 0xba, 0xbd, 0x83, 0xa0,
+		0x06, 0x48, 0x5e, 0x01, 0x79, 0x7c, 0x2c, 0x01, 0xa5, 0x71, 0xa4, 0xe8, 0x0d, 0x37, 0x7b, 0x94,
+		0x01, 0xc2, 0x97, 0x56, 0x8b, 0x12, 0x2c, 0xd6, 0x18, 0x0d, 0xd0, 0x2b, 0x43, 0xe5, 0x42, 0xd4,
+		0x7b, 0x73, 0xc3, 0x8a, 0x3e, 0x07, 0xb6, 0xca, 0x6c, 0xc1, 0x8c, 0xbc, 0x05, 0xb3, 0x63, 0x74,
+		0x0c, 0xbc, 0x01, 0x9e, 0x38, 0xfa, 0x92, 0x20, 0x6f, 0xd2, 0x2c, 0x71, 0x16, 0xa4, 0x0e, 0xd3,
+		0x92, 0x5b, 0xf2, 0xe8, 0xdc, 0x24, 0x2d, 0xde, 0x76, 0x0a, 0x2e, 0x0c, 0xcc, 0xf5, 0x48, 0x6a,
+		0x39, 0x0f, 0xb6, 0xd2, 0x8a, 0x5a, 0x79, 0xd2, 0x87, 0xae, 0x3c, 0xc2, 0xbb, 0xfb, 0x41, 0x96,
+		0xac, 0xde, 0xfa, 0xf3, 0x3f, 0x2c, 0x9b, 0x00, 0x7c, 0xaa, 0xa2, 0x25, 0x2d, 0x25, 0xef, 0x75,
+		0xbe, 0x67, 0x39, 0x93, 0x65, 0xc7, 0xfd, 0x6a, 0x8d, 0xd0, 0x6f, 0xc4, 0x21, 0x1f, 0x1c, 0xa1,
+		0xbf, 0x90, 0x8b, 0x16, 0x59, 0xf7, 0xcd, 0x14, 0xbf, 0xd0, 0xf3, 0xd8, 0x38, 0x33, 0x35, 0xa4,
+		0xcd, 0x11, 0xf6, 0xe9, 0x33, 0xd3, 0x90, 0x16, 0x19, 0x77, 0x1b, 0x43, 0x8e, 0x6c, 0x2c, 0xea,
+		0x49, 0x82, 0x82, 0x7c, 0x92, 0x60, 0xa4, 0x1f, 0xfb, 0x30, 0xe0, 0x43, 0x05, 0x7a, 0x28, 0x8d,
+		0x0f, 0x8f, 0x3a, 0x70, 0x5f, 0xeb, 0xf5, 0xde, 0xc1, 0x4c, 0x46, 0x44, 0xf3, 0xed, 0x30, 0xe2,
+		0xe0, 0xdd, 0xa9, 0x3a, 0x87, 0x90, 0x27, 0x80, 0xec, 0x7b, 0x81, 0x09, 0xdd, 0x17, 0x04, 0xe2,
+		0xcd, 0xfa, 0x25, 0x12, 0x1d, 0xdf, 0x64, 0xb1, 0xcc, 0x96, 0xce, 0x9f, 0x9d, 0x16, 0x8f, 0x7a,
+		0x23, 0x64, 0x19, 0x01, 0xe4, 0x1b, 0x63, 0xdc, 0x1f, 0x1e, 0xd8, 0x36, 0x8b, 0xed, 0xc8, 0xd6,
+		0x04, 0x93, 0xe2, 0x0f, 0xdf, 0x38, 0x5d, 0x3e, 0x30, 0xba, 0x9d, 0x8a, 0x3a, 0x82, 0x8f, 0x3a,
+		0x6a, 0x27, 0x8f, 0x8e, 0x73, 0x78, 0xdb, 0x4d, 0x56, 0x40, 0xc1, 0xf7, 0x17, 0x7a, 0xe2, 0x37,
+		0xb9, 0xf5, 0x5d, 0x4a, 0xc5, 0xc6, 0xe5, 0xc4, 0xe2, 0x88, 0x47, 0xc0, 0x17, 0xf1, 0x31, 0x6d,
+		0x71, 0x04, 0x56, 0xbc, 0x41, 0x0f, 0x34, 0x41, 0x77, 0x99, 0x52, 0xf2, 0x6a, 0x50, 0xe9, 0x58,
+		0xd7, 0x77, 0x8d, 0x26, 0x9e, 0xa9, 0x65, 0xde, 0x52, 0xf8, 0xc1, 0xd7, 0x44, 0xdc, 0x15, 0x5e,
+		0x66, 0x2c, 0xc9, 0x6b, 0x70, 0x62, 0xaf, 0x63, 0xed, 0xe0, 0xb5, 0xb5, 0xf6, 0x6b, 0xfd, 0x76,
+		0x4b, 0x17, 0x0a, 0xa8, 0x37, 0x0d, 0xbb, 0x94, 0x7d, 0x4b, 0xf5, 0x1d, 0xe7, 0x8c, 0x57, 0x19,
+		0xdf, 0x06, 0x67, 0x5b, 0x33, 0x6c, 0x72, 0x0b, 0xee, 0xf7, 0xbb, 0x37, 0xa2, 0x56, 0x78, 0x4b,
+		0xb5, 0x9e, 0xf0, 0x78, 0x0f, 0x55, 0xfc, 0x1a, 0x9c, 0x08, 0xe9, 0x2f, 0x3f, 0x0e, 0xd4, 0x99,
+		0xee, 0x97, 0x72, 0x6f, 0xa9, 0xd6, 0xe3, 0xc1, 0x29, 0xc0, 0x4e, 0x13, 0x35, 0xe4, 0x5a, 0x79,
+		0x17, 0x1a, 0xbd, 0x3b, 0xbf, 0xf3, 0x13, 0x67, 0x4f, 0x06, 0xde, 0x26, 0xbf, 0xed, 0x85, 0x59,
+		0xf9, 0x4c, 0x55, 0xff, 0x69, 0x0c, 0xe6, 0xb6, 0x70, 0x63, 0xdd, 0x7e, 0x9d, 0xb2, 0x2d, 0xb0,
+		0x88, 0xd6, 0x15, 0x21, 0x2e, 0x9e, 0x33, 0x4a, 0x6a, 0xf1, 0x76, 0x0b, 0x5f, 0xfc, 0xb1, 0x6e,
+		0x99, 0xe2, 0x20, 0xfd, 0x30, 0xfb, 0xc8, 0xd1, 0xd8, 0x84, 0xb5, 0x5a, 0xfd, 0x0e, 0xd5, 0x8d,
+		0x26, 0xb7, 0xd1, 0x3c, 0xb0, 0x52, 0xe0, 0xd0, 0x2a, 0x07, 0x86, 0x9f, 0x7d, 0x49, 0x4e, 0x6a,
+		0xd8, 0x7c, 0x1a, 0x61, 0xda, 0x7f, 0x2c, 0x06, 0xc4, 0xf7, 0x88, 0xbc, 0x7b, 0x28, 0x6b, 0x00,
+		0x81, 0x5d, 0x6a, 0xec, 0xf0, 0xdd, 0xaf, 0x4f, 0x1f, 0xda, 0xfd, 0x06, 0xd6, 0x8b, 0xf7, 0xfb,
+		0x0e, 0x49, 0x3c, 0x6a, 0x7a, 0x05, 0x4d, 0xa5, 0x20, 0x62, 0x6d, 0x9d, 0x52, 0xff, 0x75, 0x0c,
+		0xd3, 0x15, 0x07, 0x4c, 0xab, 0xd7, 0xe4, 0x26, 0x90, 0x5e, 0xa0, 0x90, 0x99, 0x28, 0x99, 0xf8,
+		0x70, 0x6f, 0x96, 0x7a, 0xb6, 0x37, 0x58, 0xfa, 0x36, 0x79, 0x56, 0x42, 0xf6, 0xbf, 0x12, 0xc3,
+		0x0b, 0x58, 0xad, 0x61, 0xe9, 0x37, 0x20, 0x1f, 0xac, 0x5a, 0x74, 0xe2, 0x5d, 0x93, 0x74, 0x22,
+		0xd8, 0xfe, 0x10, 0x13, 0x72, 0xdd, 0x5f, 0xbe, 0x78, 0x94, 0xfa, 0xa9, 0x89, 0x85, 0x22, 0x1b,
+		0x36, 0x72, 0x19, 0xe3, 0x63, 0xf3, 0xbb, 0x31, 0x48, 0x6e, 0x5a, 0x56, 0x87, 0xbc, 0x06, 0xb3,
+		0xa6, 0xe5, 0x32, 0x0b, 0x48, 0x5b, 0xba, 0x08, 0x5a, 0x71, 0xd7, 0xa0, 0x7e, 0xa8, 0xac, 0xbe,
+		0xf4, 0xc6, 0xe9, 0x61, 0xca, 0x51, 0xbf, 0x72, 0x30, 0x63, 0x5a, 0xee, 0x12, 0x43, 0x62, 0xd3,
+		0xcf, 0x21, 0xbb, 0x50, 0x08, 0x57, 0xc7, 0x67, 0x5a, 0x35, 0xaa, 0xba, 0x42, 0x64, 0x55, 0xf9,
+		0x9d, 0x40, 0x3d, 0xfc, 0xd1, 0xf2, 0xdf, 0xc7, 0x91, 0x7b, 0x19, 0x94, 0xeb, 0x83, 0x09, 0x75,
+		0x75, 0x98, 0x96, 0x09, 0x74, 0xb1, 0x09, 0x73, 0xf3, 0x82, 0xe2, 0x14, 0xb4, 0xea, 0xe7, 0xe2,
+		0x70, 0x02, 0xf3, 0xec, 0x44, 0x74, 0x51, 0xac, 0x30, 0xfc, 0x4c, 0xe0, 0x00, 0x43, 0x62, 0x23,
+		0x63, 0x9f, 0xf9, 0xe1, 0x08, 0xe7, 0x75, 0x98, 0x41, 0x57, 0x0f, 0xa3, 0x93, 0x6f, 0x2d, 0xc0,
+		0x59, 0xb0, 0x3a, 0x2d, 0xd1, 0x22, 0x0c, 0x6f, 0x5e, 0x87, 0x19, 0x93, 0xde, 0x0a, 0xf1, 0x4d,
+		0xdc, 0x1b, 0x5f, 0x93, 0xde, 0x0a, 0xf0, 0xf5, 0x73, 0x37, 0x92, 0xa1, 0xdc, 0x8d, 0x8b, 0x90,
+		0xc0, 0x65, 0x39, 0x75, 0x04, 0xbb, 0x81, 0x04, 0x01, 0xf7, 0xaa, 0x01, 0x27, 0x44, 0xc4, 0xca,
+		0xd9, 0xd8, 0x65, 0x12, 0xa5, 0xac, 0x43, 0x2f, 0xd0, 0x83, 0x11, 0xe1, 0xab, 0xfc, 0x44, 0xe1,
+		0xab, 0xb3, 0x3f, 0x1d, 0x03, 0xf0, 0x03, 0xb5, 0xf8, 0xce, 0xda, 0xd2, 0xc6, 0xfa, 0xb2, 0xde,
+		0xd8, 0xaa, 0x6e, 0x6d, 0x37, 0xc2, 0xbf, 0x61, 0x24, 0x5f, 0x65, 0x73, 0x6c, 0xda, 0x6c, 0xef,
+		0xb6, 0x69, 0x8b, 0x3c, 0x02, 0xf3, 0x61, 0x6c, 0xfc, 0xaa, 0x2f, 0x2b, 0xb1, 0x72, 0xfe, 0xce,
+		0xdd, 0x85, 0x0c, 0xdf, 0xab, 0x52, 0x7c, 0x63, 0xf8, 0xd8, 0x30, 0x1e, 0xfe, 0xfe, 0x51, 0xbc,
+		0x5c, 0xb8, 0x73, 0x77, 0x21, 0xeb, 0x6d, 0x6a, 0x89, 0x0a, 0x24, 0x88, 0x29, 0xf8, 0x25, 0xca,
+		0x70, 0xe7, 0xee, 0x42, 0x9a, 0xcf, 0x96, 0x72, 0x12, 0xdf, 0x5e, 0x3b, 0xfb, 0x75, 0x00, 0x2b,
+		0xe6, 0x6e, 0xcf, 0x68, 0x32, 0xab, 0x50, 0x86, 0xe3, 0x2b, 0xeb, 0x97, 0xb5, 0x6a, 0x6d, 0x6b,
+		0x65, 0x63, 0x3d, 0xdc, 0xec, 0x81, 0xb2, 0xe5, 0x8d, 0xed, 0xa5, 0xd5, 0xba, 0x8e, 0xaf, 0xbd,
+		0xf1, 0x14, 0x94, 0x50, 0xd9, 0x07, 0xd6, 0xb7, 0x56, 0xd6, 0xea, 0x4a, 0x7c, 0xe9, 0xe2, 0xd8,
+		0x23, 0xce, 0xfb, 0x43, 0xf3, 0xd0, 0x5f, 0x4f, 0x43, 0xe7, 0x9a, 0xff, 0x77, 0x00, 0x1b, 0x75,
+		0x9c, 0x29, 0xbe, 0xa5, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2524,6 +2634,51 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.MaxValidatorPowerRatio.Equal(that1.MaxValidatorPowerRatio) {
+		return false
+	}
+	return true
+}
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3464,6 +3619,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxValidatorPowerRatio.Size()
+		i -= size
+		if _, err := m.MaxValidatorPowerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3517,6 +3712,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4210,6 +4454,38 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxValidatorPowerRatio.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -7172,6 +7448,307 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorPowerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])